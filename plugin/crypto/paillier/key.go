@@ -0,0 +1,212 @@
+package paillier
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+
+	"github.com/33cn/chain33/common"
+)
+
+var one = big.NewInt(1)
+
+// PublicKey paillier公钥，g固定为n+1
+type PublicKey struct {
+	N       *big.Int
+	G       *big.Int
+	NSquare *big.Int
+}
+
+// PrivateKey paillier私钥
+type PrivateKey struct {
+	PublicKey
+	Lambda *big.Int
+	Mu     *big.Int
+}
+
+// GenerateKey 生成bits位模数n的密钥对
+func GenerateKey(random io.Reader, bits int) (*PrivateKey, error) {
+	if bits < 64 || bits%2 != 0 || bits > math.MaxInt16*8 {
+		return nil, fmt.Errorf("GenerateKey. error bits:%d", bits)
+	}
+	if random == nil {
+		random = rand.Reader
+	}
+	for {
+		p, err := rand.Prime(random, bits/2)
+		if err != nil {
+			return nil, fmt.Errorf("GenerateKey.Prime. error:%v", err)
+		}
+		q, err := rand.Prime(random, bits/2)
+		if err != nil {
+			return nil, fmt.Errorf("GenerateKey.Prime. error:%v", err)
+		}
+		n := new(big.Int).Mul(p, q)
+		if p.Cmp(q) == 0 || n.BitLen() != bits {
+			continue
+		}
+		pminus := new(big.Int).Sub(p, one)
+		qminus := new(big.Int).Sub(q, one)
+		// lambda = lcm(p-1, q-1)
+		gcd := new(big.Int).GCD(nil, nil, pminus, qminus)
+		lambda := new(big.Int).Mul(pminus, qminus)
+		lambda.Div(lambda, gcd)
+		priv, err := newPrivateKey(n, lambda)
+		if err != nil {
+			continue
+		}
+		return priv, nil
+	}
+}
+
+func newPublicKey(n *big.Int) *PublicKey {
+	return &PublicKey{
+		N:       n,
+		G:       new(big.Int).Add(n, one),
+		NSquare: new(big.Int).Mul(n, n),
+	}
+}
+
+func newPrivateKey(n, lambda *big.Int) (*PrivateKey, error) {
+	// g = n+1时, L(g^lambda mod n^2) = lambda mod n, mu = lambda^-1 mod n
+	mu := new(big.Int).ModInverse(lambda, n)
+	if mu == nil {
+		return nil, fmt.Errorf("newPrivateKey. error: lambda is not invertible")
+	}
+	return &PrivateKey{PublicKey: *newPublicKey(n), Lambda: lambda, Mu: mu}, nil
+}
+
+// Bytes 公钥序列化为[2字节nlen][n]，与密文的前缀一致
+func (pub *PublicKey) Bytes() []byte {
+	nBytes := pub.N.Bytes()
+	data := make([]byte, 2+len(nBytes))
+	copy(data[:2], intToBytes(len(nBytes)))
+	copy(data[2:], nBytes)
+	return data
+}
+
+// Bytes 私钥序列化为[2字节nlen][n][lambda]
+func (priv *PrivateKey) Bytes() []byte {
+	return append(priv.PublicKey.Bytes(), priv.Lambda.Bytes()...)
+}
+
+// ParsePublicKey 解析公钥，也可以直接传入密文，从密文前缀中解析公钥
+func ParsePublicKey(data []byte) (*PublicKey, error) {
+	nBytes, _, err := splitBytes(data)
+	if err != nil {
+		return nil, fmt.Errorf("ParsePublicKey. error:%v", err)
+	}
+	return newPublicKey(new(big.Int).SetBytes(nBytes)), nil
+}
+
+// ParsePrivateKey 解析私钥
+func ParsePrivateKey(data []byte) (*PrivateKey, error) {
+	nBytes, lambdaBytes, err := splitBytes(data)
+	if err != nil || len(lambdaBytes) == 0 {
+		return nil, fmt.Errorf("ParsePrivateKey. error param length")
+	}
+	return newPrivateKey(new(big.Int).SetBytes(nBytes), new(big.Int).SetBytes(lambdaBytes))
+}
+
+// Encrypt 加密明文m(0<=m<n)，返回[2字节nlen][n][ciphertext]
+func (pub *PublicKey) Encrypt(m *big.Int) ([]byte, error) {
+	if m.Sign() < 0 || m.Cmp(pub.N) >= 0 {
+		return nil, fmt.Errorf("Encrypt. error: plaintext out of range")
+	}
+	r, err := randomUnit(pub.N)
+	if err != nil {
+		return nil, fmt.Errorf("Encrypt.randomUnit. error:%v", err)
+	}
+	// c = g^m * r^n mod n^2, g = n+1 => g^m = 1 + m*n mod n^2
+	c := new(big.Int).Mul(m, pub.N)
+	c.Add(c, one).Mod(c, pub.NSquare)
+	c.Mul(c, new(big.Int).Exp(r, pub.N, pub.NSquare)).Mod(c, pub.NSquare)
+
+	return append(pub.Bytes(), c.Bytes()...), nil
+}
+
+// Decrypt 解密[2字节nlen][n][ciphertext]格式的密文
+func (priv *PrivateKey) Decrypt(cipherbytes []byte) (*big.Int, error) {
+	nBytes, data, err := splitBytes(cipherbytes)
+	if err != nil {
+		return nil, fmt.Errorf("Decrypt. error:%v", err)
+	}
+	if new(big.Int).SetBytes(nBytes).Cmp(priv.N) != 0 {
+		return nil, fmt.Errorf("Decrypt. error: ciphertext is not encrypted by this key")
+	}
+	c := new(big.Int).SetBytes(data)
+	if c.Sign() <= 0 || c.Cmp(priv.NSquare) >= 0 {
+		return nil, fmt.Errorf("Decrypt. error: ciphertext out of range")
+	}
+	// m = L(c^lambda mod n^2) * mu mod n, L(x) = (x-1)/n
+	m := new(big.Int).Exp(c, priv.Lambda, priv.NSquare)
+	m.Sub(m, one).Div(m, priv.N)
+	m.Mul(m, priv.Mu).Mod(m, priv.N)
+	return m, nil
+}
+
+// EncryptHex 使用hex格式的公钥加密，返回hex格式的密文
+func EncryptHex(pubKey string, m *big.Int) (string, error) {
+	data, err := common.FromHex(pubKey)
+	if err != nil {
+		return "", fmt.Errorf("EncryptHex.FromHex. pubKey:%s, error:%v", pubKey, err)
+	}
+	pub, err := ParsePublicKey(data)
+	if err != nil {
+		return "", err
+	}
+	res, err := pub.Encrypt(m)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(res), nil
+}
+
+// DecryptHex 使用hex格式的私钥解密hex格式的密文
+func DecryptHex(privKey, ciphertext string) (*big.Int, error) {
+	data, err := common.FromHex(privKey)
+	if err != nil {
+		return nil, fmt.Errorf("DecryptHex.FromHex. error:%v", err)
+	}
+	priv, err := ParsePrivateKey(data)
+	if err != nil {
+		return nil, err
+	}
+	cipherbytes, err := common.FromHex(ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("DecryptHex.FromHex. ciphertext:%s, error:%v", ciphertext, err)
+	}
+	return priv.Decrypt(cipherbytes)
+}
+
+// randomUnit 随机选取与n互素的r, 0<r<n
+func randomUnit(n *big.Int) (*big.Int, error) {
+	for {
+		r, err := rand.Int(rand.Reader, n)
+		if err != nil {
+			return nil, err
+		}
+		if r.Sign() > 0 && new(big.Int).GCD(nil, nil, r, n).Cmp(one) == 0 {
+			return r, nil
+		}
+	}
+}
+
+// splitBytes 拆分[2字节nlen][n][data]
+func splitBytes(data []byte) ([]byte, []byte, error) {
+	if len(data) < 2 {
+		return nil, nil, fmt.Errorf("error param length")
+	}
+	nlen := bytesToInt(data[0:2])
+	if nlen <= 0 || nlen > len(data)-2 {
+		return nil, nil, fmt.Errorf("error param length")
+	}
+	return data[2 : 2+nlen], data[2+nlen:], nil
+}
+
+func intToBytes(n int) []byte {
+	return []byte{byte(n >> 8), byte(n)}
+}
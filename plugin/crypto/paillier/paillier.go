@@ -72,6 +72,42 @@ func CiphertextAddBytes(cipherbytes1, cipherbytes2 []byte) ([]byte, error) {
 	return data, nil
 }
 
+// CiphertextMul 密文与明文常数k相乘，解密结果为m*k mod n
+func CiphertextMul(ciphertext string, k *big.Int) (string, error) {
+	cipherbytes, err := common.FromHex(ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("CiphertextMul.FromHex. ciphertext:%s, error:%v", ciphertext, err)
+	}
+
+	res, err := CiphertextMulBytes(cipherbytes, k)
+	if err != nil {
+		return "", fmt.Errorf("CiphertextMul.CiphertextMulBytes. error:%v", err)
+	}
+
+	return hex.EncodeToString(res), nil
+}
+
+// CiphertextMulBytes 密文与明文常数k相乘，c^k mod n^2
+func CiphertextMulBytes(cipherbytes []byte, k *big.Int) ([]byte, error) {
+	if k.Sign() < 0 {
+		return nil, fmt.Errorf("CiphertextMulBytes. error: negative scalar")
+	}
+	nBytes, data, err := splitBytes(cipherbytes)
+	if err != nil || len(data) == 0 {
+		return nil, fmt.Errorf("CiphertextMulBytes. error param length")
+	}
+
+	n := new(big.Int).SetBytes(nBytes)
+	nsquare := new(big.Int).Mul(n, n)
+	res := new(big.Int).Exp(new(big.Int).SetBytes(data), k, nsquare)
+
+	out := make([]byte, len(nBytes)+2+len(res.Bytes()))
+	copy(out[:len(nBytes)+2], cipherbytes[:len(nBytes)+2])
+	copy(out[len(nBytes)+2:], res.Bytes())
+
+	return out, nil
+}
+
 func bytesToInt(cipherbytes []byte) int {
 	bytebuff := bytes.NewBuffer(cipherbytes)
 	var data int16
@@ -1,6 +1,9 @@
 package paillier
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -17,3 +20,71 @@ func TestCiphertextAdd(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, c3, data)
 }
+
+func TestEncryptDecrypt(t *testing.T) {
+	priv, err := GenerateKey(rand.Reader, 512)
+	assert.Nil(t, err)
+
+	m1 := big.NewInt(100)
+	m2 := big.NewInt(23)
+	c1, err := priv.Encrypt(m1)
+	assert.Nil(t, err)
+	c2, err := priv.Encrypt(m2)
+	assert.Nil(t, err)
+	assert.Equal(t, priv.PublicKey.Bytes(), c1[:len(priv.PublicKey.Bytes())])
+
+	m, err := priv.Decrypt(c1)
+	assert.Nil(t, err)
+	assert.Equal(t, m1, m)
+
+	// 同态加法和常数乘法
+	sum, err := CiphertextAddBytes(c1, c2)
+	assert.Nil(t, err)
+	m, err = priv.Decrypt(sum)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(123), m)
+
+	product, err := CiphertextMulBytes(sum, big.NewInt(3))
+	assert.Nil(t, err)
+	m, err = priv.Decrypt(product)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(369), m)
+	_, err = CiphertextMulBytes(sum, big.NewInt(-1))
+	assert.NotNil(t, err)
+
+	_, err = priv.Encrypt(priv.N)
+	assert.NotNil(t, err)
+	_, err = priv.Encrypt(big.NewInt(-1))
+	assert.NotNil(t, err)
+
+	other, err := GenerateKey(rand.Reader, 512)
+	assert.Nil(t, err)
+	_, err = other.Decrypt(c1)
+	assert.NotNil(t, err)
+}
+
+func TestKeySerialize(t *testing.T) {
+	priv, err := GenerateKey(rand.Reader, 256)
+	assert.Nil(t, err)
+
+	priv2, err := ParsePrivateKey(priv.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, priv.N, priv2.N)
+	assert.Equal(t, priv.Mu, priv2.Mu)
+	pub, err := ParsePublicKey(priv.PublicKey.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, priv.NSquare, pub.NSquare)
+
+	ciphertext, err := EncryptHex(hex.EncodeToString(pub.Bytes()), big.NewInt(42))
+	assert.Nil(t, err)
+	ciphertext, err = CiphertextAdd(ciphertext, ciphertext)
+	assert.Nil(t, err)
+	m, err := DecryptHex(hex.EncodeToString(priv.Bytes()), ciphertext)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(84), m)
+
+	_, err = ParsePrivateKey(pub.Bytes())
+	assert.NotNil(t, err)
+	_, err = GenerateKey(rand.Reader, 63)
+	assert.NotNil(t, err)
+}
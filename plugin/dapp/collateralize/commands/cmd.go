@@ -2,6 +2,7 @@ package commands
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 
 	jsonrpc "github.com/33cn/chain33/rpc/jsonclient"
@@ -47,6 +48,8 @@ func CollateralizeCreateRawTxCmd() *cobra.Command {
 func addCollateralizeCreateFlags(cmd *cobra.Command) {
 	cmd.Flags().Float64P("balance", "b", 0, "balance")
 	cmd.MarkFlagRequired("balance")
+	cmd.Flags().StringP("assetExec", "e", "", "collateral asset exec, default bty")
+	cmd.Flags().StringP("assetSymbol", "y", "", "collateral asset symbol, default bty")
 }
 
 //CollateralizeCreate ...
@@ -54,11 +57,13 @@ func CollateralizeCreate(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	paraName, _ := cmd.Flags().GetString("paraName")
 	balance, _ := cmd.Flags().GetFloat64("balance")
+	assetExec, _ := cmd.Flags().GetString("assetExec")
+	assetSymbol, _ := cmd.Flags().GetString("assetSymbol")
 
 	params := &rpctypes.CreateTxIn{
 		Execer:     types.GetExecName(pkt.CollateralizeX, paraName),
 		ActionName: "CollateralizeCreate",
-		Payload:    []byte(fmt.Sprintf("{\"totalBalance\":%f}", balance)),
+		Payload:    []byte(fmt.Sprintf("{\"totalBalance\":%f, \"assetExec\":\"%s\", \"assetSymbol\":\"%s\"}", balance, assetExec, assetSymbol)),
 	}
 
 	var res string
@@ -157,6 +162,7 @@ func addCollateralizeRepayFlags(cmd *cobra.Command) {
 	cmd.MarkFlagRequired("collateralizeID")
 	cmd.Flags().StringP("recordID", "r", "", "recordID")
 	cmd.MarkFlagRequired("recordID")
+	cmd.Flags().Float64P("value", "v", 0, "repay value, stability fee first, 0 for all")
 }
 
 //CollateralizeRepay ...
@@ -165,11 +171,12 @@ func CollateralizeRepay(cmd *cobra.Command, args []string) {
 	paraName, _ := cmd.Flags().GetString("paraName")
 	collateralizeID, _ := cmd.Flags().GetString("collateralizeID")
 	recordID, _ := cmd.Flags().GetString("recordID")
+	value, _ := cmd.Flags().GetFloat64("value")
 
 	params := &rpctypes.CreateTxIn{
 		Execer:     types.GetExecName(pkt.CollateralizeX, paraName),
 		ActionName: "CollateralizeRepay",
-		Payload:    []byte(fmt.Sprintf("{\"collateralizeID\":\"%s\",\"recordID\":\"%s\"}", collateralizeID, recordID)),
+		Payload:    []byte(fmt.Sprintf("{\"collateralizeID\":\"%s\",\"recordID\":\"%s\",\"value\":%f}", collateralizeID, recordID, value)),
 	}
 
 	var res string
@@ -190,9 +197,10 @@ func CollateralizePriceFeedRawTxCmd() *cobra.Command {
 
 func addCollateralizePriceFeedFlags(cmd *cobra.Command) {
 	cmd.Flags().Float64P("price", "p", 0, "price")
-	cmd.MarkFlagRequired("price")
 	cmd.Flags().Uint64P("volume", "v", 0, "volume")
-	cmd.MarkFlagRequired("volume")
+	cmd.Flags().StringP("assetExec", "e", "", "collateral asset exec, default bty")
+	cmd.Flags().StringP("assetSymbol", "y", "", "collateral asset symbol, default bty")
+	cmd.Flags().StringP("oracle", "o", "", "oracle price event id (type price, subType exec.symbol/CCNY, result is price*1e4), use the published result as price instead of price/volume")
 }
 
 //CollateralizePriceFeed ...
@@ -201,11 +209,24 @@ func CollateralizePriceFeed(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	price, _ := cmd.Flags().GetFloat64("price")
 	volume, _ := cmd.Flags().GetUint64("volume")
+	assetExec, _ := cmd.Flags().GetString("assetExec")
+	assetSymbol, _ := cmd.Flags().GetString("assetSymbol")
+	oracleEventID, _ := cmd.Flags().GetString("oracle")
+
+	payload := fmt.Sprintf("{\"price\":[ %f ], \"volume\":[ %d ], \"assetExec\":\"%s\", \"assetSymbol\":\"%s\"}",
+		price, volume, assetExec, assetSymbol)
+	if oracleEventID != "" {
+		payload = fmt.Sprintf("{\"oracleEventId\":\"%s\", \"assetExec\":\"%s\", \"assetSymbol\":\"%s\"}",
+			oracleEventID, assetExec, assetSymbol)
+	} else if price == 0 || volume == 0 {
+		fmt.Fprintln(os.Stderr, "price and volume required if oracle event id not set")
+		return
+	}
 
 	params := &rpctypes.CreateTxIn{
 		Execer:     types.GetExecName(pkt.CollateralizeX, paraName),
 		ActionName: "CollateralizePriceFeed",
-		Payload:    []byte(fmt.Sprintf("{\"price\":[ %f ], \"volume\":[ %d ]}", price, volume)),
+		Payload:    []byte(payload),
 	}
 
 	var res string
@@ -266,6 +287,11 @@ func addCollateralizeManageFlags(cmd *cobra.Command) {
 	cmd.Flags().Float64P("stabilityFeeRatio", "s", 0, "stabilityFeeRatio")
 	cmd.Flags().Uint64P("period", "p", 0, "period")
 	cmd.Flags().Float64P("totalBalance", "t", 0, "totalBalance")
+	cmd.Flags().StringP("assetExec", "e", "", "collateral asset exec, liquidationRatio only for this asset if set")
+	cmd.Flags().StringP("assetSymbol", "y", "", "collateral asset symbol")
+	cmd.Flags().Int32P("feedQuorum", "q", 0, "price feed submissions required for median price, 0 or 1 for single feeder")
+	cmd.Flags().Int64P("feedWindow", "w", 0, "price feed time window in seconds, older submissions are dropped")
+	cmd.Flags().Float64P("feedDeviation", "v", 0, "max deviation ratio from median price, outliers are dropped")
 }
 
 //CollateralizeManage ...
@@ -277,12 +303,17 @@ func CollateralizeManage(cmd *cobra.Command, args []string) {
 	stabilityFeeRatio, _ := cmd.Flags().GetFloat64("stabilityFeeRatio")
 	period, _ := cmd.Flags().GetUint64("period")
 	totalBalance, _ := cmd.Flags().GetFloat64("totalBalance")
+	assetExec, _ := cmd.Flags().GetString("assetExec")
+	assetSymbol, _ := cmd.Flags().GetString("assetSymbol")
+	feedQuorum, _ := cmd.Flags().GetInt32("feedQuorum")
+	feedWindow, _ := cmd.Flags().GetInt64("feedWindow")
+	feedDeviation, _ := cmd.Flags().GetFloat64("feedDeviation")
 
 	params := &rpctypes.CreateTxIn{
 		Execer:     types.GetExecName(pkt.CollateralizeX, paraName),
 		ActionName: "CollateralizeManage",
-		Payload: []byte(fmt.Sprintf("{\"debtCeiling\":%f, \"liquidationRatio\":%f, \"stabilityFeeRatio\":%f, \"period\":%d, \"totalBalance\":%f}",
-			debtCeiling, liquidationRatio, stabilityFeeRatio, period, totalBalance)),
+		Payload: []byte(fmt.Sprintf("{\"debtCeiling\":%f, \"liquidationRatio\":%f, \"stabilityFeeRatio\":%f, \"period\":%d, \"totalBalance\":%f, \"assetExec\":\"%s\", \"assetSymbol\":\"%s\", \"feedQuorum\":%d, \"feedWindow\":%d, \"feedDeviation\":%f}",
+			debtCeiling, liquidationRatio, stabilityFeeRatio, period, totalBalance, assetExec, assetSymbol, feedQuorum, feedWindow, feedDeviation)),
 	}
 
 	var res string
@@ -320,17 +351,26 @@ func CollateralizeQueryPriceCmd() *cobra.Command {
 		Short: "Query latest price",
 		Run:   CollateralizeQueryPrice,
 	}
+	cmd.Flags().StringP("assetExec", "e", "", "collateral asset exec, default bty")
+	cmd.Flags().StringP("assetSymbol", "y", "", "collateral asset symbol, default bty")
 	return cmd
 }
 
 //CollateralizeQueryPrice ...
 func CollateralizeQueryPrice(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	assetExec, _ := cmd.Flags().GetString("assetExec")
+	assetSymbol, _ := cmd.Flags().GetString("assetSymbol")
 
 	var params rpctypes.Query4Jrpc
 	params.Execer = pkt.CollateralizeX
 
 	params.FuncName = "CollateralizePrice"
+	if assetExec != "" || assetSymbol != "" {
+		params.FuncName = "CollateralizeAssetPrice"
+		req := &pkt.ReqCollateralizeAssetPrice{AssetExec: assetExec, AssetSymbol: assetSymbol}
+		params.Payload = types.MustPBToJSON(req)
+	}
 	var res pkt.RepCollateralizePrice
 	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
 	ctx.Run()
@@ -370,6 +410,46 @@ func CollateralizeQueryUserBalance(cmd *cobra.Command, args []string) {
 	ctx.Run()
 }
 
+//CollateralizeQueryUserDebtCmd ...
+func CollateralizeQueryUserDebtCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debt",
+		Short: "Query user debt with accrued stability fee",
+		Run:   CollateralizeQueryUserDebt,
+	}
+	addCollateralizeQueryDebtFlags(cmd)
+	return cmd
+}
+
+func addCollateralizeQueryDebtFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("address", "a", "", "address")
+	cmd.MarkFlagRequired("address")
+	cmd.Flags().StringP("collateralizeID", "g", "", "collateralize ID")
+	cmd.Flags().Int64P("time", "t", 0, "accrue fee until time, default latest block time")
+}
+
+//CollateralizeQueryUserDebt ...
+func CollateralizeQueryUserDebt(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("address")
+	collateralizeID, _ := cmd.Flags().GetString("collateralizeID")
+	ts, _ := cmd.Flags().GetInt64("time")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = pkt.CollateralizeX
+	params.FuncName = "CollateralizeUserDebt"
+	req := &pkt.ReqCollateralizeUserDebt{
+		Addr:            addr,
+		CollateralizeId: collateralizeID,
+		Time:            ts,
+	}
+	params.Payload = types.MustPBToJSON(req)
+
+	var res pkt.RepCollateralizeUserDebt
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
 // CollateralizeQueryCmd 查询命令行
 func CollateralizeQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -382,6 +462,7 @@ func CollateralizeQueryCmd() *cobra.Command {
 		CollateralizeQueryCfgCmd(),
 		CollateralizeQueryPriceCmd(),
 		CollateralizeQueryUserBalanceCmd(),
+		CollateralizeQueryUserDebtCmd(),
 	)
 	return cmd
 }
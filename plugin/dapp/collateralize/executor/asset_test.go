@@ -0,0 +1,115 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	pkt "github.com/33cn/plugin/plugin/dapp/collateralize/types"
+	tokenE "github.com/33cn/plugin/plugin/dapp/token/executor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollateralizeMultiAsset(t *testing.T) {
+	env := initEnv()
+	assetExec, assetSymbol := tokenE.GetName(), "TEST"
+	forkHeight := env.blockHeight + 10
+	env.cfg.SetDappFork(pkt.CollateralizeX, pkt.ForkCollateralizeMultiAsset, forkHeight)
+
+	exec := newCollateralize().(*Collateralize)
+	exec.SetAPI(env.api)
+	exec.SetStateDB(env.db)
+	exec.SetLocalDB(env.kvdb)
+	exec.SetEnv(env.blockHeight+1, env.blockTime+1, env.difficulty)
+
+	// 抵押资产
+	testAcc, _ := account.NewAccountDB(env.cfg, assetExec, assetSymbol, env.db)
+	testAcc.SaveExecAccount(env.execAddr, &types.Account{Balance: total, Addr: string(Nodes[1])})
+
+	// 全局配置
+	manage := &pkt.CollateralizeManageTx{Period: 3600, LiquidationRatio: 0.25, DebtCeiling: 1000, StabilityFeeRatio: 0.0001, TotalBalance: 10000}
+	tx, err := pkt.CreateRawCollateralizeManageTx(env.cfg, manage)
+	assert.Nil(t, err)
+	_, err = execAndSave(t, env, exec, tx, PrivKeyA)
+	assert.Nil(t, err)
+
+	// fork之前不支持多资产
+	assetManage := &pkt.CollateralizeManageTx{LiquidationRatio: 0.5, AssetExec: assetExec, AssetSymbol: assetSymbol}
+	tx, err = pkt.CreateRawCollateralizeManageTx(env.cfg, assetManage)
+	assert.Nil(t, err)
+	_, err = execAndSave(t, env, exec, tx, PrivKeyA)
+	assert.Equal(t, types.ErrNotSupport, err)
+
+	exec.SetEnv(forkHeight, env.blockTime+2, env.difficulty)
+	tx, err = pkt.CreateRawCollateralizeManageTx(env.cfg, assetManage)
+	assert.Nil(t, err)
+	_, err = execAndSave(t, env, exec, tx, PrivKeyA)
+	assert.Nil(t, err)
+	res, err := exec.Query("CollateralizeConfig", nil)
+	assert.Nil(t, err)
+	cfgRes := res.(*pkt.RepCollateralizeConfig)
+	assert.Equal(t, int64(2500), cfgRes.LiquidationRatio)
+	assert.Equal(t, 1, len(cfgRes.AssetConfigs))
+	assert.Equal(t, int64(5000), cfgRes.AssetConfigs[0].LiquidationRatio)
+
+	// 不能用ccny作为抵押物
+	tx, err = pkt.CreateRawCollateralizeCreateTx(env.cfg, &pkt.CollateralizeCreateTx{TotalBalance: 1000, AssetExec: assetExec, AssetSymbol: pkt.CCNYTokenName})
+	assert.Nil(t, err)
+	_, err = execAndSave(t, env, exec, tx, PrivKeyA)
+	assert.Equal(t, pkt.ErrAssetType, err)
+
+	// 创建TEST抵押的放贷
+	tx, err = pkt.CreateRawCollateralizeCreateTx(env.cfg, &pkt.CollateralizeCreateTx{TotalBalance: 1000, AssetExec: assetExec, AssetSymbol: assetSymbol})
+	assert.Nil(t, err)
+	tx, err = execAndSave(t, env, exec, tx, PrivKeyA)
+	assert.Nil(t, err)
+	collID := common.ToHex(tx.Hash())
+	res, err = exec.Query("CollateralizeInfoByID", types.Encode(&pkt.ReqCollateralizeInfo{CollateralizeId: collID}))
+	assert.Nil(t, err)
+	info := res.(*pkt.RepCollateralizeCurrentInfo)
+	assert.Equal(t, assetExec, info.AssetExec)
+	assert.Equal(t, assetSymbol, info.AssetSymbol)
+	assert.Equal(t, int64(5000), info.LiquidationRatio)
+
+	// TEST喂价
+	tx, err = pkt.CreateRawCollateralizeFeedTx(env.cfg, &pkt.CollateralizeFeedTx{Price: []float64{1}, Volume: []int64{100}, AssetExec: assetExec, AssetSymbol: assetSymbol})
+	assert.Nil(t, err)
+	_, err = execAndSave(t, env, exec, tx, PrivKeyB)
+	assert.Nil(t, err)
+	res, err = exec.Query("CollateralizeAssetPrice", types.Encode(&pkt.ReqCollateralizeAssetPrice{AssetExec: assetExec, AssetSymbol: assetSymbol}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1e4), res.(*pkt.RepCollateralizePrice).Price)
+	_, err = exec.Query("CollateralizePrice", nil)
+	assert.NotNil(t, err)
+
+	// 借出100ccny，冻结200TEST
+	tx, err = pkt.CreateRawCollateralizeBorrowTx(env.cfg, &pkt.CollateralizeBorrowTx{CollateralizeID: collID, Value: 100})
+	assert.Nil(t, err)
+	tx, err = execAndSave(t, env, exec, tx, PrivKeyB)
+	assert.Nil(t, err)
+	recordID := common.ToHex(tx.Hash())
+	assert.Equal(t, total-200*types.DefaultCoinPrecision, testAcc.LoadExecAccount(string(Nodes[1]), env.execAddr).Balance)
+	assert.Equal(t, 200*types.DefaultCoinPrecision, testAcc.LoadExecAccount(string(Nodes[0]), env.execAddr).Frozen)
+	assert.Equal(t, total, exec.GetCoinsAccount().LoadExecAccount(string(Nodes[1]), env.execAddr).Balance)
+
+	// bty喂价不按价格清算TEST抵押的借贷，但仍做超时检查
+	tx, err = pkt.CreateRawCollateralizeFeedTx(env.cfg, &pkt.CollateralizeFeedTx{Price: []float64{0.1}, Volume: []int64{100}})
+	assert.Nil(t, err)
+	_, err = execAndSave(t, env, exec, tx, PrivKeyB)
+	assert.Nil(t, err)
+	res, err = exec.Query("CollateralizeRecordByID", types.Encode(&pkt.ReqCollateralizeRecord{CollateralizeId: collID, RecordId: recordID}))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(pkt.CollateralizeUserStatusExpire), res.(*pkt.RepCollateralizeRecord).Record.Status)
+
+	// TEST价格跌破清算线，抵押物转给担保账户
+	tx, err = pkt.CreateRawCollateralizeFeedTx(env.cfg, &pkt.CollateralizeFeedTx{Price: []float64{0.5}, Volume: []int64{100}, AssetExec: assetExec, AssetSymbol: assetSymbol})
+	assert.Nil(t, err)
+	_, err = execAndSave(t, env, exec, tx, PrivKeyB)
+	assert.Nil(t, err)
+	res, err = exec.Query("CollateralizeRecordByID", types.Encode(&pkt.ReqCollateralizeRecord{CollateralizeId: collID, RecordId: recordID}))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(pkt.CollateralizeUserStatusSystemLiquidate), res.(*pkt.RepCollateralizeRecord).Record.Status)
+	assert.Equal(t, 200*types.DefaultCoinPrecision, testAcc.LoadExecAccount(string(Nodes[2]), env.execAddr).Balance)
+	assert.Equal(t, int64(0), testAcc.LoadExecAccount(string(Nodes[0]), env.execAddr).Frozen)
+}
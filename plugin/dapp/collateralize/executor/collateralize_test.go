@@ -1,6 +1,7 @@
 package executor
 
 import (
+	"sync"
 	"testing"
 	"time"
 
@@ -44,6 +45,7 @@ var (
 	}
 	total      = 10000 * types.DefaultCoinPrecision
 	totalToken = 100000 * types.DefaultCoinPrecision
+	initOnce   sync.Once
 )
 
 func manageKeySet(key string, value string, db dbm.KV) {
@@ -78,7 +80,7 @@ func initEnv() *execEnv {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	cfg.SetTitleOnlyForTest("chain33")
 	cfg.RegisterDappFork(pkt.CollateralizeX, pkt.ForkCollateralizeTableUpdate, 0)
-	Init(pkt.CollateralizeX, cfg, nil)
+	initOnce.Do(func() { Init(pkt.CollateralizeX, cfg, nil) })
 	_, ldb, kvdb := util.CreateTestDB()
 
 	accountA := types.Account{
@@ -668,6 +670,24 @@ func TestCollateralize(t *testing.T) {
 	assert.NotNil(t, res)
 }
 
+func execAndSave(t *testing.T, env *execEnv, exec *Collateralize, tx *types.Transaction, hexPrivKey string) (*types.Transaction, error) {
+	tx.Execer = []byte(pkt.CollateralizeX)
+	tx, err := signTx(tx, hexPrivKey)
+	assert.Nil(t, err)
+	receipt, err := exec.Exec(tx, int(1))
+	if err != nil {
+		return tx, err
+	}
+	for _, kv := range receipt.KV {
+		env.db.Set(kv.Key, kv.Value)
+	}
+	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	set, err := exec.ExecLocal(tx, receiptData, int(1))
+	assert.Nil(t, err)
+	util.SaveKVList(env.ldb, set.KV)
+	return tx, nil
+}
+
 func signTx(tx *types.Transaction, hexPrivKey string) (*types.Transaction, error) {
 	signType := types.SECP256K1
 	c, err := crypto.Load(types.GetSignName(pkt.CollateralizeX, signType), -1)
@@ -17,6 +17,8 @@ import (
 	"github.com/33cn/chain33/types"
 	pty "github.com/33cn/plugin/plugin/dapp/collateralize/types"
 	issuanceE "github.com/33cn/plugin/plugin/dapp/issuance/types"
+	oracleE "github.com/33cn/plugin/plugin/dapp/oracle/executor"
+	oty "github.com/33cn/plugin/plugin/dapp/oracle/types"
 	tokenE "github.com/33cn/plugin/plugin/dapp/token/executor"
 )
 
@@ -37,6 +39,7 @@ const (
 	DefaultTotalBalance       = 0               // 默认放贷总额
 	PriceWarningRate          = 1.3 * 1e4       // 价格提前预警率
 	ExpireWarningTime         = 3600 * 24 * 10  // 提前10天超时预警
+	SecondsPerYear            = 3600 * 24 * 365 // 稳定费按年计息
 )
 
 // CollateralizeDB def
@@ -84,6 +87,24 @@ func PriceKey() (key []byte) {
 	return key
 }
 
+//AssetPriceKey Key for asset price feed, bty沿用PriceKey
+func AssetPriceKey(assetExec, assetSymbol string) (key []byte) {
+	if assetExec == "" {
+		return PriceKey()
+	}
+	key = append(key, []byte("mavl-"+pty.CollateralizeX+"-price-"+assetExec+"-"+assetSymbol)...)
+	return key
+}
+
+//FeedRoundKey Key for multi-feeder price round
+func FeedRoundKey(assetExec, assetSymbol string) (key []byte) {
+	key = append(key, []byte("mavl-"+pty.CollateralizeX+"-feed-round")...)
+	if assetExec != "" {
+		key = append(key, []byte("-"+assetExec+"-"+assetSymbol)...)
+	}
+	return key
+}
+
 // Action struct
 type Action struct {
 	coinsAccount  *account.DB // bty账户
@@ -117,6 +138,52 @@ func NewCollateralizeAction(c *Collateralize, tx *types.Transaction, index int)
 		execaddr: dapp.ExecAddress(string(tx.Execer)), difficulty: c.GetDifficulty(), index: index, Collateralize: c}
 }
 
+// 规范化抵押资产，bty统一表示为空
+func (action *Action) normalizeAsset(assetExec, assetSymbol string) (string, string, error) {
+	if assetExec == "" && assetSymbol == "" {
+		return "", "", nil
+	}
+	if assetExec == "" || assetSymbol == "" {
+		return "", "", pty.ErrAssetType
+	}
+	cfg := action.Collateralize.GetAPI().GetConfig()
+	if assetExec == cfg.GetCoinExec() && assetSymbol == cfg.GetCoinSymbol() {
+		return "", "", nil
+	}
+	// 不能用ccny抵押借出ccny
+	if assetExec == tokenE.GetName() && assetSymbol == pty.CCNYTokenName {
+		return "", "", pty.ErrAssetType
+	}
+	return assetExec, assetSymbol, nil
+}
+
+// 获取抵押资产账户
+func (action *Action) assetAccount(coll *pty.Collateralize) (*account.DB, error) {
+	if coll.AssetExec == "" {
+		return action.coinsAccount, nil
+	}
+	cfg := action.Collateralize.GetAPI().GetConfig()
+	return account.NewAccountDB(cfg, coll.AssetExec, coll.AssetSymbol, action.db)
+}
+
+// 获取抵押资产精度
+func assetPrecision(cfg *types.Chain33Config, assetExec string) int64 {
+	if assetExec == tokenE.GetName() {
+		return cfg.GetTokenPrecision()
+	}
+	return cfg.GetCoinPrecision()
+}
+
+// 获取抵押资产的清算比例，未单独配置时使用全局配置
+func getAssetLiquidationRatio(collcfg *pty.CollateralizeManage, assetExec, assetSymbol string) int64 {
+	for _, asset := range collcfg.AssetConfigs {
+		if asset.AssetExec == assetExec && asset.AssetSymbol == assetSymbol {
+			return asset.LiquidationRatio
+		}
+	}
+	return collcfg.LiquidationRatio
+}
+
 // GetCreateReceiptLog generate logs for Collateralize create action
 func (action *Action) GetCreateReceiptLog(collateralize *pty.Collateralize) *types.ReceiptLog {
 	log := &types.ReceiptLog{}
@@ -256,8 +323,35 @@ func (action *Action) CollateralizeManage(manage *pty.CollateralizeManage) (*typ
 		return nil, pty.ErrRiskParam
 	}
 
-	manConfig, _ := getCollateralizeConfig(action.db)
 	cfg := action.Collateralize.GetAPI().GetConfig()
+	if len(manage.AssetConfigs) > 0 && !cfg.IsDappFork(action.height, pty.CollateralizeX, pty.ForkCollateralizeMultiAsset) {
+		return nil, types.ErrNotSupport
+	}
+	if (manage.FeedQuorum != 0 || manage.FeedWindow != 0 || manage.FeedDeviation != 0) &&
+		!cfg.IsDappFork(action.height, pty.CollateralizeX, pty.ForkCollateralizeFeedQuorum) {
+		return nil, types.ErrNotSupport
+	}
+	if manage.FeedQuorum < 0 || manage.FeedWindow < 0 || manage.FeedDeviation < 0 || manage.FeedDeviation >= 10000 {
+		return nil, pty.ErrRiskParam
+	}
+	var assets []*pty.CollateralizeAssetConfig
+	for _, asset := range manage.AssetConfigs {
+		if asset.LiquidationRatio <= 0 || asset.LiquidationRatio >= 10000 {
+			return nil, pty.ErrRiskParam
+		}
+		exec, symbol, err := action.normalizeAsset(asset.AssetExec, asset.AssetSymbol)
+		if err != nil {
+			clog.Error("CollateralizeManage", "assetExec", asset.AssetExec, "assetSymbol", asset.AssetSymbol, "error", err)
+			return nil, err
+		}
+		if exec == "" {
+			clog.Error("CollateralizeManage", "error", "bty use global liquidation ratio")
+			return nil, pty.ErrAssetType
+		}
+		assets = append(assets, &pty.CollateralizeAssetConfig{AssetExec: exec, AssetSymbol: symbol, LiquidationRatio: asset.LiquidationRatio})
+	}
+
+	manConfig, _ := getCollateralizeConfig(action.db)
 	if manConfig == nil {
 		manConfig = &pty.CollateralizeManage{
 			DebtCeiling:       DefaultDebtCeiling * cfg.GetCoinPrecision(),
@@ -298,6 +392,42 @@ func (action *Action) CollateralizeManage(manage *pty.CollateralizeManage) (*typ
 	} else {
 		collConfig.TotalBalance = manConfig.TotalBalance
 	}
+
+	if manage.FeedQuorum != 0 {
+		collConfig.FeedQuorum = manage.FeedQuorum
+	} else {
+		collConfig.FeedQuorum = manConfig.FeedQuorum
+	}
+
+	if manage.FeedWindow != 0 {
+		collConfig.FeedWindow = manage.FeedWindow
+	} else {
+		collConfig.FeedWindow = manConfig.FeedWindow
+	}
+
+	if manage.FeedDeviation != 0 {
+		collConfig.FeedDeviation = manage.FeedDeviation
+	} else {
+		collConfig.FeedDeviation = manConfig.FeedDeviation
+	}
+
+	// 各抵押资产清算比例，按资产覆盖
+	for _, asset := range manConfig.AssetConfigs {
+		collConfig.AssetConfigs = append(collConfig.AssetConfigs, types.Clone(asset).(*pty.CollateralizeAssetConfig))
+	}
+	for _, asset := range assets {
+		found := false
+		for _, old := range collConfig.AssetConfigs {
+			if old.AssetExec == asset.AssetExec && old.AssetSymbol == asset.AssetSymbol {
+				old.LiquidationRatio = asset.LiquidationRatio
+				found = true
+				break
+			}
+		}
+		if !found {
+			collConfig.AssetConfigs = append(collConfig.AssetConfigs, asset)
+		}
+	}
 	collConfig.CurrentTime = action.blocktime
 
 	value := types.Encode(collConfig)
@@ -385,6 +515,17 @@ func (action *Action) CollateralizeCreate(create *pty.CollateralizeCreate) (*typ
 		return nil, types.ErrAmount
 	}
 
+	// 抵押资产检查
+	cfg := action.Collateralize.GetAPI().GetConfig()
+	if (create.AssetExec != "" || create.AssetSymbol != "") && !cfg.IsDappFork(action.height, pty.CollateralizeX, pty.ForkCollateralizeMultiAsset) {
+		return nil, types.ErrNotSupport
+	}
+	assetExec, assetSymbol, err := action.normalizeAsset(create.AssetExec, create.AssetSymbol)
+	if err != nil {
+		clog.Error("CollateralizeCreate", "addr", action.fromaddr, "assetExec", create.AssetExec, "assetSymbol", create.AssetSymbol, "error", err)
+		return nil, err
+	}
+
 	// 获取借贷配置
 	collcfg, err := getCollateralizeConfig(action.db)
 	if err != nil {
@@ -416,6 +557,20 @@ func (action *Action) CollateralizeCreate(create *pty.CollateralizeCreate) (*typ
 		return nil, err
 	}
 
+	// 同一地址每种抵押资产只保留一期放贷
+	var collateralize *pty.Collateralize
+	for _, id := range collateralizeIDs {
+		c, err := queryCollateralizeByID(action.db, id)
+		if err != nil {
+			clog.Error("CollateralizeCreate.queryCollateralizeByID", "addr", action.fromaddr, "execaddr", action.execaddr, "collId", id)
+			return nil, err
+		}
+		if c.AssetExec == assetExec && c.AssetSymbol == assetSymbol {
+			collateralize = c
+			break
+		}
+	}
+
 	// 冻结ccny
 	receipt, err = action.tokenAccount.ExecFrozen(action.fromaddr, action.execaddr, create.TotalBalance)
 	if err != nil {
@@ -427,12 +582,12 @@ func (action *Action) CollateralizeCreate(create *pty.CollateralizeCreate) (*typ
 
 	var collateralizeID string
 	coll := &CollateralizeDB{}
-	if collateralizeIDs == nil {
+	if collateralize == nil {
 		collateralizeID = common.ToHex(action.txhash)
 
 		// 构造coll结构
 		coll.CollateralizeId = collateralizeID
-		coll.LiquidationRatio = collcfg.LiquidationRatio
+		coll.LiquidationRatio = getAssetLiquidationRatio(collcfg, assetExec, assetSymbol)
 		coll.TotalBalance = create.TotalBalance
 		coll.DebtCeiling = collcfg.DebtCeiling
 		coll.StabilityFeeRatio = collcfg.StabilityFeeRatio
@@ -441,12 +596,9 @@ func (action *Action) CollateralizeCreate(create *pty.CollateralizeCreate) (*typ
 		coll.CreateAddr = action.fromaddr
 		coll.Status = pty.CollateralizeActionCreate
 		coll.CollBalance = 0
+		coll.AssetExec = assetExec
+		coll.AssetSymbol = assetSymbol
 	} else {
-		collateralize, err := queryCollateralizeByID(action.db, collateralizeIDs[0])
-		if err != nil {
-			clog.Error("CollateralizeCreate.queryCollateralizeByID", "addr", action.fromaddr, "execaddr", action.execaddr, "collId", collateralizeIDs[0])
-			return nil, err
-		}
 		coll.Collateralize = *collateralize
 		coll.TotalBalance += create.TotalBalance
 		coll.Balance += create.TotalBalance
@@ -482,6 +634,63 @@ func calcLiquidationPrice(value int64, colValue int64) int64 {
 	return (value * pty.CollateralizePreLiquidationRatio) / colValue
 }
 
+// 根据借贷记录当前的债务和抵押物计算清算价格
+func (action *Action) calcRecordLiquidationPrice(coll *pty.Collateralize, record *pty.BorrowRecord) int64 {
+	// 精度转换 #1024
+	cfg := action.Collateralize.GetAPI().GetConfig()
+	debtValueReal := record.DebtValue
+	collateralValueReal := record.CollateralValue
+	if cfg.IsDappFork(action.Collateralize.GetHeight(), pty.CollateralizeX, pty.ForkCollateralizePrecision) {
+		precisionNum := int(math.Log10(float64(cfg.GetTokenPrecision())))
+		debtValueReal = decimal.NewFromInt(debtValueReal).Shift(int32(-precisionNum)).Shift(8).IntPart()
+		if coll.AssetExec != "" {
+			precisionNum = int(math.Log10(float64(assetPrecision(cfg, coll.AssetExec))))
+		}
+		collateralValueReal = decimal.NewFromInt(collateralValueReal).Shift(int32(-precisionNum)).Shift(8).IntPart()
+	}
+	return calcLiquidationPrice(debtValueReal, collateralValueReal)
+}
+
+// 计算借贷记录截至now应付的稳定费，按借出本金和时间计息
+// fork之后的借贷记录借出时记录计息起始时间，fork之前的记录没有计息起始时间，仍按固定比例收取稳定费，不计息
+func calcAccruedFee(record *pty.BorrowRecord, feeRatio int64, now int64) int64 {
+	last := record.LastAccrueTime
+	if last == 0 || now <= last {
+		return record.AccruedFee
+	}
+
+	fee := decimal.NewFromInt(record.DebtValue).Mul(decimal.NewFromInt(feeRatio)).Mul(decimal.NewFromInt(now - last)).
+		Div(decimal.NewFromInt(1e4 * SecondsPerYear)).IntPart()
+	return record.AccruedFee + fee
+}
+
+// 计算fork之前的借贷记录按固定比例收取的稳定费
+func calcFlatFee(cfg *types.Chain33Config, height int64, record *pty.BorrowRecord, feeRatio int64) int64 {
+	// 精度转换 #1024
+	// token精度转成精度8
+	valueReal := record.DebtValue
+	if cfg.IsDappFork(height, pty.CollateralizeX, pty.ForkCollateralizePrecision) {
+		precisionNum := int(math.Log10(float64(cfg.GetTokenPrecision())))
+		valueReal = decimal.NewFromInt(valueReal).Shift(int32(-precisionNum)).Shift(8).IntPart()
+	}
+	// 借贷金额+利息
+	fee := ((valueReal * feeRatio) / 1e8) * 1e4
+	// 精度8转成token精度
+	if cfg.IsDappFork(height, pty.CollateralizeX, pty.ForkCollateralizePrecision) {
+		precisionNum := int(math.Log10(float64(cfg.GetTokenPrecision())))
+		fee = decimal.NewFromInt(fee).Shift(-8).Shift(int32(precisionNum)).IntPart()
+	}
+	return fee
+}
+
+// 计提稳定费
+func accrueFee(record *pty.BorrowRecord, feeRatio int64, now int64) {
+	record.AccruedFee = calcAccruedFee(record, feeRatio, now)
+	if now > record.LastAccrueTime {
+		record.LastAccrueTime = now
+	}
+}
+
 // 获取最近抵押物价格
 func getLatestPrice(db dbm.KV) (int64, error) {
 	data, err := db.Get(PriceKey())
@@ -500,9 +709,29 @@ func getLatestPrice(db dbm.KV) (int64, error) {
 	return price.BtyPrice, nil
 }
 
+// 获取指定抵押资产最近价格
+func getLatestAssetPrice(db dbm.KV, assetExec, assetSymbol string) (int64, error) {
+	if assetExec == "" {
+		return getLatestPrice(db)
+	}
+	data, err := db.Get(AssetPriceKey(assetExec, assetSymbol))
+	if err != nil {
+		clog.Error("getLatestAssetPrice", "get", err, "assetExec", assetExec, "assetSymbol", assetSymbol)
+		return -1, err
+	}
+	var price pty.AssetPriceRecord
+	err = types.Decode(data, &price)
+	if err != nil {
+		clog.Error("getLatestAssetPrice", "decode", err)
+		return -1, err
+	}
+
+	return price.Price, nil
+}
+
 // CheckExecAccountBalance 检查账户抵押物余额
-func (action *Action) CheckExecAccountBalance(fromAddr string, ToFrozen, ToActive int64) bool {
-	acc := action.coinsAccount.LoadExecAccount(fromAddr, action.execaddr)
+func (action *Action) CheckExecAccountBalance(assetDB *account.DB, fromAddr string, ToFrozen, ToActive int64) bool {
+	acc := assetDB.LoadExecAccount(fromAddr, action.execaddr)
 	if acc.GetBalance() >= ToFrozen && acc.GetFrozen() >= ToActive {
 		return true
 	}
@@ -566,11 +795,16 @@ func (action *Action) CollateralizeBorrow(borrow *pty.CollateralizeBorrow) (*typ
 	clog.Debug("CollateralizeBorrow", "value", borrow.GetValue())
 
 	// 获取抵押物价格
-	lastPrice, err := getLatestPrice(action.db)
+	lastPrice, err := getLatestAssetPrice(action.db, coll.AssetExec, coll.AssetSymbol)
 	if err != nil {
 		clog.Error("CollateralizeBorrow.getLatestPrice", "CollID", coll.CollateralizeId, "addr", action.fromaddr, "execaddr", action.execaddr, "error", err)
 		return nil, err
 	}
+	assetDB, err := action.assetAccount(&coll.Collateralize)
+	if err != nil {
+		clog.Error("CollateralizeBorrow.assetAccount", "CollID", coll.CollateralizeId, "error", err)
+		return nil, err
+	}
 
 	// 精度转换 #1024
 	// token精度转成精度8
@@ -586,20 +820,20 @@ func (action *Action) CollateralizeBorrow(borrow *pty.CollateralizeBorrow) (*typ
 		clog.Error("CollateralizeBorrow.getBtyNumToFrozen", "CollID", coll.CollateralizeId, "addr", action.fromaddr, "execaddr", action.execaddr, "error", err)
 		return nil, err
 	}
-	// bty精度8转成coins精度
+	// 精度8转成抵押资产精度
 	if cfg.IsDappFork(action.Collateralize.GetHeight(), pty.CollateralizeX, pty.ForkCollateralizePrecision) {
-		precisionNum := int(math.Log10(float64(cfg.GetCoinPrecision())))
+		precisionNum := int(math.Log10(float64(assetPrecision(cfg, coll.AssetExec))))
 		btyFrozen = decimal.NewFromInt(btyFrozen).Shift(-8).Shift(int32(precisionNum)).IntPart()
 	}
 
 	// 检查抵押物账户余额
-	if !action.CheckExecAccountBalance(action.fromaddr, btyFrozen, 0) {
+	if !action.CheckExecAccountBalance(assetDB, action.fromaddr, btyFrozen, 0) {
 		clog.Error("CollateralizeBorrow.CheckExecAccountBalance", "CollID", coll.CollateralizeId, "addr", action.fromaddr, "execaddr", action.execaddr, "balance", btyFrozen, "error", types.ErrNoBalance)
 		return nil, types.ErrNoBalance
 	}
 
 	// 抵押物转账
-	receipt, err := action.coinsAccount.ExecTransfer(action.fromaddr, coll.CreateAddr, action.execaddr, btyFrozen)
+	receipt, err := assetDB.ExecTransfer(action.fromaddr, coll.CreateAddr, action.execaddr, btyFrozen)
 	if err != nil {
 		clog.Error("CollateralizeBorrow.ExecTransfer", "addr", action.fromaddr, "execaddr", action.execaddr, "amount", btyFrozen)
 		return nil, err
@@ -608,7 +842,7 @@ func (action *Action) CollateralizeBorrow(borrow *pty.CollateralizeBorrow) (*typ
 	kv = append(kv, receipt.KV...)
 
 	// 抵押物冻结
-	receipt, err = action.coinsAccount.ExecFrozen(coll.CreateAddr, action.execaddr, btyFrozen)
+	receipt, err = assetDB.ExecFrozen(coll.CreateAddr, action.execaddr, btyFrozen)
 	if err != nil {
 		clog.Error("CollateralizeBorrow.Frozen", "addr", coll.CreateAddr, "execaddr", action.execaddr, "amount", btyFrozen)
 		return nil, err
@@ -632,6 +866,9 @@ func (action *Action) CollateralizeBorrow(borrow *pty.CollateralizeBorrow) (*typ
 	borrowRecord.AccountAddr = action.fromaddr
 	borrowRecord.CollateralValue = btyFrozen
 	borrowRecord.StartTime = action.blocktime
+	if cfg.IsDappFork(action.height, pty.CollateralizeX, pty.ForkCollateralizeInterest) {
+		borrowRecord.LastAccrueTime = action.blocktime
+	}
 	borrowRecord.CollateralPrice = lastPrice
 	borrowRecord.DebtValue = borrow.GetValue()
 	borrowRecord.LiquidationPrice = (coll.LiquidationRatio * lastPrice * pty.CollateralizePreLiquidationRatio) / 1e8
@@ -665,6 +902,16 @@ func (action *Action) CollateralizeRepay(repay *pty.CollateralizeRepay) (*types.
 	var kv []*types.KeyValue
 	var receipt *types.Receipt
 
+	cfg := action.Collateralize.GetAPI().GetConfig()
+	interestFork := cfg.IsDappFork(action.height, pty.CollateralizeX, pty.ForkCollateralizeInterest)
+	if repay.Value != 0 && !interestFork {
+		return nil, types.ErrNotSupport
+	}
+	if repay.Value < 0 {
+		clog.Error("CollateralizeRepay", "CollID", repay.CollateralizeId, "value", repay.Value, "error", types.ErrAmount)
+		return nil, types.ErrAmount
+	}
+
 	// 找到相应的借贷
 	collateralize, err := queryCollateralizeByID(action.db, repay.CollateralizeId)
 	if err != nil {
@@ -696,24 +943,31 @@ func (action *Action) CollateralizeRepay(repay *pty.CollateralizeRepay) (*types.
 		return nil, pty.ErrRecordNotExist
 	}
 
-	// 精度转换 #1024
-	// token精度转成精度8
-	cfg := action.Collateralize.GetAPI().GetConfig()
-	valueReal := borrowRecord.DebtValue
-	if cfg.IsDappFork(action.Collateralize.GetHeight(), pty.CollateralizeX, pty.ForkCollateralizePrecision) {
-		precisionNum := int(math.Log10(float64(cfg.GetTokenPrecision())))
-		valueReal = decimal.NewFromInt(valueReal).Shift(int32(-precisionNum)).Shift(8).IntPart()
-	}
-	// 借贷金额+利息
-	fee := ((valueReal * coll.StabilityFeeRatio) / 1e8) * 1e4
-	// 精度8转成token精度
-	if cfg.IsDappFork(action.Collateralize.GetHeight(), pty.CollateralizeX, pty.ForkCollateralizePrecision) {
-		precisionNum := int(math.Log10(float64(cfg.GetTokenPrecision())))
-		fee = decimal.NewFromInt(fee).Shift(-8).Shift(int32(precisionNum)).IntPart()
+	var fee int64
+	if interestFork && borrowRecord.LastAccrueTime != 0 {
+		// 按借出时间计提稳定费
+		accrueFee(borrowRecord, coll.StabilityFeeRatio, action.blocktime)
+		fee = borrowRecord.AccruedFee
+		if repay.Value > 0 && repay.Value < borrowRecord.DebtValue+fee {
+			return action.partialRepay(coll, borrowRecord, repay.Value)
+		}
+	} else {
+		// fork之前的借贷记录按固定比例收取稳定费，只能全部还款
+		fee = calcFlatFee(cfg, action.Collateralize.GetHeight(), borrowRecord, coll.StabilityFeeRatio)
+		if repay.Value > 0 && repay.Value < borrowRecord.DebtValue+fee {
+			clog.Error("CollateralizeRepay", "CollID", repay.CollateralizeId, "RecordId", repay.RecordId, "value", repay.Value, "error", "partial repay not support")
+			return nil, types.ErrNotSupport
+		}
 	}
 
 	realRepay := borrowRecord.DebtValue + fee
 
+	assetDB, err := action.assetAccount(&coll.Collateralize)
+	if err != nil {
+		clog.Error("CollateralizeRepay.assetAccount", "CollID", coll.CollateralizeId, "error", err)
+		return nil, err
+	}
+
 	// 检查
 	if !action.CheckExecTokenAccount(action.fromaddr, realRepay, false) {
 		clog.Error("CollateralizeRepay.CheckExecTokenAccount", "CollID", coll.CollateralizeId, "addr", action.fromaddr, "execaddr", action.execaddr, "amount", realRepay, "error", types.ErrInsufficientBalance)
@@ -739,7 +993,7 @@ func (action *Action) CollateralizeRepay(repay *pty.CollateralizeRepay) (*types.
 	kv = append(kv, receipt.KV...)
 
 	// 抵押物归还
-	receipt, err = action.coinsAccount.ExecTransferFrozen(coll.CreateAddr, action.fromaddr, action.execaddr, borrowRecord.CollateralValue)
+	receipt, err = assetDB.ExecTransferFrozen(coll.CreateAddr, action.fromaddr, action.execaddr, borrowRecord.CollateralValue)
 	if err != nil {
 		clog.Error("CollateralizeRepay.ExecTransferFrozen", "addr", coll.CreateAddr, "execaddr", action.execaddr, "amount", borrowRecord.CollateralValue)
 		return nil, err
@@ -750,6 +1004,7 @@ func (action *Action) CollateralizeRepay(repay *pty.CollateralizeRepay) (*types.
 	// 借贷记录关闭
 	borrowRecord.PreStatus = borrowRecord.Status
 	borrowRecord.Status = pty.CollateralizeUserStatusClose
+	borrowRecord.AccruedFee = 0
 
 	// 保存
 	coll.Balance += borrowRecord.DebtValue
@@ -768,6 +1023,59 @@ func (action *Action) CollateralizeRepay(repay *pty.CollateralizeRepay) (*types.
 	return receipt, nil
 }
 
+// 部分还款，先还稳定费再还本金，抵押物不归还
+func (action *Action) partialRepay(coll *CollateralizeDB, borrowRecord *pty.BorrowRecord, value int64) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+
+	interest := value
+	if interest > borrowRecord.AccruedFee {
+		interest = borrowRecord.AccruedFee
+	}
+	principal := value - interest
+
+	// 检查
+	if !action.CheckExecTokenAccount(action.fromaddr, value, false) {
+		clog.Error("CollateralizeRepay.CheckExecTokenAccount", "CollID", coll.CollateralizeId, "addr", action.fromaddr, "execaddr", action.execaddr, "amount", value, "error", types.ErrInsufficientBalance)
+		return nil, types.ErrNoBalance
+	}
+
+	// ccny转移
+	receipt, err := action.tokenAccount.ExecTransfer(action.fromaddr, coll.CreateAddr, action.execaddr, value)
+	if err != nil {
+		clog.Error("CollateralizeRepay.ExecTokenTransfer", "addr", action.fromaddr, "execaddr", action.execaddr, "amount", value)
+		return nil, err
+	}
+	logs = append(logs, receipt.Logs...)
+	kv = append(kv, receipt.KV...)
+
+	// 归还的本金重新冻结，可继续放贷
+	if principal > 0 {
+		receipt, err = action.tokenAccount.ExecFrozen(coll.CreateAddr, action.execaddr, principal)
+		if err != nil {
+			clog.Error("CollateralizeRepay.Frozen", "addr", coll.CreateAddr, "execaddr", action.execaddr, "amount", principal)
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+	}
+
+	borrowRecord.AccruedFee -= interest
+	borrowRecord.DebtValue -= principal
+	borrowRecord.LiquidationPrice = action.calcRecordLiquidationPrice(&coll.Collateralize, borrowRecord)
+
+	// 保存
+	coll.Balance += principal
+	coll.LatestLiquidationPrice = getLatestLiquidationPrice(&coll.Collateralize)
+	coll.Save(action.db)
+	kv = append(kv, coll.GetKVSet()...)
+
+	receiptLog := action.GetRepayReceiptLog(&coll.Collateralize, borrowRecord)
+	logs = append(logs, receiptLog)
+
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
 // CollateralizeAppend 追加抵押物
 func (action *Action) CollateralizeAppend(cAppend *pty.CollateralizeAppend) (*types.Receipt, error) {
 	var logs []*types.ReceiptLog
@@ -810,20 +1118,25 @@ func (action *Action) CollateralizeAppend(cAppend *pty.CollateralizeAppend) (*ty
 	clog.Debug("CollateralizeAppend", "value", cAppend.CollateralValue)
 
 	// 获取抵押物价格
-	lastPrice, err := getLatestPrice(action.db)
+	lastPrice, err := getLatestAssetPrice(action.db, coll.AssetExec, coll.AssetSymbol)
 	if err != nil {
 		clog.Error("CollateralizeBorrow", "CollID", coll.CollateralizeId, "addr", action.fromaddr, "execaddr", action.execaddr, "error", err)
 		return nil, err
 	}
+	assetDB, err := action.assetAccount(&coll.Collateralize)
+	if err != nil {
+		clog.Error("CollateralizeAppend.assetAccount", "CollID", coll.CollateralizeId, "error", err)
+		return nil, err
+	}
 
 	// 检查抵押物账户余额
-	if !action.CheckExecAccountBalance(action.fromaddr, cAppend.CollateralValue, 0) {
+	if !action.CheckExecAccountBalance(assetDB, action.fromaddr, cAppend.CollateralValue, 0) {
 		clog.Error("CollateralizeBorrow.CheckExecAccountBalance", "CollID", coll.CollateralizeId, "addr", action.fromaddr, "execaddr", action.execaddr, "amount", cAppend.CollateralValue, "error", types.ErrNoBalance)
 		return nil, types.ErrNoBalance
 	}
 
 	// 抵押物转账
-	receipt, err := action.coinsAccount.ExecTransfer(action.fromaddr, coll.CreateAddr, action.execaddr, cAppend.CollateralValue)
+	receipt, err := assetDB.ExecTransfer(action.fromaddr, coll.CreateAddr, action.execaddr, cAppend.CollateralValue)
 	if err != nil {
 		clog.Error("CollateralizeBorrow.ExecTransfer", "addr", action.fromaddr, "execaddr", action.execaddr, "amount", cAppend.CollateralValue, "error", err)
 		return nil, err
@@ -832,7 +1145,7 @@ func (action *Action) CollateralizeAppend(cAppend *pty.CollateralizeAppend) (*ty
 	kv = append(kv, receipt.KV...)
 
 	// 抵押物冻结
-	receipt, err = action.coinsAccount.ExecFrozen(coll.CreateAddr, action.execaddr, cAppend.CollateralValue)
+	receipt, err = assetDB.ExecFrozen(coll.CreateAddr, action.execaddr, cAppend.CollateralValue)
 	if err != nil {
 		clog.Error("CollateralizeBorrow.Frozen", "addr", coll.CreateAddr, "execaddr", action.execaddr, "amount", cAppend.CollateralValue, "error", err)
 		return nil, err
@@ -844,16 +1157,7 @@ func (action *Action) CollateralizeAppend(cAppend *pty.CollateralizeAppend) (*ty
 	borrowRecord.CollateralValue += cAppend.CollateralValue
 	borrowRecord.CollateralPrice = lastPrice
 
-	// 精度转换 #1024
-	cfg := action.Collateralize.GetAPI().GetConfig()
-	debtValueReal := borrowRecord.DebtValue
-	collateralValueReal := borrowRecord.CollateralValue
-	if cfg.IsDappFork(action.Collateralize.GetHeight(), pty.CollateralizeX, pty.ForkCollateralizePrecision) {
-		precisionNum := int(math.Log10(float64(cfg.GetTokenPrecision())))
-		debtValueReal = decimal.NewFromInt(debtValueReal).Shift(int32(-precisionNum)).Shift(8).IntPart()
-		collateralValueReal = decimal.NewFromInt(collateralValueReal).Shift(int32(-precisionNum)).Shift(8).IntPart()
-	}
-	borrowRecord.LiquidationPrice = calcLiquidationPrice(debtValueReal, collateralValueReal)
+	borrowRecord.LiquidationPrice = action.calcRecordLiquidationPrice(&coll.Collateralize, borrowRecord)
 	if borrowRecord.LiquidationPrice*PriceWarningRate < lastPrice {
 		// 告警解除
 		if borrowRecord.Status == pty.CollateralizeUserStatusWarning {
@@ -952,6 +1256,12 @@ func (action *Action) systemLiquidation(coll *pty.Collateralize, price int64) (*
 	var kv []*types.KeyValue
 	var removeRecord []*pty.BorrowRecord
 
+	assetDB, err := action.assetAccount(coll)
+	if err != nil {
+		clog.Error("liquidation.assetAccount", "CollID", coll.CollateralizeId, "error", err)
+		return nil, err
+	}
+
 	for _, borrowRecord := range coll.BorrowRecords {
 		if (borrowRecord.LiquidationPrice*PriceWarningRate)/1e4 < price {
 			// 价格恢复，告警记录恢复
@@ -978,7 +1288,7 @@ func (action *Action) systemLiquidation(coll *pty.Collateralize, price int64) (*
 			}
 
 			// 抵押物转移
-			receipt, err := action.coinsAccount.ExecTransferFrozen(coll.CreateAddr, getGuarantorAddr, action.execaddr, borrowRecord.CollateralValue)
+			receipt, err := assetDB.ExecTransferFrozen(coll.CreateAddr, getGuarantorAddr, action.execaddr, borrowRecord.CollateralValue)
 			if err != nil {
 				clog.Error("systemLiquidation", "addr", action.fromaddr, "execaddr", action.execaddr, "amount", borrowRecord.CollateralValue, "error", err)
 				continue
@@ -1030,6 +1340,12 @@ func (action *Action) expireLiquidation(coll *pty.Collateralize) (*types.Receipt
 	var kv []*types.KeyValue
 	var removeRecord []*pty.BorrowRecord
 
+	assetDB, err := action.assetAccount(coll)
+	if err != nil {
+		clog.Error("liquidation.assetAccount", "CollID", coll.CollateralizeId, "error", err)
+		return nil, err
+	}
+
 	for _, borrowRecord := range coll.BorrowRecords {
 		if borrowRecord.ExpireTime-ExpireWarningTime > action.blocktime {
 			continue
@@ -1049,7 +1365,7 @@ func (action *Action) expireLiquidation(coll *pty.Collateralize) (*types.Receipt
 			}
 
 			// 抵押物转移
-			receipt, err := action.coinsAccount.ExecTransferFrozen(coll.CreateAddr, getGuarantorAddr, action.execaddr, borrowRecord.CollateralValue)
+			receipt, err := assetDB.ExecTransferFrozen(coll.CreateAddr, getGuarantorAddr, action.execaddr, borrowRecord.CollateralValue)
 			if err != nil {
 				clog.Error("expireLiquidation", "addr", action.fromaddr, "execaddr", action.execaddr, "amount", borrowRecord.CollateralValue, "error", err)
 				continue
@@ -1120,7 +1436,7 @@ func (action *Action) CollateralizeFeed(feed *pty.CollateralizeFeed) (*types.Rec
 	var logs []*types.ReceiptLog
 	var kv []*types.KeyValue
 
-	if feed == nil || len(feed.Price) == 0 || len(feed.Price) != len(feed.Volume) {
+	if feed == nil || (feed.OracleEventId == "" && len(feed.Price) == 0) || len(feed.Price) != len(feed.Volume) {
 		clog.Error("CollateralizePriceFeed", types.ErrInvalidParam)
 		return nil, types.ErrInvalidParam
 	}
@@ -1131,10 +1447,53 @@ func (action *Action) CollateralizeFeed(feed *pty.CollateralizeFeed) (*types.Rec
 		return nil, pty.ErrPermissionDeny
 	}
 
-	price := pricePolicy(feed)
-	if price <= 0 {
-		clog.Error("CollateralizePriceFeed", "price", price, "error", pty.ErrPriceInvalid)
-		return nil, pty.ErrPriceInvalid
+	cfg := action.Collateralize.GetAPI().GetConfig()
+	if (feed.AssetExec != "" || feed.AssetSymbol != "") && !cfg.IsDappFork(action.height, pty.CollateralizeX, pty.ForkCollateralizeMultiAsset) {
+		return nil, types.ErrNotSupport
+	}
+	assetExec, assetSymbol, err := action.normalizeAsset(feed.AssetExec, feed.AssetSymbol)
+	if err != nil {
+		clog.Error("CollateralizePriceFeed", "assetExec", feed.AssetExec, "assetSymbol", feed.AssetSymbol, "error", err)
+		return nil, err
+	}
+
+	if feed.OracleEventId != "" && !cfg.IsDappFork(action.height, pty.CollateralizeX, pty.ForkCollateralizeFeedQuorum) {
+		return nil, types.ErrNotSupport
+	}
+
+	collcfg, _ := getCollateralizeConfig(action.db)
+	var price int64
+	if feed.OracleEventId != "" {
+		// 价格来自已公布结果的oracle事件
+		price, err = action.getOraclePrice(feed.OracleEventId, assetExec, assetSymbol, collcfg)
+		if err != nil {
+			clog.Error("CollateralizePriceFeed", "oracleEventId", feed.OracleEventId, "error", err)
+			return nil, err
+		}
+	} else {
+		price = pricePolicy(feed)
+		if price <= 0 {
+			clog.Error("CollateralizePriceFeed", "price", price, "error", pty.ErrPriceInvalid)
+			return nil, pty.ErrPriceInvalid
+		}
+	}
+
+	// 多喂价人模式，报价数量达到要求后取中位数，oracle事件的价格同样作为喂价人的报价
+	if collcfg != nil && collcfg.FeedQuorum > 1 {
+		round := getFeedRound(action.db, assetExec, assetSymbol)
+		round.Submit(action.fromaddr, price, action.blocktime, collcfg.FeedWindow)
+		median, ok := round.MedianPrice(collcfg.FeedQuorum, collcfg.FeedDeviation)
+		if ok {
+			round.Submissions = nil
+		}
+		roundkv := &types.KeyValue{Key: FeedRoundKey(assetExec, assetSymbol), Value: types.Encode(round)}
+		action.db.Set(roundkv.Key, roundkv.Value)
+		kv = append(kv, roundkv)
+		if !ok {
+			clog.Debug("CollateralizePriceFeed", "addr", action.fromaddr, "price", price, "submissions", len(round.Submissions))
+			return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+		}
+		price = median
 	}
 
 	ids, err := queryCollateralizeByStatus(action.localDB, pty.CollateralizeStatusCreated, "")
@@ -1148,7 +1507,6 @@ func (action *Action) CollateralizeFeed(feed *pty.CollateralizeFeed) (*types.Rec
 			clog.Error("CollateralizePriceFeed", "Collateralize ID", coll.CollateralizeId, "get collateralize record by id error", err)
 			continue
 		}
-
 		// 超时清算判断
 		if coll.LatestExpireTime-ExpireWarningTime <= action.blocktime {
 			receipt, err := action.expireLiquidation(coll)
@@ -1160,6 +1518,11 @@ func (action *Action) CollateralizeFeed(feed *pty.CollateralizeFeed) (*types.Rec
 			kv = append(kv, receipt.KV...)
 		}
 
+		// 只按喂价资产的价格清算以该资产抵押的借贷
+		if coll.AssetExec != assetExec || coll.AssetSymbol != assetSymbol {
+			continue
+		}
+
 		// 系统清算判断
 		receipt, err := action.systemLiquidation(coll, price)
 		if err != nil {
@@ -1171,11 +1534,15 @@ func (action *Action) CollateralizeFeed(feed *pty.CollateralizeFeed) (*types.Rec
 	}
 
 	var priceRecord pty.AssetPriceRecord
-	priceRecord.BtyPrice = price
+	if assetExec == "" {
+		priceRecord.BtyPrice = price
+	} else {
+		priceRecord.Price = price
+	}
 	priceRecord.RecordTime = action.blocktime
 
 	// 最近喂价记录
-	pricekv := &types.KeyValue{Key: PriceKey(), Value: types.Encode(&priceRecord)}
+	pricekv := &types.KeyValue{Key: AssetPriceKey(assetExec, assetSymbol), Value: types.Encode(&priceRecord)}
 	action.db.Set(pricekv.Key, pricekv.Value)
 	kv = append(kv, pricekv)
 
@@ -1183,6 +1550,46 @@ func (action *Action) CollateralizeFeed(feed *pty.CollateralizeFeed) (*types.Rec
 	return receipt, nil
 }
 
+// 获取当前喂价轮次
+func getFeedRound(db dbm.KV, assetExec, assetSymbol string) *oty.PriceFeedRound {
+	round := &oty.PriceFeedRound{}
+	data, err := db.Get(FeedRoundKey(assetExec, assetSymbol))
+	if err != nil {
+		return round
+	}
+	err = types.Decode(data, round)
+	if err != nil {
+		clog.Error("getFeedRound", "decode", err)
+		return &oty.PriceFeedRound{}
+	}
+	return round
+}
+
+// 从oracle事件结果获取价格，事件必须是抵押资产对ccny的价格事件，超出报价时间窗口的结果不可用
+func (action *Action) getOraclePrice(eventID, assetExec, assetSymbol string, collcfg *pty.CollateralizeManage) (int64, error) {
+	data, err := action.db.Get(oracleE.Key(eventID))
+	if err != nil {
+		return 0, oty.ErrEventIDNotFound
+	}
+	var status oty.OracleStatus
+	err = types.Decode(data, &status)
+	if err != nil {
+		return 0, err
+	}
+	cfg := action.Collateralize.GetAPI().GetConfig()
+	if assetExec == "" {
+		assetExec, assetSymbol = cfg.GetCoinExec(), cfg.GetCoinSymbol()
+	}
+	price, err := oty.ResultPrice(&status, oty.PricePair(assetExec, assetSymbol, pty.CCNYTokenName))
+	if err != nil {
+		return 0, err
+	}
+	if collcfg != nil && collcfg.FeedWindow > 0 && action.blocktime-status.Time > collcfg.FeedWindow {
+		return 0, oty.ErrPriceStale
+	}
+	return price, nil
+}
+
 // CollateralizeRetrieve 收回未放贷
 func (action *Action) CollateralizeRetrieve(retrieve *pty.CollateralizeRetrieve) (*types.Receipt, error) {
 	var logs []*types.ReceiptLog
@@ -1489,3 +1896,63 @@ func queryCollateralizeUserBalance(db dbm.KV, localdb dbm.KVDB, addr string) (in
 
 	return totalBalance, nil
 }
+
+// 查询用户当前债务，包含截至now应付的稳定费
+func queryCollateralizeUserDebt(cfg *types.Chain33Config, height int64, db dbm.KV, localdb dbm.KVDB, addr string, collID string, now int64) (*pty.RepCollateralizeUserDebt, error) {
+	reply := &pty.RepCollateralizeUserDebt{}
+	query := pty.NewRecordTable(localdb).GetQuery(localdb)
+	status := []int32{pty.CollateralizeUserStatusCreate, pty.CollateralizeUserStatusWarning, pty.CollateralizeUserStatusExpire}
+	for _, st := range status {
+		var primary []byte
+		data := &pty.ReceiptCollateralize{
+			AccountAddr: addr,
+			Status:      st,
+		}
+		for {
+			rows, err := query.List("addr_status", data, primary, DefaultCount, ListDESC)
+			if err == types.ErrNotFound {
+				break
+			}
+			if err != nil {
+				clog.Error("queryCollateralizeUserDebt.List", "addr", addr, "status", st, "error", err)
+				return nil, err
+			}
+
+			for _, row := range rows {
+				r := row.Data.(*pty.ReceiptCollateralize)
+				if len(collID) > 0 && r.CollateralizeId != collID {
+					continue
+				}
+				coll, err := queryCollateralizeByID(db, r.CollateralizeId)
+				if err != nil {
+					continue
+				}
+				for _, record := range coll.BorrowRecords {
+					if record.RecordId != r.RecordId {
+						continue
+					}
+					fee := calcAccruedFee(record, coll.StabilityFeeRatio, now)
+					if record.LastAccrueTime == 0 {
+						fee = calcFlatFee(cfg, height, record, coll.StabilityFeeRatio)
+					}
+					reply.Debts = append(reply.Debts, &pty.CollateralizeDebt{
+						CollateralizeId: record.CollateralizeId,
+						RecordId:        record.RecordId,
+						DebtValue:       record.DebtValue,
+						AccruedFee:      fee,
+						TotalDebt:       record.DebtValue + fee,
+					})
+					reply.TotalDebt += record.DebtValue + fee
+					reply.TotalFee += fee
+				}
+			}
+
+			if len(rows) < int(DefaultCount) {
+				break
+			}
+			primary = []byte(rows[DefaultCount-1].Data.(*pty.ReceiptCollateralize).RecordId)
+		}
+	}
+
+	return reply, nil
+}
@@ -0,0 +1,121 @@
+package executor
+
+import (
+	"testing"
+
+	pty "github.com/33cn/chain33/system/dapp/manage/types"
+	"github.com/33cn/chain33/types"
+	pkt "github.com/33cn/plugin/plugin/dapp/collateralize/types"
+	oracleE "github.com/33cn/plugin/plugin/dapp/oracle/executor"
+	oty "github.com/33cn/plugin/plugin/dapp/oracle/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollateralizeFeedQuorum(t *testing.T) {
+	env := initEnv()
+	forkHeight := env.blockHeight + 10
+	env.cfg.SetDappFork(pkt.CollateralizeX, pkt.ForkCollateralizeFeedQuorum, forkHeight)
+
+	exec := newCollateralize().(*Collateralize)
+	exec.SetAPI(env.api)
+	exec.SetStateDB(env.db)
+	exec.SetLocalDB(env.kvdb)
+	exec.SetEnv(env.blockHeight+1, env.blockTime+1, env.difficulty)
+
+	// 三个喂价人
+	item := &types.ConfigItem{Key: "issuance-price-feed", Ty: pty.ConfigItemArrayConfig}
+	item.Value = &types.ConfigItem_Arr{Arr: &types.ArrayConfig{Value: []string{string(Nodes[0]), string(Nodes[1]), string(Nodes[2])}}}
+	env.db.Set([]byte(types.ManageKey("issuance-price-feed")), types.Encode(item))
+
+	manage := &pkt.CollateralizeManageTx{Period: 3600, LiquidationRatio: 0.25, DebtCeiling: 1000, StabilityFeeRatio: 0.0001, TotalBalance: 10000,
+		FeedQuorum: 3, FeedWindow: 60, FeedDeviation: 0.1}
+	tx, err := pkt.CreateRawCollateralizeManageTx(env.cfg, manage)
+	assert.Nil(t, err)
+	_, err = execAndSave(t, env, exec, tx, PrivKeyA)
+	assert.Equal(t, types.ErrNotSupport, err)
+
+	exec.SetEnv(forkHeight, env.blockTime+1, env.difficulty)
+	_, err = execAndSave(t, env, exec, tx, PrivKeyA)
+	assert.Nil(t, err)
+	res, err := exec.Query("CollateralizeConfig", nil)
+	assert.Nil(t, err)
+	cfgRes := res.(*pkt.RepCollateralizeConfig)
+	assert.Equal(t, int32(3), cfgRes.FeedQuorum)
+	assert.Equal(t, int64(60), cfgRes.FeedWindow)
+	assert.Equal(t, int64(1000), cfgRes.FeedDeviation)
+
+	feed := func(privKey string, price float64) error {
+		tx, err := pkt.CreateRawCollateralizeFeedTx(env.cfg, &pkt.CollateralizeFeedTx{Price: []float64{price}, Volume: []int64{100}})
+		assert.Nil(t, err)
+		_, err = execAndSave(t, env, exec, tx, privKey)
+		return err
+	}
+
+	// 报价数量不足时不更新价格
+	assert.Nil(t, feed(PrivKeyA, 1))
+	assert.Nil(t, feed(PrivKeyB, 1.05))
+	_, err = exec.Query("CollateralizePrice", nil)
+	assert.NotNil(t, err)
+	res, err = exec.Query("CollateralizeFeedRound", types.Encode(&pkt.ReqCollateralizeAssetPrice{}))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(res.(*oty.PriceFeedRound).Submissions))
+
+	// 超出时间窗口的报价被丢弃
+	exec.SetEnv(forkHeight+1, env.blockTime+100, env.difficulty)
+	assert.Nil(t, feed(PrivKeyC, 1.02))
+	_, err = exec.Query("CollateralizePrice", nil)
+	assert.NotNil(t, err)
+
+	// 偏离过大的报价也会记录，取中位数时被剔除，剩余报价不足quorum不更新价格
+	assert.Nil(t, feed(PrivKeyA, 1))
+	assert.Nil(t, feed(PrivKeyB, 5))
+	_, err = exec.Query("CollateralizePrice", nil)
+	assert.NotNil(t, err)
+	res, err = exec.Query("CollateralizeFeedRound", types.Encode(&pkt.ReqCollateralizeAssetPrice{}))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(res.(*oty.PriceFeedRound).Submissions))
+
+	assert.Nil(t, feed(PrivKeyB, 1.01))
+	res, err = exec.Query("CollateralizePrice", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10100), res.(*pkt.RepCollateralizePrice).Price)
+	res, err = exec.Query("CollateralizeFeedRound", types.Encode(&pkt.ReqCollateralizeAssetPrice{}))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(res.(*oty.PriceFeedRound).Submissions))
+
+	// oracle事件结果喂价
+	pair := oty.PricePair(env.cfg.GetCoinExec(), env.cfg.GetCoinSymbol(), pkt.CCNYTokenName)
+	status := &oty.OracleStatus{EventID: "event1", Type: oty.PriceEventType, SubType: pair, Time: env.blockTime + 90, Result: "9800",
+		Status: &oty.EventStatus{Status: oty.ResultPrePublished}}
+	env.db.Set(oracleE.Key("event1"), types.Encode(status))
+	tx, err = pkt.CreateRawCollateralizeFeedTx(env.cfg, &pkt.CollateralizeFeedTx{OracleEventID: "event1"})
+	assert.Nil(t, err)
+	_, err = execAndSave(t, env, exec, tx, PrivKeyA)
+	assert.Equal(t, oty.ErrResultNotPublished, err)
+
+	// 事件必须是抵押资产的价格事件
+	status.Status.Status = oty.ResultPublished
+	status.SubType = oty.PricePair("token", "TEST", pkt.CCNYTokenName)
+	env.db.Set(oracleE.Key("event1"), types.Encode(status))
+	_, err = execAndSave(t, env, exec, tx, PrivKeyA)
+	assert.Equal(t, oty.ErrPricePairMismatch, err)
+
+	// oracle价格同样需要达到quorum后取中位数
+	status.SubType = pair
+	env.db.Set(oracleE.Key("event1"), types.Encode(status))
+	_, err = execAndSave(t, env, exec, tx, PrivKeyA)
+	assert.Nil(t, err)
+	res, err = exec.Query("CollateralizePrice", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10100), res.(*pkt.RepCollateralizePrice).Price)
+	assert.Nil(t, feed(PrivKeyB, 0.99))
+	assert.Nil(t, feed(PrivKeyC, 0.98))
+	res, err = exec.Query("CollateralizePrice", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(9800), res.(*pkt.RepCollateralizePrice).Price)
+
+	// 过期的oracle结果不可用
+	exec.SetEnv(forkHeight+2, env.blockTime+200, env.difficulty)
+	_, err = execAndSave(t, env, exec, tx, PrivKeyA)
+	assert.Equal(t, oty.ErrPriceStale, err)
+}
@@ -0,0 +1,141 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	pkt "github.com/33cn/plugin/plugin/dapp/collateralize/types"
+	tokenE "github.com/33cn/plugin/plugin/dapp/token/executor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalcAccruedFee(t *testing.T) {
+	record := &pkt.BorrowRecord{DebtValue: 100 * types.DefaultCoinPrecision, StartTime: 1000, LastAccrueTime: 1000}
+	// 年化10%
+	assert.Equal(t, int64(0), calcAccruedFee(record, 1000, 1000))
+	assert.Equal(t, 10*types.DefaultCoinPrecision, calcAccruedFee(record, 1000, 1000+SecondsPerYear))
+
+	accrueFee(record, 1000, 1000+SecondsPerYear/2)
+	assert.Equal(t, 5*types.DefaultCoinPrecision, record.AccruedFee)
+	assert.Equal(t, int64(1000+SecondsPerYear/2), record.LastAccrueTime)
+	// 已计提部分不重复计息
+	assert.Equal(t, 10*types.DefaultCoinPrecision, calcAccruedFee(record, 1000, 1000+SecondsPerYear))
+
+	// fork之前的记录不计息
+	legacy := &pkt.BorrowRecord{DebtValue: 100 * types.DefaultCoinPrecision, StartTime: 1000}
+	assert.Equal(t, int64(0), calcAccruedFee(legacy, 1000, 1000+SecondsPerYear))
+}
+
+func TestCollateralizeInterest(t *testing.T) {
+	env := initEnv()
+	forkHeight := env.blockHeight + 10
+	env.cfg.SetDappFork(pkt.CollateralizeX, pkt.ForkCollateralizeInterest, forkHeight)
+
+	exec := newCollateralize().(*Collateralize)
+	exec.SetAPI(env.api)
+	exec.SetStateDB(env.db)
+	exec.SetLocalDB(env.kvdb)
+	exec.SetEnv(env.blockHeight+1, env.blockTime+1, env.difficulty)
+
+	ccny, _ := account.NewAccountDB(env.cfg, tokenE.GetName(), pkt.CCNYTokenName, env.db)
+	ccny.SaveExecAccount(env.execAddr, &types.Account{Balance: 1000 * types.DefaultCoinPrecision, Addr: string(Nodes[1])})
+
+	manage := &pkt.CollateralizeManageTx{Period: 2 * SecondsPerYear, LiquidationRatio: 0.25, DebtCeiling: 1000, StabilityFeeRatio: 0.1, TotalBalance: 10000}
+	tx, err := pkt.CreateRawCollateralizeManageTx(env.cfg, manage)
+	assert.Nil(t, err)
+	_, err = execAndSave(t, env, exec, tx, PrivKeyA)
+	assert.Nil(t, err)
+
+	tx, err = pkt.CreateRawCollateralizeCreateTx(env.cfg, &pkt.CollateralizeCreateTx{TotalBalance: 1000})
+	assert.Nil(t, err)
+	tx, err = execAndSave(t, env, exec, tx, PrivKeyA)
+	assert.Nil(t, err)
+	collID := common.ToHex(tx.Hash())
+
+	tx, err = pkt.CreateRawCollateralizeFeedTx(env.cfg, &pkt.CollateralizeFeedTx{Price: []float64{1}, Volume: []int64{100}})
+	assert.Nil(t, err)
+	_, err = execAndSave(t, env, exec, tx, PrivKeyB)
+	assert.Nil(t, err)
+
+	tx, err = pkt.CreateRawCollateralizeBorrowTx(env.cfg, &pkt.CollateralizeBorrowTx{CollateralizeID: collID, Value: 100})
+	assert.Nil(t, err)
+	tx, err = execAndSave(t, env, exec, tx, PrivKeyB)
+	assert.Nil(t, err)
+	legacyID := common.ToHex(tx.Hash())
+
+	// fork之前不支持部分还款
+	tx, err = pkt.CreateRawCollateralizeRepayTx(env.cfg, &pkt.CollateralizeRepayTx{CollateralizeID: collID, RecordID: legacyID, Value: 3})
+	assert.Nil(t, err)
+	_, err = execAndSave(t, env, exec, tx, PrivKeyB)
+	assert.Equal(t, types.ErrNotSupport, err)
+
+	// fork之后借出的记录从借出时间开始计息
+	borrowTime := env.blockTime + 2
+	exec.SetEnv(forkHeight, borrowTime, env.difficulty)
+	tx, err = pkt.CreateRawCollateralizeBorrowTx(env.cfg, &pkt.CollateralizeBorrowTx{CollateralizeID: collID, Value: 100})
+	assert.Nil(t, err)
+	tx, err = execAndSave(t, env, exec, tx, PrivKeyB)
+	assert.Nil(t, err)
+	recordID := common.ToHex(tx.Hash())
+	record, err := queryCollateralizeRecordByID(env.db, collID, recordID)
+	assert.Nil(t, err)
+	assert.Equal(t, borrowTime, record.LastAccrueTime)
+
+	// 半年后稳定费5ccny，fork之前的记录仍按固定比例收取10ccny
+	halfYear := borrowTime + SecondsPerYear/2
+	res, err := exec.Query("CollateralizeUserDebt", types.Encode(&pkt.ReqCollateralizeUserDebt{Addr: string(Nodes[1]), Time: halfYear}))
+	assert.Nil(t, err)
+	debt := res.(*pkt.RepCollateralizeUserDebt)
+	assert.Equal(t, 2, len(debt.Debts))
+	assert.Equal(t, 15*types.DefaultCoinPrecision, debt.TotalFee)
+	assert.Equal(t, 215*types.DefaultCoinPrecision, debt.TotalDebt)
+
+	// fork之前的记录不支持部分还款
+	exec.SetEnv(forkHeight, halfYear, env.difficulty)
+	tx, err = pkt.CreateRawCollateralizeRepayTx(env.cfg, &pkt.CollateralizeRepayTx{CollateralizeID: collID, RecordID: legacyID, Value: 3})
+	assert.Nil(t, err)
+	_, err = execAndSave(t, env, exec, tx, PrivKeyB)
+	assert.Equal(t, types.ErrNotSupport, err)
+
+	// 先还稳定费
+	tx, err = pkt.CreateRawCollateralizeRepayTx(env.cfg, &pkt.CollateralizeRepayTx{CollateralizeID: collID, RecordID: recordID, Value: 3})
+	assert.Nil(t, err)
+	_, err = execAndSave(t, env, exec, tx, PrivKeyB)
+	assert.Nil(t, err)
+	record, err = queryCollateralizeRecordByID(env.db, collID, recordID)
+	assert.Nil(t, err)
+	assert.Equal(t, 2*types.DefaultCoinPrecision, record.AccruedFee)
+	assert.Equal(t, 100*types.DefaultCoinPrecision, record.DebtValue)
+
+	// 剩余稳定费还清后还本金
+	tx, err = pkt.CreateRawCollateralizeRepayTx(env.cfg, &pkt.CollateralizeRepayTx{CollateralizeID: collID, RecordID: recordID, Value: 52})
+	assert.Nil(t, err)
+	_, err = execAndSave(t, env, exec, tx, PrivKeyB)
+	assert.Nil(t, err)
+	record, err = queryCollateralizeRecordByID(env.db, collID, recordID)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), record.AccruedFee)
+	assert.Equal(t, 50*types.DefaultCoinPrecision, record.DebtValue)
+	assert.Equal(t, int32(pkt.CollateralizeUserStatusCreate), record.Status)
+
+	// 全部还清
+	for _, id := range []string{recordID, legacyID} {
+		tx, err = pkt.CreateRawCollateralizeRepayTx(env.cfg, &pkt.CollateralizeRepayTx{CollateralizeID: collID, RecordID: id})
+		assert.Nil(t, err)
+		_, err = execAndSave(t, env, exec, tx, PrivKeyB)
+		assert.Nil(t, err)
+		record, err = queryCollateralizeRecordByID(env.db, collID, id)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(pkt.CollateralizeUserStatusClose), record.Status)
+	}
+	// 共借出200，计息记录还款105，fork之前的记录还款110
+	assert.Equal(t, (1000+200-105-110)*types.DefaultCoinPrecision, ccny.LoadExecAccount(string(Nodes[1]), env.execAddr).Balance)
+	coll, err := queryCollateralizeByID(env.db, collID)
+	assert.Nil(t, err)
+	assert.Equal(t, 1000*types.DefaultCoinPrecision, coll.Balance)
+	res, err = exec.Query("CollateralizeUserDebt", types.Encode(&pkt.ReqCollateralizeUserDebt{Addr: string(Nodes[1]), Time: halfYear}))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(res.(*pkt.RepCollateralizeUserDebt).Debts))
+}
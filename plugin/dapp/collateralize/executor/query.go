@@ -28,6 +28,8 @@ func (c *Collateralize) Query_CollateralizeInfoByID(req *pty.ReqCollateralizeInf
 		Period:            coll.Period,
 		CollateralizeId:   coll.CollateralizeId,
 		CollBalance:       coll.CollBalance,
+		AssetExec:         coll.AssetExec,
+		AssetSymbol:       coll.AssetSymbol,
 	}
 	info.BorrowRecords = append(info.BorrowRecords, coll.BorrowRecords...)
 	info.BorrowRecords = append(info.BorrowRecords, coll.InvalidRecords...)
@@ -56,6 +58,8 @@ func (c *Collateralize) Query_CollateralizeInfoByIDs(req *pty.ReqCollateralizeIn
 			Period:            coll.Period,
 			CollateralizeId:   coll.CollateralizeId,
 			CollBalance:       coll.CollBalance,
+			AssetExec:         coll.AssetExec,
+			AssetSymbol:       coll.AssetSymbol,
 		}
 		info.BorrowRecords = append(info.BorrowRecords, coll.BorrowRecords...)
 		info.BorrowRecords = append(info.BorrowRecords, coll.InvalidRecords...)
@@ -161,6 +165,10 @@ func (c *Collateralize) Query_CollateralizeConfig(req *pty.ReqCollateralizeRecor
 		Period:            config.Period,
 		Balance:           balance,
 		CurrentTime:       config.CurrentTime,
+		AssetConfigs:      config.AssetConfigs,
+		FeedQuorum:        config.FeedQuorum,
+		FeedWindow:        config.FeedWindow,
+		FeedDeviation:     config.FeedDeviation,
 	}
 
 	return ret, nil
@@ -177,6 +185,55 @@ func (c *Collateralize) Query_CollateralizePrice(req *pty.ReqCollateralizeRecord
 	return &pty.RepCollateralizePrice{Price: price}, nil
 }
 
+//Query_CollateralizeAssetPrice ...
+func (c *Collateralize) Query_CollateralizeAssetPrice(req *pty.ReqCollateralizeAssetPrice) (types.Message, error) {
+	assetExec, assetSymbol := req.AssetExec, req.AssetSymbol
+	cfg := c.GetAPI().GetConfig()
+	if assetExec == cfg.GetCoinExec() && assetSymbol == cfg.GetCoinSymbol() {
+		assetExec, assetSymbol = "", ""
+	}
+	price, err := getLatestAssetPrice(c.GetStateDB(), assetExec, assetSymbol)
+	if err != nil {
+		clog.Error("Query_CollateralizeAssetPrice", "assetExec", req.AssetExec, "assetSymbol", req.AssetSymbol, "error", err)
+		return nil, err
+	}
+
+	return &pty.RepCollateralizePrice{Price: price}, nil
+}
+
+//Query_CollateralizeFeedRound ...
+func (c *Collateralize) Query_CollateralizeFeedRound(req *pty.ReqCollateralizeAssetPrice) (types.Message, error) {
+	assetExec, assetSymbol := req.AssetExec, req.AssetSymbol
+	cfg := c.GetAPI().GetConfig()
+	if assetExec == cfg.GetCoinExec() && assetSymbol == cfg.GetCoinSymbol() {
+		assetExec, assetSymbol = "", ""
+	}
+	return getFeedRound(c.GetStateDB(), assetExec, assetSymbol), nil
+}
+
+//Query_CollateralizeUserDebt ...
+func (c *Collateralize) Query_CollateralizeUserDebt(req *pty.ReqCollateralizeUserDebt) (types.Message, error) {
+	if req == nil || req.Addr == "" {
+		return nil, types.ErrInvalidParam
+	}
+	now := req.Time
+	if now == 0 {
+		header, err := c.GetAPI().GetLastHeader()
+		if err != nil {
+			clog.Error("Query_CollateralizeUserDebt", "GetLastHeader", err)
+			return nil, err
+		}
+		now = header.BlockTime
+	}
+
+	debt, err := queryCollateralizeUserDebt(c.GetAPI().GetConfig(), c.GetHeight(), c.GetStateDB(), c.GetLocalDB(), req.Addr, req.CollateralizeId, now)
+	if err != nil {
+		clog.Error("Query_CollateralizeUserDebt", "addr", req.Addr, "error", err)
+		return nil, err
+	}
+	return debt, nil
+}
+
 //Query_CollateralizeUserBalance ...
 func (c *Collateralize) Query_CollateralizeUserBalance(req *pty.ReqCollateralizeRecordByAddr) (types.Message, error) {
 	balance, err := queryCollateralizeUserBalance(c.GetStateDB(), c.GetLocalDB(), req.Addr)
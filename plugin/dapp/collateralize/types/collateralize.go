@@ -31,6 +31,9 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(CollateralizeX, "Enable", 0)
 	cfg.RegisterDappFork(CollateralizeX, ForkCollateralizeTableUpdate, 0)
 	cfg.RegisterDappFork(CollateralizeX, ForkCollateralizePrecision, 0)
+	cfg.RegisterDappFork(CollateralizeX, ForkCollateralizeMultiAsset, 0)
+	cfg.RegisterDappFork(CollateralizeX, ForkCollateralizeInterest, 0)
+	cfg.RegisterDappFork(CollateralizeX, ForkCollateralizeFeedQuorum, 0)
 }
 
 //InitExecutor ...
@@ -164,6 +167,8 @@ func CreateRawCollateralizeCreateTx(cfg *types.Chain33Config, parm *Collateraliz
 	}
 	v := &CollateralizeCreate{
 		TotalBalance: totalBalanceInt64,
+		AssetExec:    parm.AssetExec,
+		AssetSymbol:  parm.AssetSymbol,
 	}
 	create := &CollateralizeAction{
 		Ty:    CollateralizeActionCreate,
@@ -222,9 +227,14 @@ func CreateRawCollateralizeRepayTx(cfg *types.Chain33Config, parm *Collateralize
 		return nil, types.ErrInvalidParam
 	}
 
+	valueInt64, err := types.FormatFloatDisplay2Value(parm.Value, cfg.GetTokenPrecision())
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidParam, "FormatFloatDisplay2Value.Value")
+	}
 	v := &CollateralizeRepay{
 		CollateralizeId: parm.CollateralizeID,
 		RecordId:        parm.RecordID,
+		Value:           valueInt64,
 	}
 	repay := &CollateralizeAction{
 		Ty:    CollateralizeActionRepay,
@@ -237,7 +247,7 @@ func CreateRawCollateralizeRepayTx(cfg *types.Chain33Config, parm *Collateralize
 		To:      address.ExecAddress(cfg.ExecName(CollateralizeX)),
 	}
 	name := cfg.ExecName(CollateralizeX)
-	tx, err := types.FormatTx(cfg, name, tx)
+	tx, err = types.FormatTx(cfg, name, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -285,7 +295,10 @@ func CreateRawCollateralizeFeedTx(cfg *types.Chain33Config, parm *CollateralizeF
 	}
 
 	v := &CollateralizeFeed{
-		Volume: parm.Volume,
+		Volume:        parm.Volume,
+		AssetExec:     parm.AssetExec,
+		AssetSymbol:   parm.AssetSymbol,
+		OracleEventId: parm.OracleEventID,
 	}
 
 	for _, r := range parm.Price {
@@ -363,6 +376,18 @@ func CreateRawCollateralizeManageTx(cfg *types.Chain33Config, parm *Collateraliz
 		StabilityFeeRatio: int64(math.Trunc((parm.StabilityFeeRatio + 0.0000001) * 1e4)),
 		Period:            parm.Period,
 		TotalBalance:      totalBalanceInt64,
+		FeedQuorum:        parm.FeedQuorum,
+		FeedWindow:        parm.FeedWindow,
+		FeedDeviation:     int64(math.Trunc((parm.FeedDeviation + 0.0000001) * 1e4)),
+	}
+	// 指定抵押资产时，清算比例只作用于该资产
+	if parm.AssetExec != "" || parm.AssetSymbol != "" {
+		v.AssetConfigs = append(v.AssetConfigs, &CollateralizeAssetConfig{
+			AssetExec:        parm.AssetExec,
+			AssetSymbol:      parm.AssetSymbol,
+			LiquidationRatio: v.LiquidationRatio,
+		})
+		v.LiquidationRatio = 0
 	}
 
 	manage := &CollateralizeAction{
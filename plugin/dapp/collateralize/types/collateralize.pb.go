@@ -42,6 +42,8 @@ type Collateralize struct {
 	LatestExpireTime       int64           `protobuf:"varint,13,opt,name=latestExpireTime,proto3" json:"latestExpireTime,omitempty"`             //最近超期时间
 	CollBalance            int64           `protobuf:"varint,14,opt,name=collBalance,proto3" json:"collBalance,omitempty"`                       //抵押bty
 	PreStatus              int32           `protobuf:"varint,15,opt,name=preStatus,proto3" json:"preStatus,omitempty"`                           //上一个状态
+	AssetExec              string          `protobuf:"bytes,16,opt,name=assetExec,proto3" json:"assetExec,omitempty"`                            //抵押资产执行器,为空表示bty
+	AssetSymbol            string          `protobuf:"bytes,17,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`                        //抵押资产符号
 }
 
 func (x *Collateralize) Reset() {
@@ -181,6 +183,20 @@ func (x *Collateralize) GetPreStatus() int32 {
 	return 0
 }
 
+func (x *Collateralize) GetAssetExec() string {
+	if x != nil {
+		return x.AssetExec
+	}
+	return ""
+}
+
+func (x *Collateralize) GetAssetSymbol() string {
+	if x != nil {
+		return x.AssetSymbol
+	}
+	return ""
+}
+
 // 借出记录
 type BorrowRecord struct {
 	state         protoimpl.MessageState
@@ -199,6 +215,8 @@ type BorrowRecord struct {
 	PreStatus        int32  `protobuf:"varint,10,opt,name=preStatus,proto3" json:"preStatus,omitempty"`              //上一次抵押状态，用于告警恢复
 	RecordId         string `protobuf:"bytes,11,opt,name=recordId,proto3" json:"recordId,omitempty"`                 //借贷id，标识一次借出记录
 	CollateralizeId  string `protobuf:"bytes,12,opt,name=collateralizeId,proto3" json:"collateralizeId,omitempty"`   //放贷id
+	AccruedFee       int64  `protobuf:"varint,13,opt,name=accruedFee,proto3" json:"accruedFee,omitempty"`            //已计提未支付的稳定费(ccny)
+	LastAccrueTime   int64  `protobuf:"varint,14,opt,name=lastAccrueTime,proto3" json:"lastAccrueTime,omitempty"`    //上次计提稳定费时间
 }
 
 func (x *BorrowRecord) Reset() {
@@ -317,6 +335,20 @@ func (x *BorrowRecord) GetCollateralizeId() string {
 	return ""
 }
 
+func (x *BorrowRecord) GetAccruedFee() int64 {
+	if x != nil {
+		return x.AccruedFee
+	}
+	return 0
+}
+
+func (x *BorrowRecord) GetLastAccrueTime() int64 {
+	if x != nil {
+		return x.LastAccrueTime
+	}
+	return 0
+}
+
 // 资产价格记录
 type AssetPriceRecord struct {
 	state         protoimpl.MessageState
@@ -327,6 +359,7 @@ type AssetPriceRecord struct {
 	BtyPrice   int64 `protobuf:"varint,2,opt,name=btyPrice,proto3" json:"btyPrice,omitempty"`     // bty价格
 	BtcPrice   int64 `protobuf:"varint,3,opt,name=btcPrice,proto3" json:"btcPrice,omitempty"`     // btc价格
 	EthPrice   int64 `protobuf:"varint,4,opt,name=ethPrice,proto3" json:"ethPrice,omitempty"`     // eth价格
+	Price      int64 `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`           // 其他抵押资产价格
 }
 
 func (x *AssetPriceRecord) Reset() {
@@ -389,6 +422,13 @@ func (x *AssetPriceRecord) GetEthPrice() int64 {
 	return 0
 }
 
+func (x *AssetPriceRecord) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
 // action
 type CollateralizeAction struct {
 	state         protoimpl.MessageState
@@ -553,12 +593,16 @@ type CollateralizeManage struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DebtCeiling       int64 `protobuf:"varint,1,opt,name=debtCeiling,proto3" json:"debtCeiling,omitempty"`             //单用户可借出的限额(ccny)
-	LiquidationRatio  int64 `protobuf:"varint,2,opt,name=liquidationRatio,proto3" json:"liquidationRatio,omitempty"`   //清算比例
-	StabilityFeeRatio int64 `protobuf:"varint,3,opt,name=stabilityFeeRatio,proto3" json:"stabilityFeeRatio,omitempty"` //稳定费
-	Period            int64 `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"`                       //合约期限
-	TotalBalance      int64 `protobuf:"varint,5,opt,name=totalBalance,proto3" json:"totalBalance,omitempty"`           //放贷总量
-	CurrentTime       int64 `protobuf:"varint,6,opt,name=currentTime,proto3" json:"currentTime,omitempty"`             //设置时间
+	DebtCeiling       int64                       `protobuf:"varint,1,opt,name=debtCeiling,proto3" json:"debtCeiling,omitempty"`             //单用户可借出的限额(ccny)
+	LiquidationRatio  int64                       `protobuf:"varint,2,opt,name=liquidationRatio,proto3" json:"liquidationRatio,omitempty"`   //清算比例
+	StabilityFeeRatio int64                       `protobuf:"varint,3,opt,name=stabilityFeeRatio,proto3" json:"stabilityFeeRatio,omitempty"` //稳定费
+	Period            int64                       `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"`                       //合约期限
+	TotalBalance      int64                       `protobuf:"varint,5,opt,name=totalBalance,proto3" json:"totalBalance,omitempty"`           //放贷总量
+	CurrentTime       int64                       `protobuf:"varint,6,opt,name=currentTime,proto3" json:"currentTime,omitempty"`             //设置时间
+	AssetConfigs      []*CollateralizeAssetConfig `protobuf:"bytes,7,rep,name=assetConfigs,proto3" json:"assetConfigs,omitempty"`            //各抵押资产配置
+	FeedQuorum        int32                       `protobuf:"varint,8,opt,name=feedQuorum,proto3" json:"feedQuorum,omitempty"`               //多喂价人模式下取中位数所需的最少报价数，小于等于1表示单一喂价
+	FeedWindow        int64                       `protobuf:"varint,9,opt,name=feedWindow,proto3" json:"feedWindow,omitempty"`               //报价有效时间窗口(秒)
+	FeedDeviation     int64                       `protobuf:"varint,10,opt,name=feedDeviation,proto3" json:"feedDeviation,omitempty"`        //允许偏离全部报价中位数的比例，超出的报价在取中位数时剔除
 }
 
 func (x *CollateralizeManage) Reset() {
@@ -635,6 +679,98 @@ func (x *CollateralizeManage) GetCurrentTime() int64 {
 	return 0
 }
 
+func (x *CollateralizeManage) GetAssetConfigs() []*CollateralizeAssetConfig {
+	if x != nil {
+		return x.AssetConfigs
+	}
+	return nil
+}
+
+func (x *CollateralizeManage) GetFeedQuorum() int32 {
+	if x != nil {
+		return x.FeedQuorum
+	}
+	return 0
+}
+
+func (x *CollateralizeManage) GetFeedWindow() int64 {
+	if x != nil {
+		return x.FeedWindow
+	}
+	return 0
+}
+
+func (x *CollateralizeManage) GetFeedDeviation() int64 {
+	if x != nil {
+		return x.FeedDeviation
+	}
+	return 0
+}
+
+// 抵押资产配置
+type CollateralizeAssetConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssetExec        string `protobuf:"bytes,1,opt,name=assetExec,proto3" json:"assetExec,omitempty"`                //抵押资产执行器
+	AssetSymbol      string `protobuf:"bytes,2,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`            //抵押资产符号
+	LiquidationRatio int64  `protobuf:"varint,3,opt,name=liquidationRatio,proto3" json:"liquidationRatio,omitempty"` //清算比例
+}
+
+func (x *CollateralizeAssetConfig) Reset() {
+	*x = CollateralizeAssetConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collateralize_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollateralizeAssetConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralizeAssetConfig) ProtoMessage() {}
+
+func (x *CollateralizeAssetConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_collateralize_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralizeAssetConfig.ProtoReflect.Descriptor instead.
+func (*CollateralizeAssetConfig) Descriptor() ([]byte, []int) {
+	return file_collateralize_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CollateralizeAssetConfig) GetAssetExec() string {
+	if x != nil {
+		return x.AssetExec
+	}
+	return ""
+}
+
+func (x *CollateralizeAssetConfig) GetAssetSymbol() string {
+	if x != nil {
+		return x.AssetSymbol
+	}
+	return ""
+}
+
+func (x *CollateralizeAssetConfig) GetLiquidationRatio() int64 {
+	if x != nil {
+		return x.LiquidationRatio
+	}
+	return 0
+}
+
 type CollateralizeAddr struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -646,7 +782,7 @@ type CollateralizeAddr struct {
 func (x *CollateralizeAddr) Reset() {
 	*x = CollateralizeAddr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[5]
+		mi := &file_collateralize_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -659,7 +795,7 @@ func (x *CollateralizeAddr) String() string {
 func (*CollateralizeAddr) ProtoMessage() {}
 
 func (x *CollateralizeAddr) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[5]
+	mi := &file_collateralize_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -672,7 +808,7 @@ func (x *CollateralizeAddr) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollateralizeAddr.ProtoReflect.Descriptor instead.
 func (*CollateralizeAddr) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{5}
+	return file_collateralize_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CollateralizeAddr) GetSuperAddrs() []string {
@@ -688,13 +824,15 @@ type CollateralizeCreate struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalBalance int64 `protobuf:"varint,1,opt,name=totalBalance,proto3" json:"totalBalance,omitempty"` //可借贷总金额
+	TotalBalance int64  `protobuf:"varint,1,opt,name=totalBalance,proto3" json:"totalBalance,omitempty"` //可借贷总金额
+	AssetExec    string `protobuf:"bytes,2,opt,name=assetExec,proto3" json:"assetExec,omitempty"`        //抵押资产执行器,为空表示bty
+	AssetSymbol  string `protobuf:"bytes,3,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`    //抵押资产符号
 }
 
 func (x *CollateralizeCreate) Reset() {
 	*x = CollateralizeCreate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[6]
+		mi := &file_collateralize_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -707,7 +845,7 @@ func (x *CollateralizeCreate) String() string {
 func (*CollateralizeCreate) ProtoMessage() {}
 
 func (x *CollateralizeCreate) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[6]
+	mi := &file_collateralize_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -720,7 +858,7 @@ func (x *CollateralizeCreate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollateralizeCreate.ProtoReflect.Descriptor instead.
 func (*CollateralizeCreate) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{6}
+	return file_collateralize_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CollateralizeCreate) GetTotalBalance() int64 {
@@ -730,6 +868,20 @@ func (x *CollateralizeCreate) GetTotalBalance() int64 {
 	return 0
 }
 
+func (x *CollateralizeCreate) GetAssetExec() string {
+	if x != nil {
+		return x.AssetExec
+	}
+	return ""
+}
+
+func (x *CollateralizeCreate) GetAssetSymbol() string {
+	if x != nil {
+		return x.AssetSymbol
+	}
+	return ""
+}
+
 // 质押借出
 type CollateralizeBorrow struct {
 	state         protoimpl.MessageState
@@ -743,7 +895,7 @@ type CollateralizeBorrow struct {
 func (x *CollateralizeBorrow) Reset() {
 	*x = CollateralizeBorrow{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[7]
+		mi := &file_collateralize_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -756,7 +908,7 @@ func (x *CollateralizeBorrow) String() string {
 func (*CollateralizeBorrow) ProtoMessage() {}
 
 func (x *CollateralizeBorrow) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[7]
+	mi := &file_collateralize_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -769,7 +921,7 @@ func (x *CollateralizeBorrow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollateralizeBorrow.ProtoReflect.Descriptor instead.
 func (*CollateralizeBorrow) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{7}
+	return file_collateralize_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CollateralizeBorrow) GetCollateralizeId() string {
@@ -794,12 +946,13 @@ type CollateralizeRepay struct {
 
 	CollateralizeId string `protobuf:"bytes,1,opt,name=collateralizeId,proto3" json:"collateralizeId,omitempty"` //借贷期数ID
 	RecordId        string `protobuf:"bytes,2,opt,name=recordId,proto3" json:"recordId,omitempty"`               //借贷ID
+	Value           int64  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`                    //还款金额(ccny)，先还稳定费再还本金，0表示全部还清
 }
 
 func (x *CollateralizeRepay) Reset() {
 	*x = CollateralizeRepay{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[8]
+		mi := &file_collateralize_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -812,7 +965,7 @@ func (x *CollateralizeRepay) String() string {
 func (*CollateralizeRepay) ProtoMessage() {}
 
 func (x *CollateralizeRepay) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[8]
+	mi := &file_collateralize_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -825,7 +978,7 @@ func (x *CollateralizeRepay) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollateralizeRepay.ProtoReflect.Descriptor instead.
 func (*CollateralizeRepay) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{8}
+	return file_collateralize_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CollateralizeRepay) GetCollateralizeId() string {
@@ -842,6 +995,13 @@ func (x *CollateralizeRepay) GetRecordId() string {
 	return ""
 }
 
+func (x *CollateralizeRepay) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
 // 追加抵押物
 type CollateralizeAppend struct {
 	state         protoimpl.MessageState
@@ -856,7 +1016,7 @@ type CollateralizeAppend struct {
 func (x *CollateralizeAppend) Reset() {
 	*x = CollateralizeAppend{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[9]
+		mi := &file_collateralize_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -869,7 +1029,7 @@ func (x *CollateralizeAppend) String() string {
 func (*CollateralizeAppend) ProtoMessage() {}
 
 func (x *CollateralizeAppend) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[9]
+	mi := &file_collateralize_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -882,7 +1042,7 @@ func (x *CollateralizeAppend) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollateralizeAppend.ProtoReflect.Descriptor instead.
 func (*CollateralizeAppend) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{9}
+	return file_collateralize_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CollateralizeAppend) GetCollateralizeId() string {
@@ -912,15 +1072,18 @@ type CollateralizeFeed struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CollType int32   `protobuf:"varint,1,opt,name=collType,proto3" json:"collType,omitempty"`    //抵押物价格类型(1，bty，2，btc，3，eth...)
-	Price    []int64 `protobuf:"varint,2,rep,packed,name=price,proto3" json:"price,omitempty"`   //喂价
-	Volume   []int64 `protobuf:"varint,3,rep,packed,name=volume,proto3" json:"volume,omitempty"` //成交量
+	CollType      int32   `protobuf:"varint,1,opt,name=collType,proto3" json:"collType,omitempty"`          //抵押物价格类型(1，bty，2，btc，3，eth...)
+	Price         []int64 `protobuf:"varint,2,rep,packed,name=price,proto3" json:"price,omitempty"`         //喂价
+	Volume        []int64 `protobuf:"varint,3,rep,packed,name=volume,proto3" json:"volume,omitempty"`       //成交量
+	AssetExec     string  `protobuf:"bytes,4,opt,name=assetExec,proto3" json:"assetExec,omitempty"`         //抵押资产执行器,为空表示bty
+	AssetSymbol   string  `protobuf:"bytes,5,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`     //抵押资产符号
+	OracleEventId string  `protobuf:"bytes,6,opt,name=oracleEventId,proto3" json:"oracleEventId,omitempty"` //从已公布结果的oracle价格事件获取价格，事件子类别为抵押资产对CCNY的交易对，结果为放大1e4的整数价格
 }
 
 func (x *CollateralizeFeed) Reset() {
 	*x = CollateralizeFeed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[10]
+		mi := &file_collateralize_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -933,7 +1096,7 @@ func (x *CollateralizeFeed) String() string {
 func (*CollateralizeFeed) ProtoMessage() {}
 
 func (x *CollateralizeFeed) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[10]
+	mi := &file_collateralize_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -946,7 +1109,7 @@ func (x *CollateralizeFeed) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollateralizeFeed.ProtoReflect.Descriptor instead.
 func (*CollateralizeFeed) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{10}
+	return file_collateralize_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CollateralizeFeed) GetCollType() int32 {
@@ -970,6 +1133,27 @@ func (x *CollateralizeFeed) GetVolume() []int64 {
 	return nil
 }
 
+func (x *CollateralizeFeed) GetAssetExec() string {
+	if x != nil {
+		return x.AssetExec
+	}
+	return ""
+}
+
+func (x *CollateralizeFeed) GetAssetSymbol() string {
+	if x != nil {
+		return x.AssetSymbol
+	}
+	return ""
+}
+
+func (x *CollateralizeFeed) GetOracleEventId() string {
+	if x != nil {
+		return x.OracleEventId
+	}
+	return ""
+}
+
 // 收回
 type CollateralizeRetrieve struct {
 	state         protoimpl.MessageState
@@ -983,7 +1167,7 @@ type CollateralizeRetrieve struct {
 func (x *CollateralizeRetrieve) Reset() {
 	*x = CollateralizeRetrieve{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[11]
+		mi := &file_collateralize_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -996,7 +1180,7 @@ func (x *CollateralizeRetrieve) String() string {
 func (*CollateralizeRetrieve) ProtoMessage() {}
 
 func (x *CollateralizeRetrieve) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[11]
+	mi := &file_collateralize_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1009,7 +1193,7 @@ func (x *CollateralizeRetrieve) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollateralizeRetrieve.ProtoReflect.Descriptor instead.
 func (*CollateralizeRetrieve) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{11}
+	return file_collateralize_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CollateralizeRetrieve) GetCollateralizeId() string {
@@ -1041,7 +1225,7 @@ type ReceiptCollateralize struct {
 func (x *ReceiptCollateralize) Reset() {
 	*x = ReceiptCollateralize{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[12]
+		mi := &file_collateralize_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1054,7 +1238,7 @@ func (x *ReceiptCollateralize) String() string {
 func (*ReceiptCollateralize) ProtoMessage() {}
 
 func (x *ReceiptCollateralize) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[12]
+	mi := &file_collateralize_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1067,7 +1251,7 @@ func (x *ReceiptCollateralize) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptCollateralize.ProtoReflect.Descriptor instead.
 func (*ReceiptCollateralize) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{12}
+	return file_collateralize_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ReceiptCollateralize) GetCollateralizeId() string {
@@ -1110,7 +1294,7 @@ type CollateralizeRecords struct {
 func (x *CollateralizeRecords) Reset() {
 	*x = CollateralizeRecords{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[13]
+		mi := &file_collateralize_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1123,7 +1307,7 @@ func (x *CollateralizeRecords) String() string {
 func (*CollateralizeRecords) ProtoMessage() {}
 
 func (x *CollateralizeRecords) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[13]
+	mi := &file_collateralize_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1136,7 +1320,7 @@ func (x *CollateralizeRecords) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollateralizeRecords.ProtoReflect.Descriptor instead.
 func (*CollateralizeRecords) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{13}
+	return file_collateralize_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CollateralizeRecords) GetRecords() []*ReceiptCollateralize {
@@ -1158,7 +1342,7 @@ type ReqCollateralizeInfo struct {
 func (x *ReqCollateralizeInfo) Reset() {
 	*x = ReqCollateralizeInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[14]
+		mi := &file_collateralize_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1171,7 +1355,7 @@ func (x *ReqCollateralizeInfo) String() string {
 func (*ReqCollateralizeInfo) ProtoMessage() {}
 
 func (x *ReqCollateralizeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[14]
+	mi := &file_collateralize_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1184,7 +1368,7 @@ func (x *ReqCollateralizeInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqCollateralizeInfo.ProtoReflect.Descriptor instead.
 func (*ReqCollateralizeInfo) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{14}
+	return file_collateralize_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ReqCollateralizeInfo) GetCollateralizeId() string {
@@ -1211,12 +1395,14 @@ type RepCollateralizeCurrentInfo struct {
 	CollateralizeId   string          `protobuf:"bytes,9,opt,name=collateralizeId,proto3" json:"collateralizeId,omitempty"`      //放贷ID
 	CollBalance       int64           `protobuf:"varint,10,opt,name=collBalance,proto3" json:"collBalance,omitempty"`            //抵押bty
 	BorrowRecords     []*BorrowRecord `protobuf:"bytes,11,rep,name=borrowRecords,proto3" json:"borrowRecords,omitempty"`         //借贷记录
+	AssetExec         string          `protobuf:"bytes,12,opt,name=assetExec,proto3" json:"assetExec,omitempty"`                 //抵押资产执行器
+	AssetSymbol       string          `protobuf:"bytes,13,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`             //抵押资产符号
 }
 
 func (x *RepCollateralizeCurrentInfo) Reset() {
 	*x = RepCollateralizeCurrentInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[15]
+		mi := &file_collateralize_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1229,7 +1415,7 @@ func (x *RepCollateralizeCurrentInfo) String() string {
 func (*RepCollateralizeCurrentInfo) ProtoMessage() {}
 
 func (x *RepCollateralizeCurrentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[15]
+	mi := &file_collateralize_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1242,7 +1428,7 @@ func (x *RepCollateralizeCurrentInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepCollateralizeCurrentInfo.ProtoReflect.Descriptor instead.
 func (*RepCollateralizeCurrentInfo) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{15}
+	return file_collateralize_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RepCollateralizeCurrentInfo) GetStatus() int32 {
@@ -1322,6 +1508,20 @@ func (x *RepCollateralizeCurrentInfo) GetBorrowRecords() []*BorrowRecord {
 	return nil
 }
 
+func (x *RepCollateralizeCurrentInfo) GetAssetExec() string {
+	if x != nil {
+		return x.AssetExec
+	}
+	return ""
+}
+
+func (x *RepCollateralizeCurrentInfo) GetAssetSymbol() string {
+	if x != nil {
+		return x.AssetSymbol
+	}
+	return ""
+}
+
 // 根据ID列表查询多期放贷信息
 type ReqCollateralizeInfos struct {
 	state         protoimpl.MessageState
@@ -1334,7 +1534,7 @@ type ReqCollateralizeInfos struct {
 func (x *ReqCollateralizeInfos) Reset() {
 	*x = ReqCollateralizeInfos{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[16]
+		mi := &file_collateralize_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1347,7 +1547,7 @@ func (x *ReqCollateralizeInfos) String() string {
 func (*ReqCollateralizeInfos) ProtoMessage() {}
 
 func (x *ReqCollateralizeInfos) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[16]
+	mi := &file_collateralize_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1360,7 +1560,7 @@ func (x *ReqCollateralizeInfos) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqCollateralizeInfos.ProtoReflect.Descriptor instead.
 func (*ReqCollateralizeInfos) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{16}
+	return file_collateralize_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ReqCollateralizeInfos) GetCollateralizeIds() []string {
@@ -1382,7 +1582,7 @@ type RepCollateralizeCurrentInfos struct {
 func (x *RepCollateralizeCurrentInfos) Reset() {
 	*x = RepCollateralizeCurrentInfos{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[17]
+		mi := &file_collateralize_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1395,7 +1595,7 @@ func (x *RepCollateralizeCurrentInfos) String() string {
 func (*RepCollateralizeCurrentInfos) ProtoMessage() {}
 
 func (x *RepCollateralizeCurrentInfos) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[17]
+	mi := &file_collateralize_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1408,7 +1608,7 @@ func (x *RepCollateralizeCurrentInfos) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepCollateralizeCurrentInfos.ProtoReflect.Descriptor instead.
 func (*RepCollateralizeCurrentInfos) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{17}
+	return file_collateralize_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *RepCollateralizeCurrentInfos) GetInfos() []*RepCollateralizeCurrentInfo {
@@ -1431,7 +1631,7 @@ type ReqCollateralizeByStatus struct {
 func (x *ReqCollateralizeByStatus) Reset() {
 	*x = ReqCollateralizeByStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[18]
+		mi := &file_collateralize_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1444,7 +1644,7 @@ func (x *ReqCollateralizeByStatus) String() string {
 func (*ReqCollateralizeByStatus) ProtoMessage() {}
 
 func (x *ReqCollateralizeByStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[18]
+	mi := &file_collateralize_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1457,7 +1657,7 @@ func (x *ReqCollateralizeByStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqCollateralizeByStatus.ProtoReflect.Descriptor instead.
 func (*ReqCollateralizeByStatus) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{18}
+	return file_collateralize_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ReqCollateralizeByStatus) GetStatus() int32 {
@@ -1488,7 +1688,7 @@ type ReqCollateralizeByAddr struct {
 func (x *ReqCollateralizeByAddr) Reset() {
 	*x = ReqCollateralizeByAddr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[19]
+		mi := &file_collateralize_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1501,7 +1701,7 @@ func (x *ReqCollateralizeByAddr) String() string {
 func (*ReqCollateralizeByAddr) ProtoMessage() {}
 
 func (x *ReqCollateralizeByAddr) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[19]
+	mi := &file_collateralize_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1514,7 +1714,7 @@ func (x *ReqCollateralizeByAddr) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqCollateralizeByAddr.ProtoReflect.Descriptor instead.
 func (*ReqCollateralizeByAddr) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{19}
+	return file_collateralize_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ReqCollateralizeByAddr) GetAddr() string {
@@ -1550,7 +1750,7 @@ type RepCollateralizeIDs struct {
 func (x *RepCollateralizeIDs) Reset() {
 	*x = RepCollateralizeIDs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[20]
+		mi := &file_collateralize_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1563,7 +1763,7 @@ func (x *RepCollateralizeIDs) String() string {
 func (*RepCollateralizeIDs) ProtoMessage() {}
 
 func (x *RepCollateralizeIDs) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[20]
+	mi := &file_collateralize_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1576,7 +1776,7 @@ func (x *RepCollateralizeIDs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepCollateralizeIDs.ProtoReflect.Descriptor instead.
 func (*RepCollateralizeIDs) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{20}
+	return file_collateralize_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *RepCollateralizeIDs) GetIDs() []string {
@@ -1601,7 +1801,7 @@ type ReqCollateralizeRecordByAddr struct {
 func (x *ReqCollateralizeRecordByAddr) Reset() {
 	*x = ReqCollateralizeRecordByAddr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[21]
+		mi := &file_collateralize_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1614,7 +1814,7 @@ func (x *ReqCollateralizeRecordByAddr) String() string {
 func (*ReqCollateralizeRecordByAddr) ProtoMessage() {}
 
 func (x *ReqCollateralizeRecordByAddr) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[21]
+	mi := &file_collateralize_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1627,7 +1827,7 @@ func (x *ReqCollateralizeRecordByAddr) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqCollateralizeRecordByAddr.ProtoReflect.Descriptor instead.
 func (*ReqCollateralizeRecordByAddr) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{21}
+	return file_collateralize_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ReqCollateralizeRecordByAddr) GetCollateralizeId() string {
@@ -1672,7 +1872,7 @@ type ReqCollateralizeRecordByStatus struct {
 func (x *ReqCollateralizeRecordByStatus) Reset() {
 	*x = ReqCollateralizeRecordByStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[22]
+		mi := &file_collateralize_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1685,7 +1885,7 @@ func (x *ReqCollateralizeRecordByStatus) String() string {
 func (*ReqCollateralizeRecordByStatus) ProtoMessage() {}
 
 func (x *ReqCollateralizeRecordByStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[22]
+	mi := &file_collateralize_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1698,7 +1898,7 @@ func (x *ReqCollateralizeRecordByStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqCollateralizeRecordByStatus.ProtoReflect.Descriptor instead.
 func (*ReqCollateralizeRecordByStatus) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{22}
+	return file_collateralize_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ReqCollateralizeRecordByStatus) GetCollateralizeId() string {
@@ -1734,7 +1934,7 @@ type RepCollateralizeRecords struct {
 func (x *RepCollateralizeRecords) Reset() {
 	*x = RepCollateralizeRecords{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[23]
+		mi := &file_collateralize_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1747,7 +1947,7 @@ func (x *RepCollateralizeRecords) String() string {
 func (*RepCollateralizeRecords) ProtoMessage() {}
 
 func (x *RepCollateralizeRecords) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[23]
+	mi := &file_collateralize_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1760,7 +1960,7 @@ func (x *RepCollateralizeRecords) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepCollateralizeRecords.ProtoReflect.Descriptor instead.
 func (*RepCollateralizeRecords) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{23}
+	return file_collateralize_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *RepCollateralizeRecords) GetRecords() []*BorrowRecord {
@@ -1783,7 +1983,7 @@ type ReqCollateralizeRecord struct {
 func (x *ReqCollateralizeRecord) Reset() {
 	*x = ReqCollateralizeRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[24]
+		mi := &file_collateralize_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1796,7 +1996,7 @@ func (x *ReqCollateralizeRecord) String() string {
 func (*ReqCollateralizeRecord) ProtoMessage() {}
 
 func (x *ReqCollateralizeRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[24]
+	mi := &file_collateralize_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1809,7 +2009,7 @@ func (x *ReqCollateralizeRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqCollateralizeRecord.ProtoReflect.Descriptor instead.
 func (*ReqCollateralizeRecord) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{24}
+	return file_collateralize_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ReqCollateralizeRecord) GetCollateralizeId() string {
@@ -1838,7 +2038,7 @@ type RepCollateralizeRecord struct {
 func (x *RepCollateralizeRecord) Reset() {
 	*x = RepCollateralizeRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[25]
+		mi := &file_collateralize_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1851,7 +2051,7 @@ func (x *RepCollateralizeRecord) String() string {
 func (*RepCollateralizeRecord) ProtoMessage() {}
 
 func (x *RepCollateralizeRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[25]
+	mi := &file_collateralize_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1864,7 +2064,7 @@ func (x *RepCollateralizeRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepCollateralizeRecord.ProtoReflect.Descriptor instead.
 func (*RepCollateralizeRecord) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{25}
+	return file_collateralize_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *RepCollateralizeRecord) GetRecord() *BorrowRecord {
@@ -1880,19 +2080,23 @@ type RepCollateralizeConfig struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DebtCeiling       int64 `protobuf:"varint,1,opt,name=debtCeiling,proto3" json:"debtCeiling,omitempty"`             //单用户可借出的限额(ccny)
-	LiquidationRatio  int64 `protobuf:"varint,2,opt,name=liquidationRatio,proto3" json:"liquidationRatio,omitempty"`   //清算比例
-	StabilityFeeRatio int64 `protobuf:"varint,3,opt,name=stabilityFeeRatio,proto3" json:"stabilityFeeRatio,omitempty"` //稳定费
-	Period            int64 `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"`                       //合约期限
-	TotalBalance      int64 `protobuf:"varint,5,opt,name=totalBalance,proto3" json:"totalBalance,omitempty"`           //放贷总量
-	Balance           int64 `protobuf:"varint,6,opt,name=balance,proto3" json:"balance,omitempty"`                     //剩余放贷额度
-	CurrentTime       int64 `protobuf:"varint,7,opt,name=currentTime,proto3" json:"currentTime,omitempty"`             //设置时间
+	DebtCeiling       int64                       `protobuf:"varint,1,opt,name=debtCeiling,proto3" json:"debtCeiling,omitempty"`             //单用户可借出的限额(ccny)
+	LiquidationRatio  int64                       `protobuf:"varint,2,opt,name=liquidationRatio,proto3" json:"liquidationRatio,omitempty"`   //清算比例
+	StabilityFeeRatio int64                       `protobuf:"varint,3,opt,name=stabilityFeeRatio,proto3" json:"stabilityFeeRatio,omitempty"` //稳定费
+	Period            int64                       `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"`                       //合约期限
+	TotalBalance      int64                       `protobuf:"varint,5,opt,name=totalBalance,proto3" json:"totalBalance,omitempty"`           //放贷总量
+	Balance           int64                       `protobuf:"varint,6,opt,name=balance,proto3" json:"balance,omitempty"`                     //剩余放贷额度
+	CurrentTime       int64                       `protobuf:"varint,7,opt,name=currentTime,proto3" json:"currentTime,omitempty"`             //设置时间
+	AssetConfigs      []*CollateralizeAssetConfig `protobuf:"bytes,8,rep,name=assetConfigs,proto3" json:"assetConfigs,omitempty"`            //各抵押资产配置
+	FeedQuorum        int32                       `protobuf:"varint,9,opt,name=feedQuorum,proto3" json:"feedQuorum,omitempty"`               //多喂价人法定报价数
+	FeedWindow        int64                       `protobuf:"varint,10,opt,name=feedWindow,proto3" json:"feedWindow,omitempty"`              //报价有效时间窗口(秒)
+	FeedDeviation     int64                       `protobuf:"varint,11,opt,name=feedDeviation,proto3" json:"feedDeviation,omitempty"`        //允许偏离中位数的比例
 }
 
 func (x *RepCollateralizeConfig) Reset() {
 	*x = RepCollateralizeConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[26]
+		mi := &file_collateralize_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1905,7 +2109,7 @@ func (x *RepCollateralizeConfig) String() string {
 func (*RepCollateralizeConfig) ProtoMessage() {}
 
 func (x *RepCollateralizeConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[26]
+	mi := &file_collateralize_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1918,7 +2122,7 @@ func (x *RepCollateralizeConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepCollateralizeConfig.ProtoReflect.Descriptor instead.
 func (*RepCollateralizeConfig) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{26}
+	return file_collateralize_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *RepCollateralizeConfig) GetDebtCeiling() int64 {
@@ -1970,6 +2174,90 @@ func (x *RepCollateralizeConfig) GetCurrentTime() int64 {
 	return 0
 }
 
+func (x *RepCollateralizeConfig) GetAssetConfigs() []*CollateralizeAssetConfig {
+	if x != nil {
+		return x.AssetConfigs
+	}
+	return nil
+}
+
+func (x *RepCollateralizeConfig) GetFeedQuorum() int32 {
+	if x != nil {
+		return x.FeedQuorum
+	}
+	return 0
+}
+
+func (x *RepCollateralizeConfig) GetFeedWindow() int64 {
+	if x != nil {
+		return x.FeedWindow
+	}
+	return 0
+}
+
+func (x *RepCollateralizeConfig) GetFeedDeviation() int64 {
+	if x != nil {
+		return x.FeedDeviation
+	}
+	return 0
+}
+
+// 查询指定抵押资产最新价格
+type ReqCollateralizeAssetPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssetExec   string `protobuf:"bytes,1,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	AssetSymbol string `protobuf:"bytes,2,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
+}
+
+func (x *ReqCollateralizeAssetPrice) Reset() {
+	*x = ReqCollateralizeAssetPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collateralize_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqCollateralizeAssetPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqCollateralizeAssetPrice) ProtoMessage() {}
+
+func (x *ReqCollateralizeAssetPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_collateralize_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqCollateralizeAssetPrice.ProtoReflect.Descriptor instead.
+func (*ReqCollateralizeAssetPrice) Descriptor() ([]byte, []int) {
+	return file_collateralize_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReqCollateralizeAssetPrice) GetAssetExec() string {
+	if x != nil {
+		return x.AssetExec
+	}
+	return ""
+}
+
+func (x *ReqCollateralizeAssetPrice) GetAssetSymbol() string {
+	if x != nil {
+		return x.AssetSymbol
+	}
+	return ""
+}
+
 // 返回最新抵押物价格
 type RepCollateralizePrice struct {
 	state         protoimpl.MessageState
@@ -1982,7 +2270,7 @@ type RepCollateralizePrice struct {
 func (x *RepCollateralizePrice) Reset() {
 	*x = RepCollateralizePrice{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[27]
+		mi := &file_collateralize_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1995,7 +2283,7 @@ func (x *RepCollateralizePrice) String() string {
 func (*RepCollateralizePrice) ProtoMessage() {}
 
 func (x *RepCollateralizePrice) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[27]
+	mi := &file_collateralize_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2008,7 +2296,7 @@ func (x *RepCollateralizePrice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepCollateralizePrice.ProtoReflect.Descriptor instead.
 func (*RepCollateralizePrice) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{27}
+	return file_collateralize_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *RepCollateralizePrice) GetPrice() int64 {
@@ -2018,6 +2306,214 @@ func (x *RepCollateralizePrice) GetPrice() int64 {
 	return 0
 }
 
+// 查询用户当前债务
+type ReqCollateralizeUserDebt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr            string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	CollateralizeId string `protobuf:"bytes,2,opt,name=collateralizeId,proto3" json:"collateralizeId,omitempty"` //为空表示所有放贷
+	Time            int64  `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`                      //计息截止时间，0表示最新区块时间
+}
+
+func (x *ReqCollateralizeUserDebt) Reset() {
+	*x = ReqCollateralizeUserDebt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collateralize_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqCollateralizeUserDebt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqCollateralizeUserDebt) ProtoMessage() {}
+
+func (x *ReqCollateralizeUserDebt) ProtoReflect() protoreflect.Message {
+	mi := &file_collateralize_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqCollateralizeUserDebt.ProtoReflect.Descriptor instead.
+func (*ReqCollateralizeUserDebt) Descriptor() ([]byte, []int) {
+	return file_collateralize_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReqCollateralizeUserDebt) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ReqCollateralizeUserDebt) GetCollateralizeId() string {
+	if x != nil {
+		return x.CollateralizeId
+	}
+	return ""
+}
+
+func (x *ReqCollateralizeUserDebt) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+// 借贷记录当前债务
+type CollateralizeDebt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollateralizeId string `protobuf:"bytes,1,opt,name=collateralizeId,proto3" json:"collateralizeId,omitempty"`
+	RecordId        string `protobuf:"bytes,2,opt,name=recordId,proto3" json:"recordId,omitempty"`
+	DebtValue       int64  `protobuf:"varint,3,opt,name=debtValue,proto3" json:"debtValue,omitempty"`   //未还本金(ccny)
+	AccruedFee      int64  `protobuf:"varint,4,opt,name=accruedFee,proto3" json:"accruedFee,omitempty"` //应付稳定费(ccny)
+	TotalDebt       int64  `protobuf:"varint,5,opt,name=totalDebt,proto3" json:"totalDebt,omitempty"`   //本金+稳定费
+}
+
+func (x *CollateralizeDebt) Reset() {
+	*x = CollateralizeDebt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collateralize_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollateralizeDebt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralizeDebt) ProtoMessage() {}
+
+func (x *CollateralizeDebt) ProtoReflect() protoreflect.Message {
+	mi := &file_collateralize_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralizeDebt.ProtoReflect.Descriptor instead.
+func (*CollateralizeDebt) Descriptor() ([]byte, []int) {
+	return file_collateralize_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CollateralizeDebt) GetCollateralizeId() string {
+	if x != nil {
+		return x.CollateralizeId
+	}
+	return ""
+}
+
+func (x *CollateralizeDebt) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *CollateralizeDebt) GetDebtValue() int64 {
+	if x != nil {
+		return x.DebtValue
+	}
+	return 0
+}
+
+func (x *CollateralizeDebt) GetAccruedFee() int64 {
+	if x != nil {
+		return x.AccruedFee
+	}
+	return 0
+}
+
+func (x *CollateralizeDebt) GetTotalDebt() int64 {
+	if x != nil {
+		return x.TotalDebt
+	}
+	return 0
+}
+
+// 返回用户当前债务
+type RepCollateralizeUserDebt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Debts     []*CollateralizeDebt `protobuf:"bytes,1,rep,name=debts,proto3" json:"debts,omitempty"`
+	TotalDebt int64                `protobuf:"varint,2,opt,name=totalDebt,proto3" json:"totalDebt,omitempty"`
+	TotalFee  int64                `protobuf:"varint,3,opt,name=totalFee,proto3" json:"totalFee,omitempty"`
+}
+
+func (x *RepCollateralizeUserDebt) Reset() {
+	*x = RepCollateralizeUserDebt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_collateralize_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepCollateralizeUserDebt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepCollateralizeUserDebt) ProtoMessage() {}
+
+func (x *RepCollateralizeUserDebt) ProtoReflect() protoreflect.Message {
+	mi := &file_collateralize_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepCollateralizeUserDebt.ProtoReflect.Descriptor instead.
+func (*RepCollateralizeUserDebt) Descriptor() ([]byte, []int) {
+	return file_collateralize_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RepCollateralizeUserDebt) GetDebts() []*CollateralizeDebt {
+	if x != nil {
+		return x.Debts
+	}
+	return nil
+}
+
+func (x *RepCollateralizeUserDebt) GetTotalDebt() int64 {
+	if x != nil {
+		return x.TotalDebt
+	}
+	return 0
+}
+
+func (x *RepCollateralizeUserDebt) GetTotalFee() int64 {
+	if x != nil {
+		return x.TotalFee
+	}
+	return 0
+}
+
 // 返回用户借贷总额
 type RepCollateralizeUserBalance struct {
 	state         protoimpl.MessageState
@@ -2030,7 +2526,7 @@ type RepCollateralizeUserBalance struct {
 func (x *RepCollateralizeUserBalance) Reset() {
 	*x = RepCollateralizeUserBalance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_collateralize_proto_msgTypes[28]
+		mi := &file_collateralize_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2043,7 +2539,7 @@ func (x *RepCollateralizeUserBalance) String() string {
 func (*RepCollateralizeUserBalance) ProtoMessage() {}
 
 func (x *RepCollateralizeUserBalance) ProtoReflect() protoreflect.Message {
-	mi := &file_collateralize_proto_msgTypes[28]
+	mi := &file_collateralize_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2056,7 +2552,7 @@ func (x *RepCollateralizeUserBalance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepCollateralizeUserBalance.ProtoReflect.Descriptor instead.
 func (*RepCollateralizeUserBalance) Descriptor() ([]byte, []int) {
-	return file_collateralize_proto_rawDescGZIP(), []int{28}
+	return file_collateralize_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *RepCollateralizeUserBalance) GetBalance() int64 {
@@ -2070,7 +2566,7 @@ var File_collateralize_proto protoreflect.FileDescriptor
 
 var file_collateralize_proto_rawDesc = []byte{
 	0x0a, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0xdf, 0x04, 0x0a,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x9f, 0x05, 0x0a,
 	0x0d, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12, 0x28,
 	0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
@@ -2108,7 +2604,11 @@ var file_collateralize_proto_rawDesc = []byte{
 	0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0e, 0x20,
 	0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
 	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0f, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xae,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x65, 0x63, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0b,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0xf6,
 	0x03, 0x0a, 0x0c, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
 	0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64,
@@ -2135,222 +2635,306 @@ var file_collateralize_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x64, 0x49, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63,
 	0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
 	0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x22,
-	0x86, 0x01, 0x0a, 0x10, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x69,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x74, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x74, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x62, 0x74, 0x63, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x62, 0x74, 0x63, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x65, 0x74, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x65, 0x74, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xa5, 0x03, 0x0a, 0x13, 0x43, 0x6f, 0x6c,
-	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x34, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
-	0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x06,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
-	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x6f, 0x72, 0x72,
-	0x6f, 0x77, 0x48, 0x00, 0x52, 0x06, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x31, 0x0a, 0x05,
-	0x72, 0x65, 0x70, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x52, 0x65, 0x70, 0x61, 0x79, 0x48, 0x00, 0x52, 0x05, 0x72, 0x65, 0x70, 0x61, 0x79, 0x12,
-	0x34, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x06, 0x61,
-	0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6c,
-	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x46, 0x65, 0x65, 0x64, 0x48, 0x00, 0x52,
-	0x04, 0x66, 0x65, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x08, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x74,
-	0x72, 0x69, 0x65, 0x76, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
-	0x65, 0x12, 0x34, 0x0a, 0x06, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
-	0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
-	0x06, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0xef, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x74,
-	0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64,
-	0x65, 0x62, 0x74, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x69,
-	0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x11, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x52,
-	0x61, 0x74, 0x69, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x22, 0x0a, 0x0c,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x22, 0x33, 0x0a, 0x11, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x70, 0x65, 0x72,
-	0x41, 0x64, 0x64, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x70,
-	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x73, 0x22, 0x39, 0x0a, 0x13, 0x43, 0x6f, 0x6c, 0x6c, 0x61,
-	0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x22,
-	0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x22, 0x55, 0x0a, 0x13, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6c,
-	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x5a, 0x0a, 0x12, 0x43, 0x6f, 0x6c,
-	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x61, 0x79, 0x12,
-	0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
-	0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x49, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
-	0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x28, 0x0a,
-	0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
-	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6f,
-	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x5d, 0x0a,
-	0x11, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x46, 0x65,
-	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x5b, 0x0a, 0x15,
-	0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x74,
-	0x72, 0x69, 0x65, 0x76, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
-	0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
 	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x14, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c,
-	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a,
-	0x0a, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x4d, 0x0a, 0x14, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x61,
-	0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x73, 0x22, 0x40, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6c,
-	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x49, 0x64, 0x22, 0xae, 0x03, 0x0a, 0x1b, 0x52, 0x65, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x61,
-	0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x74, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x62, 0x74, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e,
-	0x67, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x69, 0x71,
-	0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x2c, 0x0a,
+	0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x72, 0x75, 0x65, 0x64, 0x46, 0x65, 0x65, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x72, 0x75, 0x65, 0x64, 0x46, 0x65, 0x65, 0x12,
+	0x26, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x63, 0x72, 0x75, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x63,
+	0x72, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x10, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x62, 0x74, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x62, 0x74, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x74, 0x63, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x74, 0x63, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x74, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x74, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0xa5, 0x03, 0x0a, 0x13, 0x43, 0x6f, 0x6c, 0x6c, 0x61,
+	0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34,
+	0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x06, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77,
+	0x48, 0x00, 0x52, 0x06, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x31, 0x0a, 0x05, 0x72, 0x65,
+	0x70, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52,
+	0x65, 0x70, 0x61, 0x79, 0x48, 0x00, 0x52, 0x05, 0x72, 0x65, 0x70, 0x61, 0x79, 0x12, 0x34, 0x0a,
+	0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x06, 0x61, 0x70, 0x70,
+	0x65, 0x6e, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x46, 0x65, 0x65, 0x64, 0x48, 0x00, 0x52, 0x04, 0x66,
+	0x65, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x08, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x76, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x12,
+	0x34, 0x0a, 0x06, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x06, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x74, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x9a,
+	0x03, 0x0a, 0x13, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x74, 0x43, 0x65,
+	0x69, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x62,
+	0x74, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x61, 0x74, 0x69, 0x6f, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
 	0x11, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74,
-	0x69, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x62,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x28, 0x0a,
-	0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f,
-	0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x62, 0x6f, 0x72,
-	0x72, 0x6f, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0d, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x22, 0x43, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x43, 0x6f, 0x6c, 0x6c, 0x61,
-	0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x2a, 0x0a,
-	0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
-	0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x73, 0x22, 0x58, 0x0a, 0x1c, 0x52, 0x65, 0x70,
-	0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x43, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x38, 0x0a, 0x05, 0x69, 0x6e, 0x66,
-	0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x52, 0x65, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x6e,
-	0x66, 0x6f, 0x73, 0x22, 0x4a, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
-	0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x6c, 0x49,
-	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x6c, 0x49, 0x44, 0x22,
-	0x5c, 0x0a, 0x16, 0x52, 0x65, 0x71, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x6c, 0x49, 0x44, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x6c, 0x49, 0x44, 0x22, 0x27, 0x0a,
-	0x13, 0x52, 0x65, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x49, 0x44, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x03, 0x49, 0x44, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x71, 0x43, 0x6f,
-	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61,
-	0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a,
-	0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x22, 0x7e, 0x0a, 0x1e, 0x52, 0x65, 0x71,
-	0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x63,
+	0x69, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x43, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x64, 0x51, 0x75, 0x6f,
+	0x72, 0x75, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x65, 0x65, 0x64, 0x51,
+	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x64, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x65, 0x65, 0x64, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x65, 0x65, 0x64, 0x44, 0x65, 0x76,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x65,
+	0x65, 0x64, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x86, 0x01, 0x0a, 0x18,
+	0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x45, 0x78, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x45, 0x78, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x61, 0x74, 0x69, 0x6f, 0x22, 0x33, 0x0a, 0x11, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x70,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x73, 0x22, 0x79, 0x0a, 0x13, 0x43, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x65,
+	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78,
+	0x65, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x55, 0x0a, 0x13, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x28, 0x0a, 0x0f, 0x63,
 	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a,
-	0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x17, 0x52, 0x65, 0x70,
-	0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6f,
-	0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x73, 0x22, 0x5e, 0x0a, 0x16, 0x52, 0x65, 0x71, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
-	0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x28, 0x0a,
-	0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
-	0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x2b, 0x0a,
-	0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0x8c, 0x02, 0x0a, 0x16, 0x52,
-	0x65, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x74, 0x43, 0x65, 0x69,
-	0x6c, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x62, 0x74,
-	0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x69, 0x71, 0x75, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x10, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61,
-	0x74, 0x69, 0x6f, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
+	0x69, 0x7a, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x70, 0x0a, 0x12, 0x43,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x61,
+	0x79, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x85, 0x01,
+	0x0a, 0x13, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x41,
+	0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
+	0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x46, 0x65, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6f, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63,
+	0x6f, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78,
+	0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45,
+	0x78, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x5b, 0x0a, 0x15, 0x43,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x76, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x4d, 0x0a, 0x14, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x22, 0x40, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x49, 0x64, 0x22, 0xee, 0x03, 0x0a, 0x1b, 0x52, 0x65, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x62, 0x74, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x62, 0x74, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67,
+	0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x61, 0x74, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x2c, 0x0a, 0x11,
 	0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x69,
-	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x15, 0x52, 0x65, 0x70,
-	0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x37, 0x0a, 0x1b, 0x52, 0x65, 0x70, 0x43,
-	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x28, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6c,
+	0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x62, 0x6f, 0x72, 0x72,
+	0x6f, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x0d, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x65, 0x63,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x65,
+	0x63, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x22, 0x43, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x2a, 0x0a, 0x10,
+	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x73, 0x22, 0x58, 0x0a, 0x1c, 0x52, 0x65, 0x70, 0x43,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x38, 0x0a, 0x05, 0x69, 0x6e, 0x66, 0x6f,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x6e, 0x66,
+	0x6f, 0x73, 0x22, 0x4a, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
+	0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x6c, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x6c, 0x49, 0x44, 0x22, 0x5c,
+	0x0a, 0x16, 0x52, 0x65, 0x71, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x6c, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x6c, 0x49, 0x44, 0x22, 0x27, 0x0a, 0x13,
+	0x52, 0x65, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x49, 0x44, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x03, 0x49, 0x44, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x71, 0x43, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x22, 0x7e, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x43,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x17, 0x52, 0x65, 0x70, 0x43,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x72,
+	0x72, 0x6f, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x22, 0x5e, 0x0a, 0x16, 0x52, 0x65, 0x71, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
+	0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x28, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x49, 0x64, 0x22, 0x45, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
+	0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x2b, 0x0a, 0x06,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0xb7, 0x03, 0x0a, 0x16, 0x52, 0x65,
+	0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x74, 0x43, 0x65, 0x69, 0x6c,
+	0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x62, 0x74, 0x43,
+	0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74,
+	0x69, 0x6f, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x46,
+	0x65, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73,
+	0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x66, 0x65, 0x65, 0x64, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x66, 0x65, 0x65, 0x64, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x1e, 0x0a,
+	0x0a, 0x66, 0x65, 0x65, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x66, 0x65, 0x65, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x24, 0x0a,
+	0x0d, 0x66, 0x65, 0x65, 0x64, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x64, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x5c, 0x0a, 0x1a, 0x52, 0x65, 0x71, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x65, 0x63, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x65, 0x63, 0x12,
+	0x20, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x22, 0x2d, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x22, 0x6c, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x62, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72,
+	0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61,
+	0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xb5,
+	0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x44, 0x65, 0x62, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65,
+	0x62, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64,
+	0x65, 0x62, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x72,
+	0x75, 0x65, 0x64, 0x46, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63,
+	0x63, 0x72, 0x75, 0x65, 0x64, 0x46, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x44, 0x65, 0x62, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x44, 0x65, 0x62, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x43, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44,
+	0x65, 0x62, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x64, 0x65, 0x62, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61,
+	0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x44, 0x65, 0x62, 0x74, 0x52, 0x05, 0x64, 0x65,
+	0x62, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x62, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x62,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x22, 0x37, 0x0a,
+	0x1b, 0x52, 0x65, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2365,58 +2949,66 @@ func file_collateralize_proto_rawDescGZIP() []byte {
 	return file_collateralize_proto_rawDescData
 }
 
-var file_collateralize_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_collateralize_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_collateralize_proto_goTypes = []interface{}{
 	(*Collateralize)(nil),                  // 0: types.Collateralize
 	(*BorrowRecord)(nil),                   // 1: types.BorrowRecord
 	(*AssetPriceRecord)(nil),               // 2: types.AssetPriceRecord
 	(*CollateralizeAction)(nil),            // 3: types.CollateralizeAction
 	(*CollateralizeManage)(nil),            // 4: types.CollateralizeManage
-	(*CollateralizeAddr)(nil),              // 5: types.CollateralizeAddr
-	(*CollateralizeCreate)(nil),            // 6: types.CollateralizeCreate
-	(*CollateralizeBorrow)(nil),            // 7: types.CollateralizeBorrow
-	(*CollateralizeRepay)(nil),             // 8: types.CollateralizeRepay
-	(*CollateralizeAppend)(nil),            // 9: types.CollateralizeAppend
-	(*CollateralizeFeed)(nil),              // 10: types.CollateralizeFeed
-	(*CollateralizeRetrieve)(nil),          // 11: types.CollateralizeRetrieve
-	(*ReceiptCollateralize)(nil),           // 12: types.ReceiptCollateralize
-	(*CollateralizeRecords)(nil),           // 13: types.CollateralizeRecords
-	(*ReqCollateralizeInfo)(nil),           // 14: types.ReqCollateralizeInfo
-	(*RepCollateralizeCurrentInfo)(nil),    // 15: types.RepCollateralizeCurrentInfo
-	(*ReqCollateralizeInfos)(nil),          // 16: types.ReqCollateralizeInfos
-	(*RepCollateralizeCurrentInfos)(nil),   // 17: types.RepCollateralizeCurrentInfos
-	(*ReqCollateralizeByStatus)(nil),       // 18: types.ReqCollateralizeByStatus
-	(*ReqCollateralizeByAddr)(nil),         // 19: types.ReqCollateralizeByAddr
-	(*RepCollateralizeIDs)(nil),            // 20: types.RepCollateralizeIDs
-	(*ReqCollateralizeRecordByAddr)(nil),   // 21: types.ReqCollateralizeRecordByAddr
-	(*ReqCollateralizeRecordByStatus)(nil), // 22: types.ReqCollateralizeRecordByStatus
-	(*RepCollateralizeRecords)(nil),        // 23: types.RepCollateralizeRecords
-	(*ReqCollateralizeRecord)(nil),         // 24: types.ReqCollateralizeRecord
-	(*RepCollateralizeRecord)(nil),         // 25: types.RepCollateralizeRecord
-	(*RepCollateralizeConfig)(nil),         // 26: types.RepCollateralizeConfig
-	(*RepCollateralizePrice)(nil),          // 27: types.RepCollateralizePrice
-	(*RepCollateralizeUserBalance)(nil),    // 28: types.RepCollateralizeUserBalance
+	(*CollateralizeAssetConfig)(nil),       // 5: types.CollateralizeAssetConfig
+	(*CollateralizeAddr)(nil),              // 6: types.CollateralizeAddr
+	(*CollateralizeCreate)(nil),            // 7: types.CollateralizeCreate
+	(*CollateralizeBorrow)(nil),            // 8: types.CollateralizeBorrow
+	(*CollateralizeRepay)(nil),             // 9: types.CollateralizeRepay
+	(*CollateralizeAppend)(nil),            // 10: types.CollateralizeAppend
+	(*CollateralizeFeed)(nil),              // 11: types.CollateralizeFeed
+	(*CollateralizeRetrieve)(nil),          // 12: types.CollateralizeRetrieve
+	(*ReceiptCollateralize)(nil),           // 13: types.ReceiptCollateralize
+	(*CollateralizeRecords)(nil),           // 14: types.CollateralizeRecords
+	(*ReqCollateralizeInfo)(nil),           // 15: types.ReqCollateralizeInfo
+	(*RepCollateralizeCurrentInfo)(nil),    // 16: types.RepCollateralizeCurrentInfo
+	(*ReqCollateralizeInfos)(nil),          // 17: types.ReqCollateralizeInfos
+	(*RepCollateralizeCurrentInfos)(nil),   // 18: types.RepCollateralizeCurrentInfos
+	(*ReqCollateralizeByStatus)(nil),       // 19: types.ReqCollateralizeByStatus
+	(*ReqCollateralizeByAddr)(nil),         // 20: types.ReqCollateralizeByAddr
+	(*RepCollateralizeIDs)(nil),            // 21: types.RepCollateralizeIDs
+	(*ReqCollateralizeRecordByAddr)(nil),   // 22: types.ReqCollateralizeRecordByAddr
+	(*ReqCollateralizeRecordByStatus)(nil), // 23: types.ReqCollateralizeRecordByStatus
+	(*RepCollateralizeRecords)(nil),        // 24: types.RepCollateralizeRecords
+	(*ReqCollateralizeRecord)(nil),         // 25: types.ReqCollateralizeRecord
+	(*RepCollateralizeRecord)(nil),         // 26: types.RepCollateralizeRecord
+	(*RepCollateralizeConfig)(nil),         // 27: types.RepCollateralizeConfig
+	(*ReqCollateralizeAssetPrice)(nil),     // 28: types.ReqCollateralizeAssetPrice
+	(*RepCollateralizePrice)(nil),          // 29: types.RepCollateralizePrice
+	(*ReqCollateralizeUserDebt)(nil),       // 30: types.ReqCollateralizeUserDebt
+	(*CollateralizeDebt)(nil),              // 31: types.CollateralizeDebt
+	(*RepCollateralizeUserDebt)(nil),       // 32: types.RepCollateralizeUserDebt
+	(*RepCollateralizeUserBalance)(nil),    // 33: types.RepCollateralizeUserBalance
 }
 var file_collateralize_proto_depIdxs = []int32{
 	1,  // 0: types.Collateralize.borrowRecords:type_name -> types.BorrowRecord
 	1,  // 1: types.Collateralize.InvalidRecords:type_name -> types.BorrowRecord
-	6,  // 2: types.CollateralizeAction.create:type_name -> types.CollateralizeCreate
-	7,  // 3: types.CollateralizeAction.borrow:type_name -> types.CollateralizeBorrow
-	8,  // 4: types.CollateralizeAction.repay:type_name -> types.CollateralizeRepay
-	9,  // 5: types.CollateralizeAction.append:type_name -> types.CollateralizeAppend
-	10, // 6: types.CollateralizeAction.feed:type_name -> types.CollateralizeFeed
-	11, // 7: types.CollateralizeAction.retrieve:type_name -> types.CollateralizeRetrieve
+	7,  // 2: types.CollateralizeAction.create:type_name -> types.CollateralizeCreate
+	8,  // 3: types.CollateralizeAction.borrow:type_name -> types.CollateralizeBorrow
+	9,  // 4: types.CollateralizeAction.repay:type_name -> types.CollateralizeRepay
+	10, // 5: types.CollateralizeAction.append:type_name -> types.CollateralizeAppend
+	11, // 6: types.CollateralizeAction.feed:type_name -> types.CollateralizeFeed
+	12, // 7: types.CollateralizeAction.retrieve:type_name -> types.CollateralizeRetrieve
 	4,  // 8: types.CollateralizeAction.manage:type_name -> types.CollateralizeManage
-	12, // 9: types.CollateralizeRecords.records:type_name -> types.ReceiptCollateralize
-	1,  // 10: types.RepCollateralizeCurrentInfo.borrowRecords:type_name -> types.BorrowRecord
-	15, // 11: types.RepCollateralizeCurrentInfos.infos:type_name -> types.RepCollateralizeCurrentInfo
-	1,  // 12: types.RepCollateralizeRecords.records:type_name -> types.BorrowRecord
-	1,  // 13: types.RepCollateralizeRecord.record:type_name -> types.BorrowRecord
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	5,  // 9: types.CollateralizeManage.assetConfigs:type_name -> types.CollateralizeAssetConfig
+	13, // 10: types.CollateralizeRecords.records:type_name -> types.ReceiptCollateralize
+	1,  // 11: types.RepCollateralizeCurrentInfo.borrowRecords:type_name -> types.BorrowRecord
+	16, // 12: types.RepCollateralizeCurrentInfos.infos:type_name -> types.RepCollateralizeCurrentInfo
+	1,  // 13: types.RepCollateralizeRecords.records:type_name -> types.BorrowRecord
+	1,  // 14: types.RepCollateralizeRecord.record:type_name -> types.BorrowRecord
+	5,  // 15: types.RepCollateralizeConfig.assetConfigs:type_name -> types.CollateralizeAssetConfig
+	31, // 16: types.RepCollateralizeUserDebt.debts:type_name -> types.CollateralizeDebt
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_collateralize_proto_init() }
@@ -2486,7 +3078,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollateralizeAddr); i {
+			switch v := v.(*CollateralizeAssetConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2498,7 +3090,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollateralizeCreate); i {
+			switch v := v.(*CollateralizeAddr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2510,7 +3102,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollateralizeBorrow); i {
+			switch v := v.(*CollateralizeCreate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2522,7 +3114,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollateralizeRepay); i {
+			switch v := v.(*CollateralizeBorrow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2534,7 +3126,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollateralizeAppend); i {
+			switch v := v.(*CollateralizeRepay); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2546,7 +3138,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollateralizeFeed); i {
+			switch v := v.(*CollateralizeAppend); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2558,7 +3150,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollateralizeRetrieve); i {
+			switch v := v.(*CollateralizeFeed); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2570,7 +3162,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptCollateralize); i {
+			switch v := v.(*CollateralizeRetrieve); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2582,7 +3174,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollateralizeRecords); i {
+			switch v := v.(*ReceiptCollateralize); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2594,7 +3186,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqCollateralizeInfo); i {
+			switch v := v.(*CollateralizeRecords); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2606,7 +3198,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepCollateralizeCurrentInfo); i {
+			switch v := v.(*ReqCollateralizeInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2618,7 +3210,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqCollateralizeInfos); i {
+			switch v := v.(*RepCollateralizeCurrentInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2630,7 +3222,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepCollateralizeCurrentInfos); i {
+			switch v := v.(*ReqCollateralizeInfos); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2642,7 +3234,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqCollateralizeByStatus); i {
+			switch v := v.(*RepCollateralizeCurrentInfos); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2654,7 +3246,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqCollateralizeByAddr); i {
+			switch v := v.(*ReqCollateralizeByStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2666,7 +3258,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepCollateralizeIDs); i {
+			switch v := v.(*ReqCollateralizeByAddr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2678,7 +3270,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqCollateralizeRecordByAddr); i {
+			switch v := v.(*RepCollateralizeIDs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2690,7 +3282,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqCollateralizeRecordByStatus); i {
+			switch v := v.(*ReqCollateralizeRecordByAddr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2702,7 +3294,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepCollateralizeRecords); i {
+			switch v := v.(*ReqCollateralizeRecordByStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2714,7 +3306,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqCollateralizeRecord); i {
+			switch v := v.(*RepCollateralizeRecords); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2726,7 +3318,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepCollateralizeRecord); i {
+			switch v := v.(*ReqCollateralizeRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2738,7 +3330,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepCollateralizeConfig); i {
+			switch v := v.(*RepCollateralizeRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2750,7 +3342,7 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepCollateralizePrice); i {
+			switch v := v.(*RepCollateralizeConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2762,6 +3354,66 @@ func file_collateralize_proto_init() {
 			}
 		}
 		file_collateralize_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqCollateralizeAssetPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collateralize_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepCollateralizePrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collateralize_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqCollateralizeUserDebt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collateralize_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollateralizeDebt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collateralize_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepCollateralizeUserDebt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_collateralize_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RepCollateralizeUserBalance); i {
 			case 0:
 				return &v.state
@@ -2789,7 +3441,7 @@ func file_collateralize_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_collateralize_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   29,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -7,6 +7,8 @@ package types
 // CollateralizeCreateTx for construction
 type CollateralizeCreateTx struct {
 	TotalBalance float64 `json:"totalBalance"`
+	AssetExec    string  `json:"assetExec"`
+	AssetSymbol  string  `json:"assetSymbol"`
 	Fee          int64   `json:"fee"`
 }
 
@@ -19,9 +21,10 @@ type CollateralizeBorrowTx struct {
 
 // CollateralizeRepayTx for construction
 type CollateralizeRepayTx struct {
-	CollateralizeID string `json:"collateralizeId"`
-	RecordID        string `json:"recordID"`
-	Fee             int64  `json:"fee"`
+	CollateralizeID string  `json:"collateralizeId"`
+	RecordID        string  `json:"recordID"`
+	Value           float64 `json:"value"`
+	Fee             int64   `json:"fee"`
 }
 
 // CollateralizeAppendTx for construction
@@ -34,9 +37,12 @@ type CollateralizeAppendTx struct {
 
 // CollateralizeFeedTx for construction
 type CollateralizeFeedTx struct {
-	Price  []float64 `json:"price"`
-	Volume []int64   `json:"volume"`
-	Fee    int64     `json:"fee"`
+	Price         []float64 `json:"price"`
+	Volume        []int64   `json:"volume"`
+	AssetExec     string    `json:"assetExec"`
+	AssetSymbol   string    `json:"assetSymbol"`
+	OracleEventID string    `json:"oracleEventId"`
+	Fee           int64     `json:"fee"`
 }
 
 // CollateralizeRetrieveTx for construction
@@ -53,5 +59,10 @@ type CollateralizeManageTx struct {
 	StabilityFeeRatio float64 `json:"stabilityFeeRatio"`
 	Period            int64   `json:"period"`
 	TotalBalance      float64 `json:"totalBalance"`
+	AssetExec         string  `json:"assetExec"`
+	AssetSymbol       string  `json:"assetSymbol"`
+	FeedQuorum        int32   `json:"feedQuorum"`
+	FeedWindow        int64   `json:"feedWindow"`
+	FeedDeviation     float64 `json:"feedDeviation"`
 	Fee               int64   `json:"fee"`
 }
@@ -57,4 +57,7 @@ const (
 var (
 	ForkCollateralizeTableUpdate = "ForkCollateralizeTableUpdate"
 	ForkCollateralizePrecision   = "ForkCollateralizePrecision"
+	ForkCollateralizeMultiAsset  = "ForkCollateralizeMultiAsset"
+	ForkCollateralizeInterest    = "ForkCollateralizeInterest"
+	ForkCollateralizeFeedQuorum  = "ForkCollateralizeFeedQuorum"
 )
@@ -47,6 +47,7 @@ func EvmCmd() *cobra.Command {
 		estimateGasCmd(),
 		checkContractAddrCmd(),
 		evmDebugCmd(),
+		evmTraceCmd(),
 		evmTransferCmd(),
 		getEvmBalanceCmd(),
 		evmToolsCmd(),
@@ -637,6 +638,50 @@ func evmDebugRPC(cmd *cobra.Command, flag int32) {
 	}
 }
 
+// 跟踪合约执行，输出调用树或者执行前状态
+func evmTraceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace",
+		Short: "Trace evm tx or call, output call tree or prestate",
+		Run:   evmTrace,
+	}
+
+	cmd.Flags().StringP("hash", "s", "", "tx hash to replay, if set address and input are ignored")
+	cmd.Flags().StringP("address", "a", "", "evm contract address")
+	cmd.Flags().StringP("input", "b", "", "call data in hex format")
+	cmd.Flags().StringP("caller", "c", "", "the caller address")
+	cmd.Flags().Uint64P("value", "v", 0, "the amount transfer to the contract")
+	cmd.Flags().StringP("tracer", "t", "callTracer", "tracer type, callTracer or prestateTracer")
+
+	return cmd
+}
+
+func evmTrace(cmd *cobra.Command, args []string) {
+	hash, _ := cmd.Flags().GetString("hash")
+	addr, _ := cmd.Flags().GetString("address")
+	input, _ := cmd.Flags().GetString("input")
+	caller, _ := cmd.Flags().GetString("caller")
+	value, _ := cmd.Flags().GetUint64("value")
+	tracer, _ := cmd.Flags().GetString("tracer")
+	if hash == "" && addr == "" {
+		fmt.Fprintln(os.Stderr, "hash or address should be set")
+		return
+	}
+
+	var req = evmtypes.EvmTraceReq{TxHash: hash, Address: addr, Input: input, Caller: caller, Value: value, Tracer: tracer}
+	var resp evmtypes.EvmTraceResp
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	if !sendQuery(rpcLaddr, "Trace", &req, &resp) {
+		return
+	}
+	data, err := json.MarshalIndent(&resp, "", "  ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(string(data))
+}
+
 // 向EVM合约地址转账
 func evmTransferCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,205 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	evmCommon "github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+const (
+	evmLogPrefix      = "LODB-evm-log:"
+	evmLogAddrPrefix  = "LODB-evm-logaddr:"
+	evmLogTopicPrefix = "LODB-evm-logtopic:"
+
+	// maxEvmLogTopics 日志最多4个topic
+	maxEvmLogTopics = 4
+	// maxEvmLogCount 单次查询最多返回的日志数量
+	maxEvmLogCount = 1000
+	// evmLogPageSize 每次从localdb中读取的数量
+	evmLogPageSize = 100
+)
+
+func evmLogSuffix(height int64, txIndex, logIndex int32) string {
+	return fmt.Sprintf("%012d:%05d:%05d", height, txIndex, logIndex)
+}
+
+func calcEvmLogKey(height int64, txIndex, logIndex int32) []byte {
+	return []byte(evmLogPrefix + evmLogSuffix(height, txIndex, logIndex))
+}
+
+func calcEvmLogAddrPrefix(addr string) string {
+	return fmt.Sprintf("%s%s:", evmLogAddrPrefix, addr)
+}
+
+func calcEvmLogTopicPrefix(pos int, topic string) string {
+	return fmt.Sprintf("%s%d:%s:", evmLogTopicPrefix, pos, topic)
+}
+
+// formatEvmLogTopic topic统一格式化为32字节的十六进制字符串
+func formatEvmLogTopic(topic []byte) string {
+	return evmCommon.BytesToHash(topic).Hex()
+}
+
+// execEvmLogIndex 将交易生成的event日志写入localdb，同时按照合约地址和topic建立索引
+// 日志的地址取产生该日志的合约地址，与eth rpc保持一致
+func (evm *EVMExecutor) execEvmLogIndex(set *types.LocalDBSet, tx *types.Transaction, receipt *types.ReceiptData, index int) error {
+	var logs []*evmtypes.EVMEventLog
+	for _, item := range receipt.Logs {
+		if item.Ty != evmtypes.TyLogEVMEventData {
+			continue
+		}
+		var evmLog evmtypes.EVMEventLog
+		if err := types.Decode(item.Log, &evmLog); err != nil {
+			return err
+		}
+		logs = append(logs, &evmLog)
+	}
+
+	height := evm.GetHeight()
+	txHash := common.ToHex(tx.Hash())
+	for i, evmLog := range logs {
+		record := &evmtypes.EvmLogRecord{
+			Address:  evmLog.Address,
+			Data:     evmCommon.Bytes2Hex(evmLog.Data),
+			TxHash:   txHash,
+			Height:   height,
+			TxIndex:  int32(index),
+			LogIndex: int32(i),
+		}
+		for _, topic := range evmLog.Topic {
+			record.Topics = append(record.Topics, formatEvmLogTopic(topic))
+		}
+		key := calcEvmLogKey(height, int32(index), int32(i))
+		suffix := evmLogSuffix(height, int32(index), int32(i))
+		set.KV = append(set.KV, &types.KeyValue{Key: key, Value: types.Encode(record)})
+		if record.Address != "" {
+			set.KV = append(set.KV, &types.KeyValue{Key: []byte(calcEvmLogAddrPrefix(record.Address) + suffix), Value: key})
+		}
+		for pos, topic := range record.Topics {
+			if pos >= maxEvmLogTopics {
+				break
+			}
+			set.KV = append(set.KV, &types.KeyValue{Key: []byte(calcEvmLogTopicPrefix(pos, topic) + suffix), Value: key})
+		}
+	}
+	return nil
+}
+
+// evmLogFilter 日志过滤条件
+type evmLogFilter struct {
+	address string
+	topics  [][]string
+}
+
+func (f *evmLogFilter) match(record *evmtypes.EvmLogRecord) bool {
+	if f.address != "" && f.address != record.Address {
+		return false
+	}
+	if len(f.topics) > len(record.Topics) {
+		return false
+	}
+	for pos, expects := range f.topics {
+		if len(expects) == 0 {
+			continue
+		}
+		found := false
+		for _, topic := range expects {
+			if topic == record.Topics[pos] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Query_GetLogs 按照区块范围、合约地址和topic查询合约日志
+// topics中每个位置的多个值为或的关系，位置之间为与的关系，空位置表示不限制
+func (evm *EVMExecutor) Query_GetLogs(in *evmtypes.EvmLogFilterReq) (types.Message, error) {
+	if in.FromBlock < 0 || in.ToBlock < in.FromBlock || len(in.Topics) > maxEvmLogTopics {
+		return nil, types.ErrInvalidParam
+	}
+	count := int(in.Count)
+	if count <= 0 || count > maxEvmLogCount {
+		count = maxEvmLogCount
+	}
+
+	filter := &evmLogFilter{}
+	if in.Address != "" {
+		addr := evmCommon.StringToAddress(in.Address)
+		if addr == nil {
+			return nil, types.ErrInvalidAddress
+		}
+		filter.address = addr.String()
+	}
+	for _, item := range in.Topics {
+		var topics []string
+		for _, topic := range item.GetTopic() {
+			data, err := common.FromHex(topic)
+			if err != nil {
+				return nil, err
+			}
+			topics = append(topics, formatEvmLogTopic(data))
+		}
+		filter.topics = append(filter.topics, topics)
+	}
+
+	// 优先使用地址索引，其次使用单值topic索引，否则按区块顺序遍历全部日志
+	prefix, indexed := evmLogPrefix, false
+	if filter.address != "" {
+		prefix, indexed = calcEvmLogAddrPrefix(filter.address), true
+	} else {
+		for pos, topics := range filter.topics {
+			if len(topics) == 1 {
+				prefix, indexed = calcEvmLogTopicPrefix(pos, topics[0]), true
+				break
+			}
+		}
+	}
+
+	localdb := evm.GetLocalDB()
+	resp := &evmtypes.EvmLogFilterResp{}
+	cursor := []byte(fmt.Sprintf("%s%012d", prefix, in.FromBlock))
+	for {
+		values, err := localdb.List([]byte(prefix), cursor, evmLogPageSize, dbm.ListASC)
+		if err != nil && err != types.ErrNotFound {
+			return nil, err
+		}
+		for _, value := range values {
+			if indexed {
+				if value, err = localdb.Get(value); err != nil {
+					return nil, err
+				}
+			}
+			var record evmtypes.EvmLogRecord
+			if err = types.Decode(value, &record); err != nil {
+				return nil, err
+			}
+			if record.Height > in.ToBlock {
+				return resp, nil
+			}
+			cursor = []byte(prefix + evmLogSuffix(record.Height, record.TxIndex, record.LogIndex))
+			if !filter.match(&record) {
+				continue
+			}
+			resp.Logs = append(resp.Logs, &record)
+			if len(resp.Logs) >= count {
+				return resp, nil
+			}
+		}
+		if len(values) < evmLogPageSize {
+			return resp, nil
+		}
+	}
+}
@@ -97,6 +97,13 @@ func (evm *EVMExecutor) ExecLocal(tx *types.Transaction, receipt *types.ReceiptD
 		}
 	}
 
+	// 合约日志索引，通过自动回滚机制在ExecDelLocal中删除
+	if cfg.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMLogIndex) {
+		if err = evm.execEvmLogIndex(set, tx, receipt, index); err != nil {
+			return nil, err
+		}
+	}
+
 	set.KV = evm.AddRollbackKV(tx, []byte(evmtypes.ExecutorName), set.KV)
 	return set, err
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/33cn/chain33/system/crypto/secp256k1eth"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
+	vmcommon "github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
 	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
 	"github.com/stretchr/testify/require"
 )
@@ -117,3 +118,176 @@ func TestExecNonce(t *testing.T) {
 	require.Nil(t, err)
 
 }
+
+func TestEvmLogIndex(t *testing.T) {
+
+	testCfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	util.ResetDatadir(testCfg.GetModuleConfig(), "$TEMP/")
+	dbDir, stateDB, localDB := util.CreateTestDB()
+	defer util.CloseTestDB(dbDir, stateDB)
+	q := queue.New("channel")
+	q.SetConfig(testCfg)
+	qapi, _ := client.New(q.Client(), nil)
+
+	exec := newEVMDriver().(*EVMExecutor)
+	exec.SetAPI(qapi)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(localDB)
+
+	_, priv := util.Genaddress()
+	contractA := address.ExecAddress("user.evm.a")
+	contractB := address.ExecAddress("user.evm.b")
+	transfer, approval := []byte{0x01}, []byte{0x02}
+	newReceipt := func(contract string, topics ...[]byte) *types.ReceiptData {
+		recp := &types.ReceiptData{Ty: types.ExecOk}
+		for _, topic := range topics {
+			evmLog := &evmtypes.EVMEventLog{Topic: [][]byte{topic, {0x10}}, Data: []byte("data"), Address: contract}
+			recp.Logs = append(recp.Logs, &types.ReceiptLog{Ty: evmtypes.TyLogEVMEventData, Log: types.Encode(evmLog)})
+		}
+		return recp
+	}
+	execLocal := func(height int64, tx *types.Transaction, recp *types.ReceiptData, index int, del bool) {
+		exec.SetEnv(height, 1539918074, 1539918074)
+		var set *types.LocalDBSet
+		var err error
+		if del {
+			set, err = exec.ExecDelLocal(tx, recp, index)
+		} else {
+			set, err = exec.ExecLocal(tx, recp, index)
+		}
+		require.Nil(t, err)
+		for _, kv := range set.GetKV() {
+			require.Nil(t, localDB.Set(kv.Key, kv.Value))
+		}
+	}
+	newTx := func(nonce int64) *types.Transaction {
+		tx := &types.Transaction{Execer: []byte("evm"), Nonce: nonce}
+		tx.Payload = types.Encode(&evmtypes.EVMContractAction{})
+		tx.Sign(types.SECP256K1, priv)
+		return tx
+	}
+
+	tx1, tx2, tx3 := newTx(1), newTx(2), newTx(3)
+	recp3 := newReceipt(contractA, transfer)
+	execLocal(10, tx1, newReceipt(contractA, transfer, approval), 0, false)
+	execLocal(11, tx2, newReceipt(contractB, transfer), 1, false)
+	execLocal(12, tx3, recp3, 0, false)
+
+	query := func(req *evmtypes.EvmLogFilterReq) []*evmtypes.EvmLogRecord {
+		resp, err := exec.Query_GetLogs(req)
+		require.Nil(t, err)
+		return resp.(*evmtypes.EvmLogFilterResp).Logs
+	}
+	logs := query(&evmtypes.EvmLogFilterReq{FromBlock: 0, ToBlock: 100})
+	require.Equal(t, 4, len(logs))
+	require.Equal(t, int64(10), logs[0].Height)
+	require.Equal(t, int32(1), logs[1].LogIndex)
+
+	logs = query(&evmtypes.EvmLogFilterReq{FromBlock: 0, ToBlock: 100, Address: contractA})
+	require.Equal(t, 3, len(logs))
+	logs = query(&evmtypes.EvmLogFilterReq{FromBlock: 11, ToBlock: 100, Address: contractA})
+	require.Equal(t, 1, len(logs))
+	require.Equal(t, int64(12), logs[0].Height)
+
+	topic := &evmtypes.EvmLogTopics{Topic: []string{"0x01"}}
+	logs = query(&evmtypes.EvmLogFilterReq{FromBlock: 0, ToBlock: 11, Topics: []*evmtypes.EvmLogTopics{topic}})
+	require.Equal(t, 2, len(logs))
+	require.Equal(t, contractB, logs[1].Address)
+
+	// 同一位置多个topic为或的关系，空位置不限制
+	anyTopic := &evmtypes.EvmLogTopics{Topic: []string{"0x01", "0x02"}}
+	second := &evmtypes.EvmLogTopics{Topic: []string{"0x10"}}
+	logs = query(&evmtypes.EvmLogFilterReq{FromBlock: 0, ToBlock: 100, Topics: []*evmtypes.EvmLogTopics{anyTopic, second}})
+	require.Equal(t, 4, len(logs))
+	logs = query(&evmtypes.EvmLogFilterReq{FromBlock: 0, ToBlock: 100, Address: contractA, Topics: []*evmtypes.EvmLogTopics{{}, {Topic: []string{"0x11"}}}})
+	require.Equal(t, 0, len(logs))
+	logs = query(&evmtypes.EvmLogFilterReq{FromBlock: 0, ToBlock: 100, Count: 1})
+	require.Equal(t, 1, len(logs))
+
+	_, err := exec.Query_GetLogs(&evmtypes.EvmLogFilterReq{FromBlock: 10, ToBlock: 9})
+	require.Equal(t, types.ErrInvalidParam, err)
+
+	// 回滚区块后索引删除
+	execLocal(12, tx3, recp3, 0, true)
+	logs = query(&evmtypes.EvmLogFilterReq{FromBlock: 0, ToBlock: 100, Address: contractA})
+	require.Equal(t, 2, len(logs))
+	logs = query(&evmtypes.EvmLogFilterReq{FromBlock: 12, ToBlock: 12})
+	require.Equal(t, 0, len(logs))
+}
+
+func TestEvmLogNestedCall(t *testing.T) {
+
+	testCfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	util.ResetDatadir(testCfg.GetModuleConfig(), "$TEMP/")
+	dbDir, stateDB, localDB := util.CreateTestDB()
+	defer util.CloseTestDB(dbDir, stateDB)
+	q := queue.New("channel")
+	q.SetConfig(testCfg)
+	qapi, _ := client.New(q.Client(), nil)
+
+	Init(evmtypes.ExecutorName, testCfg, nil)
+	exec := newEVMDriver().(*EVMExecutor)
+	exec.SetAPI(qapi)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(localDB)
+	exec.SetEnv(10, 1539918074, 1539918074)
+
+	_, priv := util.Genaddress()
+	execAddr := address.ExecAddress("evm")
+	run := func(nonce int64, action *evmtypes.EVMContractAction) *types.ReceiptData {
+		tx := &types.Transaction{Execer: []byte("evm"), Nonce: nonce, Fee: 1e8, To: action.ContractAddr}
+		tx.Payload = types.Encode(action)
+		tx.Sign(types.SECP256K1, priv)
+		receipt, err := exec.Exec(tx, 0)
+		require.Nil(t, err)
+		for _, kv := range receipt.KV {
+			require.Nil(t, stateDB.Set(kv.Key, kv.Value))
+		}
+		recp := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+		set, err := exec.ExecLocal(tx, recp, 0)
+		require.Nil(t, err)
+		for _, kv := range set.GetKV() {
+			require.Nil(t, localDB.Set(kv.Key, kv.Value))
+		}
+		return recp
+	}
+	// deploy 部署合约，初始化代码返回runtime代码，返回合约地址
+	deploy := func(nonce int64, runtimeCode []byte) string {
+		initCode := []byte{0x60, byte(len(runtimeCode)), 0x80, 0x60, 0x0b, 0x60, 0x00, 0x39, 0x60, 0x00, 0xf3}
+		recp := run(nonce, &evmtypes.EVMContractAction{Code: append(initCode, runtimeCode...), ContractAddr: execAddr})
+		for _, item := range recp.Logs {
+			if item.Ty == evmtypes.TyLogCallContract {
+				var contract evmtypes.ReceiptEVMContract
+				require.Nil(t, types.Decode(item.Log, &contract))
+				return contract.ContractAddr
+			}
+		}
+		t.Fatal("contract address not found")
+		return ""
+	}
+
+	// 合约B: LOG1(topic=0xbb)
+	contractB := deploy(1, []byte{0x60, 0xbb, 0x60, 0x00, 0x60, 0x00, 0xa1, 0x00})
+	// 合约A: LOG1(topic=0xaa)后调用合约B
+	codeA := []byte{0x60, 0xaa, 0x60, 0x00, 0x60, 0x00, 0xa1, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x73}
+	codeA = append(codeA, vmcommon.StringToAddress(contractB).Bytes()...)
+	codeA = append(codeA, 0x5a, 0xf1, 0x50, 0x00)
+	contractA := deploy(2, codeA)
+	run(3, &evmtypes.EVMContractAction{ContractAddr: contractA})
+
+	resp, err := exec.Query_GetLogs(&evmtypes.EvmLogFilterReq{FromBlock: 0, ToBlock: 100})
+	require.Nil(t, err)
+	logs := resp.(*evmtypes.EvmLogFilterResp).Logs
+	require.Equal(t, 2, len(logs))
+	require.Equal(t, contractA, logs[0].Address)
+	require.Equal(t, formatEvmLogTopic([]byte{0xaa}), logs[0].Topics[0])
+	// 被调用合约产生的日志地址为被调用合约
+	require.Equal(t, contractB, logs[1].Address)
+	require.Equal(t, formatEvmLogTopic([]byte{0xbb}), logs[1].Topics[0])
+
+	resp, err = exec.Query_GetLogs(&evmtypes.EvmLogFilterReq{FromBlock: 0, ToBlock: 100, Address: contractB})
+	require.Nil(t, err)
+	logs = resp.(*evmtypes.EvmLogFilterResp).Logs
+	require.Equal(t, 1, len(logs))
+	require.Equal(t, formatEvmLogTopic([]byte{0xbb}), logs[0].Topics[0])
+}
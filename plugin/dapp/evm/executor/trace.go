@@ -0,0 +1,224 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+	evmCommon "github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+const (
+	// TracerCall 调用树跟踪
+	TracerCall = "callTracer"
+	// TracerPrestate 执行前状态跟踪
+	TracerPrestate = "prestateTracer"
+)
+
+var (
+	// ErrTraceNotEvmTx 跟踪的交易不是evm交易
+	ErrTraceNotEvmTx = errors.New("ErrTraceNotEvmTx")
+	// ErrTraceUnknownTracer 不支持的tracer类型
+	ErrTraceUnknownTracer = errors.New("ErrTraceUnknownTracer")
+)
+
+// historyKV 读取指定状态哈希下的状态数据，写入只保存在内存中，用于交易重放
+type historyKV struct {
+	api       client.QueueProtocolAPI
+	stateHash []byte
+	cache     map[string][]byte
+}
+
+func newHistoryKV(api client.QueueProtocolAPI, stateHash []byte) *historyKV {
+	return &historyKV{api: api, stateHash: stateHash, cache: make(map[string][]byte)}
+}
+
+// Get 优先读取重放过程中的写入，否则读取历史状态
+func (h *historyKV) Get(key []byte) ([]byte, error) {
+	if value, ok := h.cache[string(key)]; ok {
+		if value == nil {
+			return nil, types.ErrNotFound
+		}
+		return value, nil
+	}
+	reply, err := h.api.StoreGet(&types.StoreGet{StateHash: h.stateHash, Keys: [][]byte{key}})
+	if err != nil {
+		return nil, err
+	}
+	if len(reply.Values) == 0 || reply.Values[0] == nil {
+		return nil, types.ErrNotFound
+	}
+	return reply.Values[0], nil
+}
+
+// Set 写入内存
+func (h *historyKV) Set(key []byte, value []byte) error {
+	h.cache[string(key)] = value
+	return nil
+}
+
+// Begin 不支持事务
+func (h *historyKV) Begin() {}
+
+// Commit 不支持事务
+func (h *historyKV) Commit() error { return nil }
+
+// Rollback 不支持事务
+func (h *historyKV) Rollback() {}
+
+// Query_Trace 跟踪合约执行，返回调用树或者执行前状态，不修改原有执行器的状态数据
+// 指定txHash时在交易所在区块的父状态上重放区块内之前的evm交易，然后跟踪目标交易；
+// 否则在最新状态上按照caller/address/input模拟调用
+func (evm *EVMExecutor) Query_Trace(in *evmtypes.EvmTraceReq) (types.Message, error) {
+	prestate := false
+	switch in.Tracer {
+	case "", TracerCall:
+	case TracerPrestate:
+		prestate = true
+	default:
+		return nil, ErrTraceUnknownTracer
+	}
+
+	var (
+		msg     *evmCommon.Message
+		txHash  []byte
+		sigType int32
+		index   int
+		txFee   = uint64(evmtypes.MaxGasLimit)
+	)
+	if len(in.TxHash) > 0 {
+		tx, txIndex, err := evm.prepareTraceTx(in.TxHash)
+		if err != nil {
+			return nil, err
+		}
+		evm.CheckInit()
+		msg, err = evm.GetMessage(tx, txIndex, nil)
+		if err != nil {
+			return nil, err
+		}
+		txHash, sigType, index, txFee = tx.Hash(), tx.GetSignature().GetTy(), txIndex, msg.GasLimit()
+	} else {
+		evm.CheckInit()
+		to := evmCommon.StringToAddress(in.Address)
+		if to == nil {
+			return nil, types.ErrInvalidAddress
+		}
+		caller := evmCommon.ExecAddress(evm.GetAPI().GetConfig().ExecName(evmtypes.ExecutorName))
+		if len(in.Caller) > 0 {
+			callAddr := evmCommon.StringToAddress(in.Caller)
+			if callAddr == nil {
+				return nil, types.ErrInvalidAddress
+			}
+			caller = *callAddr
+		}
+		msg = evmCommon.NewMessage(caller, to, 0, in.Value, evmtypes.MaxGasLimit, 1, nil, evmCommon.FromHex(in.Input), "")
+		txHash = evmCommon.BigToHash(big.NewInt(evmtypes.MaxGasLimit)).Bytes()
+		if in.GetEthquery() {
+			sigType = types.EncodeSignID(types.SECP256K1ETH, 2)
+		}
+		index = 1
+	}
+
+	tracer := runtime.NewCallTracer(evm.mStateDB, prestate)
+	tracer.Touch(msg.From())
+	tracer.Touch(*msg.To())
+
+	// 查询时每次都会新建执行器对象，这里替换调试配置不会影响区块执行
+	vmCfg := *evm.vmCfg
+	vmCfg.Debug = runtime.EVMDebugOn
+	vmCfg.Tracer = tracer
+	origin := evm.vmCfg
+	evm.vmCfg = &vmCfg
+	_, err := evm.innerExec(msg, txHash, sigType, index, txFee, true)
+	evm.vmCfg = origin
+
+	root := tracer.Root()
+	if root == nil {
+		// 合约没有真正执行（如普通转账或者执行前检查失败）
+		root = &evmtypes.EvmCallFrame{
+			Type:  runtime.CALL.String(),
+			From:  msg.From().String(),
+			To:    msg.To().String(),
+			Value: msg.Value(),
+			Gas:   msg.GasLimit(),
+			Input: evmCommon.Bytes2Hex(msg.Data()),
+		}
+		if len(msg.Data()) == 0 {
+			root.Input = evmCommon.Bytes2Hex(msg.Para())
+		}
+		tracer.SetRoot(root)
+	}
+	if err != nil && root.Error == "" {
+		root.Error = err.Error()
+	}
+
+	resp := &evmtypes.EvmTraceResp{Call: root}
+	if prestate {
+		resp.Prestate = tracer.Prestate()
+	}
+	return resp, nil
+}
+
+// prepareTraceTx 将执行器切换到交易所在区块的父状态，并重放区块内目标交易之前的evm交易
+// 非evm交易产生的状态变更（包括手续费扣除）无法在执行器内部重放，对应的余额可能与实际执行时有差异
+func (evm *EVMExecutor) prepareTraceTx(hash string) (*types.Transaction, int, error) {
+	hashBytes, err := common.FromHex(hash)
+	if err != nil {
+		return nil, 0, err
+	}
+	api := evm.GetAPI()
+	detail, err := api.QueryTx(&types.ReqHash{Hash: hashBytes})
+	if err != nil {
+		return nil, 0, err
+	}
+	tx := detail.GetTx()
+	if !bytes.Equal(types.GetRealExecName(tx.Execer), evmtypes.ExecerEvm) {
+		return nil, 0, ErrTraceNotEvmTx
+	}
+	if detail.Height <= 0 {
+		return nil, 0, types.ErrInvalidParam
+	}
+	blocks, err := api.GetBlocks(&types.ReqBlocks{Start: detail.Height - 1, End: detail.Height})
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(blocks.GetItems()) != 2 {
+		return nil, 0, types.ErrBlockNotFound
+	}
+	parent, block := blocks.Items[0].Block, blocks.Items[1].Block
+
+	kv := newHistoryKV(api, parent.StateHash)
+	evm.SetEnv(block.Height, block.BlockTime, uint64(block.Difficulty))
+	evm.SetStateDB(kv)
+
+	for i := 0; i < int(detail.Index) && i < len(block.Txs); i++ {
+		prev := block.Txs[i]
+		if !bytes.Equal(types.GetRealExecName(prev.Execer), evmtypes.ExecerEvm) {
+			continue
+		}
+		evm.CheckInit()
+		msg, err := evm.GetMessage(prev, i, nil)
+		if err != nil {
+			continue
+		}
+		receipt, err := evm.innerExec(msg, prev.Hash(), prev.GetSignature().GetTy(), i, msg.GasLimit(), true)
+		if err != nil || receipt == nil {
+			log.Debug("prepareTraceTx", "skip tx", common.ToHex(prev.Hash()), "err", err)
+			continue
+		}
+		for _, item := range receipt.KV {
+			_ = kv.Set(item.Key, item.Value)
+		}
+	}
+	return tx, int(detail.Index), nil
+}
@@ -47,6 +47,9 @@ const (
 	SstoreResetGasEIP2200             uint64 = 5000  // Once per SSTORE operation from clean non-zero to something else
 	SstoreClearsScheduleRefundEIP2200 uint64 = 15000 // Once per SSTORE operation for clearing an originally existing storage slot
 
+	TloadGas  uint64 = 100 // Once per TLOAD operation (EIP-1153, part of Cancun)
+	TstoreGas uint64 = 100 // Once per TSTORE operation (EIP-1153, part of Cancun)
+
 	JumpdestGas   uint64 = 1     // Once per JUMPDEST operation.
 	EpochDuration uint64 = 30000 // Duration between proof-of-work epochs.
 
@@ -0,0 +1,227 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/state"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+var (
+	// revertSelector Error(string) 方法签名
+	revertSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+	// panicSelector Panic(uint256) 方法签名
+	panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+)
+
+// CallTracer 以调用树的方式记录合约执行过程，同时可以记录执行前被访问账户的状态（prestate）
+// 只用于查询接口中的交易重放，不参与区块执行
+type CallTracer struct {
+	statedb  state.EVMStateDB
+	prestate bool
+
+	root  *evmtypes.EvmCallFrame
+	stack []*evmtypes.EvmCallFrame
+
+	accounts map[string]*evmtypes.EvmPrestateAccount
+	slots    map[string]map[common.Hash]bool
+	order    []string
+}
+
+// NewCallTracer 新建调用跟踪器，prestate为true时记录被访问账户和存储的初始状态
+func NewCallTracer(statedb state.EVMStateDB, prestate bool) *CallTracer {
+	return &CallTracer{
+		statedb:  statedb,
+		prestate: prestate,
+		accounts: make(map[string]*evmtypes.EvmPrestateAccount),
+		slots:    make(map[string]map[common.Hash]bool),
+	}
+}
+
+// CaptureStart 顶层调用开始
+func (t *CallTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value uint64) error {
+	typ := CALL
+	if create {
+		typ = CREATE
+	}
+	t.root = newCallFrame(typ, from, to, input, gas, value)
+	t.stack = []*evmtypes.EvmCallFrame{t.root}
+	t.Touch(from)
+	t.Touch(to)
+	return nil
+}
+
+// CaptureState 在prestate模式下记录指令访问的账户和存储
+func (t *CallTracer) CaptureState(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *Memory, stack *Stack, rData []byte, contract *Contract, depth int, err error) error {
+	if !t.prestate || err != nil || stack.len() == 0 {
+		return nil
+	}
+	switch op {
+	case SLOAD, SSTORE:
+		t.touchSlot(contract.Address(), common.BytesToHash(stack.Back(0).Bytes()))
+	case BALANCE, EXTCODESIZE, EXTCODECOPY, EXTCODEHASH:
+		t.Touch(common.Uint256ToAddress(stack.Back(0)))
+	}
+	return nil
+}
+
+// CaptureFault 错误在CaptureEnd/CaptureExit中记录
+func (t *CallTracer) CaptureFault(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *Memory, stack *Stack, contract *Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureEnd 顶层调用结束
+func (t *CallTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	if t.root == nil {
+		return nil
+	}
+	fillCallFrame(t.root, output, gasUsed, err)
+	t.stack = nil
+	return nil
+}
+
+// CaptureEnter 内部调用开始，挂到当前调用帧下
+func (t *CallTracer) CaptureEnter(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value uint64) {
+	if len(t.stack) == 0 {
+		return
+	}
+	frame := newCallFrame(typ, from, to, input, gas, value)
+	parent := t.stack[len(t.stack)-1]
+	parent.Calls = append(parent.Calls, frame)
+	t.stack = append(t.stack, frame)
+	t.Touch(from)
+	t.Touch(to)
+}
+
+// CaptureExit 内部调用结束
+func (t *CallTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	// 栈底为顶层调用，由CaptureEnd处理
+	if len(t.stack) <= 1 {
+		return
+	}
+	frame := t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+	fillCallFrame(frame, output, gasUsed, err)
+}
+
+// Touch 记录账户执行前的状态，只记录第一次访问时的值
+func (t *CallTracer) Touch(addr common.Address) {
+	if !t.prestate {
+		return
+	}
+	key := addr.String()
+	if _, ok := t.accounts[key]; ok {
+		return
+	}
+	acc := &evmtypes.EvmPrestateAccount{
+		Address: key,
+		Balance: t.statedb.GetBalance(key),
+		Nonce:   t.statedb.GetNonce(key),
+	}
+	if hash := t.statedb.GetCodeHash(key); hash != (common.Hash{}) {
+		acc.CodeHash = hash.Hex()
+	}
+	t.accounts[key] = acc
+	t.order = append(t.order, key)
+}
+
+func (t *CallTracer) touchSlot(addr common.Address, key common.Hash) {
+	t.Touch(addr)
+	name := addr.String()
+	if t.slots[name] == nil {
+		t.slots[name] = make(map[common.Hash]bool)
+	}
+	if t.slots[name][key] {
+		return
+	}
+	t.slots[name][key] = true
+	value := t.statedb.GetState(name, key)
+	acc := t.accounts[name]
+	acc.Storage = append(acc.Storage, &evmtypes.EvmStorageSlot{Key: key.Hex(), Value: value.Hex()})
+}
+
+// Root 返回调用树，执行未进入合约时可能为空
+func (t *CallTracer) Root() *evmtypes.EvmCallFrame {
+	return t.root
+}
+
+// SetRoot 设置顶层调用帧，用于合约未真正执行时补全调用信息
+func (t *CallTracer) SetRoot(frame *evmtypes.EvmCallFrame) {
+	t.root = frame
+}
+
+// Prestate 按照首次访问顺序返回账户执行前状态，存储项按key排序
+func (t *CallTracer) Prestate() []*evmtypes.EvmPrestateAccount {
+	accounts := make([]*evmtypes.EvmPrestateAccount, 0, len(t.order))
+	for _, key := range t.order {
+		acc := t.accounts[key]
+		sort.Slice(acc.Storage, func(i, j int) bool { return acc.Storage[i].Key < acc.Storage[j].Key })
+		accounts = append(accounts, acc)
+	}
+	return accounts
+}
+
+func newCallFrame(typ OpCode, from, to common.Address, input []byte, gas, value uint64) *evmtypes.EvmCallFrame {
+	return &evmtypes.EvmCallFrame{
+		Type:  typ.String(),
+		From:  from.String(),
+		To:    to.String(),
+		Value: value,
+		Gas:   gas,
+		Input: common.Bytes2Hex(input),
+	}
+}
+
+func fillCallFrame(frame *evmtypes.EvmCallFrame, output []byte, gasUsed uint64, err error) {
+	frame.GasUsed = gasUsed
+	frame.Output = common.Bytes2Hex(output)
+	if err != nil {
+		frame.Error = err.Error()
+		frame.RevertReason = UnpackRevertReason(output)
+	}
+}
+
+// UnpackRevertReason 解析revert返回数据中的错误原因，支持Error(string)和Panic(uint256)两种格式
+func UnpackRevertReason(data []byte) string {
+	if len(data) < 4 {
+		return ""
+	}
+	switch {
+	case bytes.Equal(data[:4], revertSelector):
+		data = data[4:]
+		if len(data) < 64 {
+			return ""
+		}
+		offset := common.BytesToHash(data[:32]).Big()
+		if !offset.IsUint64() || offset.Uint64()+32 > uint64(len(data)) {
+			return ""
+		}
+		start := offset.Uint64()
+		size := common.BytesToHash(data[start : start+32]).Big()
+		if !size.IsUint64() || start+32+size.Uint64() > uint64(len(data)) {
+			return ""
+		}
+		return string(data[start+32 : start+32+size.Uint64()])
+	case bytes.Equal(data[:4], panicSelector):
+		if len(data) < 36 {
+			return ""
+		}
+		code := data[4:36]
+		for _, b := range code[:24] {
+			if b != 0 {
+				return fmt.Sprintf("panic: 0x%x", code)
+			}
+		}
+		return fmt.Sprintf("panic: 0x%02x", binary.BigEndian.Uint64(code[24:]))
+	}
+	return ""
+}
@@ -0,0 +1,61 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package runtime
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallTracerFrames(t *testing.T) {
+	var (
+		a = common.BytesToAddress([]byte{1})
+		b = common.BytesToAddress([]byte{2})
+		c = common.BytesToAddress([]byte{3})
+	)
+	tracer := NewCallTracer(nil, false)
+	tracer.CaptureStart(a, b, false, []byte{0x01}, 1000, 5)
+	tracer.CaptureEnter(CALL, b, c, []byte{0x02}, 500, 0)
+	tracer.CaptureEnter(STATICCALL, c, a, nil, 200, 0)
+	tracer.CaptureExit([]byte{0xff}, 50, nil)
+	revert, _ := hex.DecodeString("08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000004" +
+		"6661696c00000000000000000000000000000000000000000000000000000000")
+	tracer.CaptureExit(revert, 300, model.ErrExecutionReverted)
+	tracer.CaptureEnter(DELEGATECALL, b, c, nil, 100, 0)
+	tracer.CaptureExit(nil, 10, nil)
+	tracer.CaptureEnd([]byte{0x03}, 600, 0, nil)
+
+	root := tracer.Root()
+	assert.Equal(t, "CALL", root.Type)
+	assert.Equal(t, uint64(600), root.GasUsed)
+	assert.Equal(t, uint64(5), root.Value)
+	assert.Equal(t, "", root.Error)
+	assert.Equal(t, 2, len(root.Calls))
+
+	call := root.Calls[0]
+	assert.Equal(t, c.String(), call.To)
+	assert.Equal(t, uint64(300), call.GasUsed)
+	assert.Equal(t, model.ErrExecutionReverted.Error(), call.Error)
+	assert.Equal(t, "fail", call.RevertReason)
+	assert.Equal(t, 1, len(call.Calls))
+	assert.Equal(t, "STATICCALL", call.Calls[0].Type)
+	assert.Equal(t, "0xff", call.Calls[0].Output)
+
+	assert.Equal(t, "DELEGATECALL", root.Calls[1].Type)
+	assert.Equal(t, 0, len(root.Calls[1].Calls))
+}
+
+func TestUnpackRevertReason(t *testing.T) {
+	panicData, _ := hex.DecodeString("4e487b71" + "0000000000000000000000000000000000000000000000000000000000000011")
+	assert.Equal(t, "panic: 0x11", UnpackRevertReason(panicData))
+	assert.Equal(t, "", UnpackRevertReason([]byte{0x08, 0xc3, 0x79, 0xa0, 0x00}))
+	assert.Equal(t, "", UnpackRevertReason(nil))
+}
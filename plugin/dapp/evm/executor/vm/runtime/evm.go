@@ -194,6 +194,9 @@ func (evm *EVM) preCheck(caller ContractRef, value uint64) (pass bool, err error
 // 合约调用逻辑支持在合约调用的同时进行向合约转账的操作
 func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas uint64, value uint64) (ret []byte, snapshot int, leftOverGas uint64, err error) {
 	log.Info("Call", "caller:", caller.Address().String(), "addr:", addr.String(), "gas:", gas, "isEtx:", evm.CheckIsEthTx(), "value:", value, "inputsize:", len(input), "inputData:", common.Bytes2Hex(input))
+	if evm.captureEnter(CALL, caller.Address(), addr, input, gas, value) {
+		defer evm.captureExit(gas, &ret, &leftOverGas, &err)
+	}
 	pass, err := evm.preCheck(caller, value)
 	if !pass {
 		log.Error("Call", "preCheck:", err)
@@ -289,6 +292,9 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 // 在创建合约对象时，合约对象的上下文地址（合约对象的self属性）被设置为caller的地址
 func (evm *EVM) CallCode(caller ContractRef, addr common.Address, input []byte, gas uint64, value uint64) (ret []byte, leftOverGas uint64, err error) {
 	log.Info("CallCode", "caller:", caller.Address(), "addr:", addr, "input:", common.Bytes2Hex(input))
+	if evm.captureEnter(CALLCODE, caller.Address(), addr, input, gas, value) {
+		defer evm.captureExit(gas, &ret, &leftOverGas, &err)
+	}
 	pass, err := evm.preCheck(caller, value)
 	if !pass {
 		return nil, gas, err
@@ -343,6 +349,9 @@ func (evm *EVM) CallCode(caller ContractRef, addr common.Address, input []byte,
 // 和CallCode不同的是，它会把合约的外部调用地址设置成caller的caller
 func (evm *EVM) DelegateCall(caller ContractRef, addr common.Address, input []byte, gas uint64) (ret []byte, leftOverGas uint64, err error) {
 	log.Info("DelegateCall", "caller:", caller.Address(), "addr:", addr, "input:", common.Bytes2Hex(input))
+	if evm.captureEnter(DELEGATECALL, caller.Address(), addr, input, gas, 0) {
+		defer evm.captureExit(gas, &ret, &leftOverGas, &err)
+	}
 	pass, err := evm.preCheck(caller, 0)
 	if !pass {
 		return nil, gas, err
@@ -389,6 +398,9 @@ func (evm *EVM) DelegateCall(caller ContractRef, addr common.Address, input []by
 // 不支持向合约转账
 // 在合约逻辑中，可以指定其它的合约地址以及输入参数进行合约调用，但是，这种情况下禁止修改MemoryStateDB中的任何数据，否则执行会出错
 func (evm *EVM) StaticCall(caller ContractRef, addr common.Address, input []byte, gas uint64) (ret []byte, leftOverGas uint64, err error) {
+	if evm.captureEnter(STATICCALL, caller.Address(), addr, input, gas, 0) {
+		defer evm.captureExit(gas, &ret, &leftOverGas, &err)
+	}
 
 	addrecrecover := common.BytesToAddress(common.RightPadBytes([]byte{1}, 20))
 	log.Info("StaticCall", "input", common.Bytes2Hex(input),
@@ -475,6 +487,9 @@ func (evm *EVM) StaticCall(caller ContractRef, addr common.Address, input []byte
 // 目前chain33为了保证账户安全，不允许合约中涉及到外部账户的转账操作，
 // 所以，本步骤不接收转账金额参数
 func (evm *EVM) Create(caller ContractRef, contractAddr common.Address, code []byte, gas uint64, execName, alias string, value uint64) (ret []byte, snapshot int, leftOverGas uint64, err error) {
+	if evm.captureEnter(CREATE, caller.Address(), contractAddr, code, gas, value) {
+		defer evm.captureExit(gas, &ret, &leftOverGas, &err)
+	}
 	pass, err := evm.preCheck(caller, value)
 	if !pass {
 		return nil, -1, gas, err
@@ -542,6 +557,24 @@ func (evm *EVM) Create(caller ContractRef, contractAddr common.Address, code []b
 	return ret, snapshot, contract.Gas, err
 }
 
+// captureEnter 调试模式下记录合约内部调用，顶层调用通过CaptureStart记录
+func (evm *EVM) captureEnter(typ OpCode, from, to common.Address, input []byte, gas, value uint64) bool {
+	if EVMDebugOn != evm.VMConfig.Debug || evm.depth == 0 {
+		return false
+	}
+	evm.VMConfig.Tracer.CaptureEnter(typ, from, to, input, gas, value)
+	return true
+}
+
+// captureExit 内部调用结束，需要在defer中调用以获取最终的返回值
+func (evm *EVM) captureExit(gas uint64, ret *[]byte, leftOverGas *uint64, err *error) {
+	var used uint64
+	if gas > *leftOverGas {
+		used = gas - *leftOverGas
+	}
+	evm.VMConfig.Tracer.CaptureExit(*ret, used, *err)
+}
+
 func (evm *EVM) precompile(addr common.Address) (PrecompiledContract, StatefulPrecompiledContract, bool) {
 	p, ok := PrecompiledContractsBerlin[addr.ToHash160()]
 	if ok {
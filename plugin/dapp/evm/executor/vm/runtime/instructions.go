@@ -340,6 +340,18 @@ func opCallDataCopy(pc *uint64, evm *EVM, callContext *callCtx) ([]byte, error)
 	return nil, nil
 }
 
+// 内存内部拷贝，源和目标区域允许重叠
+func opMcopy(pc *uint64, evm *EVM, callContext *callCtx) ([]byte, error) {
+	var (
+		dst    = callContext.stack.pop()
+		src    = callContext.stack.pop()
+		length = callContext.stack.pop()
+	)
+	// These values are checked for overflow during memory expansion calculation
+	callContext.memory.Copy(dst.Uint64(), src.Uint64(), length.Uint64())
+	return nil, nil
+}
+
 // 获取合约执行返回结果的大小
 func opReturnDataSize(pc *uint64, evm *EVM, callContext *callCtx) ([]byte, error) {
 	callContext.stack.push(new(uint256.Int).SetUint64(uint64(len(evm.Interpreter.returnData))))
@@ -440,6 +452,13 @@ func opGasprice(pc *uint64, evm *EVM, callContext *callCtx) ([]byte, error) {
 	return nil, nil
 }
 
+// opBaseFee implements BASEFEE opcode
+// chain33没有EIP-1559基础费用，返回交易的gas价格
+func opBaseFee(pc *uint64, evm *EVM, callContext *callCtx) ([]byte, error) {
+	callContext.stack.push(uint256.NewInt(uint64(evm.GasPrice)))
+	return nil, nil
+}
+
 // 获取区块哈希
 func opBlockhash(pc *uint64, evm *EVM, callContext *callCtx) ([]byte, error) {
 	num := callContext.stack.peek()
@@ -541,6 +560,24 @@ func opSload(pc *uint64, evm *EVM, callContext *callCtx) ([]byte, error) {
 	return nil, nil
 }
 
+// 读合约临时存储数据
+func opTload(pc *uint64, evm *EVM, callContext *callCtx) ([]byte, error) {
+	loc := callContext.stack.peek()
+	hash := common.BytesToHash(loc.Bytes())
+	val := evm.StateDB.GetTransientState(callContext.contract.Address().String(), hash)
+	loc.SetBytes(val.Bytes())
+	return nil, nil
+}
+
+// 写合约临时存储数据，只读调用中不允许执行（通过operation.writes检查）
+func opTstore(pc *uint64, evm *EVM, callContext *callCtx) ([]byte, error) {
+	loc := callContext.stack.pop()
+	val := callContext.stack.pop()
+	evm.StateDB.SetTransientState(callContext.contract.Address().String(),
+		common.BytesToHash(loc.Bytes()), common.BytesToHash(val.Bytes()))
+	return nil, nil
+}
+
 // 写合约状态数据
 func opSstore(pc *uint64, evm *EVM, callContext *callCtx) ([]byte, error) {
 	loc := callContext.stack.pop()
@@ -952,6 +989,12 @@ func opPush1(pc *uint64, evm *EVM, callContext *callCtx) ([]byte, error) {
 	return nil, nil
 }
 
+// opPush0 implements the PUSH0 opcode
+func opPush0(pc *uint64, evm *EVM, callContext *callCtx) ([]byte, error) {
+	callContext.stack.push(new(uint256.Int))
+	return nil, nil
+}
+
 // 生成pushN操作的方法，支持push1-push32
 // 此操作可以讲合约中的数据进行压栈
 func makePush(size uint64, pushByteSize int) executionFunc {
@@ -656,3 +656,88 @@ func TestCreate2Addreses(t *testing.T) {
 		}
 	}
 }
+
+func TestOpPush0(t *testing.T) {
+	var (
+		env   = NewEVM(Context{}, &state.MemoryStateDB{}, Config{}, chain33Cfg)
+		stack = newstack()
+		pc    = uint64(0)
+	)
+	opPush0(&pc, env, &callCtx{nil, stack, nil})
+	if v := stack.pop(); len(stack.data) != 0 || !v.IsZero() {
+		t.Fatalf("Push0 fail, expected one zero item on stack")
+	}
+}
+
+func TestOpMcopy(t *testing.T) {
+	var (
+		env   = NewEVM(Context{}, &state.MemoryStateDB{}, Config{}, chain33Cfg)
+		stack = newstack()
+		mem   = NewMemory()
+		pc    = uint64(0)
+	)
+	mem.Resize(64)
+	copy(mem.Data(), common.Hex2Bytes("000102030405060708090a0b0c0d0e0f"))
+	// 重叠区域拷贝: dst=2, src=0, len=8
+	stack.pushN(*uint256.NewInt(8), *uint256.NewInt(0), *uint256.NewInt(2))
+	opMcopy(&pc, env, &callCtx{mem, stack, nil})
+	if got := hex.EncodeToString(mem.GetCopy(0, 16)); got != "000100010203040506070a0b0c0d0e0f" {
+		t.Fatalf("Mcopy fail, got %v", got)
+	}
+	// 内存扩展按照源和目标的较大值计算
+	stack.pushN(*uint256.NewInt(32), *uint256.NewInt(64), *uint256.NewInt(0))
+	size, overflow := memoryMcopy(stack)
+	if overflow || size != 96 {
+		t.Fatalf("Mcopy memory size fail, got %v", size)
+	}
+}
+
+func TestOpTransientStorage(t *testing.T) {
+	var (
+		env      = NewEVM(Context{}, &state.MemoryStateDB{}, Config{}, chain33Cfg)
+		stack    = newstack()
+		pc       = uint64(0)
+		contract = NewContract(AccountRef(common.Address{}), AccountRef(common.BytesToAddress([]byte{1})), nil, 0)
+	)
+	stack.pushN(*uint256.NewInt(0x1337), *uint256.NewInt(1))
+	opTstore(&pc, env, &callCtx{nil, stack, contract})
+	stack.push(uint256.NewInt(1))
+	opTload(&pc, env, &callCtx{nil, stack, contract})
+	if got := stack.pop(); got.Uint64() != 0x1337 {
+		t.Fatalf("Tload fail, got %v", got)
+	}
+	// 不同合约的临时存储相互隔离
+	other := NewContract(AccountRef(common.Address{}), AccountRef(common.BytesToAddress([]byte{2})), nil, 0)
+	stack.push(uint256.NewInt(1))
+	opTload(&pc, env, &callCtx{nil, stack, other})
+	if got := stack.pop(); !got.IsZero() {
+		t.Fatalf("Tload fail, expected zero got %v", got)
+	}
+}
+
+func TestShanghaiCancunJumpTable(t *testing.T) {
+	if berlinInstructionSet[PUSH0] != nil || berlinInstructionSet[TSTORE] != nil {
+		t.Fatalf("berlin instruction set should not contain shanghai or cancun opcodes")
+	}
+	if shanghaiInstructionSet[PUSH0] == nil || shanghaiInstructionSet[BASEFEE] == nil || shanghaiInstructionSet[MCOPY] != nil {
+		t.Fatalf("shanghai instruction set mismatch")
+	}
+	for _, op := range []OpCode{PUSH0, BASEFEE, TLOAD, TSTORE, MCOPY} {
+		if cancunInstructionSet[op] == nil {
+			t.Fatalf("cancun instruction set missing %v", op)
+		}
+	}
+	if !cancunInstructionSet[TSTORE].writes {
+		t.Fatalf("TSTORE should be forbidden in static call")
+	}
+
+	// 根据分叉高度选择指令集
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	cfg.SetDappFork("evm", "ForkEVMShanghai", 0)
+	cfg.SetDappFork("evm", "ForkEVMCancun", 10)
+	env := NewEVM(Context{}, &state.MemoryStateDB{}, Config{}, cfg)
+	if env.Interpreter.cfg.JumpTable[PUSH0] == nil || env.Interpreter.cfg.JumpTable[TLOAD] != nil {
+		t.Fatalf("expected shanghai instruction set before cancun fork")
+	}
+}
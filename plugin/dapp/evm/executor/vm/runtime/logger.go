@@ -29,6 +29,10 @@ type Tracer interface {
 	CaptureFault(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *Memory, stack *Stack, contract *Contract, depth int, err error) error
 	// CaptureEnd 结束记录
 	CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) error
+	// CaptureEnter 合约内部调用开始（CALL/CALLCODE/DELEGATECALL/STATICCALL/CREATE）
+	CaptureEnter(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value uint64)
+	// CaptureExit 合约内部调用结束
+	CaptureExit(output []byte, gasUsed uint64, err error)
 }
 
 // JSONLogger 使用json格式打印日志
@@ -150,6 +154,14 @@ func (logger *JSONLogger) CaptureEnd(output []byte, gasUsed uint64, t time.Durat
 	return logger.encoder.Encode(endLog{common.Bytes2Hex(output), int64(gasUsed), t, ""})
 }
 
+// CaptureEnter 目前实现为空
+func (logger *JSONLogger) CaptureEnter(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value uint64) {
+}
+
+// CaptureExit 目前实现为空
+func (logger *JSONLogger) CaptureExit(output []byte, gasUsed uint64, err error) {
+}
+
 type mdLogger struct {
 	out io.Writer
 	cfg *LogConfig
@@ -215,3 +227,11 @@ func (t *mdLogger) CaptureEnd(output []byte, gasUsed uint64, tm time.Duration, e
 		output, gasUsed, err)
 	return nil
 }
+
+func (t *mdLogger) CaptureEnter(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value uint64) {
+	fmt.Fprintf(t.out, "\n%v From: `%v` To: `%v` Data: `0x%x` Gas: `%d` Value: `%v`\n", typ, from.String(), to.String(), input, gas, value)
+}
+
+func (t *mdLogger) CaptureExit(output []byte, gasUsed uint64, err error) {
+	fmt.Fprintf(t.out, "\nReturn: `0x%x` Consumed gas: `%d` Error: `%v`\n", output, gasUsed, err)
+}
@@ -8,6 +8,15 @@ func memoryCallDataCopy(stack *Stack) (uint64, bool) {
 	return calcMemSize64(stack.Back(0), stack.Back(2))
 }
 
+func memoryMcopy(stack *Stack) (uint64, bool) {
+	// 目标和源区域都需要扩展内存，取较大者
+	mStart := stack.Back(0)
+	if stack.Back(1).Gt(mStart) {
+		mStart = stack.Back(1)
+	}
+	return calcMemSize64(mStart, stack.Back(2))
+}
+
 func memoryReturnDataCopy(stack *Stack) (uint64, bool) {
 	return calcMemSize64(stack.Back(0), stack.Back(2))
 }
@@ -92,6 +92,7 @@ func initMap() {
 		GASLIMIT:    "GASLIMIT",
 		CHAINID:     "CHAINID",
 		SELFBALANCE: "SELFBALANCE",
+		BASEFEE:     "BASEFEE",
 
 		// 0x50 range - 'storage' and execution
 		POP: "POP",
@@ -109,9 +110,10 @@ func initMap() {
 		GAS:      "GAS",
 		JUMPDEST: "JUMPDEST",
 
-		BEGINSUB:  "BEGINSUB",
-		JUMPSUB:   "JUMPSUB",
-		RETURNSUB: "RETURNSUB",
+		TLOAD:  "TLOAD",
+		TSTORE: "TSTORE",
+		MCOPY:  "MCOPY",
+		PUSH0:  "PUSH0",
 
 		// 0x60 range - push
 		PUSH1:  "PUSH1",
@@ -326,6 +328,8 @@ const (
 	CHAINID OpCode = 0x46
 	// SELFBALANCE op
 	SELFBALANCE OpCode = 0x47
+	// BASEFEE op
+	BASEFEE OpCode = 0x48
 )
 
 const (
@@ -353,12 +357,14 @@ const (
 	GAS
 	// JUMPDEST op
 	JUMPDEST
-	// BEGINSUB OP
-	BEGINSUB
-	// RETURNSUB op
-	RETURNSUB
-	// JUMPSUB op
-	JUMPSUB
+	// TLOAD op, EIP-1153 临时存储
+	TLOAD
+	// TSTORE op
+	TSTORE
+	// MCOPY op, EIP-5656 内存拷贝
+	MCOPY
+	// PUSH0 op, EIP-3855
+	PUSH0
 )
 
 const (
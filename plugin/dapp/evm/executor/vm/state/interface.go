@@ -52,6 +52,11 @@ type EVMStateDB interface {
 	// SetState 设置合约状态数据
 	SetState(string, common.Hash, common.Hash)
 
+	// GetTransientState 获取合约临时存储数据（EIP-1153，交易结束后清空）
+	GetTransientState(string, common.Hash) common.Hash
+	// SetTransientState 设置合约临时存储数据
+	SetTransientState(string, common.Hash, common.Hash)
+
 	// Suicide 合约自销毁
 	Suicide(string) bool
 	// HasSuicided 合约是否已经销毁
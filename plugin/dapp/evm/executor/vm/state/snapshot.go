@@ -117,6 +117,13 @@ type (
 		key, prevalue common.Hash
 	}
 
+	// 临时存储变更事件，只需要回滚，不产生状态数据
+	transientStorageChange struct {
+		baseChange
+		account       string
+		key, prevalue common.Hash
+	}
+
 	// 合约代码状态变更事件
 	codeChange struct {
 		baseChange
@@ -289,6 +296,10 @@ func (ch storageChange) getLog(mdb *MemoryStateDB) []*types.ReceiptLog {
 	return nil
 }
 
+func (ch transientStorageChange) revert(mdb *MemoryStateDB) {
+	mdb.setTransientState(ch.account, ch.key, ch.prevalue)
+}
+
 func (ch refundChange) revert(mdb *MemoryStateDB) {
 	mdb.refund = ch.prev
 }
@@ -49,6 +49,9 @@ type MemoryStateDB struct {
 	// 合约执行过程中退回的资金
 	refund uint64
 
+	// 合约临时存储数据（TLOAD/TSTORE），只在单个交易内有效
+	transientStorage map[string]map[common.Hash]common.Hash
+
 	// 存储makeLogN指令对应的日志数据
 	logs    map[common.Hash][]*model.ContractLog
 	logSize uint
@@ -101,6 +104,8 @@ func NewMemoryStateDB(StateDB db.KV, LocalDB db.KVDB, CoinsAccount *account.DB,
 func (mdb *MemoryStateDB) Prepare(txHash common.Hash, txIndex int) {
 	mdb.txHash = txHash
 	mdb.txIndex = txIndex
+	// 临时存储只在交易内有效，每个交易开始前清空
+	mdb.transientStorage = make(map[string]map[common.Hash]common.Hash)
 	log15.Info("MemoryStateDB::Prepare", "txHash", txHash.Hex(), "txIndex", txIndex, "logSize", mdb.logSize)
 }
 
@@ -288,6 +293,33 @@ func (mdb *MemoryStateDB) SetState(addr string, key common.Hash, value common.Ha
 	}
 }
 
+// GetTransientState 获取合约临时存储数据
+func (mdb *MemoryStateDB) GetTransientState(addr string, key common.Hash) common.Hash {
+	return mdb.transientStorage[addr][key]
+}
+
+// SetTransientState 设置合约临时存储数据，不写入状态数据库，交易结束后丢弃
+func (mdb *MemoryStateDB) SetTransientState(addr string, key, value common.Hash) {
+	prev := mdb.GetTransientState(addr, key)
+	if prev == value {
+		return
+	}
+	mdb.addChange(transientStorageChange{baseChange: baseChange{}, account: addr, key: key, prevalue: prev})
+	mdb.setTransientState(addr, key, value)
+}
+
+func (mdb *MemoryStateDB) setTransientState(addr string, key, value common.Hash) {
+	if mdb.transientStorage == nil {
+		mdb.transientStorage = make(map[string]map[common.Hash]common.Hash)
+	}
+	storage, ok := mdb.transientStorage[addr]
+	if !ok {
+		storage = make(map[common.Hash]common.Hash)
+		mdb.transientStorage[addr] = storage
+	}
+	storage[key] = value
+}
+
 // TransferStateData 转换合约状态数据存储
 func (mdb *MemoryStateDB) TransferStateData(addr string) {
 	acc := mdb.GetAccount(addr)
@@ -697,6 +729,14 @@ func (mdb *MemoryStateDB) AddLog(log *model.ContractLog) {
 		Ty:  evmtypes.TyLogEVMEventData,
 		Log: types.Encode(newEvmLog),
 	}
+	// ForkEVMLogIndex之后日志中记录产生日志的合约地址，跨合约调用时不一定是交易调用的合约
+	if mdb.api.GetConfig().IsDappFork(mdb.blockHeight, "evm", evmtypes.ForkEVMLogIndex) {
+		receiptLog.Log = types.Encode(&evmtypes.EVMEventLog{
+			Topic:   newEvmLog.Topic,
+			Data:    newEvmLog.Data,
+			Address: log.Address.String(),
+		})
+	}
 
 	mdb.addChange(addLogChange{
 		txhash: mdb.txHash,
@@ -46,6 +46,11 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(ExecutorName, ForkIntrinsicGas, 0)
 	cfg.RegisterDappFork(ExecutorName, ForkEVMAddressInit, 0)
 	cfg.RegisterDappFork(ExecutorName, ForkEvmExecNonce, 0)
+	// EVM 上海及坎昆升级指令集
+	cfg.RegisterDappFork(ExecutorName, ForkEVMShanghai, 0)
+	cfg.RegisterDappFork(ExecutorName, ForkEVMCancun, 0)
+	// EVM event日志本地索引
+	cfg.RegisterDappFork(ExecutorName, ForkEVMLogIndex, 0)
 
 }
 
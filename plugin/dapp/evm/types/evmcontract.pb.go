@@ -1982,6 +1982,756 @@ func (x *EvmGetUnpackDataRespose) GetUnpackData() []string {
 	return nil
 }
 
+// EvmTraceReq 跟踪合约执行，txHash非空时重放交易，否则按照caller/address/input模拟调用
+type EvmTraceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHash  string `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Caller  string `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Input   string `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+	Value   uint64 `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
+	// callTracer 或 prestateTracer, 默认callTracer
+	Tracer   string `protobuf:"bytes,6,opt,name=tracer,proto3" json:"tracer,omitempty"`
+	Ethquery bool   `protobuf:"varint,7,opt,name=ethquery,proto3" json:"ethquery,omitempty"`
+}
+
+func (x *EvmTraceReq) Reset() {
+	*x = EvmTraceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmTraceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmTraceReq) ProtoMessage() {}
+
+func (x *EvmTraceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmTraceReq.ProtoReflect.Descriptor instead.
+func (*EvmTraceReq) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *EvmTraceReq) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *EvmTraceReq) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *EvmTraceReq) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EvmTraceReq) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *EvmTraceReq) GetValue() uint64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *EvmTraceReq) GetTracer() string {
+	if x != nil {
+		return x.Tracer
+	}
+	return ""
+}
+
+func (x *EvmTraceReq) GetEthquery() bool {
+	if x != nil {
+		return x.Ethquery
+	}
+	return false
+}
+
+// EvmCallFrame 调用树节点
+type EvmCallFrame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type         string          `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	From         string          `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To           string          `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Value        uint64          `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	Gas          uint64          `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasUsed      uint64          `protobuf:"varint,6,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	Input        string          `protobuf:"bytes,7,opt,name=input,proto3" json:"input,omitempty"`
+	Output       string          `protobuf:"bytes,8,opt,name=output,proto3" json:"output,omitempty"`
+	Error        string          `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	RevertReason string          `protobuf:"bytes,10,opt,name=revertReason,proto3" json:"revertReason,omitempty"`
+	Calls        []*EvmCallFrame `protobuf:"bytes,11,rep,name=calls,proto3" json:"calls,omitempty"`
+}
+
+func (x *EvmCallFrame) Reset() {
+	*x = EvmCallFrame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmCallFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmCallFrame) ProtoMessage() {}
+
+func (x *EvmCallFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmCallFrame.ProtoReflect.Descriptor instead.
+func (*EvmCallFrame) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *EvmCallFrame) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EvmCallFrame) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *EvmCallFrame) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *EvmCallFrame) GetValue() uint64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *EvmCallFrame) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+func (x *EvmCallFrame) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *EvmCallFrame) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *EvmCallFrame) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *EvmCallFrame) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *EvmCallFrame) GetRevertReason() string {
+	if x != nil {
+		return x.RevertReason
+	}
+	return ""
+}
+
+func (x *EvmCallFrame) GetCalls() []*EvmCallFrame {
+	if x != nil {
+		return x.Calls
+	}
+	return nil
+}
+
+type EvmStorageSlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *EvmStorageSlot) Reset() {
+	*x = EvmStorageSlot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmStorageSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmStorageSlot) ProtoMessage() {}
+
+func (x *EvmStorageSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmStorageSlot.ProtoReflect.Descriptor instead.
+func (*EvmStorageSlot) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *EvmStorageSlot) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *EvmStorageSlot) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// EvmPrestateAccount 执行前被访问账户的状态
+type EvmPrestateAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address  string            `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance  uint64            `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Nonce    uint64            `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	CodeHash string            `protobuf:"bytes,4,opt,name=codeHash,proto3" json:"codeHash,omitempty"`
+	Storage  []*EvmStorageSlot `protobuf:"bytes,5,rep,name=storage,proto3" json:"storage,omitempty"`
+}
+
+func (x *EvmPrestateAccount) Reset() {
+	*x = EvmPrestateAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmPrestateAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmPrestateAccount) ProtoMessage() {}
+
+func (x *EvmPrestateAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmPrestateAccount.ProtoReflect.Descriptor instead.
+func (*EvmPrestateAccount) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EvmPrestateAccount) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EvmPrestateAccount) GetBalance() uint64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *EvmPrestateAccount) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *EvmPrestateAccount) GetCodeHash() string {
+	if x != nil {
+		return x.CodeHash
+	}
+	return ""
+}
+
+func (x *EvmPrestateAccount) GetStorage() []*EvmStorageSlot {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
+type EvmTraceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Call     *EvmCallFrame         `protobuf:"bytes,1,opt,name=call,proto3" json:"call,omitempty"`
+	Prestate []*EvmPrestateAccount `protobuf:"bytes,2,rep,name=prestate,proto3" json:"prestate,omitempty"`
+}
+
+func (x *EvmTraceResp) Reset() {
+	*x = EvmTraceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmTraceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmTraceResp) ProtoMessage() {}
+
+func (x *EvmTraceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmTraceResp.ProtoReflect.Descriptor instead.
+func (*EvmTraceResp) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EvmTraceResp) GetCall() *EvmCallFrame {
+	if x != nil {
+		return x.Call
+	}
+	return nil
+}
+
+func (x *EvmTraceResp) GetPrestate() []*EvmPrestateAccount {
+	if x != nil {
+		return x.Prestate
+	}
+	return nil
+}
+
+// EvmLogRecord 本地索引的合约event日志，address为产生日志的合约地址
+type EvmLogRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address  string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Topics   []string `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	Data     string   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	TxHash   string   `protobuf:"bytes,4,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Height   int64    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	TxIndex  int32    `protobuf:"varint,6,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
+	LogIndex int32    `protobuf:"varint,7,opt,name=logIndex,proto3" json:"logIndex,omitempty"`
+}
+
+func (x *EvmLogRecord) Reset() {
+	*x = EvmLogRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmLogRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmLogRecord) ProtoMessage() {}
+
+func (x *EvmLogRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmLogRecord.ProtoReflect.Descriptor instead.
+func (*EvmLogRecord) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *EvmLogRecord) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EvmLogRecord) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *EvmLogRecord) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *EvmLogRecord) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *EvmLogRecord) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *EvmLogRecord) GetTxIndex() int32 {
+	if x != nil {
+		return x.TxIndex
+	}
+	return 0
+}
+
+func (x *EvmLogRecord) GetLogIndex() int32 {
+	if x != nil {
+		return x.LogIndex
+	}
+	return 0
+}
+
+// EvmLogTopics 同一位置上的topic，满足其中任意一个即可，为空表示不限制
+type EvmLogTopics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic []string `protobuf:"bytes,1,rep,name=topic,proto3" json:"topic,omitempty"`
+}
+
+func (x *EvmLogTopics) Reset() {
+	*x = EvmLogTopics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmLogTopics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmLogTopics) ProtoMessage() {}
+
+func (x *EvmLogTopics) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmLogTopics.ProtoReflect.Descriptor instead.
+func (*EvmLogTopics) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *EvmLogTopics) GetTopic() []string {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+// EvmLogFilterReq 按照区块范围、合约地址和topic查询日志，语义同以太坊日志过滤
+type EvmLogFilterReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromBlock int64           `protobuf:"varint,1,opt,name=fromBlock,proto3" json:"fromBlock,omitempty"`
+	ToBlock   int64           `protobuf:"varint,2,opt,name=toBlock,proto3" json:"toBlock,omitempty"`
+	Address   string          `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Topics    []*EvmLogTopics `protobuf:"bytes,4,rep,name=topics,proto3" json:"topics,omitempty"`
+	Count     int32           `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *EvmLogFilterReq) Reset() {
+	*x = EvmLogFilterReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmLogFilterReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmLogFilterReq) ProtoMessage() {}
+
+func (x *EvmLogFilterReq) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmLogFilterReq.ProtoReflect.Descriptor instead.
+func (*EvmLogFilterReq) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *EvmLogFilterReq) GetFromBlock() int64 {
+	if x != nil {
+		return x.FromBlock
+	}
+	return 0
+}
+
+func (x *EvmLogFilterReq) GetToBlock() int64 {
+	if x != nil {
+		return x.ToBlock
+	}
+	return 0
+}
+
+func (x *EvmLogFilterReq) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EvmLogFilterReq) GetTopics() []*EvmLogTopics {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *EvmLogFilterReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type EvmLogFilterResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Logs []*EvmLogRecord `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (x *EvmLogFilterResp) Reset() {
+	*x = EvmLogFilterResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmLogFilterResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmLogFilterResp) ProtoMessage() {}
+
+func (x *EvmLogFilterResp) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmLogFilterResp.ProtoReflect.Descriptor instead.
+func (*EvmLogFilterResp) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *EvmLogFilterResp) GetLogs() []*EvmLogRecord {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+// EVMEventLog 合约event日志，与types.EVMLog编码兼容，address为产生日志的合约地址
+type EVMEventLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic   [][]byte `protobuf:"bytes,1,rep,name=topic,proto3" json:"topic,omitempty"`
+	Data    []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Address string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *EVMEventLog) Reset() {
+	*x = EVMEventLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EVMEventLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EVMEventLog) ProtoMessage() {}
+
+func (x *EVMEventLog) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EVMEventLog.ProtoReflect.Descriptor instead.
+func (*EVMEventLog) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *EVMEventLog) GetTopic() [][]byte {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+func (x *EVMEventLog) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *EVMEventLog) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
 var File_evmcontract_proto protoreflect.FileDescriptor
 
 var file_evmcontract_proto_rawDesc = []byte{
@@ -2196,9 +2946,93 @@ var file_evmcontract_proto_rawDesc = []byte{
 	0x64, 0x61, 0x74, 0x61, 0x22, 0x39, 0x0a, 0x17, 0x45, 0x76, 0x6d, 0x47, 0x65, 0x74, 0x55, 0x6e,
 	0x70, 0x61, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x73, 0x65, 0x12,
 	0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x70, 0x61, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x70, 0x61, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x42,
-	0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x70, 0x61, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x22,
+	0xb7, 0x01, 0x0a, 0x0b, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x74, 0x68, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x65, 0x74, 0x68, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x9b, 0x02, 0x0a, 0x0c, 0x45, 0x76,
+	0x6d, 0x43, 0x61, 0x6c, 0x6c, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61,
+	0x73, 0x55, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73,
+	0x55, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x76, 0x65,
+	0x72, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x05,
+	0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x43, 0x61, 0x6c, 0x6c, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x52, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0x38, 0x0a, 0x0e, 0x45, 0x76, 0x6d, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0xab, 0x01, 0x0a, 0x12, 0x45, 0x76, 0x6d, 0x50, 0x72, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2f,
+	0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x22,
+	0x6e, 0x0a, 0x0c, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x27, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x43, 0x61, 0x6c, 0x6c, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x35, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x50, 0x72, 0x65, 0x73, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22,
+	0xba, 0x01, 0x0a, 0x0c, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x24, 0x0a, 0x0c,
+	0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x22, 0xa6, 0x01, 0x0a, 0x0f, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x06, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3b, 0x0a, 0x10, 0x45,
+	0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x27, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0x51, 0x0a, 0x0b, 0x45, 0x56, 0x4d, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x0a, 0x5a, 0x08, 0x2e,
+	0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2213,7 +3047,7 @@ func file_evmcontract_proto_rawDescGZIP() []byte {
 	return file_evmcontract_proto_rawDescData
 }
 
-var file_evmcontract_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_evmcontract_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_evmcontract_proto_goTypes = []interface{}{
 	(*EVMContractObject)(nil),         // 0: types.EVMContractObject
 	(*EVMContractData)(nil),           // 1: types.EVMContractData
@@ -2244,19 +3078,35 @@ var file_evmcontract_proto_goTypes = []interface{}{
 	(*EvmGetPackDataRespose)(nil),     // 26: types.EvmGetPackDataRespose
 	(*EvmGetUnpackDataReq)(nil),       // 27: types.EvmGetUnpackDataReq
 	(*EvmGetUnpackDataRespose)(nil),   // 28: types.EvmGetUnpackDataRespose
-	nil,                               // 29: types.EVMContractState.StorageEntry
-	nil,                               // 30: types.EVMContractStateCmd.StorageEntry
+	(*EvmTraceReq)(nil),               // 29: types.EvmTraceReq
+	(*EvmCallFrame)(nil),              // 30: types.EvmCallFrame
+	(*EvmStorageSlot)(nil),            // 31: types.EvmStorageSlot
+	(*EvmPrestateAccount)(nil),        // 32: types.EvmPrestateAccount
+	(*EvmTraceResp)(nil),              // 33: types.EvmTraceResp
+	(*EvmLogRecord)(nil),              // 34: types.EvmLogRecord
+	(*EvmLogTopics)(nil),              // 35: types.EvmLogTopics
+	(*EvmLogFilterReq)(nil),           // 36: types.EvmLogFilterReq
+	(*EvmLogFilterResp)(nil),          // 37: types.EvmLogFilterResp
+	(*EVMEventLog)(nil),               // 38: types.EVMEventLog
+	nil,                               // 39: types.EVMContractState.StorageEntry
+	nil,                               // 40: types.EVMContractStateCmd.StorageEntry
 }
 var file_evmcontract_proto_depIdxs = []int32{
 	1,  // 0: types.EVMContractObject.data:type_name -> types.EVMContractData
 	2,  // 1: types.EVMContractObject.state:type_name -> types.EVMContractState
-	29, // 2: types.EVMContractState.storage:type_name -> types.EVMContractState.StorageEntry
-	30, // 3: types.EVMContractStateCmd.storage:type_name -> types.EVMContractStateCmd.StorageEntry
-	4,  // [4:4] is the sub-list for method output_type
-	4,  // [4:4] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	39, // 2: types.EVMContractState.storage:type_name -> types.EVMContractState.StorageEntry
+	40, // 3: types.EVMContractStateCmd.storage:type_name -> types.EVMContractStateCmd.StorageEntry
+	30, // 4: types.EvmCallFrame.calls:type_name -> types.EvmCallFrame
+	31, // 5: types.EvmPrestateAccount.storage:type_name -> types.EvmStorageSlot
+	30, // 6: types.EvmTraceResp.call:type_name -> types.EvmCallFrame
+	32, // 7: types.EvmTraceResp.prestate:type_name -> types.EvmPrestateAccount
+	35, // 8: types.EvmLogFilterReq.topics:type_name -> types.EvmLogTopics
+	34, // 9: types.EvmLogFilterResp.logs:type_name -> types.EvmLogRecord
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_evmcontract_proto_init() }
@@ -2613,6 +3463,126 @@ func file_evmcontract_proto_init() {
 				return nil
 			}
 		}
+		file_evmcontract_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmTraceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmcontract_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmCallFrame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmcontract_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmStorageSlot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmcontract_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmPrestateAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmcontract_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmTraceResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmcontract_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmLogRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmcontract_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmLogTopics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmcontract_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmLogFilterReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmcontract_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmLogFilterResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmcontract_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EVMEventLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2620,7 +3590,7 @@ func file_evmcontract_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_evmcontract_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   31,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -54,6 +54,12 @@ const (
 	ForkEVMAddressInit = "ForkEVMAddressInit"
 	//ForkEvmExecNonce 执行器校验nonce
 	ForkEvmExecNonce = "ForkEvmExecNonce"
+	// ForkEVMShanghai 上海升级指令集，支持PUSH0, BASEFEE
+	ForkEVMShanghai = "ForkEVMShanghai"
+	// ForkEVMCancun 坎昆升级指令集，支持TLOAD, TSTORE, MCOPY
+	ForkEVMCancun = "ForkEVMCancun"
+	// ForkEVMLogIndex 合约event日志按照地址和topic建立本地索引
+	ForkEVMLogIndex = "ForkEVMLogIndex"
 )
 
 var (
@@ -72,6 +78,6 @@ var (
 		TyLogContractData:       {Ty: reflect.TypeOf(EVMContractData{}), Name: "LogContractData"},
 		TyLogContractState:      {Ty: reflect.TypeOf(EVMContractState{}), Name: "LogContractState"},
 		TyLogEVMStateChangeItem: {Ty: reflect.TypeOf(EVMStateChangeItem{}), Name: "LogEVMStateChangeItem"},
-		TyLogEVMEventData:       {Ty: reflect.TypeOf(types.EVMLog{}), Name: "LogEVMEventData"},
+		TyLogEVMEventData:       {Ty: reflect.TypeOf(EVMEventLog{}), Name: "LogEVMEventData"},
 	}
 )
@@ -4,7 +4,6 @@ import (
 	"github.com/33cn/chain33/types"
 	et "github.com/33cn/plugin/plugin/dapp/exchange/types"
 	"github.com/golang/protobuf/proto"
-	"github.com/pkg/errors"
 )
 
 var cfg = types.NewChain33Config(types.GetDefaultCfgstring())
@@ -96,29 +95,27 @@ func (c *ExchangeClient) LimitOrder(msg proto.Message, hexKey string) (*et.Recei
 	return &resp, nil
 }
 
-//TODO marketOrder
 func (c *ExchangeClient) MarketOrder(msg proto.Message, hexKey string) (*et.ReceiptExchange, error) {
-	return nil, errors.New("Unopen")
-	//ety := types.LoadExecutorType(et.ExchangeX)
-	//tx, err := ety.Create("MarketOrder", msg)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//logs, err := c.client.Send(tx, hexKey)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//var resp et.ReceiptExchange
-	//for _, l := range logs {
-	//	if l.Ty == et.TyMarketOrderLog {
-	//		err = types.Decode(l.Log, &resp)
-	//		if err != nil {
-	//			return nil, err
-	//		}
-	//		break
-	//	}
-	//}
-	//return &resp, nil
+	ety := types.LoadExecutorType(et.ExchangeX)
+	tx, err := ety.Create("MarketOrder", msg)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := c.client.Send(tx, hexKey)
+	if err != nil {
+		return nil, err
+	}
+	var resp et.ReceiptExchange
+	for _, l := range logs {
+		if l.Ty == et.TyMarketOrderLog {
+			err = types.Decode(l.Log, &resp)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	return &resp, nil
 }
 
 func (c *ExchangeClient) RevokeOrder(msg proto.Message, hexKey string) (*et.ReceiptExchange, error) {
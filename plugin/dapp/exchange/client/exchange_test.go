@@ -109,25 +109,24 @@ func TestLimitOrder(t *testing.T) {
 	t.Log("LimitOrder", resp)
 }
 
-//TODO marketOrder
-//func TestMarketOrder(t *testing.T) {
-//	cli := excli.NewGRPCCli(grpcAddr)
-//	client := excli.NewExchangCient(cli)
-//
-//	req := &etypes.MarketOrder{
-//		LeftAsset:  &etypes.Asset{Symbol: "BTC", Execer: execer},
-//		RightAsset: &etypes.Asset{Symbol: "USDT", Execer: execer},
-//		Op:         etypes.OpSell,
-//		Amount:     4 * types.DefaultCoinPrecision,
-//	}
-//
-//	resp, err := client.MarketOrder(req, privKey)
-//	if err != nil {
-//		t.Log(err)
-//		return
-//	}
-//	t.Log("MarketOrder", resp)
-//}
+func TestMarketOrder(t *testing.T) {
+	cli := excli.NewGRPCCli(grpcAddr)
+	client := excli.NewExchangCient(cli)
+
+	req := &etypes.MarketOrder{
+		LeftAsset:  &etypes.Asset{Symbol: "BTC", Execer: execer},
+		RightAsset: &etypes.Asset{Symbol: "USDT", Execer: execer},
+		Op:         etypes.OpSell,
+		Amount:     4 * types.DefaultCoinPrecision,
+	}
+
+	resp, err := client.MarketOrder(req, privKeyA)
+	if err != nil {
+		t.Log(err)
+		return
+	}
+	t.Log("MarketOrder", resp)
+}
 
 func TestRevokeOrder(t *testing.T) {
 	cli := excli.NewGRPCCli(grpcAddr)
@@ -0,0 +1,402 @@
+package executor
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	tab "github.com/33cn/chain33/common/db/table"
+	"github.com/33cn/chain33/types"
+	et "github.com/33cn/plugin/plugin/dapp/exchange/types"
+)
+
+/*
+ * 条件单(止损/止盈)
+ * 下单时冻结资产，同一交易对的成交价穿过触发价格后，转为限价单或市价单撮合，订单号保持不变
+ */
+
+//CheckConditionOrder ...
+func CheckConditionOrder(cfg *types.Chain33Config, payload *et.ConditionOrder) error {
+	if !CheckExchangeAsset(cfg.GetCoinExec(), payload.GetLeftAsset(), payload.GetRightAsset()) {
+		return et.ErrAsset
+	}
+	if !CheckAmount(payload.GetAmount(), cfg.GetCoinPrecision()) {
+		return et.ErrAssetAmount
+	}
+	if !CheckPrice(payload.GetTriggerPrice()) {
+		return et.ErrTriggerPrice
+	}
+	// price 0 means a market order after triggered
+	if payload.GetPrice() != 0 && !CheckPrice(payload.GetPrice()) {
+		return et.ErrAssetPrice
+	}
+	if !CheckOp(payload.GetOp()) {
+		return et.ErrAssetOp
+	}
+	if payload.GetTy() != et.ConditionStopLoss && payload.GetTy() != et.ConditionTakeProfit {
+		return et.ErrConditionType
+	}
+	return nil
+}
+
+//TriggerDirection 卖单止损、买单止盈在成交价下跌时触发，卖单止盈、买单止损在成交价上涨时触发
+func TriggerDirection(cond *et.ConditionOrder) int32 {
+	if (cond.GetOp() == et.OpSell) == (cond.GetTy() == et.ConditionStopLoss) {
+		return et.TriggerDown
+	}
+	return et.TriggerUp
+}
+
+//calcConditionFrozen 条件单需要冻结的资产，市价买单按触发价格冻结
+func calcConditionFrozen(cond *et.ConditionOrder, coinPrecision int64) int64 {
+	if cond.GetOp() == et.OpSell {
+		return cond.GetAmount()
+	}
+	price := cond.GetPrice()
+	if price == 0 {
+		price = cond.GetTriggerPrice()
+	}
+	return SafeMul(cond.GetAmount(), price, coinPrecision)
+}
+
+func (a *Action) conditionAssetDB(cond *et.ConditionOrder) (*account.DB, error) {
+	asset := cond.GetLeftAsset()
+	if cond.GetOp() == et.OpBuy {
+		asset = cond.GetRightAsset()
+	}
+	return account.NewAccountDB(a.api.GetConfig(), asset.GetExecer(), asset.GetSymbol(), a.statedb)
+}
+
+//ConditionOrder ...
+func (a *Action) ConditionOrder(payload *et.ConditionOrder) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kvs []*types.KeyValue
+	cfg := a.api.GetConfig()
+	if err := CheckConditionOrder(cfg, payload); err != nil {
+		return nil, err
+	}
+	tCfg, err := ParseConfig(cfg, a.height)
+	if err != nil {
+		elog.Error("executor/condition ConditionOrder.ParseConfig", "err", err)
+		return nil, err
+	}
+	if tCfg.IsBankAddr(a.fromaddr) {
+		return nil, et.ErrAddrIsBank
+	}
+
+	assetDB, err := a.conditionAssetDB(payload)
+	if err != nil {
+		return nil, err
+	}
+	amount := calcConditionFrozen(payload, cfg.GetCoinPrecision())
+	acc := assetDB.LoadExecAccount(a.fromaddr, a.execaddr)
+	if acc.Balance < amount {
+		elog.Error("condition check balance", "addr", a.fromaddr, "avail", acc.Balance, "need", amount)
+		return nil, et.ErrAssetBalance
+	}
+	receipt, err := assetDB.ExecFrozen(a.fromaddr, a.execaddr, amount)
+	if err != nil {
+		elog.Error("ConditionOrder.ExecFrozen", "addr", a.fromaddr, "amount", amount, "err", err.Error())
+		return nil, err
+	}
+	logs = append(logs, receipt.Logs...)
+	kvs = append(kvs, receipt.KV...)
+
+	order := &et.Order{
+		OrderID:    a.GetIndex(),
+		Value:      &et.Order_ConditionOrder{ConditionOrder: payload},
+		Ty:         et.TyConditionOrderAction,
+		Balance:    payload.GetAmount(),
+		Status:     et.Ordered,
+		Addr:       a.fromaddr,
+		UpdateTime: a.blocktime,
+		Index:      a.GetIndex(),
+		Hash:       hex.EncodeToString(a.txhash),
+		CreateTime: a.blocktime,
+	}
+	kvs = append(kvs, a.GetKVSet(order)...)
+	re := &et.ReceiptExchange{
+		Order: order,
+		Index: a.GetIndex(),
+	}
+	logs = append(logs, &types.ReceiptLog{Ty: et.TyConditionOrderLog, Log: types.Encode(re)})
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+//revokeConditionOrder 撤回未触发的条件单，解冻下单时冻结的资产
+func (a *Action) revokeConditionOrder(order *et.Order) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kvs []*types.KeyValue
+	cond := order.GetConditionOrder()
+	assetDB, err := a.conditionAssetDB(cond)
+	if err != nil {
+		return nil, err
+	}
+	amount := calcConditionFrozen(cond, a.api.GetConfig().GetCoinPrecision())
+	receipt, err := assetDB.ExecActive(a.fromaddr, a.execaddr, amount)
+	if err != nil {
+		elog.Error("revokeConditionOrder.ExecActive", "addr", a.fromaddr, "amount", amount, "err", err.Error())
+		return nil, err
+	}
+	logs = append(logs, receipt.Logs...)
+	kvs = append(kvs, receipt.KV...)
+
+	order.Status = et.Revoked
+	order.UpdateTime = a.blocktime
+	order.RevokeHash = hex.EncodeToString(a.txhash)
+	kvs = append(kvs, a.GetKVSet(order)...)
+	re := &et.ReceiptExchange{
+		Order: order,
+		Index: a.GetIndex(),
+	}
+	logs = append(logs, &types.ReceiptLog{Ty: et.TyConditionOrderLog, Log: types.Encode(re)})
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+//recordTradePrice 记录本笔交易撮合的最高价和最低价，用于触发条件单
+func (a *Action) recordTradePrice(price int64) {
+	if a.tradeHigh == 0 || price > a.tradeHigh {
+		a.tradeHigh = price
+	}
+	if a.tradeLow == 0 || price < a.tradeLow {
+		a.tradeLow = price
+	}
+}
+
+//TriggerConditionOrders 本笔交易撮合后，触发同一交易对中成交价已穿过触发价格的条件单
+//触发的条件单撮合产生的成交不会在同一笔交易中继续触发其它条件单
+func (a *Action) TriggerConditionOrders(receipt *types.Receipt, left, right *et.Asset) (*types.Receipt, error) {
+	if !a.api.GetConfig().IsDappFork(a.height, et.ExchangeX, et.ForkConditionOrder) || a.tradeHigh == 0 {
+		return receipt, nil
+	}
+	orders, err := findTriggeredConditionOrders(a.localDB, left, right, a.tradeHigh, a.tradeLow, et.MaxTriggerCount)
+	if err != nil {
+		return nil, err
+	}
+	for i, order := range orders {
+		// The local index is updated after execution, check the order status in statedb
+		current, err := findOrderByOrderID(a.statedb, a.localDB, order.OrderID)
+		if err != nil || current.Ty != et.TyConditionOrderAction || current.Status != et.Ordered {
+			continue
+		}
+		// A failed condition order must not abort the taker's transaction,
+		// its writes are discarded and the order is revoked instead
+		cache := newConditionStateDB(a.statedb)
+		action := a.conditionAction(current, i+1)
+		action.statedb = cache
+		r, err := action.executeConditionOrder(current)
+		if err == nil {
+			err = cache.flush()
+		}
+		if err != nil {
+			elog.Error("TriggerConditionOrders", "height", a.height, "orderID", order.OrderID, "err", err)
+			current, err = findOrderByOrderID(a.statedb, a.localDB, order.OrderID)
+			if err != nil {
+				continue
+			}
+			r, err = a.conditionAction(current, i+1).revokeConditionOrder(current)
+			if err != nil {
+				elog.Error("TriggerConditionOrders revoke", "height", a.height, "orderID", current.OrderID, "err", err)
+				continue
+			}
+		}
+		receipt.KV = append(receipt.KV, r.KV...)
+		receipt.Logs = append(receipt.Logs, r.Logs...)
+	}
+	return receipt, nil
+}
+
+//conditionAction 以条件单用户的身份撮合，每个条件单分配独立的index区间
+func (a *Action) conditionAction(order *et.Order, seq int) *Action {
+	return &Action{
+		statedb:     a.statedb,
+		txhash:      a.txhash,
+		fromaddr:    order.Addr,
+		blocktime:   a.blocktime,
+		height:      a.height,
+		execaddr:    a.execaddr,
+		localDB:     a.localDB,
+		index:       a.index,
+		api:         a.api,
+		orderID:     order.OrderID,
+		indexOffset: int64(seq) * 1e3,
+	}
+}
+
+func (a *Action) executeConditionOrder(order *et.Order) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kvs []*types.KeyValue
+	cond := order.GetConditionOrder()
+	cfg := a.api.GetConfig()
+	leftAssetDB, err := account.NewAccountDB(cfg, cond.GetLeftAsset().GetExecer(), cond.GetLeftAsset().GetSymbol(), a.statedb)
+	if err != nil {
+		return nil, err
+	}
+	rightAssetDB, err := account.NewAccountDB(cfg, cond.GetRightAsset().GetExecer(), cond.GetRightAsset().GetSymbol(), a.statedb)
+	if err != nil {
+		return nil, err
+	}
+	frozenDB := leftAssetDB
+	if cond.GetOp() == et.OpBuy {
+		frozenDB = rightAssetDB
+	}
+	amount := calcConditionFrozen(cond, cfg.GetCoinPrecision())
+	receipt, err := frozenDB.ExecActive(a.fromaddr, a.execaddr, amount)
+	if err != nil {
+		elog.Error("executeConditionOrder.ExecActive", "addr", a.fromaddr, "amount", amount, "err", err.Error())
+		return nil, err
+	}
+	logs = append(logs, receipt.Logs...)
+	kvs = append(kvs, receipt.KV...)
+	if cond.GetOp() == et.OpBuy && cond.GetPrice() == 0 {
+		// a triggered market buy spends no more than what was frozen for it
+		a.spendCap = amount
+		a.spendBase = rightAssetDB.LoadExecAccount(a.fromaddr, a.execaddr).Balance
+	}
+
+	order.Status = et.Completed
+	order.UpdateTime = a.blocktime
+	re := &et.ReceiptExchange{
+		Order: order,
+		Index: a.GetIndex(),
+	}
+	logs = append(logs, &types.ReceiptLog{Ty: et.TyConditionOrderLog, Log: types.Encode(re)})
+
+	if cond.GetPrice() > 0 {
+		limitOrder := &et.LimitOrder{
+			LeftAsset:  cond.GetLeftAsset(),
+			RightAsset: cond.GetRightAsset(),
+			Price:      cond.GetPrice(),
+			Amount:     cond.GetAmount(),
+			Op:         cond.GetOp(),
+		}
+		receipt, err = a.matchLimitOrder(limitOrder, leftAssetDB, rightAssetDB, "")
+	} else {
+		marketOrder := &et.MarketOrder{
+			LeftAsset:  cond.GetLeftAsset(),
+			RightAsset: cond.GetRightAsset(),
+			Amount:     cond.GetAmount(),
+			Op:         cond.GetOp(),
+		}
+		receipt, err = a.matchMarketOrder(marketOrder, leftAssetDB, rightAssetDB)
+	}
+	if err != nil {
+		return nil, err
+	}
+	logs = append(logs, receipt.Logs...)
+	kvs = append(kvs, receipt.KV...)
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+// conditionStateDB 缓存条件单撮合的状态写入，执行成功后写回交易的状态，失败时直接丢弃
+type conditionStateDB struct {
+	parent dbm.KV
+	cache  map[string][]byte
+	kvs    []*types.KeyValue
+}
+
+func newConditionStateDB(parent dbm.KV) *conditionStateDB {
+	return &conditionStateDB{parent: parent, cache: make(map[string][]byte)}
+}
+
+// Get 优先读取条件单撮合的写入
+func (c *conditionStateDB) Get(key []byte) ([]byte, error) {
+	if value, ok := c.cache[string(key)]; ok {
+		if value == nil {
+			return nil, types.ErrNotFound
+		}
+		return value, nil
+	}
+	return c.parent.Get(key)
+}
+
+// Set 写入缓存
+func (c *conditionStateDB) Set(key []byte, value []byte) error {
+	c.cache[string(key)] = value
+	c.kvs = append(c.kvs, &types.KeyValue{Key: key, Value: value})
+	return nil
+}
+
+// Begin 不支持事务
+func (c *conditionStateDB) Begin() {}
+
+// Commit 不支持事务
+func (c *conditionStateDB) Commit() error { return nil }
+
+// Rollback 不支持事务
+func (c *conditionStateDB) Rollback() {}
+
+func (c *conditionStateDB) flush() error {
+	for _, kv := range c.kvs {
+		if err := c.parent.Set(kv.Key, kv.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//findTriggeredConditionOrders 上涨触发的条件单按触发价格由低到高，下跌触发的条件单按触发价格由高到低
+func findTriggeredConditionOrders(localdb dbm.KV, left, right *et.Asset, high, low int64, count int) ([]*et.Order, error) {
+	table := NewConditionOrderTable(localdb)
+	var list []*et.Order
+
+	prefix := []byte(fmt.Sprintf("%s:%s:%d", left.GetSymbol(), right.GetSymbol(), et.TriggerUp))
+	rows, err := table.ListIndex("trigger", prefix, nil, int32(count), et.ListASC)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	for _, row := range rows {
+		order := row.Data.(*et.Order)
+		if order.GetConditionOrder().GetTriggerPrice() > high {
+			break
+		}
+		list = append(list, order)
+	}
+	if len(list) >= count {
+		return list, nil
+	}
+
+	prefix = []byte(fmt.Sprintf("%s:%s:%d", left.GetSymbol(), right.GetSymbol(), et.TriggerDown))
+	rows, err = table.ListIndex("trigger", prefix, nil, int32(count-len(list)), et.ListDESC)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	for _, row := range rows {
+		order := row.Data.(*et.Order)
+		if order.GetConditionOrder().GetTriggerPrice() < low {
+			break
+		}
+		list = append(list, order)
+	}
+	return list, nil
+}
+
+//QueryConditionOrderList 查询用户未触发的条件单
+func QueryConditionOrderList(localdb dbm.KV, addr string, count, direction int32, primaryKey string) (types.Message, error) {
+	table := NewConditionOrderTable(localdb)
+	prefix := []byte(address.FormatAddrKey(addr))
+	if count == 0 {
+		count = et.Count
+	}
+	var rows []*tab.Row
+	var err error
+	if primaryKey == "" {
+		rows, err = table.ListIndex("addr", prefix, nil, count, direction)
+	} else {
+		rows, err = table.ListIndex("addr", prefix, []byte(primaryKey), count, direction)
+	}
+	if err != nil {
+		elog.Error("QueryConditionOrderList.", "addr", addr, "err", err.Error())
+		return nil, err
+	}
+	var orderList et.OrderList
+	for _, row := range rows {
+		orderList.List = append(orderList.List, row.Data.(*et.Order))
+	}
+	if len(rows) == int(count) {
+		orderList.PrimaryKey = string(rows[len(rows)-1].Primary)
+	}
+	return &orderList, nil
+}
@@ -80,7 +80,29 @@ func (e *exchange) CheckTx(tx *types.Transaction, index int) error {
 		}
 	}
 	if exchange.Ty == exchangetypes.TyMarketOrderAction {
-		return types.ErrActionNotSupport
+		if !cfg.IsDappFork(e.GetHeight(), exchangetypes.ExchangeX, exchangetypes.ForkMarketOrder) {
+			return types.ErrActionNotSupport
+		}
+		marketOrder := exchange.GetMarketOrder()
+		left := marketOrder.GetLeftAsset()
+		right := marketOrder.GetRightAsset()
+		amount := marketOrder.GetAmount()
+		op := marketOrder.GetOp()
+		if !CheckExchangeAsset(cfg.GetCoinExec(), left, right) {
+			return exchangetypes.ErrAsset
+		}
+		if !CheckAmount(amount, cfg.GetCoinPrecision()) {
+			return exchangetypes.ErrAssetAmount
+		}
+		if !CheckOp(op) {
+			return exchangetypes.ErrAssetOp
+		}
+	}
+	if exchange.Ty == exchangetypes.TyConditionOrderAction {
+		if !cfg.IsDappFork(e.GetHeight(), exchangetypes.ExchangeX, exchangetypes.ForkConditionOrder) {
+			return types.ErrActionNotSupport
+		}
+		return CheckConditionOrder(cfg, exchange.GetConditionOrder())
 	}
 	return nil
 }
@@ -674,6 +674,358 @@ func TestExchange(t *testing.T) {
 
 }
 
+func TestMarketOrder(t *testing.T) {
+	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	InitExecType()
+	total := 100 * types.DefaultCoinPrecision
+	dir, stateDB, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, stateDB)
+	execAddr := address.ExecAddress(et.ExchangeX)
+
+	accBty, _ := account.NewAccountDB(cfg, "coins", "bty", stateDB)
+	accCCNY, _ := account.NewAccountDB(cfg, "token", "CCNY", stateDB)
+	accBty.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: Nodes[0]})
+	accCCNY.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: Nodes[0]})
+	accCCNY.SaveExecAccount(execAddr, &types.Account{Balance: 3 * types.DefaultCoinPrecision, Addr: Nodes[2]})
+	accBty.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: Nodes[3]})
+	accCCNY.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: Nodes[3]})
+
+	env := &execEnv{
+		10,
+		1,
+		1539918074,
+	}
+	left := &et.Asset{Symbol: "bty", Execer: "coins"}
+	right := &et.Asset{Execer: "token", Symbol: "CCNY"}
+
+	/*
+	 用例说明：
+	   1.A挂两笔卖单，价格1数量5，价格2数量5
+	   2.D市价买8，依次吃掉价格1和价格2的卖单
+	   3.C余额只够买1.5，市价买5，剩余部分撤销
+	   4.D市价卖10，没有买单，全部撤销
+	*/
+	err := Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.DefaultCoinPrecision,
+		Amount: 5 * types.DefaultCoinPrecision, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 2 * types.DefaultCoinPrecision,
+		Amount: 5 * types.DefaultCoinPrecision, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+
+	err = Exec_MarketOrder(t, &et.MarketOrder{LeftAsset: left, RightAsset: right, Amount: 8 * types.DefaultCoinPrecision, Op: et.OpBuy}, PrivKeyD, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err := Exec_QueryOrderList(et.Completed, Nodes[3], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	order, err := Exec_QueryOrder(orderList.List[0].OrderID, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(et.TyMarketOrderAction), order.Ty)
+	assert.Equal(t, 8*types.DefaultCoinPrecision, order.Executed)
+	assert.Equal(t, int64(137500000), order.AVGPrice)
+	// 5*1+3*2=11 CCNY, taker fee 1% in bty
+	acc := accCCNY.LoadExecAccount(Nodes[3], execAddr)
+	assert.Equal(t, 89*types.DefaultCoinPrecision, acc.Balance)
+	acc = accBty.LoadExecAccount(Nodes[3], execAddr)
+	assert.Equal(t, 108*types.DefaultCoinPrecision-8000000, acc.Balance)
+	assert.Equal(t, int64(0), acc.Frozen)
+	// maker fee 0.1% in CCNY
+	acc = accCCNY.LoadExecAccount(Nodes[0], execAddr)
+	assert.Equal(t, 111*types.DefaultCoinPrecision-1100000, acc.Balance)
+	acc = accBty.LoadExecAccount(Nodes[0], execAddr)
+	assert.Equal(t, 2*types.DefaultCoinPrecision, acc.Frozen)
+
+	marketDepthList, err := Exec_QueryMarketDepth(&et.QueryMarketDepth{LeftAsset: left, RightAsset: right, Op: et.OpSell}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(marketDepthList.List))
+	assert.Equal(t, 2*types.DefaultCoinPrecision, marketDepthList.List[0].Price)
+	assert.Equal(t, 2*types.DefaultCoinPrecision, marketDepthList.List[0].Amount)
+
+	historyList, err := Exec_QueryHistoryOrder(&et.QueryHistoryOrderList{LeftAsset: left, RightAsset: right}, stateDB, kvdb)
+	assert.Nil(t, err)
+	//成交的卖单索引排在市价单之后
+	assert.Equal(t, 2, len(historyList.List))
+	assert.Equal(t, order.OrderID, historyList.List[1].OrderID)
+
+	err = Exec_MarketOrder(t, &et.MarketOrder{LeftAsset: left, RightAsset: right, Amount: 5 * types.DefaultCoinPrecision, Op: et.OpBuy}, PrivKeyC, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err = Exec_QueryOrderList(et.Revoked, Nodes[2], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	order = orderList.List[0]
+	assert.Equal(t, int64(150000000), order.Executed)
+	assert.Equal(t, int64(350000000), order.Balance)
+	acc = accCCNY.LoadExecAccount(Nodes[2], execAddr)
+	assert.Equal(t, int64(0), acc.Balance)
+	marketDepthList, err = Exec_QueryMarketDepth(&et.QueryMarketDepth{LeftAsset: left, RightAsset: right, Op: et.OpSell}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(50000000), marketDepthList.List[0].Amount)
+
+	err = Exec_MarketOrder(t, &et.MarketOrder{LeftAsset: left, RightAsset: right, Amount: 10 * types.DefaultCoinPrecision, Op: et.OpSell}, PrivKeyD, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err = Exec_QueryOrderList(et.Revoked, Nodes[3], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), orderList.List[0].Executed)
+	acc = accBty.LoadExecAccount(Nodes[3], execAddr)
+	assert.Equal(t, 108*types.DefaultCoinPrecision-8000000, acc.Balance)
+	assert.Equal(t, int64(0), acc.Frozen)
+}
+
+func TestConditionOrder(t *testing.T) {
+	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	InitExecType()
+	total := 100 * types.DefaultCoinPrecision
+	dir, stateDB, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, stateDB)
+	execAddr := address.ExecAddress(et.ExchangeX)
+
+	accBty, _ := account.NewAccountDB(cfg, "coins", "bty", stateDB)
+	accCCNY, _ := account.NewAccountDB(cfg, "token", "CCNY", stateDB)
+	accBty.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: Nodes[0]})
+	accCCNY.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: Nodes[0]})
+	accCCNY.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: Nodes[3]})
+
+	env := &execEnv{
+		10,
+		1,
+		1539918074,
+	}
+	left := &et.Asset{Symbol: "bty", Execer: "coins"}
+	right := &et.Asset{Execer: "token", Symbol: "CCNY"}
+
+	/*
+	 用例说明：
+	   1.A下止损卖单，触发价格1数量5，触发后以市价成交；下止盈卖单，触发价格3，限价3数量2
+	   2.D挂买单价格1数量10，A卖出1与之成交，成交价格1触发止损单
+	   3.止损单以市价卖出5，止盈单不触发
+	   4.A撤回止盈单，解冻资产
+	*/
+	err := Exec_ConditionOrder(t, &et.ConditionOrder{LeftAsset: left, RightAsset: right, TriggerPrice: types.DefaultCoinPrecision,
+		Amount: 5 * types.DefaultCoinPrecision, Op: et.OpSell, Ty: et.ConditionStopLoss}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_ConditionOrder(t, &et.ConditionOrder{LeftAsset: left, RightAsset: right, TriggerPrice: 3 * types.DefaultCoinPrecision,
+		Price: 3 * types.DefaultCoinPrecision, Amount: 2 * types.DefaultCoinPrecision, Op: et.OpSell, Ty: et.ConditionTakeProfit}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_ConditionOrder(t, &et.ConditionOrder{LeftAsset: left, RightAsset: right, TriggerPrice: types.DefaultCoinPrecision,
+		Amount: 5 * types.DefaultCoinPrecision, Op: et.OpSell, Ty: 3}, PrivKeyA, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrConditionType, err)
+
+	acc := accBty.LoadExecAccount(Nodes[0], execAddr)
+	assert.Equal(t, 7*types.DefaultCoinPrecision, acc.Frozen)
+	conditionList, err := Exec_QueryConditionOrderList(Nodes[0], stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(conditionList.List))
+	stopLossID := conditionList.List[1].OrderID
+	takeProfitID := conditionList.List[0].OrderID
+	//未触发的条件单不进入市场深度
+	_, err = Exec_QueryMarketDepth(&et.QueryMarketDepth{LeftAsset: left, RightAsset: right, Op: et.OpSell}, stateDB, kvdb)
+	assert.Equal(t, types.ErrNotFound, err)
+
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.DefaultCoinPrecision,
+		Amount: 10 * types.DefaultCoinPrecision, Op: et.OpBuy}, PrivKeyD, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.DefaultCoinPrecision,
+		Amount: types.DefaultCoinPrecision, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+
+	order, err := Exec_QueryOrder(stopLossID, stateDB, kvdb)
+	assert.Nil(t, err)
+	//触发后转为市价单，订单号不变
+	assert.Equal(t, int32(et.TyMarketOrderAction), order.Ty)
+	assert.Equal(t, int32(et.Completed), order.Status)
+	assert.Equal(t, 5*types.DefaultCoinPrecision, order.Executed)
+	marketDepthList, err := Exec_QueryMarketDepth(&et.QueryMarketDepth{LeftAsset: left, RightAsset: right, Op: et.OpBuy}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 4*types.DefaultCoinPrecision, marketDepthList.List[0].Amount)
+	acc = accBty.LoadExecAccount(Nodes[0], execAddr)
+	assert.Equal(t, 2*types.DefaultCoinPrecision, acc.Frozen)
+	assert.Equal(t, 94*types.DefaultCoinPrecision-acc.Frozen, acc.Balance)
+
+	conditionList, err = Exec_QueryConditionOrderList(Nodes[0], stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(conditionList.List))
+	assert.Equal(t, takeProfitID, conditionList.List[0].OrderID)
+
+	err = Exec_RevokeOrder(t, takeProfitID, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	order, err = Exec_QueryOrder(takeProfitID, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(et.Revoked), order.Status)
+	acc = accBty.LoadExecAccount(Nodes[0], execAddr)
+	assert.Equal(t, int64(0), acc.Frozen)
+	_, err = Exec_QueryConditionOrderList(Nodes[0], stateDB, kvdb)
+	assert.Equal(t, types.ErrNotFound, err)
+}
+
+func TestTriggeredConditionOrder(t *testing.T) {
+	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	InitExecType()
+	total := 100 * types.DefaultCoinPrecision
+	dir, stateDB, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, stateDB)
+	execAddr := address.ExecAddress(et.ExchangeX)
+
+	accBty, _ := account.NewAccountDB(cfg, "coins", "bty", stateDB)
+	accCCNY, _ := account.NewAccountDB(cfg, "token", "CCNY", stateDB)
+	accCCNY.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: Nodes[0]})
+	accBty.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: Nodes[1]})
+	accBty.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: Nodes[2]})
+	accCCNY.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: Nodes[3]})
+
+	env := &execEnv{
+		10,
+		1,
+		1539918074,
+	}
+	left := &et.Asset{Symbol: "bty", Execer: "coins"}
+	right := &et.Asset{Execer: "token", Symbol: "CCNY"}
+
+	/*
+	 用例说明：
+	   1.A下止盈市价买单，触发价格1数量2，冻结2CCNY；B下止损卖单，触发价格1数量5
+	   2.B的冻结资产被挪用，触发时执行失败
+	   3.C挂卖单价格2数量10，D挂买单价格1数量1，C卖出1与之成交，成交价格1触发两个条件单
+	   4.C的成交不受B失败的条件单影响；A的市价买单只花费冻结的2CCNY，买入1，剩余部分撤销
+	*/
+	err := Exec_ConditionOrder(t, &et.ConditionOrder{LeftAsset: left, RightAsset: right, TriggerPrice: types.DefaultCoinPrecision,
+		Amount: 2 * types.DefaultCoinPrecision, Op: et.OpBuy, Ty: et.ConditionTakeProfit}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_ConditionOrder(t, &et.ConditionOrder{LeftAsset: left, RightAsset: right, TriggerPrice: types.DefaultCoinPrecision,
+		Amount: 5 * types.DefaultCoinPrecision, Op: et.OpSell, Ty: et.ConditionStopLoss}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	conditionList, err := Exec_QueryConditionOrderList(Nodes[0], stateDB, kvdb)
+	assert.Nil(t, err)
+	buyID := conditionList.List[0].OrderID
+	conditionList, err = Exec_QueryConditionOrderList(Nodes[1], stateDB, kvdb)
+	assert.Nil(t, err)
+	sellID := conditionList.List[0].OrderID
+	accBty.SaveExecAccount(execAddr, &types.Account{Balance: total - 5*types.DefaultCoinPrecision, Addr: Nodes[1]})
+
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 2 * types.DefaultCoinPrecision,
+		Amount: 10 * types.DefaultCoinPrecision, Op: et.OpSell}, PrivKeyC, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.DefaultCoinPrecision,
+		Amount: types.DefaultCoinPrecision, Op: et.OpBuy}, PrivKeyD, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.DefaultCoinPrecision,
+		Amount: types.DefaultCoinPrecision, Op: et.OpSell}, PrivKeyC, stateDB, kvdb, env)
+	assert.Nil(t, err)
+
+	order, err := Exec_QueryOrder(buyID, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(et.TyMarketOrderAction), order.Ty)
+	assert.Equal(t, int32(et.Revoked), order.Status)
+	assert.Equal(t, types.DefaultCoinPrecision, order.Executed)
+	acc := accCCNY.LoadExecAccount(Nodes[0], execAddr)
+	assert.Equal(t, 98*types.DefaultCoinPrecision, acc.Balance)
+	assert.Equal(t, int64(0), acc.Frozen)
+
+	//执行失败的条件单不影响触发它的交易，跳过后仍保留在条件单列表中
+	order, err = Exec_QueryOrder(sellID, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(et.TyConditionOrderAction), order.Ty)
+	assert.Equal(t, int32(et.Ordered), order.Status)
+	marketDepthList, err := Exec_QueryMarketDepth(&et.QueryMarketDepth{LeftAsset: left, RightAsset: right, Op: et.OpSell}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 9*types.DefaultCoinPrecision, marketDepthList.List[0].Amount)
+}
+
+func TestKLine(t *testing.T) {
+	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	InitExecType()
+	total := 100 * types.DefaultCoinPrecision
+	dir, stateDB, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, stateDB)
+	execAddr := address.ExecAddress(et.ExchangeX)
+
+	accBty, _ := account.NewAccountDB(cfg, "coins", "bty", stateDB)
+	accCCNY, _ := account.NewAccountDB(cfg, "token", "CCNY", stateDB)
+	accBty.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: Nodes[0]})
+	accCCNY.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: Nodes[3]})
+
+	env := &execEnv{
+		1539918074,
+		1,
+		1,
+	}
+	left := &et.Asset{Symbol: "bty", Execer: "coins"}
+	right := &et.Asset{Execer: "token", Symbol: "CCNY"}
+
+	/*
+	 用例说明：
+	   1.A挂两笔卖单，价格1数量5，价格2数量5
+	   2.D市价买2，成交价格1
+	   3.一分钟后D市价买5，依次成交价格1数量3，价格2数量2
+	   4.查询1m，1d K线和24小时行情
+	*/
+	err := Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.DefaultCoinPrecision,
+		Amount: 5 * types.DefaultCoinPrecision, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 2 * types.DefaultCoinPrecision,
+		Amount: 5 * types.DefaultCoinPrecision, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	klineList, err := Exec_QueryKLine(&et.QueryKLine{LeftAsset: left, RightAsset: right, Period: et.KLine1m}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(klineList.List))
+
+	err = Exec_MarketOrder(t, &et.MarketOrder{LeftAsset: left, RightAsset: right, Amount: 2 * types.DefaultCoinPrecision, Op: et.OpBuy}, PrivKeyD, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	env.blockTime += 60
+	err = Exec_MarketOrder(t, &et.MarketOrder{LeftAsset: left, RightAsset: right, Amount: 5 * types.DefaultCoinPrecision, Op: et.OpBuy}, PrivKeyD, stateDB, kvdb, env)
+	assert.Nil(t, err)
+
+	klineList, err = Exec_QueryKLine(&et.QueryKLine{LeftAsset: left, RightAsset: right, Period: et.KLine1m}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(klineList.List))
+	kline := klineList.List[0]
+	assert.Equal(t, int64(1539918180), kline.Time)
+	assert.Equal(t, types.DefaultCoinPrecision, kline.Open)
+	assert.Equal(t, 2*types.DefaultCoinPrecision, kline.High)
+	assert.Equal(t, types.DefaultCoinPrecision, kline.Low)
+	assert.Equal(t, 2*types.DefaultCoinPrecision, kline.Close)
+	assert.Equal(t, 5*types.DefaultCoinPrecision, kline.Volume)
+	assert.Equal(t, 7*types.DefaultCoinPrecision, kline.Turnover)
+	kline = klineList.List[1]
+	assert.Equal(t, int64(1539918120), kline.Time)
+	assert.Equal(t, types.DefaultCoinPrecision, kline.Close)
+	assert.Equal(t, 2*types.DefaultCoinPrecision, kline.Volume)
+
+	klineList, err = Exec_QueryKLine(&et.QueryKLine{LeftAsset: left, RightAsset: right, Period: et.KLine1m, Count: 1, Direction: et.ListASC}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1539918120), klineList.List[0].Time)
+	klineList, err = Exec_QueryKLine(&et.QueryKLine{LeftAsset: left, RightAsset: right, Period: et.KLine1m, Count: 1, Direction: et.ListASC,
+		PrimaryKey: klineList.PrimaryKey}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1539918180), klineList.List[0].Time)
+
+	klineList, err = Exec_QueryKLine(&et.QueryKLine{LeftAsset: left, RightAsset: right, Period: et.KLine1d}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(klineList.List))
+	assert.Equal(t, int64(1539907200), klineList.List[0].Time)
+	assert.Equal(t, 7*types.DefaultCoinPrecision, klineList.List[0].Volume)
+	assert.Equal(t, 9*types.DefaultCoinPrecision, klineList.List[0].Turnover)
+
+	_, err = Exec_QueryKLine(&et.QueryKLine{LeftAsset: left, RightAsset: right, Period: 120}, stateDB, kvdb)
+	assert.Equal(t, et.ErrKLinePeriod, err)
+
+	ticker, err := Exec_QueryTicker(&et.QueryTicker{LeftAsset: left, RightAsset: right, Time: env.blockTime}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, types.DefaultCoinPrecision, ticker.Open)
+	assert.Equal(t, 2*types.DefaultCoinPrecision, ticker.Close)
+	assert.Equal(t, 2*types.DefaultCoinPrecision, ticker.High)
+	assert.Equal(t, types.DefaultCoinPrecision, ticker.Low)
+	assert.Equal(t, 7*types.DefaultCoinPrecision, ticker.Volume)
+	assert.Equal(t, types.DefaultCoinPrecision, ticker.Change)
+	assert.Equal(t, int64(10000), ticker.ChangeRate)
+
+	//统计截止时间24小时之后，没有成交
+	ticker, err = Exec_QueryTicker(&et.QueryTicker{LeftAsset: left, RightAsset: right, Time: env.blockTime + 86400}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), ticker.Close)
+	assert.Equal(t, int64(0), ticker.Volume)
+}
+
 func CreateLimitOrder(limitOrder *et.LimitOrder, privKey string) (tx *types.Transaction, err error) {
 	ety := types.LoadExecutorType(et.ExchangeX)
 	tx, err = ety.Create("LimitOrder", limitOrder)
@@ -692,6 +1044,42 @@ func CreateLimitOrder(limitOrder *et.LimitOrder, privKey string) (tx *types.Tran
 	}
 	return tx, nil
 }
+func CreateMarketOrder(marketOrder *et.MarketOrder, privKey string) (tx *types.Transaction, err error) {
+	ety := types.LoadExecutorType(et.ExchangeX)
+	tx, err = ety.Create("MarketOrder", marketOrder)
+	if err != nil {
+		return nil, err
+	}
+	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	tx, err = types.FormatTx(cfg, et.ExchangeX, tx)
+	if err != nil {
+		return nil, err
+	}
+	tx, err = signTx(tx, privKey)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+func CreateConditionOrder(conditionOrder *et.ConditionOrder, privKey string) (tx *types.Transaction, err error) {
+	ety := types.LoadExecutorType(et.ExchangeX)
+	tx, err = ety.Create("ConditionOrder", conditionOrder)
+	if err != nil {
+		return nil, err
+	}
+	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	tx, err = types.FormatTx(cfg, et.ExchangeX, tx)
+	if err != nil {
+		return nil, err
+	}
+	tx, err = signTx(tx, privKey)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
 func CreateRevokeOrder(orderID int64, privKey string) (tx *types.Transaction, err error) {
 	ety := types.LoadExecutorType(et.ExchangeX)
 	tx, err = ety.Create("RevokeOrder", &et.RevokeOrder{OrderID: orderID})
@@ -769,6 +1157,22 @@ func Exec_LimitOrder(t *testing.T, limitOrder *et.LimitOrder, privKey string, st
 	return Exec_Block(t, stateDB, kvdb, env, tx)
 }
 
+func Exec_MarketOrder(t *testing.T, marketOrder *et.MarketOrder, privKey string, stateDB db.DB, kvdb db.KVDB, env *execEnv) error {
+	tx, err := CreateMarketOrder(marketOrder, privKey)
+	if err != nil {
+		return err
+	}
+	return Exec_Block(t, stateDB, kvdb, env, tx)
+}
+
+func Exec_ConditionOrder(t *testing.T, conditionOrder *et.ConditionOrder, privKey string, stateDB db.DB, kvdb db.KVDB, env *execEnv) error {
+	tx, err := CreateConditionOrder(conditionOrder, privKey)
+	if err != nil {
+		return err
+	}
+	return Exec_Block(t, stateDB, kvdb, env, tx)
+}
+
 func Exec_RevokeOrder(t *testing.T, orderID int64, privKey string, stateDB db.DB, kvdb db.KVDB, env *execEnv) error {
 	tx, err := CreateRevokeOrder(orderID, privKey)
 	if err != nil {
@@ -794,6 +1198,23 @@ func Exec_QueryOrderList(status int32, addr string, primaryKey string, stateDB d
 	}
 	return msg.(*et.OrderList), nil
 }
+func Exec_QueryConditionOrderList(addr string, stateDB db.KV, kvdb db.KVDB) (*et.OrderList, error) {
+	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	exec := NewExchange()
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	api, _ := client.New(q.Client(), nil)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	//根据地址查看未触发的条件单
+	msg, err := exec.Query(et.FuncNameQueryConditionOrderList, types.Encode(&et.QueryConditionOrderList{Address: addr}))
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*et.OrderList), nil
+}
 func Exec_QueryOrder(orderID int64, stateDB db.KV, kvdb db.KVDB) (*et.Order, error) {
 	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
 	cfg.SetTitleOnlyForTest("chain33")
@@ -844,6 +1265,40 @@ func Exec_QueryHistoryOrder(query *et.QueryHistoryOrderList, stateDB db.KV, kvdb
 	msg, err := exec.Query(et.FuncNameQueryHistoryOrderList, types.Encode(query))
 	return msg.(*et.OrderList), err
 }
+func Exec_QueryKLine(query *et.QueryKLine, stateDB db.KV, kvdb db.KVDB) (*et.KLineList, error) {
+	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	exec := NewExchange()
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	api, _ := client.New(q.Client(), nil)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	msg, err := exec.Query(et.FuncNameQueryKLine, types.Encode(query))
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*et.KLineList), nil
+}
+
+func Exec_QueryTicker(query *et.QueryTicker, stateDB db.KV, kvdb db.KVDB) (*et.Ticker, error) {
+	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	exec := NewExchange()
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	api, _ := client.New(q.Client(), nil)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	msg, err := exec.Query(et.FuncNameQueryTicker, types.Encode(query))
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*et.Ticker), nil
+}
+
 func signTx(tx *types.Transaction, hexPrivKey string) (*types.Transaction, error) {
 	signType := types.SECP256K1
 	c, err := crypto.Load(types.GetSignName("", signType), -1)
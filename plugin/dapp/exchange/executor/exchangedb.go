@@ -27,6 +27,15 @@ type Action struct {
 	localDB   dbm.KVDB
 	index     int
 	api       client.QueueProtocolAPI
+	// trade price range of this transaction, used to trigger condition orders
+	tradeHigh int64
+	tradeLow  int64
+	// a triggered condition order keeps its orderID and uses its own index range
+	orderID     int64
+	indexOffset int64
+	// a triggered market buy is limited to its frozen amount, spendBase is the balance when matching starts
+	spendCap  int64
+	spendBase int64
 }
 
 //NewAction ...
@@ -49,7 +58,15 @@ func NewAction(e *exchange, tx *types.Transaction, index int) *Action {
 //GetIndex get index
 func (a *Action) GetIndex() int64 {
 	// Add four zeros to match multiple MatchOrder indexes
-	return (a.height*types.MaxTxsPerBlock + int64(a.index)) * 1e4
+	return (a.height*types.MaxTxsPerBlock+int64(a.index))*1e4 + a.indexOffset
+}
+
+//getOrderID get the orderID of a new order
+func (a *Action) getOrderID() int64 {
+	if a.orderID != 0 {
+		return a.orderID
+	}
+	return a.GetIndex()
 }
 
 //GetKVSet get kv set
@@ -188,6 +205,50 @@ func (a *Action) LimitOrder(payload *et.LimitOrder, entrustAddr string) (*types.
 	return nil, fmt.Errorf("unknow op")
 }
 
+//MarketOrder 市价单，按对手盘挂单价格依次成交，未成交的部分直接撤销，不会挂单
+func (a *Action) MarketOrder(payload *et.MarketOrder) (*types.Receipt, error) {
+	leftAsset := payload.GetLeftAsset()
+	rightAsset := payload.GetRightAsset()
+	cfg := a.api.GetConfig()
+	if !CheckExchangeAsset(cfg.GetCoinExec(), leftAsset, rightAsset) {
+		return nil, et.ErrAsset
+	}
+	if !CheckAmount(payload.GetAmount(), cfg.GetCoinPrecision()) {
+		return nil, et.ErrAssetAmount
+	}
+	if !CheckOp(payload.GetOp()) {
+		return nil, et.ErrAssetOp
+	}
+
+	leftAssetDB, err := account.NewAccountDB(cfg, leftAsset.GetExecer(), leftAsset.GetSymbol(), a.statedb)
+	if err != nil {
+		return nil, err
+	}
+	rightAssetDB, err := account.NewAccountDB(cfg, rightAsset.GetExecer(), rightAsset.GetSymbol(), a.statedb)
+	if err != nil {
+		return nil, err
+	}
+	//The buyer's cost is unknown before matching, each match is limited by the remaining balance
+	if payload.GetOp() == et.OpBuy {
+		rightAccount := rightAssetDB.LoadExecAccount(a.fromaddr, a.execaddr)
+		if rightAccount.Balance <= 0 {
+			elog.Error("market check right balance", "addr", a.fromaddr, "avail", rightAccount.Balance)
+			return nil, et.ErrAssetBalance
+		}
+		return a.matchMarketOrder(payload, leftAssetDB, rightAssetDB)
+	}
+	if payload.GetOp() == et.OpSell {
+		amount := payload.GetAmount()
+		leftAccount := leftAssetDB.LoadExecAccount(a.fromaddr, a.execaddr)
+		if leftAccount.Balance < amount {
+			elog.Error("market check left balance", "addr", a.fromaddr, "avail", leftAccount.Balance, "need", amount)
+			return nil, et.ErrAssetBalance
+		}
+		return a.matchMarketOrder(payload, leftAssetDB, rightAssetDB)
+	}
+	return nil, fmt.Errorf("unknow op")
+}
+
 //RevokeOrder ...
 func (a *Action) RevokeOrder(payload *et.RevokeOrder) (*types.Receipt, error) {
 	var logs []*types.ReceiptLog
@@ -204,6 +265,9 @@ func (a *Action) RevokeOrder(payload *et.RevokeOrder) (*types.Receipt, error) {
 		elog.Error("RevokeOrder.OrderCheck", "addr", a.fromaddr, "order.addr", order.Addr, "order.status", order.Status)
 		return nil, et.ErrOrderSatus
 	}
+	if order.Ty == et.TyConditionOrderAction {
+		return a.revokeConditionOrder(order)
+	}
 	leftAsset := order.GetLimitOrder().GetLeftAsset()
 	rightAsset := order.GetLimitOrder().GetRightAsset()
 	price := order.GetLimitOrder().GetPrice()
@@ -270,13 +334,11 @@ func (a *Action) RevokeOrder(payload *et.RevokeOrder) (*types.Receipt, error) {
 //3. Match the same prices on a first-in, first-out basis
 func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAccountDB *account.DB, entrustAddr string) (*types.Receipt, error) {
 	var (
-		logs     []*types.ReceiptLog
-		kvs      []*types.KeyValue
-		priceKey string
-		count    int
-		taker    int32
-		maker    int32
-		minFee   int64
+		logs   []*types.ReceiptLog
+		kvs    []*types.KeyValue
+		taker  int32
+		maker  int32
+		minFee int64
 	)
 
 	cfg := a.api.GetConfig()
@@ -298,7 +360,7 @@ func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAcc
 	}
 
 	or := &et.Order{
-		OrderID:     a.GetIndex(),
+		OrderID:     a.getOrderID(),
 		Value:       &et.Order_LimitOrder{LimitOrder: payload},
 		Ty:          et.TyLimitOrderAction,
 		Executed:    0,
@@ -319,6 +381,56 @@ func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAcc
 		Index: a.GetIndex(),
 	}
 
+	logs, kvs, err = a.matchOrder(payload, or, re, leftAccountDB, rightAccountDB, tCfg.GetFeeAddr(), taker)
+	if err != nil {
+		return nil, err
+	}
+	if or.Status == et.Completed {
+		receiptlog := &types.ReceiptLog{Ty: et.TyLimitOrderLog, Log: types.Encode(re)}
+		logs = append(logs, receiptlog)
+		receipts := &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
+		return receipts, nil
+	}
+
+	//Outstanding orders require freezing of the remaining unclosed funds
+	if payload.Op == et.OpBuy {
+		amount := CalcActualCost(et.OpBuy, or.Balance, payload.Price, cfg.GetCoinPrecision())
+		receipt, err := rightAccountDB.ExecFrozen(a.fromaddr, a.execaddr, amount)
+		if err != nil {
+			elog.Error("LimitOrder.ExecFrozen OpBuy", "addr", a.fromaddr, "amount", amount, "err", err.Error())
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kvs = append(kvs, receipt.KV...)
+	}
+	if payload.Op == et.OpSell {
+		amount := CalcActualCost(et.OpSell, or.Balance, payload.Price, cfg.GetCoinPrecision())
+		receipt, err := leftAccountDB.ExecFrozen(a.fromaddr, a.execaddr, amount)
+		if err != nil {
+			elog.Error("LimitOrder.ExecFrozen OpSell", "addr", a.fromaddr, "amount", amount, "err", err.Error())
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kvs = append(kvs, receipt.KV...)
+	}
+	kvs = append(kvs, a.GetKVSet(or)...)
+	re.Order = or
+	receiptlog := &types.ReceiptLog{Ty: et.TyLimitOrderLog, Log: types.Encode(re)}
+	logs = append(logs, receiptlog)
+	receipts := &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
+	return receipts, nil
+}
+
+// matchOrder Match the listed orders of the opposite side until the order is completed,
+// the price limit is reached or the maximum match count is exceeded
+func (a *Action) matchOrder(payload *et.LimitOrder, or *et.Order, re *et.ReceiptExchange, leftAccountDB, rightAccountDB *account.DB, feeAddr string, taker int32) ([]*types.ReceiptLog, []*types.KeyValue, error) {
+	var (
+		logs     []*types.ReceiptLog
+		kvs      []*types.KeyValue
+		priceKey string
+		count    int
+	)
+
 	// A single transaction can match up to 100 historical orders, the maximum depth can be matched, the system has to protect itself
 	// Iteration has listing price
 	var done bool
@@ -365,7 +477,7 @@ func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAcc
 						break
 					}
 					elog.Error("findOrderIDListByPrice error", "height", a.height, "symbol", payload.GetLeftAsset().Symbol, "price", marketDepth.Price, "op", a.OpSwap(payload.Op), "error", err)
-					return nil, err
+					return nil, nil, err
 				}
 				for _, matchorder := range orderList.List {
 					if count >= et.MaxMatchCount {
@@ -380,21 +492,22 @@ func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAcc
 						}
 						continue
 					}
-					log, kv, err := a.matchModel(leftAccountDB, rightAccountDB, payload, order, or, re, tCfg.GetFeeAddr(), taker) // payload, or redundant
+					if or.Ty == et.TyMarketOrderAction && payload.Op == et.OpBuy && !a.limitMarketBuy(rightAccountDB, or, order) {
+						done = true
+						break
+					}
+					log, kv, err := a.matchModel(leftAccountDB, rightAccountDB, payload, order, or, re, feeAddr, taker) // payload, or redundant
 					if err != nil {
 						if err == types.ErrNoBalance {
 							elog.Warn("matchModel RevokeOrder", "height", a.height, "orderID", order.GetOrderID(), "payloadID", or.GetOrderID(), "error", err)
 							continue
 						}
-						return nil, err
+						return nil, nil, err
 					}
 					logs = append(logs, log...)
 					kvs = append(kvs, kv...)
 					if or.Status == et.Completed {
-						receiptlog := &types.ReceiptLog{Ty: et.TyLimitOrderLog, Log: types.Encode(re)}
-						logs = append(logs, receiptlog)
-						receipts := &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
-						return receipts, nil
+						return logs, kvs, nil
 					}
 					// match depth count
 					count = count + 1
@@ -422,35 +535,109 @@ func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAcc
 		priceKey = marketDepthList.PrimaryKey
 	}
 
-	//Outstanding orders require freezing of the remaining unclosed funds
-	if payload.Op == et.OpBuy {
-		amount := CalcActualCost(et.OpBuy, or.Balance, payload.Price, cfg.GetCoinPrecision())
-		receipt, err := rightAccountDB.ExecFrozen(a.fromaddr, a.execaddr, amount)
-		if err != nil {
-			elog.Error("LimitOrder.ExecFrozen OpBuy", "addr", a.fromaddr, "amount", amount, "err", err.Error())
-			return nil, err
-		}
-		logs = append(logs, receipt.Logs...)
-		kvs = append(kvs, receipt.KV...)
+	return logs, kvs, nil
+}
+
+// matchMarketOrder A market order is matched without price limit and never listed,
+// the unmatched part is revoked in the same transaction
+func (a *Action) matchMarketOrder(payload *et.MarketOrder, leftAccountDB, rightAccountDB *account.DB) (*types.Receipt, error) {
+	var (
+		taker  int32
+		minFee int64
+	)
+
+	cfg := a.api.GetConfig()
+	tCfg, err := ParseConfig(cfg, a.height)
+	if err != nil {
+		elog.Error("executor/exchangedb matchMarketOrder.ParseConfig", "err", err)
+		return nil, err
 	}
-	if payload.Op == et.OpSell {
-		amount := CalcActualCost(et.OpSell, or.Balance, payload.Price, cfg.GetCoinPrecision())
-		receipt, err := leftAccountDB.ExecFrozen(a.fromaddr, a.execaddr, amount)
-		if err != nil {
-			elog.Error("LimitOrder.ExecFrozen OpSell", "addr", a.fromaddr, "amount", amount, "err", err.Error())
-			return nil, err
-		}
-		logs = append(logs, receipt.Logs...)
-		kvs = append(kvs, receipt.KV...)
+
+	if tCfg.IsBankAddr(a.fromaddr) {
+		return nil, et.ErrAddrIsBank
+	}
+
+	if !tCfg.IsFeeFreeAddr(a.fromaddr) {
+		trade := tCfg.GetTrade(payload.GetLeftAsset(), payload.GetRightAsset())
+		taker = trade.GetTaker()
+		minFee = trade.GetMinFee()
+	}
+
+	or := &et.Order{
+		OrderID:    a.getOrderID(),
+		Value:      &et.Order_MarketOrder{MarketOrder: payload},
+		Ty:         et.TyMarketOrderAction,
+		Executed:   0,
+		AVGPrice:   0,
+		Balance:    payload.GetAmount(),
+		Status:     et.Ordered,
+		Addr:       a.fromaddr,
+		UpdateTime: a.blocktime,
+		Index:      a.GetIndex(),
+		Rate:       taker,
+		MinFee:     minFee,
+		Hash:       hex.EncodeToString(a.txhash),
+		CreateTime: a.blocktime,
+	}
+	re := &et.ReceiptExchange{
+		Order: or,
+		Index: a.GetIndex(),
+	}
+
+	// The buy order accepts any ask price and the sell order accepts any bid price
+	limit := &et.LimitOrder{
+		LeftAsset:  payload.GetLeftAsset(),
+		RightAsset: payload.GetRightAsset(),
+		Price:      math.MaxInt64,
+		Amount:     payload.GetAmount(),
+		Op:         payload.GetOp(),
+	}
+	if payload.GetOp() == et.OpSell {
+		limit.Price = 0
+	}
+	logs, kvs, err := a.matchOrder(limit, or, re, leftAccountDB, rightAccountDB, tCfg.GetFeeAddr(), taker)
+	if err != nil {
+		return nil, err
+	}
+
+	// Nothing is frozen for a market order, the rest of the amount is simply revoked
+	or.Balance = payload.GetAmount() - or.Executed
+	or.UpdateTime = a.blocktime
+	if or.Balance == 0 {
+		or.Status = et.Completed
+	} else {
+		or.Status = et.Revoked
+		or.RevokeHash = hex.EncodeToString(a.txhash)
 	}
 	kvs = append(kvs, a.GetKVSet(or)...)
 	re.Order = or
-	receiptlog := &types.ReceiptLog{Ty: et.TyLimitOrderLog, Log: types.Encode(re)}
+	receiptlog := &types.ReceiptLog{Ty: et.TyMarketOrderLog, Log: types.Encode(re)}
 	logs = append(logs, receiptlog)
 	receipts := &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
 	return receipts, nil
 }
 
+// limitMarketBuy Limit the balance of a market buy order to what the buyer can still pay at the price of the match order,
+// false is returned if not even the smallest unit can be paid
+func (a *Action) limitMarketBuy(rightAccountDB *account.DB, or, matchorder *et.Order) bool {
+	price := matchorder.GetLimitOrder().GetPrice()
+	if price <= 0 {
+		return false
+	}
+	balance := rightAccountDB.LoadExecAccount(a.fromaddr, a.execaddr).Balance
+	if a.spendCap > 0 {
+		if remain := a.spendCap - (a.spendBase - balance); remain < balance {
+			balance = remain
+		}
+	}
+	afford := big.NewInt(0).Mul(big.NewInt(balance), big.NewInt(a.api.GetConfig().GetCoinPrecision()))
+	afford = afford.Div(afford, big.NewInt(price))
+	if afford.Cmp(big.NewInt(or.Balance)) < 0 {
+		or.Balance = afford.Int64()
+	}
+	return or.Balance > 0
+}
+
 func (a *Action) matchModel(leftAccountDB, rightAccountDB *account.DB, payload *et.LimitOrder, matchorder *et.Order, or *et.Order, re *et.ReceiptExchange, feeAddr string, taker int32) ([]*types.ReceiptLog, []*types.KeyValue, error) {
 	var logs []*types.ReceiptLog
 	var kvs []*types.KeyValue
@@ -583,6 +770,7 @@ func (a *Action) matchModel(leftAccountDB, rightAccountDB *account.DB, payload *
 	}
 
 	matchorder.UpdateTime = a.blocktime
+	a.recordTradePrice(matchorder.GetLimitOrder().Price)
 
 	if matched == matchorder.GetBalance() {
 		matchorder.Status = et.Completed
@@ -633,7 +821,7 @@ func findOrderByOrderID(statedb dbm.KV, localdb dbm.KV, orderID int64) (*et.Orde
 		elog.Error("findOrderByOrderID.Decode", "orderID", orderID, "err", err.Error())
 		return nil, err
 	}
-	order.Executed = order.GetLimitOrder().Amount - order.Balance
+	order.Executed = getOrderAmount(&order) - order.Balance
 	return &order, nil
 }
 
@@ -887,7 +1075,7 @@ HERE:
 			continue
 		}
 		// The replacement has been done
-		order.Executed = order.GetLimitOrder().Amount - order.Balance
+		order.Executed = getOrderAmount(order) - order.Balance
 		orderList.List = append(orderList.List, order)
 		if len(orderList.List) == int(count) {
 			orderList.PrimaryKey = string(row.Primary)
@@ -928,7 +1116,7 @@ func QueryOrderList(localdb dbm.KV, addr string, status, count, direction int32,
 	var orderList et.OrderList
 	for _, row := range rows {
 		order := row.Data.(*et.Order)
-		order.Executed = order.GetLimitOrder().Amount - order.Balance
+		order.Executed = getOrderAmount(order) - order.Balance
 		orderList.List = append(orderList.List, order)
 	}
 	if len(rows) == int(count) {
@@ -950,6 +1138,29 @@ func queryMarketDepth(marketTable *tab.Table, left, right *et.Asset, op int32, p
 	return row.Data.(*et.MarketDepth), nil
 }
 
+//getOrderPair Trading pair and op of a limit, market or condition order
+func getOrderPair(order *et.Order) (left, right *et.Asset, op int32) {
+	if marketOrder := order.GetMarketOrder(); marketOrder != nil {
+		return marketOrder.GetLeftAsset(), marketOrder.GetRightAsset(), marketOrder.GetOp()
+	}
+	if conditionOrder := order.GetConditionOrder(); conditionOrder != nil {
+		return conditionOrder.GetLeftAsset(), conditionOrder.GetRightAsset(), conditionOrder.GetOp()
+	}
+	limitOrder := order.GetLimitOrder()
+	return limitOrder.GetLeftAsset(), limitOrder.GetRightAsset(), limitOrder.GetOp()
+}
+
+//getOrderAmount Total amount of a limit, market or condition order
+func getOrderAmount(order *et.Order) int64 {
+	if marketOrder := order.GetMarketOrder(); marketOrder != nil {
+		return marketOrder.GetAmount()
+	}
+	if conditionOrder := order.GetConditionOrder(); conditionOrder != nil {
+		return conditionOrder.GetAmount()
+	}
+	return order.GetLimitOrder().GetAmount()
+}
+
 //SafeMul Safe multiplication of large numbers, prevent overflow
 func SafeMul(x, y, coinPrecision int64) int64 {
 	res := big.NewInt(0).Mul(big.NewInt(x), big.NewInt(y))
@@ -959,10 +1170,10 @@ func SafeMul(x, y, coinPrecision int64) int64 {
 
 //Calculate the average transaction price
 func caclAVGPrice(order *et.Order, price int64, amount int64) int64 {
-	x := big.NewInt(0).Mul(big.NewInt(order.AVGPrice), big.NewInt(order.GetLimitOrder().Amount-order.GetBalance()))
+	x := big.NewInt(0).Mul(big.NewInt(order.AVGPrice), big.NewInt(order.GetExecuted()))
 	y := big.NewInt(0).Mul(big.NewInt(price), big.NewInt(amount))
 	total := big.NewInt(0).Add(x, y)
-	div := big.NewInt(0).Add(big.NewInt(order.GetLimitOrder().Amount-order.GetBalance()), big.NewInt(amount))
+	div := big.NewInt(0).Add(big.NewInt(order.GetExecuted()), big.NewInt(amount))
 	avg := big.NewInt(0).Div(total, div)
 	return avg.Int64()
 }
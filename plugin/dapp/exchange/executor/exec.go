@@ -13,13 +13,24 @@ import (
 // 限价交易
 func (e *exchange) Exec_LimitOrder(payload *exchangetypes.LimitOrder, tx *types.Transaction, index int) (*types.Receipt, error) {
 	action := NewAction(e, tx, index)
-	return action.LimitOrder(payload, "")
+	receipt, err := action.LimitOrder(payload, "")
+	if err != nil {
+		return nil, err
+	}
+	return action.TriggerConditionOrders(receipt, payload.GetLeftAsset(), payload.GetRightAsset())
 }
 
 //市价交易
 func (e *exchange) Exec_MarketOrder(payload *exchangetypes.MarketOrder, tx *types.Transaction, index int) (*types.Receipt, error) {
-	//TODO marketOrder
-	return nil, types.ErrActionNotSupport
+	if !e.GetAPI().GetConfig().IsDappFork(e.GetHeight(), exchangetypes.ExchangeX, exchangetypes.ForkMarketOrder) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := NewAction(e, tx, index)
+	receipt, err := action.MarketOrder(payload)
+	if err != nil {
+		return nil, err
+	}
+	return action.TriggerConditionOrders(receipt, payload.GetLeftAsset(), payload.GetRightAsset())
 }
 
 // 撤单
@@ -37,7 +48,11 @@ func (e *exchange) Exec_ExchangeBind(payload *exchangetypes.ExchangeBind, tx *ty
 // 委托交易
 func (e *exchange) Exec_EntrustOrder(payload *exchangetypes.EntrustOrder, tx *types.Transaction, index int) (*types.Receipt, error) {
 	action := NewAction(e, tx, index)
-	return action.EntrustOrder(payload)
+	receipt, err := action.EntrustOrder(payload)
+	if err != nil {
+		return nil, err
+	}
+	return action.TriggerConditionOrders(receipt, payload.GetLeftAsset(), payload.GetRightAsset())
 }
 
 // 委托撤单
@@ -45,3 +60,12 @@ func (e *exchange) Exec_EntrustRevokeOrder(payload *exchangetypes.EntrustRevokeO
 	action := NewAction(e, tx, index)
 	return action.EntrustRevokeOrder(payload)
 }
+
+// 条件单
+func (e *exchange) Exec_ConditionOrder(payload *exchangetypes.ConditionOrder, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !e.GetAPI().GetConfig().IsDappFork(e.GetHeight(), exchangetypes.ExchangeX, exchangetypes.ForkConditionOrder) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := NewAction(e, tx, index)
+	return action.ConditionOrder(payload)
+}
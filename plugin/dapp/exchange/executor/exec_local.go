@@ -3,6 +3,7 @@ package executor
 import (
 	"fmt"
 
+	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/common/db/table"
 	"github.com/33cn/chain33/types"
 	ety "github.com/33cn/plugin/plugin/dapp/exchange/types"
@@ -29,6 +30,10 @@ func (e *exchange) ExecLocal_EntrustOrder(payload *ety.LimitOrder, tx *types.Tra
 	return e.interExecLocal(tx, receiptData, index)
 }
 
+func (e *exchange) ExecLocal_ConditionOrder(payload *ety.ConditionOrder, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return e.interExecLocal(tx, receiptData, index)
+}
+
 func (e *exchange) ExecLocal_EntrustRevokeOrder(payload *ety.MarketOrder, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return e.interExecLocal(tx, receiptData, index)
 }
@@ -36,22 +41,50 @@ func (e *exchange) ExecLocal_EntrustRevokeOrder(payload *ety.MarketOrder, tx *ty
 func (e *exchange) interExecLocal(tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	dbSet := &types.LocalDBSet{}
 	historyTable := NewHistoryOrderTable(e.GetLocalDB())
-	marketTable := NewMarketDepthTable(e.GetLocalDB())
+	marketKV := newPendingKV(e.GetLocalDB())
+	marketTable := NewMarketDepthTable(marketKV)
 	orderTable := NewMarketOrderTable(e.GetLocalDB())
+	conditionTable := NewConditionOrderTable(e.GetLocalDB())
+	klines := newKLineCache(e.GetLocalDB())
+	var kvs []*types.KeyValue
 	if receiptData.Ty == types.ExecOk {
 		for _, log := range receiptData.Logs {
 			switch log.Ty {
-			case ety.TyMarketOrderLog, ety.TyRevokeOrderLog, ety.TyLimitOrderLog:
+			case ety.TyRevokeOrderLog, ety.TyLimitOrderLog:
 				receipt := &ety.ReceiptExchange{}
 				if err := types.Decode(log.Log, receipt); err != nil {
 					return nil, err
 				}
 				e.updateIndex(marketTable, orderTable, historyTable, receipt)
+				e.updateKLine(klines, receipt)
+			case ety.TyMarketOrderLog:
+				receipt := &ety.ReceiptExchange{}
+				if err := types.Decode(log.Log, receipt); err != nil {
+					return nil, err
+				}
+				e.updateMarketOrderIndex(marketTable, orderTable, historyTable, receipt)
+				e.updateKLine(klines, receipt)
+			case ety.TyConditionOrderLog:
+				receipt := &ety.ReceiptExchange{}
+				if err := types.Decode(log.Log, receipt); err != nil {
+					return nil, err
+				}
+				// The triggered order matches the same depth again, flush the depth updated before
+				if receipt.GetOrder().GetStatus() == ety.Completed {
+					kv, err := marketTable.Save()
+					if err != nil {
+						elog.Error("updateIndex", "marketTable.Save", err.Error())
+						return nil, nil
+					}
+					kvs = append(kvs, kv...)
+					marketKV.apply(kv)
+					marketTable = NewMarketDepthTable(marketKV)
+				}
+				e.updateConditionOrderIndex(conditionTable, historyTable, receipt)
 			}
 		}
 	}
 
-	var kvs []*types.KeyValue
 	kv, err := marketTable.Save()
 	if err != nil {
 		elog.Error("updateIndex", "marketTable.Save", err.Error())
@@ -72,6 +105,20 @@ func (e *exchange) interExecLocal(tx *types.Transaction, receiptData *types.Rece
 		return nil, nil
 	}
 	kvs = append(kvs, kv...)
+
+	kv, err = conditionTable.Save()
+	if err != nil {
+		elog.Error("updateIndex", "conditionTable.Save", err.Error())
+		return nil, nil
+	}
+	kvs = append(kvs, kv...)
+
+	kv, err = klines.save()
+	if err != nil {
+		elog.Error("updateIndex", "klineTable.Save", err.Error())
+		return nil, nil
+	}
+	kvs = append(kvs, kv...)
 	dbSet.KV = append(dbSet.KV, kvs...)
 	dbSet = e.addAutoRollBack(tx, dbSet.KV)
 	localDB := e.GetLocalDB()
@@ -85,6 +132,32 @@ func (e *exchange) interExecLocal(tx *types.Transaction, receiptData *types.Rece
 	return dbSet, nil
 }
 
+// pendingKV reads the kvs saved in this transaction before they are written to localdb
+type pendingKV struct {
+	dbm.KV
+	cache map[string][]byte
+}
+
+func newPendingKV(kv dbm.KV) *pendingKV {
+	return &pendingKV{KV: kv, cache: make(map[string][]byte)}
+}
+
+func (p *pendingKV) Get(key []byte) ([]byte, error) {
+	if value, ok := p.cache[string(key)]; ok {
+		if value == nil {
+			return nil, types.ErrNotFound
+		}
+		return value, nil
+	}
+	return p.KV.Get(key)
+}
+
+func (p *pendingKV) apply(kvs []*types.KeyValue) {
+	for _, kv := range kvs {
+		p.cache[string(kv.Key)] = kv.Value
+	}
+}
+
 // Set automatic rollback
 func (e *exchange) addAutoRollBack(tx *types.Transaction, kv []*types.KeyValue) *types.LocalDBSet {
 	dbSet := &types.LocalDBSet{}
@@ -122,6 +195,47 @@ func (e *exchange) updateIndex(marketTable, orderTable, historyTable *table.Tabl
 	return
 }
 
+// A market order is never listed, only the matched orders and the history need to be updated
+func (e *exchange) updateMarketOrderIndex(marketTable, orderTable, historyTable *table.Table, receipt *ety.ReceiptExchange) {
+	err := e.updateMatchOrders(marketTable, orderTable, historyTable, receipt.GetOrder(), receipt.GetMatchOrders(), receipt.GetIndex())
+	if err != nil {
+		return
+	}
+	order := receipt.GetOrder()
+	order.Index = receipt.GetIndex()
+	err = historyTable.Replace(order)
+	if err != nil {
+		elog.Error("updateIndex", "historyTable.Replace", err.Error())
+	}
+}
+
+// A pending condition order is kept in the condition table until it is triggered or revoked
+func (e *exchange) updateConditionOrderIndex(conditionTable, historyTable *table.Table, receipt *ety.ReceiptExchange) {
+	order := receipt.GetOrder()
+	switch order.Status {
+	case ety.Ordered:
+		err := conditionTable.Replace(order)
+		if err != nil {
+			elog.Error("updateIndex", "conditionTable.Replace", err.Error())
+		}
+	case ety.Completed:
+		err := conditionTable.Del([]byte(fmt.Sprintf("%022d", order.OrderID)))
+		if err != nil {
+			elog.Error("updateIndex", "conditionTable.Del", err.Error())
+		}
+	case ety.Revoked:
+		err := conditionTable.Del([]byte(fmt.Sprintf("%022d", order.OrderID)))
+		if err != nil {
+			elog.Error("updateIndex", "conditionTable.Del", err.Error())
+		}
+		order.Index = receipt.GetIndex()
+		err = historyTable.Replace(order)
+		if err != nil {
+			elog.Error("updateIndex", "historyTable.Replace", err.Error())
+		}
+	}
+}
+
 func (e *exchange) updateOrder(marketTable, orderTable, historyTable *table.Table, order *ety.Order, index int64) error {
 	left := order.GetLimitOrder().GetLeftAsset()
 	right := order.GetLimitOrder().GetRightAsset()
@@ -197,9 +311,7 @@ func (e *exchange) updateOrder(marketTable, orderTable, historyTable *table.Tabl
 	return nil
 }
 func (e *exchange) updateMatchOrders(marketTable, orderTable, historyTable *table.Table, order *ety.Order, matchOrders []*ety.Order, index int64) error {
-	left := order.GetLimitOrder().GetLeftAsset()
-	right := order.GetLimitOrder().GetRightAsset()
-	op := order.GetLimitOrder().GetOp()
+	left, right, op := getOrderPair(order)
 	if len(matchOrders) > 0 {
 		cache := make(map[int64]int64)
 		for i, matchOrder := range matchOrders {
@@ -0,0 +1,173 @@
+package executor
+
+import (
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
+	tab "github.com/33cn/chain33/common/db/table"
+	"github.com/33cn/chain33/types"
+	et "github.com/33cn/plugin/plugin/dapp/exchange/types"
+)
+
+/*
+ * K线和24小时行情
+ * 每次撮合成交后在localdb中按1m/5m/1h/1d周期更新交易对的K线，区块回退时由自动回滚删除
+ */
+
+var klinePeriods = []int32{et.KLine1m, et.KLine5m, et.KLine1h, et.KLine1d}
+
+//tickerWindow 24小时行情的统计区间(秒)
+const tickerWindow = int64(86400)
+
+//CheckKLinePeriod ...
+func CheckKLinePeriod(period int32) bool {
+	for _, p := range klinePeriods {
+		if p == period {
+			return true
+		}
+	}
+	return false
+}
+
+func calcKLinePrefix(left, right *et.Asset, period int32) string {
+	return fmt.Sprintf("%s:%s:%d:", left.GetSymbol(), right.GetSymbol(), period)
+}
+
+//klineCache 一笔交易中更新的K线，按更新的先后顺序保存
+type klineCache struct {
+	table  *tab.Table
+	klines map[string]*et.KLine
+	keys   []string
+}
+
+func newKLineCache(kvdb dbm.KV) *klineCache {
+	return &klineCache{
+		table:  NewKLineTable(kvdb),
+		klines: make(map[string]*et.KLine),
+	}
+}
+
+func (c *klineCache) getKLine(left, right *et.Asset, period int32, time int64) *et.KLine {
+	key := fmt.Sprintf("%s%016d", calcKLinePrefix(left, right, period), time)
+	if kline, ok := c.klines[key]; ok {
+		return kline
+	}
+	kline := &et.KLine{LeftAsset: left, RightAsset: right, Period: period, Time: time}
+	row, err := c.table.GetData([]byte(key))
+	if err == nil {
+		kline = row.Data.(*et.KLine)
+	}
+	c.klines[key] = kline
+	c.keys = append(c.keys, key)
+	return kline
+}
+
+//update 按成交价格和数量更新各个周期的K线
+func (c *klineCache) update(left, right *et.Asset, price, volume, turnover, blocktime int64) {
+	for _, period := range klinePeriods {
+		kline := c.getKLine(left, right, period, blocktime-blocktime%int64(period))
+		if kline.Volume == 0 {
+			kline.Open = price
+			kline.High = price
+			kline.Low = price
+		}
+		if price > kline.High {
+			kline.High = price
+		}
+		if price < kline.Low {
+			kline.Low = price
+		}
+		kline.Close = price
+		kline.Volume += volume
+		kline.Turnover += turnover
+	}
+}
+
+func (c *klineCache) save() ([]*types.KeyValue, error) {
+	for _, key := range c.keys {
+		err := c.table.Replace(c.klines[key])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return c.table.Save()
+}
+
+// Each matched order in the receipt is a trade at the price of the matched order
+func (e *exchange) updateKLine(cache *klineCache, receipt *et.ReceiptExchange) {
+	left, right, _ := getOrderPair(receipt.GetOrder())
+	coinPrecision := e.GetAPI().GetConfig().GetCoinPrecision()
+	for _, matchOrder := range receipt.GetMatchOrders() {
+		if matchOrder.Executed == 0 {
+			continue
+		}
+		price := matchOrder.GetLimitOrder().GetPrice()
+		cache.update(left, right, price, matchOrder.Executed, SafeMul(matchOrder.Executed, price, coinPrecision), e.GetBlockTime())
+	}
+}
+
+//QueryKLine 按周期查询交易对的K线，默认按时间降序
+func QueryKLine(localdb dbm.KV, left, right *et.Asset, period int32, primaryKey string, count, direction int32) (types.Message, error) {
+	table := NewKLineTable(localdb)
+	prefix := []byte(calcKLinePrefix(left, right, period))
+	if count == 0 {
+		count = et.Count
+	}
+	var rows []*tab.Row
+	var err error
+	if primaryKey == "" {
+		rows, err = table.ListIndex("time", prefix, nil, count, direction)
+	} else {
+		rows, err = table.ListIndex("time", prefix, []byte(primaryKey), count, direction)
+	}
+	if err != nil && err != types.ErrNotFound {
+		elog.Error("QueryKLine.", "left", left, "right", right, "period", period, "err", err.Error())
+		return nil, err
+	}
+	var klineList et.KLineList
+	for _, row := range rows {
+		klineList.List = append(klineList.List, row.Data.(*et.KLine))
+	}
+	if len(rows) == int(count) {
+		klineList.PrimaryKey = string(rows[len(rows)-1].Primary)
+	}
+	return &klineList, nil
+}
+
+//QueryTicker 根据5分钟K线统计截止时间之前24小时的行情
+func QueryTicker(localdb dbm.KV, left, right *et.Asset, end int64) (types.Message, error) {
+	table := NewKLineTable(localdb)
+	prefix := calcKLinePrefix(left, right, et.KLine5m)
+	start := []byte(fmt.Sprintf("%s%016d", prefix, end+1))
+	rows, err := table.ListIndex("time", []byte(prefix), start, int32(tickerWindow/int64(et.KLine5m)), et.ListDESC)
+	if err != nil && err != types.ErrNotFound {
+		elog.Error("QueryTicker.", "left", left, "right", right, "err", err.Error())
+		return nil, err
+	}
+	ticker := &et.Ticker{LeftAsset: left, RightAsset: right, Time: end}
+	for _, row := range rows {
+		kline := row.Data.(*et.KLine)
+		if kline.Time <= end-tickerWindow {
+			break
+		}
+		if ticker.Close == 0 {
+			ticker.Close = kline.Close
+			ticker.High = kline.High
+			ticker.Low = kline.Low
+		}
+		if kline.High > ticker.High {
+			ticker.High = kline.High
+		}
+		if kline.Low < ticker.Low {
+			ticker.Low = kline.Low
+		}
+		ticker.Open = kline.Open
+		ticker.Volume += kline.Volume
+		ticker.Turnover += kline.Turnover
+	}
+	if ticker.Open != 0 {
+		ticker.Change = ticker.Close - ticker.Open
+		ticker.ChangeRate = ticker.Change * 1e4 / ticker.Open
+	}
+	return ticker, nil
+}
@@ -92,3 +92,49 @@ func (e *exchange) Query_QueryOrderList(in *et.QueryOrderList) (types.Message, e
 	}
 	return QueryOrderList(e.GetLocalDB(), in.Address, in.Status, in.Count, in.Direction, in.PrimaryKey)
 }
+
+//根据地址查询未触发的条件单
+func (e *exchange) Query_QueryConditionOrderList(in *et.QueryConditionOrderList) (types.Message, error) {
+	if !CheckCount(in.Count) {
+		return nil, et.ErrCount
+	}
+
+	if !CheckDirection(in.Direction) {
+		return nil, et.ErrDirection
+	}
+
+	if in.Address == "" {
+		return nil, et.ErrAddr
+	}
+	return QueryConditionOrderList(e.GetLocalDB(), in.Address, in.Count, in.Direction, in.PrimaryKey)
+}
+
+//按周期查询交易对的K线
+func (e *exchange) Query_QueryKLine(in *et.QueryKLine) (types.Message, error) {
+	if !CheckExchangeAsset(e.GetAPI().GetConfig().GetCoinExec(), in.LeftAsset, in.RightAsset) {
+		return nil, et.ErrAsset
+	}
+	if !CheckKLinePeriod(in.Period) {
+		return nil, et.ErrKLinePeriod
+	}
+	if !CheckCount(in.Count) {
+		return nil, et.ErrCount
+	}
+
+	if !CheckDirection(in.Direction) {
+		return nil, et.ErrDirection
+	}
+	return QueryKLine(e.GetLocalDB(), in.LeftAsset, in.RightAsset, in.Period, in.PrimaryKey, in.Count, in.Direction)
+}
+
+//查询交易对24小时行情
+func (e *exchange) Query_QueryTicker(in *et.QueryTicker) (types.Message, error) {
+	if !CheckExchangeAsset(e.GetAPI().GetConfig().GetCoinExec(), in.LeftAsset, in.RightAsset) {
+		return nil, et.ErrAsset
+	}
+	end := in.Time
+	if end == 0 {
+		end = types.Now().Unix()
+	}
+	return QueryTicker(e.GetLocalDB(), in.LeftAsset, in.RightAsset, end)
+}
@@ -52,6 +52,22 @@ var opt_exchange_history = &table.Option{
 	Index:   []string{"name", "addr_status"},
 }
 
+//未触发的条件单，触发或者撤回后从表中删除
+var opt_exchange_condition = &table.Option{
+	Prefix:  KeyPrefixLocalDB,
+	Name:    "condition",
+	Primary: "orderID",
+	Index:   []string{"trigger", "addr"},
+}
+
+//K线，每个交易对按周期和周期开始时间记录一行
+var opt_exchange_kline = &table.Option{
+	Prefix:  KeyPrefixLocalDB,
+	Name:    "kline",
+	Primary: "time",
+	Index:   nil,
+}
+
 //NewMarketDepthTable 新建表
 func NewMarketDepthTable(kvdb db.KV) *table.Table {
 	rowmeta := NewMarketDepthRow()
@@ -82,6 +98,26 @@ func NewHistoryOrderTable(kvdb db.KV) *table.Table {
 	return table
 }
 
+//NewConditionOrderTable ...
+func NewConditionOrderTable(kvdb db.KV) *table.Table {
+	rowmeta := NewConditionOrderRow()
+	table, err := table.NewTable(rowmeta, kvdb, opt_exchange_condition)
+	if err != nil {
+		panic(err)
+	}
+	return table
+}
+
+//NewKLineTable ...
+func NewKLineTable(kvdb db.KV) *table.Table {
+	rowmeta := NewKLineRow()
+	table, err := table.NewTable(rowmeta, kvdb, opt_exchange_kline)
+	if err != nil {
+		panic(err)
+	}
+	return table
+}
+
 //OrderRow table meta 结构
 type OrderRow struct {
 	*ety.Order
@@ -147,13 +183,51 @@ func (m *HistoryOrderRow) Get(key string) ([]byte, error) {
 	if key == "index" {
 		return []byte(fmt.Sprintf("%022d", m.Index)), nil
 	} else if key == "name" {
-		return []byte(fmt.Sprintf("%s:%s", m.GetLimitOrder().LeftAsset.GetSymbol(), m.GetLimitOrder().RightAsset.GetSymbol())), nil
+		left, right, _ := getOrderPair(m.Order)
+		return []byte(fmt.Sprintf("%s:%s", left.GetSymbol(), right.GetSymbol())), nil
 	} else if key == "addr_status" {
 		return []byte(fmt.Sprintf("%s:%d", address.FormatAddrKey(m.Addr), m.Status)), nil
 	}
 	return nil, types.ErrNotFound
 }
 
+//ConditionOrderRow table meta 结构
+type ConditionOrderRow struct {
+	*ety.Order
+}
+
+//NewConditionOrderRow ...
+func NewConditionOrderRow() *ConditionOrderRow {
+	return &ConditionOrderRow{Order: &ety.Order{Value: &ety.Order_ConditionOrder{ConditionOrder: &ety.ConditionOrder{}}}}
+}
+
+//CreateRow ...
+func (m *ConditionOrderRow) CreateRow() *table.Row {
+	return &table.Row{Data: &ety.Order{Value: &ety.Order_ConditionOrder{ConditionOrder: &ety.ConditionOrder{}}}}
+}
+
+//SetPayload 设置数据
+func (m *ConditionOrderRow) SetPayload(data types.Message) error {
+	if txdata, ok := data.(*ety.Order); ok {
+		m.Order = txdata
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+//Get 按照indexName 查询 indexValue
+func (m *ConditionOrderRow) Get(key string) ([]byte, error) {
+	if key == "orderID" {
+		return []byte(fmt.Sprintf("%022d", m.OrderID)), nil
+	} else if key == "trigger" {
+		cond := m.GetConditionOrder()
+		return []byte(fmt.Sprintf("%s:%s:%d:%016d", cond.GetLeftAsset().GetSymbol(), cond.GetRightAsset().GetSymbol(), TriggerDirection(cond), cond.GetTriggerPrice())), nil
+	} else if key == "addr" {
+		return []byte(address.FormatAddrKey(m.Addr)), nil
+	}
+	return nil, types.ErrNotFound
+}
+
 //MarketDepthRow table meta 结构
 type MarketDepthRow struct {
 	*ety.MarketDepth
@@ -185,3 +259,35 @@ func (m *MarketDepthRow) Get(key string) ([]byte, error) {
 	}
 	return nil, types.ErrNotFound
 }
+
+//KLineRow table meta 结构
+type KLineRow struct {
+	*ety.KLine
+}
+
+//NewKLineRow 新建一个meta 结构
+func NewKLineRow() *KLineRow {
+	return &KLineRow{KLine: &ety.KLine{}}
+}
+
+//CreateRow ...
+func (m *KLineRow) CreateRow() *table.Row {
+	return &table.Row{Data: &ety.KLine{}}
+}
+
+//SetPayload 设置数据
+func (m *KLineRow) SetPayload(data types.Message) error {
+	if txdata, ok := data.(*ety.KLine); ok {
+		m.KLine = txdata
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+//Get 按照indexName 查询 indexValue
+func (m *KLineRow) Get(key string) ([]byte, error) {
+	if key == "time" {
+		return []byte(fmt.Sprintf("%s:%s:%d:%016d", m.LeftAsset.GetSymbol(), m.RightAsset.GetSymbol(), m.Period, m.Time)), nil
+	}
+	return nil, types.ErrNotFound
+}
@@ -21,4 +21,8 @@ var (
 	ErrCfgFmt     = fmt.Errorf("%s", "ErrCfgFmt")
 	ErrBindAddr   = fmt.Errorf("%s", "The address is not bound")
 	ErrAddrIsBank = fmt.Errorf("%s", "The address cannot be banks")
+
+	ErrConditionType = fmt.Errorf("%s", "The condition type only 1 or 2!")
+	ErrTriggerPrice  = fmt.Errorf("%s", "The trigger price is not valid!")
+	ErrKLinePeriod   = fmt.Errorf("%s", "The kline period only in 60, 300, 3600, 86400!")
 )
@@ -22,6 +22,7 @@ const (
 	TyExchangeBindAction
 	TyEntrustOrderAction
 	TyEntrustRevokeOrderAction
+	TyConditionOrderAction
 
 	NameLimitOrderAction         = "LimitOrder"
 	NameMarketOrderAction        = "MarketOrder"
@@ -29,11 +30,16 @@ const (
 	NameExchangeBindAction       = "ExchangeBind"
 	NameEntrustOrderAction       = "EntrustOrder"
 	NameEntrustRevokeOrderAction = "EntrustRevokeOrder"
+	NameConditionOrderAction     = "ConditionOrder"
 
 	FuncNameQueryMarketDepth      = "QueryMarketDepth"
 	FuncNameQueryHistoryOrderList = "QueryHistoryOrderList"
 	FuncNameQueryOrder            = "QueryOrder"
 	FuncNameQueryOrderList        = "QueryOrderList"
+
+	FuncNameQueryConditionOrderList = "QueryConditionOrderList"
+	FuncNameQueryKLine              = "QueryKLine"
+	FuncNameQueryTicker             = "QueryTicker"
 )
 
 // log类型id值
@@ -44,6 +50,7 @@ const (
 	TyRevokeOrderLog
 
 	TyExchangeBindLog
+	TyConditionOrderLog
 )
 
 // OP
@@ -52,6 +59,28 @@ const (
 	OpSell
 )
 
+//condition order type
+const (
+	ConditionStopLoss = iota + 1
+	ConditionTakeProfit
+)
+
+//condition order trigger direction
+const (
+	//TriggerUp 成交价大于等于触发价格时触发
+	TriggerUp = iota + 1
+	//TriggerDown 成交价小于等于触发价格时触发
+	TriggerDown
+)
+
+//kline period (seconds)
+const (
+	KLine1m = int32(60)
+	KLine5m = int32(300)
+	KLine1h = int32(3600)
+	KLine1d = int32(86400)
+)
+
 //order status
 const (
 	Ordered = iota
@@ -71,6 +100,8 @@ const (
 	Count = int32(10)
 	//MaxMatchCount 系统最大撮合深度
 	MaxMatchCount = 100
+	//MaxTriggerCount 单笔交易最多触发的条件单数量
+	MaxTriggerCount = 9
 )
 
 var (
@@ -84,18 +115,24 @@ var (
 		NameExchangeBindAction:       TyExchangeBindAction,
 		NameEntrustOrderAction:       TyEntrustOrderAction,
 		NameEntrustRevokeOrderAction: TyEntrustRevokeOrderAction,
+		NameConditionOrderAction:     TyConditionOrderAction,
 	}
 	//定义log的id和具体log类型及名称，填入具体自定义log类型
 	logMap = map[int64]*types.LogInfo{
-		TyLimitOrderLog:   {Ty: reflect.TypeOf(ReceiptExchange{}), Name: "TyLimitOrderLog"},
-		TyMarketOrderLog:  {Ty: reflect.TypeOf(ReceiptExchange{}), Name: "TyMarketOrderLog"},
-		TyRevokeOrderLog:  {Ty: reflect.TypeOf(ReceiptExchange{}), Name: "TyRevokeOrderLog"},
-		TyExchangeBindLog: {Ty: reflect.TypeOf(ReceiptExchangeBind{}), Name: "TyExchangeBindLog"},
+		TyLimitOrderLog:     {Ty: reflect.TypeOf(ReceiptExchange{}), Name: "TyLimitOrderLog"},
+		TyMarketOrderLog:    {Ty: reflect.TypeOf(ReceiptExchange{}), Name: "TyMarketOrderLog"},
+		TyRevokeOrderLog:    {Ty: reflect.TypeOf(ReceiptExchange{}), Name: "TyRevokeOrderLog"},
+		TyExchangeBindLog:   {Ty: reflect.TypeOf(ReceiptExchangeBind{}), Name: "TyExchangeBindLog"},
+		TyConditionOrderLog: {Ty: reflect.TypeOf(ReceiptExchange{}), Name: "TyConditionOrderLog"},
 	}
 	//tlog = log.New("module", "exchange.types")
 
 	//ForkFix Forks
 	ForkFix1 = "ForkFix1"
+	//ForkMarketOrder 开启市价单
+	ForkMarketOrder = "ForkMarketOrder"
+	//ForkConditionOrder 开启条件单
+	ForkConditionOrder = "ForkConditionOrder"
 
 	ForkParamV1 = "ForkParamV1"
 	ForkParamV2 = "ForkParamV2"
@@ -141,6 +178,8 @@ func init() {
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(ExchangeX, "Enable", 0)
 	cfg.RegisterDappFork(ExchangeX, ForkFix1, 0)
+	cfg.RegisterDappFork(ExchangeX, ForkMarketOrder, 0)
+	cfg.RegisterDappFork(ExchangeX, ForkConditionOrder, 0)
 	cfg.RegisterDappFork(ExchangeX, ForkParamV1, 0)
 	cfg.RegisterDappFork(ExchangeX, ForkParamV2, 0)
 	cfg.RegisterDappFork(ExchangeX, ForkParamV3, 0)
@@ -72,6 +72,7 @@ type ExchangeAction struct {
 	//	*ExchangeAction_ExchangeBind
 	//	*ExchangeAction_EntrustOrder
 	//	*ExchangeAction_EntrustRevokeOrder
+	//	*ExchangeAction_ConditionOrder
 	Value isExchangeAction_Value `protobuf_oneof:"value"`
 	Ty    int32                  `protobuf:"varint,6,opt,name=ty,proto3" json:"ty,omitempty"`
 }
@@ -157,6 +158,13 @@ func (x *ExchangeAction) GetEntrustRevokeOrder() *EntrustRevokeOrder {
 	return nil
 }
 
+func (x *ExchangeAction) GetConditionOrder() *ConditionOrder {
+	if x, ok := x.GetValue().(*ExchangeAction_ConditionOrder); ok {
+		return x.ConditionOrder
+	}
+	return nil
+}
+
 func (x *ExchangeAction) GetTy() int32 {
 	if x != nil {
 		return x.Ty
@@ -192,6 +200,10 @@ type ExchangeAction_EntrustRevokeOrder struct {
 	EntrustRevokeOrder *EntrustRevokeOrder `protobuf:"bytes,7,opt,name=entrustRevokeOrder,proto3,oneof"`
 }
 
+type ExchangeAction_ConditionOrder struct {
+	ConditionOrder *ConditionOrder `protobuf:"bytes,8,opt,name=conditionOrder,proto3,oneof"`
+}
+
 func (*ExchangeAction_LimitOrder) isExchangeAction_Value() {}
 
 func (*ExchangeAction_MarketOrder) isExchangeAction_Value() {}
@@ -204,6 +216,8 @@ func (*ExchangeAction_EntrustOrder) isExchangeAction_Value() {}
 
 func (*ExchangeAction_EntrustRevokeOrder) isExchangeAction_Value() {}
 
+func (*ExchangeAction_ConditionOrder) isExchangeAction_Value() {}
+
 //限价订单
 type LimitOrder struct {
 	state         protoimpl.MessageState
@@ -574,6 +588,109 @@ func (x *EntrustRevokeOrder) GetAddr() string {
 	return ""
 }
 
+//条件单(止损/止盈)，成交价穿过触发价格后转为限价单或市价单
+type ConditionOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//交易对
+	LeftAsset *Asset `protobuf:"bytes,1,opt,name=leftAsset,proto3" json:"leftAsset,omitempty"`
+	//交易对
+	RightAsset *Asset `protobuf:"bytes,2,opt,name=rightAsset,proto3" json:"rightAsset,omitempty"`
+	//触发价格
+	TriggerPrice int64 `protobuf:"varint,3,opt,name=triggerPrice,proto3" json:"triggerPrice,omitempty"`
+	//触发后的委托价格，0表示触发后以市价单成交
+	Price int64 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	//总量
+	Amount int64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	//操作， 1为买，2为卖
+	Op int32 `protobuf:"varint,6,opt,name=op,proto3" json:"op,omitempty"`
+	//条件类型， 1为止损，2为止盈
+	Ty int32 `protobuf:"varint,7,opt,name=ty,proto3" json:"ty,omitempty"`
+}
+
+func (x *ConditionOrder) Reset() {
+	*x = ConditionOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchange_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConditionOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConditionOrder) ProtoMessage() {}
+
+func (x *ConditionOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConditionOrder.ProtoReflect.Descriptor instead.
+func (*ConditionOrder) Descriptor() ([]byte, []int) {
+	return file_exchange_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ConditionOrder) GetLeftAsset() *Asset {
+	if x != nil {
+		return x.LeftAsset
+	}
+	return nil
+}
+
+func (x *ConditionOrder) GetRightAsset() *Asset {
+	if x != nil {
+		return x.RightAsset
+	}
+	return nil
+}
+
+func (x *ConditionOrder) GetTriggerPrice() int64 {
+	if x != nil {
+		return x.TriggerPrice
+	}
+	return 0
+}
+
+func (x *ConditionOrder) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ConditionOrder) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ConditionOrder) GetOp() int32 {
+	if x != nil {
+		return x.Op
+	}
+	return 0
+}
+
+func (x *ConditionOrder) GetTy() int32 {
+	if x != nil {
+		return x.Ty
+	}
+	return 0
+}
+
 //撤回订单
 type RevokeOrder struct {
 	state         protoimpl.MessageState
@@ -587,7 +704,7 @@ type RevokeOrder struct {
 func (x *RevokeOrder) Reset() {
 	*x = RevokeOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exchange_proto_msgTypes[7]
+		mi := &file_exchange_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -600,7 +717,7 @@ func (x *RevokeOrder) String() string {
 func (*RevokeOrder) ProtoMessage() {}
 
 func (x *RevokeOrder) ProtoReflect() protoreflect.Message {
-	mi := &file_exchange_proto_msgTypes[7]
+	mi := &file_exchange_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -613,7 +730,7 @@ func (x *RevokeOrder) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeOrder.ProtoReflect.Descriptor instead.
 func (*RevokeOrder) Descriptor() ([]byte, []int) {
-	return file_exchange_proto_rawDescGZIP(), []int{7}
+	return file_exchange_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RevokeOrder) GetOrderID() int64 {
@@ -636,7 +753,7 @@ type Asset struct {
 func (x *Asset) Reset() {
 	*x = Asset{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exchange_proto_msgTypes[8]
+		mi := &file_exchange_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -649,7 +766,7 @@ func (x *Asset) String() string {
 func (*Asset) ProtoMessage() {}
 
 func (x *Asset) ProtoReflect() protoreflect.Message {
-	mi := &file_exchange_proto_msgTypes[8]
+	mi := &file_exchange_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -662,7 +779,7 @@ func (x *Asset) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Asset.ProtoReflect.Descriptor instead.
 func (*Asset) Descriptor() ([]byte, []int) {
-	return file_exchange_proto_rawDescGZIP(), []int{8}
+	return file_exchange_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Asset) GetExecer() string {
@@ -689,6 +806,7 @@ type Order struct {
 	// Types that are assignable to Value:
 	//	*Order_LimitOrder
 	//	*Order_MarketOrder
+	//	*Order_ConditionOrder
 	Value isOrder_Value `protobuf_oneof:"value"`
 	//挂单类型
 	Ty int32 `protobuf:"varint,4,opt,name=ty,proto3" json:"ty,omitempty"`
@@ -725,7 +843,7 @@ type Order struct {
 func (x *Order) Reset() {
 	*x = Order{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exchange_proto_msgTypes[9]
+		mi := &file_exchange_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -738,7 +856,7 @@ func (x *Order) String() string {
 func (*Order) ProtoMessage() {}
 
 func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_exchange_proto_msgTypes[9]
+	mi := &file_exchange_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -751,7 +869,7 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Order.ProtoReflect.Descriptor instead.
 func (*Order) Descriptor() ([]byte, []int) {
-	return file_exchange_proto_rawDescGZIP(), []int{9}
+	return file_exchange_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Order) GetOrderID() int64 {
@@ -782,6 +900,13 @@ func (x *Order) GetMarketOrder() *MarketOrder {
 	return nil
 }
 
+func (x *Order) GetConditionOrder() *ConditionOrder {
+	if x, ok := x.GetValue().(*Order_ConditionOrder); ok {
+		return x.ConditionOrder
+	}
+	return nil
+}
+
 func (x *Order) GetTy() int32 {
 	if x != nil {
 		return x.Ty
@@ -899,10 +1024,16 @@ type Order_MarketOrder struct {
 	MarketOrder *MarketOrder `protobuf:"bytes,3,opt,name=marketOrder,proto3,oneof"`
 }
 
+type Order_ConditionOrder struct {
+	ConditionOrder *ConditionOrder `protobuf:"bytes,19,opt,name=conditionOrder,proto3,oneof"`
+}
+
 func (*Order_LimitOrder) isOrder_Value() {}
 
 func (*Order_MarketOrder) isOrder_Value() {}
 
+func (*Order_ConditionOrder) isOrder_Value() {}
+
 //查询接口
 type QueryMarketDepth struct {
 	state         protoimpl.MessageState
@@ -926,7 +1057,7 @@ type QueryMarketDepth struct {
 func (x *QueryMarketDepth) Reset() {
 	*x = QueryMarketDepth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exchange_proto_msgTypes[10]
+		mi := &file_exchange_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -939,7 +1070,7 @@ func (x *QueryMarketDepth) String() string {
 func (*QueryMarketDepth) ProtoMessage() {}
 
 func (x *QueryMarketDepth) ProtoReflect() protoreflect.Message {
-	mi := &file_exchange_proto_msgTypes[10]
+	mi := &file_exchange_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -952,7 +1083,7 @@ func (x *QueryMarketDepth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryMarketDepth.ProtoReflect.Descriptor instead.
 func (*QueryMarketDepth) Descriptor() ([]byte, []int) {
-	return file_exchange_proto_rawDescGZIP(), []int{10}
+	return file_exchange_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *QueryMarketDepth) GetLeftAsset() *Asset {
@@ -1018,7 +1149,7 @@ type MarketDepth struct {
 func (x *MarketDepth) Reset() {
 	*x = MarketDepth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exchange_proto_msgTypes[11]
+		mi := &file_exchange_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1031,7 +1162,7 @@ func (x *MarketDepth) String() string {
 func (*MarketDepth) ProtoMessage() {}
 
 func (x *MarketDepth) ProtoReflect() protoreflect.Message {
-	mi := &file_exchange_proto_msgTypes[11]
+	mi := &file_exchange_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1044,7 +1175,7 @@ func (x *MarketDepth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarketDepth.ProtoReflect.Descriptor instead.
 func (*MarketDepth) Descriptor() ([]byte, []int) {
-	return file_exchange_proto_rawDescGZIP(), []int{11}
+	return file_exchange_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *MarketDepth) GetLeftAsset() *Asset {
@@ -1095,7 +1226,7 @@ type MarketDepthList struct {
 func (x *MarketDepthList) Reset() {
 	*x = MarketDepthList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exchange_proto_msgTypes[12]
+		mi := &file_exchange_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1108,7 +1239,7 @@ func (x *MarketDepthList) String() string {
 func (*MarketDepthList) ProtoMessage() {}
 
 func (x *MarketDepthList) ProtoReflect() protoreflect.Message {
-	mi := &file_exchange_proto_msgTypes[12]
+	mi := &file_exchange_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1121,7 +1252,7 @@ func (x *MarketDepthList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarketDepthList.ProtoReflect.Descriptor instead.
 func (*MarketDepthList) Descriptor() ([]byte, []int) {
-	return file_exchange_proto_rawDescGZIP(), []int{12}
+	return file_exchange_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *MarketDepthList) GetList() []*MarketDepth {
@@ -1153,7 +1284,7 @@ type MarketAllDepth struct {
 func (x *MarketAllDepth) Reset() {
 	*x = MarketAllDepth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exchange_proto_msgTypes[13]
+		mi := &file_exchange_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1166,7 +1297,7 @@ func (x *MarketAllDepth) String() string {
 func (*MarketAllDepth) ProtoMessage() {}
 
 func (x *MarketAllDepth) ProtoReflect() protoreflect.Message {
-	mi := &file_exchange_proto_msgTypes[13]
+	mi := &file_exchange_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1179,7 +1310,7 @@ func (x *MarketAllDepth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarketAllDepth.ProtoReflect.Descriptor instead.
 func (*MarketAllDepth) Descriptor() ([]byte, []int) {
-	return file_exchange_proto_rawDescGZIP(), []int{13}
+	return file_exchange_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *MarketAllDepth) GetBids() []*MarketDepth {
@@ -1217,7 +1348,7 @@ type QueryHistoryOrderList struct {
 func (x *QueryHistoryOrderList) Reset() {
 	*x = QueryHistoryOrderList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exchange_proto_msgTypes[14]
+		mi := &file_exchange_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1230,7 +1361,7 @@ func (x *QueryHistoryOrderList) String() string {
 func (*QueryHistoryOrderList) ProtoMessage() {}
 
 func (x *QueryHistoryOrderList) ProtoReflect() protoreflect.Message {
-	mi := &file_exchange_proto_msgTypes[14]
+	mi := &file_exchange_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1243,7 +1374,7 @@ func (x *QueryHistoryOrderList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryHistoryOrderList.ProtoReflect.Descriptor instead.
 func (*QueryHistoryOrderList) Descriptor() ([]byte, []int) {
-	return file_exchange_proto_rawDescGZIP(), []int{14}
+	return file_exchange_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *QueryHistoryOrderList) GetLeftAsset() *Asset {
@@ -1293,7 +1424,7 @@ type QueryOrder struct {
 func (x *QueryOrder) Reset() {
 	*x = QueryOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exchange_proto_msgTypes[15]
+		mi := &file_exchange_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1306,7 +1437,7 @@ func (x *QueryOrder) String() string {
 func (*QueryOrder) ProtoMessage() {}
 
 func (x *QueryOrder) ProtoReflect() protoreflect.Message {
-	mi := &file_exchange_proto_msgTypes[15]
+	mi := &file_exchange_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1319,7 +1450,7 @@ func (x *QueryOrder) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryOrder.ProtoReflect.Descriptor instead.
 func (*QueryOrder) Descriptor() ([]byte, []int) {
-	return file_exchange_proto_rawDescGZIP(), []int{15}
+	return file_exchange_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *QueryOrder) GetOrderID() int64 {
@@ -1350,7 +1481,7 @@ type QueryOrderList struct {
 func (x *QueryOrderList) Reset() {
 	*x = QueryOrderList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exchange_proto_msgTypes[16]
+		mi := &file_exchange_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1363,7 +1494,7 @@ func (x *QueryOrderList) String() string {
 func (*QueryOrderList) ProtoMessage() {}
 
 func (x *QueryOrderList) ProtoReflect() protoreflect.Message {
-	mi := &file_exchange_proto_msgTypes[16]
+	mi := &file_exchange_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1376,7 +1507,7 @@ func (x *QueryOrderList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryOrderList.ProtoReflect.Descriptor instead.
 func (*QueryOrderList) Descriptor() ([]byte, []int) {
-	return file_exchange_proto_rawDescGZIP(), []int{16}
+	return file_exchange_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *QueryOrderList) GetStatus() int32 {
@@ -1414,6 +1545,82 @@ func (x *QueryOrderList) GetDirection() int32 {
 	return 0
 }
 
+//根据地址查询用户未触发的条件单
+type QueryConditionOrderList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//用户地址信息，必填
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// 主键索引
+	PrimaryKey string `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	//单页返回多少条记录，默认返回10条,为了系统安全最多单次只能返回20条
+	Count int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// 0降序，1升序，默认降序
+	Direction int32 `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+}
+
+func (x *QueryConditionOrderList) Reset() {
+	*x = QueryConditionOrderList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchange_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryConditionOrderList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryConditionOrderList) ProtoMessage() {}
+
+func (x *QueryConditionOrderList) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryConditionOrderList.ProtoReflect.Descriptor instead.
+func (*QueryConditionOrderList) Descriptor() ([]byte, []int) {
+	return file_exchange_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *QueryConditionOrderList) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *QueryConditionOrderList) GetPrimaryKey() string {
+	if x != nil {
+		return x.PrimaryKey
+	}
+	return ""
+}
+
+func (x *QueryConditionOrderList) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *QueryConditionOrderList) GetDirection() int32 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
 //订单列表
 type OrderList struct {
 	state         protoimpl.MessageState
@@ -1427,7 +1634,7 @@ type OrderList struct {
 func (x *OrderList) Reset() {
 	*x = OrderList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exchange_proto_msgTypes[17]
+		mi := &file_exchange_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1440,7 +1647,7 @@ func (x *OrderList) String() string {
 func (*OrderList) ProtoMessage() {}
 
 func (x *OrderList) ProtoReflect() protoreflect.Message {
-	mi := &file_exchange_proto_msgTypes[17]
+	mi := &file_exchange_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1453,7 +1660,7 @@ func (x *OrderList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderList.ProtoReflect.Descriptor instead.
 func (*OrderList) Descriptor() ([]byte, []int) {
-	return file_exchange_proto_rawDescGZIP(), []int{17}
+	return file_exchange_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *OrderList) GetList() []*Order {
@@ -1470,34 +1677,43 @@ func (x *OrderList) GetPrimaryKey() string {
 	return ""
 }
 
-// exchange执行票据日志
-type ReceiptExchange struct {
+//查询K线
+type QueryKLine struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Order       *Order   `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
-	MatchOrders []*Order `protobuf:"bytes,2,rep,name=matchOrders,proto3" json:"matchOrders,omitempty"`
-	Index       int64    `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	//资产1
+	LeftAsset *Asset `protobuf:"bytes,1,opt,name=leftAsset,proto3" json:"leftAsset,omitempty"`
+	//资产2
+	RightAsset *Asset `protobuf:"bytes,2,opt,name=rightAsset,proto3" json:"rightAsset,omitempty"`
+	//周期(秒)，支持60(1m)，300(5m)，3600(1h)，86400(1d)
+	Period int32 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	// 主键索引
+	PrimaryKey string `protobuf:"bytes,4,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	//单页返回多少条记录，默认返回10条,为了系统安全最多单次只能返回20条
+	Count int32 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	// 0降序，1升序，默认降序
+	Direction int32 `protobuf:"varint,6,opt,name=direction,proto3" json:"direction,omitempty"`
 }
 
-func (x *ReceiptExchange) Reset() {
-	*x = ReceiptExchange{}
+func (x *QueryKLine) Reset() {
+	*x = QueryKLine{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exchange_proto_msgTypes[18]
+		mi := &file_exchange_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReceiptExchange) String() string {
+func (x *QueryKLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceiptExchange) ProtoMessage() {}
+func (*QueryKLine) ProtoMessage() {}
 
-func (x *ReceiptExchange) ProtoReflect() protoreflect.Message {
-	mi := &file_exchange_proto_msgTypes[18]
+func (x *QueryKLine) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1508,39 +1724,516 @@ func (x *ReceiptExchange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceiptExchange.ProtoReflect.Descriptor instead.
-func (*ReceiptExchange) Descriptor() ([]byte, []int) {
-	return file_exchange_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use QueryKLine.ProtoReflect.Descriptor instead.
+func (*QueryKLine) Descriptor() ([]byte, []int) {
+	return file_exchange_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ReceiptExchange) GetOrder() *Order {
+func (x *QueryKLine) GetLeftAsset() *Asset {
 	if x != nil {
-		return x.Order
+		return x.LeftAsset
 	}
 	return nil
 }
 
-func (x *ReceiptExchange) GetMatchOrders() []*Order {
+func (x *QueryKLine) GetRightAsset() *Asset {
 	if x != nil {
-		return x.MatchOrders
+		return x.RightAsset
 	}
 	return nil
 }
 
-func (x *ReceiptExchange) GetIndex() int64 {
+func (x *QueryKLine) GetPeriod() int32 {
 	if x != nil {
-		return x.Index
+		return x.Period
 	}
 	return 0
 }
 
-type ReceiptExchangeBind struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//交易地址
-	ExchangeAddress string `protobuf:"bytes,1,opt,name=exchangeAddress,proto3" json:"exchangeAddress,omitempty"`
+func (x *QueryKLine) GetPrimaryKey() string {
+	if x != nil {
+		return x.PrimaryKey
+	}
+	return ""
+}
+
+func (x *QueryKLine) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *QueryKLine) GetDirection() int32 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+// K线
+type KLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//资产1
+	LeftAsset *Asset `protobuf:"bytes,1,opt,name=leftAsset,proto3" json:"leftAsset,omitempty"`
+	//资产2
+	RightAsset *Asset `protobuf:"bytes,2,opt,name=rightAsset,proto3" json:"rightAsset,omitempty"`
+	//周期(秒)
+	Period int32 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	//周期开始时间
+	Time int64 `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	//开盘价
+	Open int64 `protobuf:"varint,5,opt,name=open,proto3" json:"open,omitempty"`
+	//最高价
+	High int64 `protobuf:"varint,6,opt,name=high,proto3" json:"high,omitempty"`
+	//最低价
+	Low int64 `protobuf:"varint,7,opt,name=low,proto3" json:"low,omitempty"`
+	//收盘价
+	Close int64 `protobuf:"varint,8,opt,name=close,proto3" json:"close,omitempty"`
+	//成交量(leftAsset)
+	Volume int64 `protobuf:"varint,9,opt,name=volume,proto3" json:"volume,omitempty"`
+	//成交额(rightAsset)
+	Turnover int64 `protobuf:"varint,10,opt,name=turnover,proto3" json:"turnover,omitempty"`
+}
+
+func (x *KLine) Reset() {
+	*x = KLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchange_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KLine) ProtoMessage() {}
+
+func (x *KLine) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KLine.ProtoReflect.Descriptor instead.
+func (*KLine) Descriptor() ([]byte, []int) {
+	return file_exchange_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *KLine) GetLeftAsset() *Asset {
+	if x != nil {
+		return x.LeftAsset
+	}
+	return nil
+}
+
+func (x *KLine) GetRightAsset() *Asset {
+	if x != nil {
+		return x.RightAsset
+	}
+	return nil
+}
+
+func (x *KLine) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *KLine) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *KLine) GetOpen() int64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *KLine) GetHigh() int64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *KLine) GetLow() int64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *KLine) GetClose() int64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
+}
+
+func (x *KLine) GetVolume() int64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *KLine) GetTurnover() int64 {
+	if x != nil {
+		return x.Turnover
+	}
+	return 0
+}
+
+//查询接口返回的K线列表
+type KLineList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List       []*KLine `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	PrimaryKey string   `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+}
+
+func (x *KLineList) Reset() {
+	*x = KLineList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchange_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KLineList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KLineList) ProtoMessage() {}
+
+func (x *KLineList) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KLineList.ProtoReflect.Descriptor instead.
+func (*KLineList) Descriptor() ([]byte, []int) {
+	return file_exchange_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *KLineList) GetList() []*KLine {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *KLineList) GetPrimaryKey() string {
+	if x != nil {
+		return x.PrimaryKey
+	}
+	return ""
+}
+
+//查询24小时行情
+type QueryTicker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//资产1
+	LeftAsset *Asset `protobuf:"bytes,1,opt,name=leftAsset,proto3" json:"leftAsset,omitempty"`
+	//资产2
+	RightAsset *Asset `protobuf:"bytes,2,opt,name=rightAsset,proto3" json:"rightAsset,omitempty"`
+	//统计截止时间，默认为当前时间
+	Time int64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *QueryTicker) Reset() {
+	*x = QueryTicker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchange_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTicker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTicker) ProtoMessage() {}
+
+func (x *QueryTicker) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTicker.ProtoReflect.Descriptor instead.
+func (*QueryTicker) Descriptor() ([]byte, []int) {
+	return file_exchange_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QueryTicker) GetLeftAsset() *Asset {
+	if x != nil {
+		return x.LeftAsset
+	}
+	return nil
+}
+
+func (x *QueryTicker) GetRightAsset() *Asset {
+	if x != nil {
+		return x.RightAsset
+	}
+	return nil
+}
+
+func (x *QueryTicker) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+// 24小时行情
+type Ticker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//资产1
+	LeftAsset *Asset `protobuf:"bytes,1,opt,name=leftAsset,proto3" json:"leftAsset,omitempty"`
+	//资产2
+	RightAsset *Asset `protobuf:"bytes,2,opt,name=rightAsset,proto3" json:"rightAsset,omitempty"`
+	//开盘价
+	Open int64 `protobuf:"varint,3,opt,name=open,proto3" json:"open,omitempty"`
+	//最高价
+	High int64 `protobuf:"varint,4,opt,name=high,proto3" json:"high,omitempty"`
+	//最低价
+	Low int64 `protobuf:"varint,5,opt,name=low,proto3" json:"low,omitempty"`
+	//最新价
+	Close int64 `protobuf:"varint,6,opt,name=close,proto3" json:"close,omitempty"`
+	//成交量(leftAsset)
+	Volume int64 `protobuf:"varint,7,opt,name=volume,proto3" json:"volume,omitempty"`
+	//成交额(rightAsset)
+	Turnover int64 `protobuf:"varint,8,opt,name=turnover,proto3" json:"turnover,omitempty"`
+	//涨跌额
+	Change int64 `protobuf:"varint,9,opt,name=change,proto3" json:"change,omitempty"`
+	//涨跌幅，单位万分之一
+	ChangeRate int64 `protobuf:"varint,10,opt,name=changeRate,proto3" json:"changeRate,omitempty"`
+	//统计截止时间
+	Time int64 `protobuf:"varint,11,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *Ticker) Reset() {
+	*x = Ticker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchange_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ticker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ticker) ProtoMessage() {}
+
+func (x *Ticker) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ticker.ProtoReflect.Descriptor instead.
+func (*Ticker) Descriptor() ([]byte, []int) {
+	return file_exchange_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Ticker) GetLeftAsset() *Asset {
+	if x != nil {
+		return x.LeftAsset
+	}
+	return nil
+}
+
+func (x *Ticker) GetRightAsset() *Asset {
+	if x != nil {
+		return x.RightAsset
+	}
+	return nil
+}
+
+func (x *Ticker) GetOpen() int64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *Ticker) GetHigh() int64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *Ticker) GetLow() int64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *Ticker) GetClose() int64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
+}
+
+func (x *Ticker) GetVolume() int64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *Ticker) GetTurnover() int64 {
+	if x != nil {
+		return x.Turnover
+	}
+	return 0
+}
+
+func (x *Ticker) GetChange() int64 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
+}
+
+func (x *Ticker) GetChangeRate() int64 {
+	if x != nil {
+		return x.ChangeRate
+	}
+	return 0
+}
+
+func (x *Ticker) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+// exchange执行票据日志
+type ReceiptExchange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Order       *Order   `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	MatchOrders []*Order `protobuf:"bytes,2,rep,name=matchOrders,proto3" json:"matchOrders,omitempty"`
+	Index       int64    `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *ReceiptExchange) Reset() {
+	*x = ReceiptExchange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchange_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptExchange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptExchange) ProtoMessage() {}
+
+func (x *ReceiptExchange) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptExchange.ProtoReflect.Descriptor instead.
+func (*ReceiptExchange) Descriptor() ([]byte, []int) {
+	return file_exchange_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReceiptExchange) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *ReceiptExchange) GetMatchOrders() []*Order {
+	if x != nil {
+		return x.MatchOrders
+	}
+	return nil
+}
+
+func (x *ReceiptExchange) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type ReceiptExchangeBind struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//交易地址
+	ExchangeAddress string `protobuf:"bytes,1,opt,name=exchangeAddress,proto3" json:"exchangeAddress,omitempty"`
 	//委托地址
 	NewEntrustAddress string `protobuf:"bytes,2,opt,name=newEntrustAddress,proto3" json:"newEntrustAddress,omitempty"`
 	//委托地址
@@ -1550,7 +2243,7 @@ type ReceiptExchangeBind struct {
 func (x *ReceiptExchangeBind) Reset() {
 	*x = ReceiptExchangeBind{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exchange_proto_msgTypes[19]
+		mi := &file_exchange_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1563,7 +2256,7 @@ func (x *ReceiptExchangeBind) String() string {
 func (*ReceiptExchangeBind) ProtoMessage() {}
 
 func (x *ReceiptExchangeBind) ProtoReflect() protoreflect.Message {
-	mi := &file_exchange_proto_msgTypes[19]
+	mi := &file_exchange_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1576,7 +2269,7 @@ func (x *ReceiptExchangeBind) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptExchangeBind.ProtoReflect.Descriptor instead.
 func (*ReceiptExchangeBind) Descriptor() ([]byte, []int) {
-	return file_exchange_proto_rawDescGZIP(), []int{19}
+	return file_exchange_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ReceiptExchangeBind) GetExchangeAddress() string {
@@ -1605,7 +2298,7 @@ var File_exchange_proto protoreflect.FileDescriptor
 var file_exchange_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x0a, 0x0a, 0x08, 0x45, 0x78, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x22, 0x91, 0x03, 0x0a, 0x0e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x6e, 0x67, 0x65, 0x22, 0xd2, 0x03, 0x0a, 0x0e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
 	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4f,
 	0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70,
 	0x65, 0x73, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52,
@@ -1628,57 +2321,75 @@ var file_exchange_proto_rawDesc = []byte{
 	0x6b, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
 	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x52, 0x65, 0x76,
 	0x6f, 0x6b, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x12, 0x65, 0x6e, 0x74, 0x72,
-	0x75, 0x73, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e,
-	0x0a, 0x02, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x42, 0x07,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x0a, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x52, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x6f, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6f, 0x70, 0x22, 0x8f,
-	0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2a,
-	0x0a, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52,
-	0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x72, 0x69,
-	0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0a, 0x72, 0x69,
-	0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6f, 0x70,
-	0x22, 0x60, 0x0a, 0x0c, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x69, 0x6e, 0x64,
-	0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x6e,
-	0x74, 0x72, 0x75, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x65, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x0c, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x52, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12,
-	0x2c, 0x0a, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x52, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6f,
-	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x61,
-	0x64, 0x64, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x22,
-	0x42, 0x0a, 0x12, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12,
-	0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61,
-	0x64, 0x64, 0x72, 0x22, 0x27, 0x0a, 0x0b, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4f, 0x72, 0x64,
+	0x75, 0x73, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3f,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52,
+	0x0e, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x42,
+	0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x0a, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6f, 0x70, 0x22,
+	0x8f, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x2a, 0x0a, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x72,
+	0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0a, 0x72,
+	0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6f,
+	0x70, 0x22, 0x60, 0x0a, 0x0c, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x69, 0x6e,
+	0x64, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x65,
+	0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x0c, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x12, 0x2c, 0x0a, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x52, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x6f, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72,
+	0x22, 0x42, 0x0a, 0x12, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x22, 0xdc, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x6f, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x74, 0x79, 0x22, 0x27, 0x0a, 0x0b, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4f, 0x72, 0x64,
 	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x22, 0x37, 0x0a, 0x05,
 	0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65, 0x72, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65, 0x72, 0x12, 0x16, 0x0a,
 	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0xa0, 0x04, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0xe1, 0x04, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
 	0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
 	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x0a, 0x6c, 0x69, 0x6d,
 	0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
@@ -1687,116 +2398,191 @@ var file_exchange_proto_rawDesc = []byte{
 	0x0a, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
 	0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x41, 0x56, 0x47, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x41, 0x56, 0x47, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x72,
-	0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x46, 0x65,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
-	0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a,
-	0x0a, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x65, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x18, 0x12, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x42,
-	0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x10, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x2a, 0x0a,
-	0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x09,
-	0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x72, 0x69, 0x67,
-	0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0a, 0x72, 0x69, 0x67,
-	0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61,
-	0x72, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69,
-	0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65,
-	0x70, 0x74, 0x68, 0x22, 0xa5, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65,
-	0x70, 0x74, 0x68, 0x12, 0x2a, 0x0a, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x41, 0x56, 0x47, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x41, 0x56, 0x47, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x46,
+	0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x10, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x2a,
+	0x0a, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x72, 0x69,
+	0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0a, 0x72, 0x69,
+	0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64,
+	0x65, 0x70, 0x74, 0x68, 0x22, 0xa5, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44,
+	0x65, 0x70, 0x74, 0x68, 0x12, 0x2a, 0x0a, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x12, 0x2c, 0x0a, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x52, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x6f, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6f, 0x70, 0x22, 0x59, 0x0a, 0x0f,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74,
+	0x68, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61,
+	0x72, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69,
+	0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x60, 0x0a, 0x0e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x04, 0x62, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x04, 0x62, 0x69, 0x64,
+	0x73, 0x12, 0x26, 0x0a, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65,
+	0x70, 0x74, 0x68, 0x52, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x15, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61,
 	0x73, 0x73, 0x65, 0x74, 0x52, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12,
 	0x2c, 0x0a, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x52, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6f,
-	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6f, 0x70, 0x22, 0x59, 0x0a, 0x0f, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26,
-	0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68,
-	0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72,
-	0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d,
-	0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x60, 0x0a, 0x0e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x04, 0x62, 0x69, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x04, 0x62, 0x69, 0x64, 0x73,
-	0x12, 0x26, 0x0a, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70,
-	0x74, 0x68, 0x52, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x15, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x52, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x2c,
-	0x0a, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x52, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x26, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x18,
-	0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x22, 0x96, 0x01, 0x0a, 0x0e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a,
+	0x74, 0x52, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x0a,
 	0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a,
 	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
 	0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
 	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x4d, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20,
-	0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
-	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79,
-	0x22, 0x7b, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x6d, 0x61, 0x74, 0x63,
-	0x68, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x9b, 0x01,
-	0x0a, 0x13, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x42, 0x69, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x2c, 0x0a, 0x11, 0x6e, 0x65, 0x77, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6e, 0x65, 0x77, 0x45,
-	0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a,
-	0x11, 0x6f, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x6c, 0x64, 0x45, 0x6e, 0x74,
-	0x72, 0x75, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x32, 0x0a, 0x0a, 0x08, 0x65,
-	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x22, 0x26, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x22, 0x96, 0x01, 0x0a, 0x0e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x09,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x22, 0xd2, 0x01, 0x0a, 0x0a,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4b, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x6c, 0x65,
+	0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x09, 0x6c, 0x65, 0x66,
+	0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x91, 0x02, 0x0a, 0x05, 0x4b, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x6c, 0x65,
+	0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x09, 0x6c, 0x65, 0x66,
+	0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x6f, 0x70, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x68, 0x69, 0x67, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6c, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x75, 0x72, 0x6e,
+	0x6f, 0x76, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x75, 0x72, 0x6e,
+	0x6f, 0x76, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x09, 0x4b, 0x4c, 0x69, 0x6e, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x4b, 0x65, 0x79, 0x22, 0x7b, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x52, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x2c,
+	0x0a, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x22, 0xb2, 0x02, 0x0a, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x6c,
+	0x65, 0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x09, 0x6c, 0x65,
+	0x66, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x67,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x68, 0x69, 0x67, 0x68, 0x12, 0x10, 0x0a,
+	0x03, 0x6c, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6c, 0x6f, 0x77, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x7b, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x0b,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x22, 0x9b, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x6e, 0x65, 0x77, 0x45, 0x6e, 0x74, 0x72, 0x75,
+	0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x6e, 0x65, 0x77, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x6f, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f,
+	0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x32, 0x0a, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x0a, 0x5a, 0x08,
+	0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1811,61 +2597,81 @@ func file_exchange_proto_rawDescGZIP() []byte {
 	return file_exchange_proto_rawDescData
 }
 
-var file_exchange_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_exchange_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_exchange_proto_goTypes = []interface{}{
-	(*Exchange)(nil),              // 0: types.Exchange
-	(*ExchangeAction)(nil),        // 1: types.ExchangeAction
-	(*LimitOrder)(nil),            // 2: types.LimitOrder
-	(*MarketOrder)(nil),           // 3: types.MarketOrder
-	(*ExchangeBind)(nil),          // 4: types.ExchangeBind
-	(*EntrustOrder)(nil),          // 5: types.EntrustOrder
-	(*EntrustRevokeOrder)(nil),    // 6: types.EntrustRevokeOrder
-	(*RevokeOrder)(nil),           // 7: types.RevokeOrder
-	(*Asset)(nil),                 // 8: types.asset
-	(*Order)(nil),                 // 9: types.Order
-	(*QueryMarketDepth)(nil),      // 10: types.QueryMarketDepth
-	(*MarketDepth)(nil),           // 11: types.MarketDepth
-	(*MarketDepthList)(nil),       // 12: types.MarketDepthList
-	(*MarketAllDepth)(nil),        // 13: types.MarketAllDepth
-	(*QueryHistoryOrderList)(nil), // 14: types.QueryHistoryOrderList
-	(*QueryOrder)(nil),            // 15: types.QueryOrder
-	(*QueryOrderList)(nil),        // 16: types.QueryOrderList
-	(*OrderList)(nil),             // 17: types.OrderList
-	(*ReceiptExchange)(nil),       // 18: types.ReceiptExchange
-	(*ReceiptExchangeBind)(nil),   // 19: types.ReceiptExchangeBind
+	(*Exchange)(nil),                // 0: types.Exchange
+	(*ExchangeAction)(nil),          // 1: types.ExchangeAction
+	(*LimitOrder)(nil),              // 2: types.LimitOrder
+	(*MarketOrder)(nil),             // 3: types.MarketOrder
+	(*ExchangeBind)(nil),            // 4: types.ExchangeBind
+	(*EntrustOrder)(nil),            // 5: types.EntrustOrder
+	(*EntrustRevokeOrder)(nil),      // 6: types.EntrustRevokeOrder
+	(*ConditionOrder)(nil),          // 7: types.ConditionOrder
+	(*RevokeOrder)(nil),             // 8: types.RevokeOrder
+	(*Asset)(nil),                   // 9: types.asset
+	(*Order)(nil),                   // 10: types.Order
+	(*QueryMarketDepth)(nil),        // 11: types.QueryMarketDepth
+	(*MarketDepth)(nil),             // 12: types.MarketDepth
+	(*MarketDepthList)(nil),         // 13: types.MarketDepthList
+	(*MarketAllDepth)(nil),          // 14: types.MarketAllDepth
+	(*QueryHistoryOrderList)(nil),   // 15: types.QueryHistoryOrderList
+	(*QueryOrder)(nil),              // 16: types.QueryOrder
+	(*QueryOrderList)(nil),          // 17: types.QueryOrderList
+	(*QueryConditionOrderList)(nil), // 18: types.QueryConditionOrderList
+	(*OrderList)(nil),               // 19: types.OrderList
+	(*QueryKLine)(nil),              // 20: types.QueryKLine
+	(*KLine)(nil),                   // 21: types.KLine
+	(*KLineList)(nil),               // 22: types.KLineList
+	(*QueryTicker)(nil),             // 23: types.QueryTicker
+	(*Ticker)(nil),                  // 24: types.Ticker
+	(*ReceiptExchange)(nil),         // 25: types.ReceiptExchange
+	(*ReceiptExchangeBind)(nil),     // 26: types.ReceiptExchangeBind
 }
 var file_exchange_proto_depIdxs = []int32{
 	2,  // 0: types.ExchangeAction.limitOrder:type_name -> types.LimitOrder
 	3,  // 1: types.ExchangeAction.marketOrder:type_name -> types.MarketOrder
-	7,  // 2: types.ExchangeAction.revokeOrder:type_name -> types.RevokeOrder
+	8,  // 2: types.ExchangeAction.revokeOrder:type_name -> types.RevokeOrder
 	4,  // 3: types.ExchangeAction.exchangeBind:type_name -> types.ExchangeBind
 	5,  // 4: types.ExchangeAction.entrustOrder:type_name -> types.EntrustOrder
 	6,  // 5: types.ExchangeAction.entrustRevokeOrder:type_name -> types.EntrustRevokeOrder
-	8,  // 6: types.LimitOrder.leftAsset:type_name -> types.asset
-	8,  // 7: types.LimitOrder.rightAsset:type_name -> types.asset
-	8,  // 8: types.MarketOrder.leftAsset:type_name -> types.asset
-	8,  // 9: types.MarketOrder.rightAsset:type_name -> types.asset
-	8,  // 10: types.EntrustOrder.leftAsset:type_name -> types.asset
-	8,  // 11: types.EntrustOrder.rightAsset:type_name -> types.asset
-	2,  // 12: types.Order.limitOrder:type_name -> types.LimitOrder
-	3,  // 13: types.Order.marketOrder:type_name -> types.MarketOrder
-	8,  // 14: types.QueryMarketDepth.leftAsset:type_name -> types.asset
-	8,  // 15: types.QueryMarketDepth.rightAsset:type_name -> types.asset
-	8,  // 16: types.MarketDepth.leftAsset:type_name -> types.asset
-	8,  // 17: types.MarketDepth.rightAsset:type_name -> types.asset
-	11, // 18: types.MarketDepthList.list:type_name -> types.MarketDepth
-	11, // 19: types.MarketAllDepth.bids:type_name -> types.MarketDepth
-	11, // 20: types.MarketAllDepth.asks:type_name -> types.MarketDepth
-	8,  // 21: types.QueryHistoryOrderList.leftAsset:type_name -> types.asset
-	8,  // 22: types.QueryHistoryOrderList.rightAsset:type_name -> types.asset
-	9,  // 23: types.OrderList.list:type_name -> types.Order
-	9,  // 24: types.ReceiptExchange.order:type_name -> types.Order
-	9,  // 25: types.ReceiptExchange.matchOrders:type_name -> types.Order
-	26, // [26:26] is the sub-list for method output_type
-	26, // [26:26] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	7,  // 6: types.ExchangeAction.conditionOrder:type_name -> types.ConditionOrder
+	9,  // 7: types.LimitOrder.leftAsset:type_name -> types.asset
+	9,  // 8: types.LimitOrder.rightAsset:type_name -> types.asset
+	9,  // 9: types.MarketOrder.leftAsset:type_name -> types.asset
+	9,  // 10: types.MarketOrder.rightAsset:type_name -> types.asset
+	9,  // 11: types.EntrustOrder.leftAsset:type_name -> types.asset
+	9,  // 12: types.EntrustOrder.rightAsset:type_name -> types.asset
+	9,  // 13: types.ConditionOrder.leftAsset:type_name -> types.asset
+	9,  // 14: types.ConditionOrder.rightAsset:type_name -> types.asset
+	2,  // 15: types.Order.limitOrder:type_name -> types.LimitOrder
+	3,  // 16: types.Order.marketOrder:type_name -> types.MarketOrder
+	7,  // 17: types.Order.conditionOrder:type_name -> types.ConditionOrder
+	9,  // 18: types.QueryMarketDepth.leftAsset:type_name -> types.asset
+	9,  // 19: types.QueryMarketDepth.rightAsset:type_name -> types.asset
+	9,  // 20: types.MarketDepth.leftAsset:type_name -> types.asset
+	9,  // 21: types.MarketDepth.rightAsset:type_name -> types.asset
+	12, // 22: types.MarketDepthList.list:type_name -> types.MarketDepth
+	12, // 23: types.MarketAllDepth.bids:type_name -> types.MarketDepth
+	12, // 24: types.MarketAllDepth.asks:type_name -> types.MarketDepth
+	9,  // 25: types.QueryHistoryOrderList.leftAsset:type_name -> types.asset
+	9,  // 26: types.QueryHistoryOrderList.rightAsset:type_name -> types.asset
+	10, // 27: types.OrderList.list:type_name -> types.Order
+	9,  // 28: types.QueryKLine.leftAsset:type_name -> types.asset
+	9,  // 29: types.QueryKLine.rightAsset:type_name -> types.asset
+	9,  // 30: types.KLine.leftAsset:type_name -> types.asset
+	9,  // 31: types.KLine.rightAsset:type_name -> types.asset
+	21, // 32: types.KLineList.list:type_name -> types.KLine
+	9,  // 33: types.QueryTicker.leftAsset:type_name -> types.asset
+	9,  // 34: types.QueryTicker.rightAsset:type_name -> types.asset
+	9,  // 35: types.Ticker.leftAsset:type_name -> types.asset
+	9,  // 36: types.Ticker.rightAsset:type_name -> types.asset
+	10, // 37: types.ReceiptExchange.order:type_name -> types.Order
+	10, // 38: types.ReceiptExchange.matchOrders:type_name -> types.Order
+	39, // [39:39] is the sub-list for method output_type
+	39, // [39:39] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_exchange_proto_init() }
@@ -1959,7 +2765,7 @@ func file_exchange_proto_init() {
 			}
 		}
 		file_exchange_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RevokeOrder); i {
+			switch v := v.(*ConditionOrder); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1971,7 +2777,7 @@ func file_exchange_proto_init() {
 			}
 		}
 		file_exchange_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Asset); i {
+			switch v := v.(*RevokeOrder); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1983,7 +2789,7 @@ func file_exchange_proto_init() {
 			}
 		}
 		file_exchange_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Order); i {
+			switch v := v.(*Asset); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1995,7 +2801,7 @@ func file_exchange_proto_init() {
 			}
 		}
 		file_exchange_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryMarketDepth); i {
+			switch v := v.(*Order); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2007,7 +2813,7 @@ func file_exchange_proto_init() {
 			}
 		}
 		file_exchange_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketDepth); i {
+			switch v := v.(*QueryMarketDepth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2019,7 +2825,7 @@ func file_exchange_proto_init() {
 			}
 		}
 		file_exchange_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketDepthList); i {
+			switch v := v.(*MarketDepth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2031,7 +2837,7 @@ func file_exchange_proto_init() {
 			}
 		}
 		file_exchange_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketAllDepth); i {
+			switch v := v.(*MarketDepthList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2043,7 +2849,7 @@ func file_exchange_proto_init() {
 			}
 		}
 		file_exchange_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryHistoryOrderList); i {
+			switch v := v.(*MarketAllDepth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2055,7 +2861,7 @@ func file_exchange_proto_init() {
 			}
 		}
 		file_exchange_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryOrder); i {
+			switch v := v.(*QueryHistoryOrderList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2067,7 +2873,7 @@ func file_exchange_proto_init() {
 			}
 		}
 		file_exchange_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryOrderList); i {
+			switch v := v.(*QueryOrder); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2079,7 +2885,7 @@ func file_exchange_proto_init() {
 			}
 		}
 		file_exchange_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderList); i {
+			switch v := v.(*QueryOrderList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2091,7 +2897,7 @@ func file_exchange_proto_init() {
 			}
 		}
 		file_exchange_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptExchange); i {
+			switch v := v.(*QueryConditionOrderList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2103,6 +2909,90 @@ func file_exchange_proto_init() {
 			}
 		}
 		file_exchange_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exchange_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryKLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exchange_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exchange_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KLineList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exchange_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTicker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exchange_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ticker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exchange_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptExchange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exchange_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReceiptExchangeBind); i {
 			case 0:
 				return &v.state
@@ -2122,10 +3012,12 @@ func file_exchange_proto_init() {
 		(*ExchangeAction_ExchangeBind)(nil),
 		(*ExchangeAction_EntrustOrder)(nil),
 		(*ExchangeAction_EntrustRevokeOrder)(nil),
+		(*ExchangeAction_ConditionOrder)(nil),
 	}
-	file_exchange_proto_msgTypes[9].OneofWrappers = []interface{}{
+	file_exchange_proto_msgTypes[10].OneofWrappers = []interface{}{
 		(*Order_LimitOrder)(nil),
 		(*Order_MarketOrder)(nil),
+		(*Order_ConditionOrder)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2133,7 +3025,7 @@ func file_exchange_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_exchange_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -287,6 +287,8 @@ exchanges = [
 [fork.sub.exchange]
 Enable=0
 ForkFix1=0
+ForkMarketOrder=0
+ForkConditionOrder=0
 ForkParamV1 = 0
 ForkParamV2 = 0
 ForkParamV3 = 0
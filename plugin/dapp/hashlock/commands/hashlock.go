@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/33cn/chain33/common"
 	cmdtypes "github.com/33cn/chain33/system/dapp/commands/types"
 	"github.com/pkg/errors"
 
@@ -31,6 +32,7 @@ func HashlockCmd() *cobra.Command {
 		HashlockLockCmd(),
 		HashlockUnlockCmd(),
 		HashlockSendCmd(),
+		HashlockListCmd(),
 	)
 
 	return cmd
@@ -58,6 +60,8 @@ func addHashlockLockCmdFlags(cmd *cobra.Command) {
 	cmd.MarkFlagRequired("to")
 	cmd.Flags().StringP("return", "r", "", "return address")
 	cmd.MarkFlagRequired("return")
+	cmd.Flags().StringP("execer", "e", "", "asset execer, default coins")
+	cmd.Flags().StringP("symbol", "y", "", "asset symbol, default coins")
 
 	cmd.Flags().Float64P("fee", "f", 0.0, "transaction fee")
 }
@@ -69,6 +73,8 @@ func hashlockLockCmd(cmd *cobra.Command, args []string) {
 	returnAddr, _ := cmd.Flags().GetString("return")
 	delay, _ := cmd.Flags().GetInt64("delay")
 	amount, _ := cmd.Flags().GetFloat64("amount")
+	execer, _ := cmd.Flags().GetString("execer")
+	symbol, _ := cmd.Flags().GetString("symbol")
 
 	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
 	if err != nil {
@@ -82,7 +88,11 @@ func hashlockLockCmd(cmd *cobra.Command, args []string) {
 		fmt.Println("delay period changed to 60")
 		delay = 60
 	}
-	amountInt64, err := types.FormatFloatDisplay2Value(amount, cfg.CoinPrecision)
+	precision := cfg.CoinPrecision
+	if execer == "token" {
+		precision = cfg.TokenPrecision
+	}
+	amountInt64, err := types.FormatFloatDisplay2Value(amount, precision)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "FormatFloatDisplay2Value.amount"))
 		return
@@ -101,6 +111,8 @@ func hashlockLockCmd(cmd *cobra.Command, args []string) {
 		Time:       delay,
 		ToAddr:     toAddr,
 		ReturnAddr: returnAddr,
+		Execer:     execer,
+		Symbol:     symbol,
 		Fee:        feeInt64,
 	}
 
@@ -221,3 +233,58 @@ func hashlockSendCmd(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", paramWithExecAction, nil)
 	ctx.RunWithoutMarshal()
 }
+
+// HashlockListCmd list hashlocks
+func HashlockListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List hashlocks by hash, sender or recipient",
+		Run:   hashlockList,
+	}
+	addHashlockListFlags(cmd)
+	return cmd
+}
+
+func addHashlockListFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("hash", "x", "", "hash of the secret, hex format")
+	cmd.Flags().StringP("sender", "s", "", "sender(return) address")
+	cmd.Flags().StringP("recipient", "r", "", "recipient(to) address")
+	cmd.Flags().Int32P("status", "t", 0, "status, 1:locked 2:unlocked 3:sent, default locked")
+	cmd.Flags().StringP("primary", "p", "", "primary key to list from")
+	cmd.Flags().Int32P("count", "c", 10, "count")
+	cmd.Flags().Int32P("direction", "d", 0, "direction, 0:desc 1:asc")
+}
+
+func hashlockList(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	hash, _ := cmd.Flags().GetString("hash")
+	sender, _ := cmd.Flags().GetString("sender")
+	recipient, _ := cmd.Flags().GetString("recipient")
+	status, _ := cmd.Flags().GetInt32("status")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+
+	hashBytes, err := common.FromHex(hash)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "hash"))
+		return
+	}
+	req := &pty.ReqHashlockList{
+		Hash:       hashBytes,
+		Sender:     sender,
+		Recipient:  recipient,
+		Status:     status,
+		PrimaryKey: primary,
+		Count:      count,
+		Direction:  direction,
+	}
+	params := rpctypes.Query4Jrpc{
+		Execer:   pty.HashlockX,
+		FuncName: "ListHashlocks",
+		Payload:  types.MustPBToJSON(req),
+	}
+	var res pty.ReplyHashlockList
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
@@ -0,0 +1,107 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	pty "github.com/33cn/plugin/plugin/dapp/hashlock/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashlockAsset(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	InitExecType()
+	h := newHashlock().(*Hashlock)
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	api, _ := client.New(q.Client(), nil)
+	h.SetAPI(api)
+	_, _, stateDB := util.CreateTestDB()
+	_, _, localDB := util.CreateTestDB()
+	h.SetStateDB(stateDB)
+	h.SetLocalDB(localDB)
+	h.SetEnv(10, 1539918074, 1)
+
+	sender, senderPriv := genaddress()
+	recipient, recipientPriv := genaddress()
+	execAddr := address.ExecAddress(pty.HashlockX)
+	tokenAcc, err := account.NewAccountDB(cfg, "token", "TEST", stateDB)
+	assert.Nil(t, err)
+	acc := tokenAcc.LoadExecAccount(sender, execAddr)
+	acc.Balance = 100
+	tokenAcc.SaveExecAccount(execAddr, acc)
+
+	secret := []byte("hashlock asset secret")
+	exec := func(action *pty.HashlockAction, priv crypto.PrivKey) (*types.Receipt, *types.Transaction, error) {
+		tx := &types.Transaction{Execer: []byte(pty.HashlockX), Payload: types.Encode(action), Fee: 1e6, To: execAddr}
+		tx, err := types.FormatTx(cfg, pty.HashlockX, tx)
+		assert.Nil(t, err)
+		tx.Sign(types.SECP256K1, priv)
+		receipt, err := h.Exec(tx, 0)
+		return receipt, tx, err
+	}
+	execLocal := func(tx *types.Transaction, receipt *types.Receipt, isAdd bool) {
+		receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+		var set *types.LocalDBSet
+		if isAdd {
+			set, err = h.ExecLocal(tx, receiptData, 0)
+		} else {
+			set, err = h.ExecDelLocal(tx, receiptData, 0)
+		}
+		assert.Nil(t, err)
+		for _, kv := range set.KV {
+			assert.Nil(t, localDB.Set(kv.Key, kv.Value))
+		}
+	}
+	list := func(req *pty.ReqHashlockList) []*pty.Hashlock {
+		reply, err := h.Query_ListHashlocks(req)
+		if err == types.ErrNotFound {
+			return nil
+		}
+		assert.Nil(t, err)
+		return reply.(*pty.ReplyHashlockList).Hashlocks
+	}
+
+	// 只指定execer是非法的资产
+	lock := &pty.HashlockLock{Amount: 90, Time: 70, Hash: common.Sha256(secret), ToAddress: recipient, ReturnAddress: sender, Execer: "token"}
+	action := &pty.HashlockAction{Value: &pty.HashlockAction_Hlock{Hlock: lock}, Ty: pty.HashlockActionLock}
+	_, _, err = exec(action, senderPriv)
+	assert.Equal(t, pty.ErrHashlockAsset, err)
+
+	lock.Symbol = "TEST"
+	receipt, tx, err := exec(action, senderPriv)
+	assert.Nil(t, err)
+	execLocal(tx, receipt, true)
+	assert.Equal(t, int64(90), tokenAcc.LoadExecAccount(sender, execAddr).Frozen)
+
+	locks := list(&pty.ReqHashlockList{Recipient: recipient})
+	assert.Equal(t, 1, len(locks))
+	assert.Equal(t, "TEST", locks[0].Symbol)
+	assert.Equal(t, 1, len(list(&pty.ReqHashlockList{Sender: sender})))
+	assert.Equal(t, 1, len(list(&pty.ReqHashlockList{Hash: common.Sha256(secret)})))
+
+	send := &pty.HashlockAction{Value: &pty.HashlockAction_Hsend{Hsend: &pty.HashlockSend{Secret: secret}}, Ty: pty.HashlockActionSend}
+	receipt, tx, err = exec(send, recipientPriv)
+	assert.Nil(t, err)
+	execLocal(tx, receipt, true)
+	assert.Equal(t, int64(0), tokenAcc.LoadExecAccount(sender, execAddr).Frozen)
+	assert.Equal(t, int64(90), tokenAcc.LoadExecAccount(recipient, execAddr).Balance)
+	assert.Equal(t, 0, len(list(&pty.ReqHashlockList{Recipient: recipient})))
+	assert.Equal(t, 1, len(list(&pty.ReqHashlockList{Recipient: recipient, Status: hashlockSent})))
+
+	// 回滚之后恢复锁定状态
+	execLocal(tx, receipt, false)
+	assert.Equal(t, 1, len(list(&pty.ReqHashlockList{Sender: sender})))
+	assert.Equal(t, 0, len(list(&pty.ReqHashlockList{Sender: sender, Status: hashlockSent})))
+}
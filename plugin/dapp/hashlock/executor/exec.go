@@ -35,6 +35,16 @@ func (h *Hashlock) Exec_Hlock(hlock *pty.HashlockLock, tx *types.Transaction, in
 		clog.Warn("exec hashlock time not enough")
 		return nil, pty.ErrHashlockTime
 	}
+	if hlock.Execer != "" || hlock.Symbol != "" {
+		cfg := h.GetAPI().GetConfig()
+		if !cfg.IsDappFork(h.GetHeight(), pty.HashlockX, pty.ForkHashlockAssetX) {
+			return nil, types.ErrActionNotSupport
+		}
+		if hlock.Execer == "" || hlock.Symbol == "" {
+			clog.Warn("exec hashlock asset", "execer", hlock.Execer, "symbol", hlock.Symbol)
+			return nil, pty.ErrHashlockAsset
+		}
+	}
 	actiondb := NewAction(h, tx, drivers.ExecAddress(string(tx.Execer)))
 	return actiondb.Hashlocklock(hlock)
 }
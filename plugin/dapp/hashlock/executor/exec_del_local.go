@@ -17,7 +17,11 @@ func (h *Hashlock) ExecDelLocal_Hlock(hlock *pty.HashlockLock, tx *types.Transac
 	if err != nil {
 		return nil, err
 	}
-	return &types.LocalDBSet{KV: []*types.KeyValue{kv}}, nil
+	kvs, err := h.updateHashlockTable(receipt, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: append([]*types.KeyValue{kv}, kvs...)}, nil
 }
 
 // ExecDelLocal_Hsend Action
@@ -27,7 +31,11 @@ func (h *Hashlock) ExecDelLocal_Hsend(hsend *pty.HashlockSend, tx *types.Transac
 	if err != nil {
 		return nil, err
 	}
-	return &types.LocalDBSet{KV: []*types.KeyValue{kv}}, nil
+	kvs, err := h.updateHashlockTable(receipt, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: append([]*types.KeyValue{kv}, kvs...)}, nil
 }
 
 // ExecDelLocal_Hunlock Action
@@ -37,5 +45,9 @@ func (h *Hashlock) ExecDelLocal_Hunlock(hunlock *pty.HashlockUnlock, tx *types.T
 	if err != nil {
 		return nil, err
 	}
-	return &types.LocalDBSet{KV: []*types.KeyValue{kv}}, nil
+	kvs, err := h.updateHashlockTable(receipt, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: append([]*types.KeyValue{kv}, kvs...)}, nil
 }
@@ -18,7 +18,11 @@ func (h *Hashlock) ExecLocal_Hlock(hlock *pty.HashlockLock, tx *types.Transactio
 	if err != nil {
 		return nil, err
 	}
-	return &types.LocalDBSet{KV: []*types.KeyValue{kv}}, nil
+	kvs, err := h.updateHashlockTable(receipt, true)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: append([]*types.KeyValue{kv}, kvs...)}, nil
 }
 
 // ExecLocal_Hsend Action
@@ -29,7 +33,11 @@ func (h *Hashlock) ExecLocal_Hsend(hsend *pty.HashlockSend, tx *types.Transactio
 	if err != nil {
 		return nil, err
 	}
-	return &types.LocalDBSet{KV: []*types.KeyValue{kv}}, nil
+	kvs, err := h.updateHashlockTable(receipt, true)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: append([]*types.KeyValue{kv}, kvs...)}, nil
 }
 
 // ExecLocal_Hunlock Action
@@ -40,5 +48,9 @@ func (h *Hashlock) ExecLocal_Hunlock(hunlock *pty.HashlockUnlock, tx *types.Tran
 	if err != nil {
 		return nil, err
 	}
-	return &types.LocalDBSet{KV: []*types.KeyValue{kv}}, nil
+	kvs, err := h.updateHashlockTable(receipt, true)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: append([]*types.KeyValue{kv}, kvs...)}, nil
 }
@@ -52,6 +52,13 @@ func (h *DB) GetKVSet() (kvset []*types.KeyValue) {
 	return kvset
 }
 
+// GetReceiptLog 记录hashlock变化前后的状态，prev为nil表示新建
+func (h *DB) GetReceiptLog(ty int32, prev *pty.Hashlock) *types.ReceiptLog {
+	current := types.Clone(&h.Hashlock).(*pty.Hashlock)
+	r := &pty.ReceiptHashlock{Prev: prev, Current: current}
+	return &types.ReceiptLog{Ty: ty, Log: types.Encode(r)}
+}
+
 // Save KV
 func (h *DB) Save(db dbm.KV) {
 	set := h.GetKVSet()
@@ -86,6 +93,19 @@ func NewAction(h *Hashlock, tx *types.Transaction, execaddr string) *Action {
 	return &Action{h.GetCoinsAccount(), h.GetStateDB(), hash, fromaddr, h.GetBlockTime(), h.GetHeight(), execaddr, h.GetAPI()}
 }
 
+//锁定的资产所在的账户，没有指定资产时使用coins账户
+func (action *Action) assetAccount(hashlock *pty.Hashlock) (*account.DB, error) {
+	if hashlock.Execer == "" && hashlock.Symbol == "" {
+		return action.coinsAccount, nil
+	}
+	acc, err := account.NewAccountDB(action.api.GetConfig(), hashlock.Execer, hashlock.Symbol, action.db)
+	if err != nil {
+		hlog.Error("assetAccount", "execer", hashlock.Execer, "symbol", hashlock.Symbol, "err", err)
+		return nil, err
+	}
+	return acc, nil
+}
+
 // Hashlocklock Action
 func (action *Action) Hashlocklock(hlock *pty.HashlockLock) (*types.Receipt, error) {
 
@@ -105,8 +125,14 @@ func (action *Action) Hashlocklock(hlock *pty.HashlockLock) (*types.Receipt, err
 	}
 
 	h := NewDB(hlock.Hash, action.fromaddr, hlock.ToAddress, action.blocktime, hlock.Amount, hlock.Time)
+	h.Execer = hlock.Execer
+	h.Symbol = hlock.Symbol
+	acc, err := action.assetAccount(&h.Hashlock)
+	if err != nil {
+		return nil, err
+	}
 	//冻结子账户资金
-	receipt, err := action.coinsAccount.ExecFrozen(action.fromaddr, action.execaddr, hlock.Amount)
+	receipt, err := acc.ExecFrozen(action.fromaddr, action.execaddr, hlock.Amount)
 
 	if err != nil {
 		hlog.Error("Hashlocklock.Frozen", "addr", action.fromaddr, "execaddr", action.execaddr, "amount", hlock.Amount)
@@ -116,8 +142,8 @@ func (action *Action) Hashlocklock(hlock *pty.HashlockLock) (*types.Receipt, err
 	h.Save(action.db)
 	logs = append(logs, receipt.Logs...)
 	kv = append(kv, receipt.KV...)
-	//logs = append(logs, h.GetReceiptLog())
 	kv = append(kv, h.GetKVSet()...)
+	logs = append(logs, h.GetReceiptLog(pty.TyLogHashlockLock, nil))
 
 	receipt = &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}
 	return receipt, nil
@@ -152,7 +178,11 @@ func (action *Action) Hashlockunlock(unlock *pty.HashlockUnlock) (*types.Receipt
 
 	//different with typedef in C
 	h := &DB{*hash}
-	receipt, errR := action.coinsAccount.ExecActive(h.ReturnAddress, action.execaddr, h.Amount)
+	acc, err := action.assetAccount(hash)
+	if err != nil {
+		return nil, err
+	}
+	receipt, errR := acc.ExecActive(h.ReturnAddress, action.execaddr, h.Amount)
 	if errR != nil {
 		hlog.Error("ExecActive error", "ReturnAddress", h.ReturnAddress, "execaddr", action.execaddr, "amount", h.Amount)
 		return nil, errR
@@ -162,8 +192,8 @@ func (action *Action) Hashlockunlock(unlock *pty.HashlockUnlock) (*types.Receipt
 	h.Save(action.db)
 	logs = append(logs, receipt.Logs...)
 	kv = append(kv, receipt.KV...)
-	//logs = append(logs, t.GetReceiptLog())
 	kv = append(kv, h.GetKVSet()...)
+	logs = append(logs, h.GetReceiptLog(pty.TyLogHashlockUnlock, hash))
 
 	receipt = &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}
 	return receipt, nil
@@ -198,7 +228,11 @@ func (action *Action) Hashlocksend(send *pty.HashlockSend) (*types.Receipt, erro
 
 	//different with typedef in C
 	h := &DB{*hash}
-	receipt, errR := action.coinsAccount.ExecTransferFrozen(h.ReturnAddress, h.ToAddress, action.execaddr, h.Amount)
+	acc, err := action.assetAccount(hash)
+	if err != nil {
+		return nil, err
+	}
+	receipt, errR := acc.ExecTransferFrozen(h.ReturnAddress, h.ToAddress, action.execaddr, h.Amount)
 	if errR != nil {
 		hlog.Error("ExecTransferFrozen error", "ReturnAddress", h.ReturnAddress, "ToAddress", h.ToAddress, "execaddr", action.execaddr, "amount", h.Amount)
 		return nil, errR
@@ -207,8 +241,8 @@ func (action *Action) Hashlocksend(send *pty.HashlockSend) (*types.Receipt, erro
 	h.Save(action.db)
 	logs = append(logs, receipt.Logs...)
 	kv = append(kv, receipt.KV...)
-	//logs = append(logs, t.GetReceiptLog())
 	kv = append(kv, h.GetKVSet()...)
+	logs = append(logs, h.GetReceiptLog(pty.TyLogHashlockSend, hash))
 
 	receipt = &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}
 	return receipt, nil
@@ -4,7 +4,14 @@
 
 package executor
 
-import "github.com/33cn/chain33/types"
+import (
+	"encoding/hex"
+
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/hashlock/types"
+)
+
+const listMaxCount = 100
 
 // Query_GetHashlocKById get hashlock instance
 func (h *Hashlock) Query_GetHashlocKById(in []byte) (types.Message, error) {
@@ -12,3 +19,50 @@ func (h *Hashlock) Query_GetHashlocKById(in []byte) (types.Message, error) {
 	clog.Error("Query action")
 	return h.GetTxsByHashlockID(in, differTime)
 }
+
+// Query_ListHashlocks 按照hash，锁定方或者接收方查询hashlock，默认只返回锁定中的记录
+func (h *Hashlock) Query_ListHashlocks(in *pty.ReqHashlockList) (types.Message, error) {
+	if in == nil {
+		return nil, types.ErrInvalidParam
+	}
+	tab := NewHashlockTable(h.GetLocalDB())
+	if len(in.Hash) > 0 {
+		row, err := tab.GetData([]byte(hex.EncodeToString(in.Hash)))
+		if err != nil {
+			return nil, err
+		}
+		return &pty.ReplyHashlockList{Hashlocks: []*pty.Hashlock{row.Data.(*pty.Hashlock)}}, nil
+	}
+
+	status := in.Status
+	if status == 0 {
+		status = hashlockLocked
+	}
+	var indexName string
+	var prefix []byte
+	if in.Sender != "" {
+		indexName, prefix = hashlockTableSender, hashlockIndex(in.Sender, status)
+	} else if in.Recipient != "" {
+		indexName, prefix = hashlockTableRecv, hashlockIndex(in.Recipient, status)
+	} else {
+		return nil, types.ErrInvalidParam
+	}
+	count := in.Count
+	if count <= 0 || count > listMaxCount {
+		count = listMaxCount
+	}
+	var primaryKey []byte
+	if in.PrimaryKey != "" {
+		primaryKey = []byte(in.PrimaryKey)
+	}
+	rows, err := tab.ListIndex(indexName, prefix, primaryKey, count, in.Direction)
+	if err != nil {
+		clog.Error("Query_ListHashlocks", "index", indexName, "prefix", string(prefix), "err", err)
+		return nil, err
+	}
+	reply := &pty.ReplyHashlockList{}
+	for _, row := range rows {
+		reply.Hashlocks = append(reply.Hashlocks, row.Data.(*pty.Hashlock))
+	}
+	return reply, nil
+}
@@ -0,0 +1,113 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/db/table"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/hashlock/types"
+)
+
+/*
+ * hashlock 本地索引表：
+ * 主键为hash的十六进制，按照 锁定方:状态 和 接收方:状态 建立索引，
+ * 方便交易对手查询锁定中的hashlock
+ */
+
+const (
+	hashlockTablePrimary = "hash"
+	hashlockTableSender  = "sender"
+	hashlockTableRecv    = "recipient"
+)
+
+var hashlockTableOpt = &table.Option{
+	Prefix:  "LODB-hashlock",
+	Name:    "lock",
+	Primary: hashlockTablePrimary,
+	Index:   []string{hashlockTableSender, hashlockTableRecv},
+}
+
+//NewHashlockTable 新建hashlock表
+func NewHashlockTable(kvdb db.KV) *table.Table {
+	rowmeta := NewHashlockRow()
+	t, err := table.NewTable(rowmeta, kvdb, hashlockTableOpt)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+//HashlockRow table meta 结构
+type HashlockRow struct {
+	*pty.Hashlock
+}
+
+//NewHashlockRow 新建一个meta 结构
+func NewHashlockRow() *HashlockRow {
+	return &HashlockRow{Hashlock: &pty.Hashlock{}}
+}
+
+//CreateRow 新建数据行
+func (r *HashlockRow) CreateRow() *table.Row {
+	return &table.Row{Data: &pty.Hashlock{}}
+}
+
+//SetPayload 设置数据
+func (r *HashlockRow) SetPayload(data types.Message) error {
+	if d, ok := data.(*pty.Hashlock); ok {
+		r.Hashlock = d
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+//Get 按照indexName 查询 indexValue
+func (r *HashlockRow) Get(key string) ([]byte, error) {
+	switch key {
+	case hashlockTablePrimary:
+		return []byte(hex.EncodeToString(r.HashlockId)), nil
+	case hashlockTableSender:
+		return hashlockIndex(r.ReturnAddress, r.Status), nil
+	case hashlockTableRecv:
+		return hashlockIndex(r.ToAddress, r.Status), nil
+	}
+	return nil, types.ErrNotFound
+}
+
+func hashlockIndex(addr string, status int32) []byte {
+	return []byte(fmt.Sprintf("%s:%d", addr, status))
+}
+
+//根据回执更新hashlock表，回滚时删除新建的记录或者恢复之前的状态
+func (h *Hashlock) updateHashlockTable(receipt *types.ReceiptData, isAdd bool) ([]*types.KeyValue, error) {
+	tab := NewHashlockTable(h.GetLocalDB())
+	for _, log := range receipt.Logs {
+		if log.Ty != pty.TyLogHashlockLock && log.Ty != pty.TyLogHashlockSend && log.Ty != pty.TyLogHashlockUnlock {
+			continue
+		}
+		var r pty.ReceiptHashlock
+		if err := types.Decode(log.Log, &r); err != nil {
+			return nil, err
+		}
+		var err error
+		switch {
+		case isAdd:
+			err = tab.Replace(r.Current)
+		case r.Prev == nil:
+			err = tab.Del([]byte(hex.EncodeToString(r.Current.HashlockId)))
+		default:
+			err = tab.Replace(r.Prev)
+		}
+		if err != nil {
+			clog.Error("updateHashlockTable", "isAdd", isAdd, "logTy", log.Ty, "err", err)
+			return nil, err
+		}
+	}
+	return tab.Save()
+}
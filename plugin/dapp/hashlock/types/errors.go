@@ -16,4 +16,5 @@ var (
 	ErrHashlockTime         = errors.New("ErrHashlockTime")
 	ErrHashlockReapeathash  = errors.New("ErrHashlockReapeathash")
 	ErrHashlockSendAddress  = errors.New("ErrHashlockSendAddress")
+	ErrHashlockAsset        = errors.New("ErrHashlockAsset")
 )
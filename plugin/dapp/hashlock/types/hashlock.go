@@ -6,6 +6,7 @@ package types
 
 import (
 	"encoding/json"
+	"reflect"
 
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
@@ -27,6 +28,7 @@ func init() {
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(HashlockX, "Enable", 0)
 	cfg.RegisterDappFork(HashlockX, ForkBadRepeatSecretX, 0)
+	cfg.RegisterDappFork(HashlockX, ForkHashlockAssetX, 0)
 }
 
 //InitExecutor ...
@@ -102,7 +104,11 @@ func (hashlock *HashlockType) GetTypeMap() map[string]int32 {
 
 // GetLogMap method
 func (hashlock *HashlockType) GetLogMap() map[int64]*types.LogInfo {
-	return map[int64]*types.LogInfo{}
+	return map[int64]*types.LogInfo{
+		TyLogHashlockLock:   {Ty: reflect.TypeOf(ReceiptHashlock{}), Name: "LogHashlockLock"},
+		TyLogHashlockSend:   {Ty: reflect.TypeOf(ReceiptHashlock{}), Name: "LogHashlockSend"},
+		TyLogHashlockUnlock: {Ty: reflect.TypeOf(ReceiptHashlock{}), Name: "LogHashlockUnlock"},
+	}
 }
 
 // CreateRawHashlockLockTx method
@@ -118,6 +124,8 @@ func CreateRawHashlockLockTx(cfg *types.Chain33Config, parm *HashlockLockTx) (*t
 		Hash:          common.Sha256([]byte(parm.Secret)),
 		ToAddress:     parm.ToAddr,
 		ReturnAddress: parm.ReturnAddr,
+		Execer:        parm.Execer,
+		Symbol:        parm.Symbol,
 	}
 	lock := &HashlockAction{
 		Ty:    HashlockActionLock,
@@ -33,6 +33,8 @@ type Hashlock struct {
 	ReturnAddress string `protobuf:"bytes,5,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
 	Amount        int64  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
 	Frozentime    int64  `protobuf:"varint,7,opt,name=frozentime,proto3" json:"frozentime,omitempty"`
+	Execer        string `protobuf:"bytes,8,opt,name=execer,proto3" json:"execer,omitempty"`
+	Symbol        string `protobuf:"bytes,9,opt,name=symbol,proto3" json:"symbol,omitempty"`
 }
 
 func (x *Hashlock) Reset() {
@@ -116,6 +118,20 @@ func (x *Hashlock) GetFrozentime() int64 {
 	return 0
 }
 
+func (x *Hashlock) GetExecer() string {
+	if x != nil {
+		return x.Execer
+	}
+	return ""
+}
+
+func (x *Hashlock) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
 type HashlockLock struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -126,6 +142,9 @@ type HashlockLock struct {
 	Hash          []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
 	ToAddress     string `protobuf:"bytes,4,opt,name=toAddress,proto3" json:"toAddress,omitempty"`
 	ReturnAddress string `protobuf:"bytes,5,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
+	// 锁定的资产，为空时锁定主链coins
+	Execer string `protobuf:"bytes,6,opt,name=execer,proto3" json:"execer,omitempty"`
+	Symbol string `protobuf:"bytes,7,opt,name=symbol,proto3" json:"symbol,omitempty"`
 }
 
 func (x *HashlockLock) Reset() {
@@ -195,6 +214,20 @@ func (x *HashlockLock) GetReturnAddress() string {
 	return ""
 }
 
+func (x *HashlockLock) GetExecer() string {
+	if x != nil {
+		return x.Execer
+	}
+	return ""
+}
+
+func (x *HashlockLock) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
 type HashlockSend struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -526,11 +559,211 @@ func (*HashlockAction_Hsend) isHashlockAction_Value() {}
 
 func (*HashlockAction_Hunlock) isHashlockAction_Value() {}
 
+// hashlock 状态变化的回执
+type ReceiptHashlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prev    *Hashlock `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current *Hashlock `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+}
+
+func (x *ReceiptHashlock) Reset() {
+	*x = ReceiptHashlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashlock_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptHashlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptHashlock) ProtoMessage() {}
+
+func (x *ReceiptHashlock) ProtoReflect() protoreflect.Message {
+	mi := &file_hashlock_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptHashlock.ProtoReflect.Descriptor instead.
+func (*ReceiptHashlock) Descriptor() ([]byte, []int) {
+	return file_hashlock_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReceiptHashlock) GetPrev() *Hashlock {
+	if x != nil {
+		return x.Prev
+	}
+	return nil
+}
+
+func (x *ReceiptHashlock) GetCurrent() *Hashlock {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+// 按照hash，锁定方或者接收方查询hashlock
+type ReqHashlockList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash      []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Sender    string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// 为0时查询锁定中的hashlock
+	Status     int32  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	PrimaryKey string `protobuf:"bytes,5,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count      int32  `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	Direction  int32  `protobuf:"varint,7,opt,name=direction,proto3" json:"direction,omitempty"`
+}
+
+func (x *ReqHashlockList) Reset() {
+	*x = ReqHashlockList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashlock_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqHashlockList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqHashlockList) ProtoMessage() {}
+
+func (x *ReqHashlockList) ProtoReflect() protoreflect.Message {
+	mi := &file_hashlock_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqHashlockList.ProtoReflect.Descriptor instead.
+func (*ReqHashlockList) Descriptor() ([]byte, []int) {
+	return file_hashlock_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReqHashlockList) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *ReqHashlockList) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *ReqHashlockList) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *ReqHashlockList) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ReqHashlockList) GetPrimaryKey() string {
+	if x != nil {
+		return x.PrimaryKey
+	}
+	return ""
+}
+
+func (x *ReqHashlockList) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ReqHashlockList) GetDirection() int32 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+type ReplyHashlockList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hashlocks []*Hashlock `protobuf:"bytes,1,rep,name=hashlocks,proto3" json:"hashlocks,omitempty"`
+}
+
+func (x *ReplyHashlockList) Reset() {
+	*x = ReplyHashlockList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashlock_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplyHashlockList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyHashlockList) ProtoMessage() {}
+
+func (x *ReplyHashlockList) ProtoReflect() protoreflect.Message {
+	mi := &file_hashlock_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyHashlockList.ProtoReflect.Descriptor instead.
+func (*ReplyHashlockList) Descriptor() ([]byte, []int) {
+	return file_hashlock_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReplyHashlockList) GetHashlocks() []*Hashlock {
+	if x != nil {
+		return x.Hashlocks
+	}
+	return nil
+}
+
 var File_hashlock_proto protoreflect.FileDescriptor
 
 var file_hashlock_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x68, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0xde, 0x01, 0x0a, 0x08, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x8e, 0x02, 0x0a, 0x08, 0x48, 0x61, 0x73, 0x68,
 	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b,
 	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x68, 0x6c, 0x6f,
 	0x63, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
@@ -544,7 +777,10 @@ var file_hashlock_proto_rawDesc = []byte{
 	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
 	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x7a,
 	0x65, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x72,
-	0x6f, 0x7a, 0x65, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x0c, 0x48, 0x61, 0x73,
+	0x6f, 0x7a, 0x65, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x65, 0x63,
+	0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0xc2, 0x01, 0x0a, 0x0c, 0x48, 0x61, 0x73,
 	0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
 	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
 	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
@@ -553,7 +789,10 @@ var file_hashlock_proto_rawDesc = []byte{
 	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f,
 	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72,
 	0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x26, 0x0a,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x78, 0x65, 0x63, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x78, 0x65, 0x63, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x26, 0x0a,
 	0x0c, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a,
 	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73,
 	0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x0d, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f,
@@ -586,8 +825,31 @@ var file_hashlock_proto_rawDesc = []byte{
 	0x73, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
 	0x48, 0x00, 0x52, 0x07, 0x68, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x74,
 	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x61, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x48,
+	0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x23, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x76, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x61,
+	0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x04, 0x70, 0x72, 0x65, 0x76, 0x12, 0x29, 0x0a, 0x07,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x07,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0xc7, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x48,
+	0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x42, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x09, 0x68, 0x61, 0x73, 0x68, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x09, 0x68, 0x61, 0x73, 0x68,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -602,26 +864,32 @@ func file_hashlock_proto_rawDescGZIP() []byte {
 	return file_hashlock_proto_rawDescData
 }
 
-var file_hashlock_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_hashlock_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_hashlock_proto_goTypes = []interface{}{
-	(*Hashlock)(nil),       // 0: types.Hashlock
-	(*HashlockLock)(nil),   // 1: types.HashlockLock
-	(*HashlockSend)(nil),   // 2: types.HashlockSend
-	(*Hashlockquery)(nil),  // 3: types.Hashlockquery
-	(*HashRecv)(nil),       // 4: types.HashRecv
-	(*HashlockUnlock)(nil), // 5: types.HashlockUnlock
-	(*HashlockAction)(nil), // 6: types.HashlockAction
+	(*Hashlock)(nil),          // 0: types.Hashlock
+	(*HashlockLock)(nil),      // 1: types.HashlockLock
+	(*HashlockSend)(nil),      // 2: types.HashlockSend
+	(*Hashlockquery)(nil),     // 3: types.Hashlockquery
+	(*HashRecv)(nil),          // 4: types.HashRecv
+	(*HashlockUnlock)(nil),    // 5: types.HashlockUnlock
+	(*HashlockAction)(nil),    // 6: types.HashlockAction
+	(*ReceiptHashlock)(nil),   // 7: types.ReceiptHashlock
+	(*ReqHashlockList)(nil),   // 8: types.ReqHashlockList
+	(*ReplyHashlockList)(nil), // 9: types.ReplyHashlockList
 }
 var file_hashlock_proto_depIdxs = []int32{
 	3, // 0: types.HashRecv.Information:type_name -> types.Hashlockquery
 	1, // 1: types.HashlockAction.hlock:type_name -> types.HashlockLock
 	2, // 2: types.HashlockAction.hsend:type_name -> types.HashlockSend
 	5, // 3: types.HashlockAction.hunlock:type_name -> types.HashlockUnlock
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0, // 4: types.ReceiptHashlock.prev:type_name -> types.Hashlock
+	0, // 5: types.ReceiptHashlock.current:type_name -> types.Hashlock
+	0, // 6: types.ReplyHashlockList.hashlocks:type_name -> types.Hashlock
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_hashlock_proto_init() }
@@ -714,6 +982,42 @@ func file_hashlock_proto_init() {
 				return nil
 			}
 		}
+		file_hashlock_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptHashlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashlock_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqHashlockList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashlock_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyHashlockList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_hashlock_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*HashlockAction_Hlock)(nil),
@@ -726,7 +1030,7 @@ func file_hashlock_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_hashlock_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -11,6 +11,8 @@ type HashlockLockTx struct {
 	Time       int64  `json:"time"`
 	ToAddr     string `json:"toAddr"`
 	ReturnAddr string `json:"returnAddr"`
+	Execer     string `json:"execer"`
+	Symbol     string `json:"symbol"`
 	Fee        int64  `json:"fee"`
 }
 
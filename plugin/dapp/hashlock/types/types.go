@@ -11,8 +11,16 @@ const (
 	HashlockActionUnlock = 3
 )
 
+// hashlock log
+const (
+	TyLogHashlockLock   = 1101
+	TyLogHashlockSend   = 1102
+	TyLogHashlockUnlock = 1103
+)
+
 // HashlockX name
 var (
 	HashlockX            = "hashlock"
 	ForkBadRepeatSecretX = "ForkBadRepeatSecret"
+	ForkHashlockAssetX   = "ForkHashlockAsset"
 )
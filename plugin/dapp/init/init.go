@@ -26,6 +26,7 @@ import (
 	_ "github.com/33cn/plugin/plugin/dapp/pokerbull"      //auto gen
 	_ "github.com/33cn/plugin/plugin/dapp/privacy"        //auto gen
 	_ "github.com/33cn/plugin/plugin/dapp/qbftNode"       //auto gen
+	_ "github.com/33cn/plugin/plugin/dapp/randbeacon"     //auto gen
 	_ "github.com/33cn/plugin/plugin/dapp/relay"          //auto gen
 	_ "github.com/33cn/plugin/plugin/dapp/retrieve"       //auto gen
 	_ "github.com/33cn/plugin/plugin/dapp/rollup"         //auto gen
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 
 	jsonrpc "github.com/33cn/chain33/rpc/jsonclient"
@@ -160,9 +161,8 @@ func IssuancePriceFeedRawTxCmd() *cobra.Command {
 
 func addIssuancePriceFeedFlags(cmd *cobra.Command) {
 	cmd.Flags().Float64P("price", "p", 0, "price")
-	cmd.MarkFlagRequired("price")
 	cmd.Flags().Uint64P("volume", "v", 0, "volume")
-	cmd.MarkFlagRequired("volume")
+	cmd.Flags().StringP("oracle", "o", "", "oracle price event id (type price, subType exec.symbol/CCNY, result is price*1e4), use the published result as price instead of price/volume")
 }
 
 //IssuancePriceFeed ...
@@ -172,11 +172,20 @@ func IssuancePriceFeed(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	price, _ := cmd.Flags().GetFloat64("price")
 	volume, _ := cmd.Flags().GetUint64("volume")
+	oracleEventID, _ := cmd.Flags().GetString("oracle")
+
+	payload := fmt.Sprintf("{\"price\":[ %f ], \"volume\":[ %d ]}", price, volume)
+	if oracleEventID != "" {
+		payload = fmt.Sprintf("{\"oracleEventId\":\"%s\"}", oracleEventID)
+	} else if price == 0 || volume == 0 {
+		fmt.Fprintln(os.Stderr, "price and volume required if oracle event id not set")
+		return
+	}
 
 	params := &rpctypes.CreateTxIn{
 		Execer:     types.GetExecName(pkt.IssuanceX, paraName),
 		ActionName: "IssuancePriceFeed",
-		Payload:    []byte(fmt.Sprintf("{\"price\":[ %f ], \"volume\":[ %d ]}", price, volume)),
+		Payload:    []byte(payload),
 	}
 
 	var res string
@@ -231,7 +240,9 @@ func IssuanceManageRawTxCmd() *cobra.Command {
 
 func addIssuanceManageFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("addr", "a", "", "addr")
-	cmd.MarkFlagRequired("addr")
+	cmd.Flags().Int32P("feedQuorum", "q", 0, "price feed submissions required for median price, 0 or 1 for single feeder")
+	cmd.Flags().Int64P("feedWindow", "w", 0, "price feed time window in seconds, older submissions are dropped")
+	cmd.Flags().Float64P("feedDeviation", "v", 0, "max deviation ratio from median price, outliers are dropped")
 }
 
 //IssuanceManage ...
@@ -240,11 +251,20 @@ func IssuanceManage(cmd *cobra.Command, args []string) {
 
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	addr, _ := cmd.Flags().GetString("addr")
+	feedQuorum, _ := cmd.Flags().GetInt32("feedQuorum")
+	feedWindow, _ := cmd.Flags().GetInt64("feedWindow")
+	feedDeviation, _ := cmd.Flags().GetFloat64("feedDeviation")
+
+	addrs := "[]"
+	if addr != "" {
+		addrs = fmt.Sprintf("[\"%s\"]", addr)
+	}
 
 	params := &rpctypes.CreateTxIn{
 		Execer:     types.GetExecName(pkt.IssuanceX, paraName),
 		ActionName: "IssuanceManage",
-		Payload:    []byte(fmt.Sprintf("{\"addr\":[\"%s\"]}", addr)),
+		Payload: []byte(fmt.Sprintf("{\"addr\":%s, \"feedQuorum\":%d, \"feedWindow\":%d, \"feedDeviation\":%f}",
+			addrs, feedQuorum, feedWindow, feedDeviation)),
 	}
 
 	var res string
@@ -0,0 +1,110 @@
+package executor
+
+import (
+	"testing"
+
+	pty "github.com/33cn/chain33/system/dapp/manage/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	pkt "github.com/33cn/plugin/plugin/dapp/issuance/types"
+	oracleE "github.com/33cn/plugin/plugin/dapp/oracle/executor"
+	oty "github.com/33cn/plugin/plugin/dapp/oracle/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssuanceFeedQuorum(t *testing.T) {
+	env := initEnv()
+	forkHeight := env.blockHeight + 10
+	env.cfg.SetDappFork(pkt.IssuanceX, pkt.ForkIssuanceFeedQuorum, forkHeight)
+
+	exec := newIssuance().(*Issuance)
+	exec.SetAPI(env.api)
+	exec.SetStateDB(env.db)
+	exec.SetLocalDB(env.kvdb)
+	exec.SetEnv(env.blockHeight+1, env.blockTime+1, env.difficulty)
+	run := func(tx *types.Transaction, privKey string) error {
+		tx.Execer = []byte(pkt.IssuanceX)
+		tx, err := signTx(tx, privKey)
+		assert.Nil(t, err)
+		receipt, err := exec.Exec(tx, int(1))
+		if err != nil {
+			return err
+		}
+		for _, kv := range receipt.KV {
+			env.db.Set(kv.Key, kv.Value)
+		}
+		set, err := exec.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, int(1))
+		assert.Nil(t, err)
+		util.SaveKVList(env.ldb, set.KV)
+		return nil
+	}
+
+	// 三个喂价人
+	item := &types.ConfigItem{Key: pkt.PriceFeedKey, Ty: pty.ConfigItemArrayConfig}
+	item.Value = &types.ConfigItem_Arr{Arr: &types.ArrayConfig{Value: []string{string(Nodes[0]), string(Nodes[1]), string(Nodes[2])}}}
+	env.db.Set([]byte(types.ManageKey(pkt.PriceFeedKey)), types.Encode(item))
+
+	tx, err := pkt.CreateRawIssuanceManageTx(env.cfg, &pkt.IssuanceManageTx{FeedQuorum: 2, FeedWindow: 60, FeedDeviation: 0.1})
+	assert.Nil(t, err)
+	assert.Equal(t, types.ErrNotSupport, run(tx, PrivKeyA))
+
+	exec.SetEnv(forkHeight, env.blockTime+1, env.difficulty)
+	assert.Nil(t, run(tx, PrivKeyA))
+	res, err := exec.Query("IssuanceFeedConfig", types.Encode(&types.ReqNil{}))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), res.(*pkt.IssuanceFeedConfig).Quorum)
+	assert.Equal(t, int64(1000), res.(*pkt.IssuanceFeedConfig).Deviation)
+
+	feed := func(privKey string, price float64) error {
+		tx, err := pkt.CreateRawIssuanceFeedTx(env.cfg, &pkt.IssuanceFeedTx{Price: []float64{price}, Volume: []int64{100}})
+		assert.Nil(t, err)
+		return run(tx, privKey)
+	}
+
+	assert.Nil(t, feed(PrivKeyA, 1))
+	_, err = exec.Query("IssuancePrice", types.Encode(&pkt.ReqIssuanceRecords{}))
+	assert.NotNil(t, err)
+
+	// 偏离过大的报价也会记录，取中位数时被剔除，剩余报价不足quorum不更新价格
+	assert.Nil(t, feed(PrivKeyB, 3))
+	_, err = exec.Query("IssuancePrice", types.Encode(&pkt.ReqIssuanceRecords{}))
+	assert.NotNil(t, err)
+
+	// 超出时间窗口的报价被丢弃后重新收集
+	exec.SetEnv(forkHeight+1, env.blockTime+100, env.difficulty)
+	assert.Nil(t, feed(PrivKeyC, 1.02))
+	res, err = exec.Query("IssuanceFeedRound", types.Encode(&types.ReqNil{}))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(res.(*oty.PriceFeedRound).Submissions))
+
+	assert.Nil(t, feed(PrivKeyA, 1))
+	res, err = exec.Query("IssuancePrice", types.Encode(&pkt.ReqIssuanceRecords{}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10100), res.(*pkt.RepIssuancePrice).Price)
+
+	// oracle事件结果喂价，事件必须是bty/ccny的价格事件
+	pair := oty.PricePair(env.cfg.GetCoinExec(), env.cfg.GetCoinSymbol(), pkt.CCNYTokenName)
+	status := &oty.OracleStatus{EventID: "event1", Type: oty.PriceEventType, SubType: "token.TEST/" + pkt.CCNYTokenName, Time: env.blockTime + 90, Result: "9800",
+		Status: &oty.EventStatus{Status: oty.ResultPublished}}
+	env.db.Set(oracleE.Key("event1"), types.Encode(status))
+	tx, err = pkt.CreateRawIssuanceFeedTx(env.cfg, &pkt.IssuanceFeedTx{OracleEventID: "event1"})
+	assert.Nil(t, err)
+	assert.Equal(t, oty.ErrPricePairMismatch, run(tx, PrivKeyA))
+
+	status.SubType = pair
+	status.Time = env.blockTime + 20
+	env.db.Set(oracleE.Key("event1"), types.Encode(status))
+	assert.Equal(t, oty.ErrPriceStale, run(tx, PrivKeyA))
+
+	// oracle价格同样需要达到quorum后取中位数
+	status.Time = env.blockTime + 90
+	env.db.Set(oracleE.Key("event1"), types.Encode(status))
+	assert.Nil(t, run(tx, PrivKeyA))
+	res, err = exec.Query("IssuancePrice", types.Encode(&pkt.ReqIssuanceRecords{}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10100), res.(*pkt.RepIssuancePrice).Price)
+	assert.Nil(t, feed(PrivKeyB, 0.98))
+	res, err = exec.Query("IssuancePrice", types.Encode(&pkt.ReqIssuanceRecords{}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(9800), res.(*pkt.RepIssuancePrice).Price)
+}
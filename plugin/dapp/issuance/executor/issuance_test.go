@@ -1,6 +1,7 @@
 package executor
 
 import (
+	"sync"
 	"testing"
 	"time"
 
@@ -44,6 +45,7 @@ var (
 	}
 	total      = 10000 * types.DefaultCoinPrecision
 	totalToken = 100000 * types.DefaultCoinPrecision
+	initOnce   sync.Once
 )
 
 func manageKeySet(key string, value string, db dbm.KV) {
@@ -65,7 +67,7 @@ func initEnv() *execEnv {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	cfg.SetTitleOnlyForTest("chain33")
 	cfg.RegisterDappFork(pkt.IssuanceX, pkt.ForkIssuanceTableUpdate, 0)
-	Init(pkt.IssuanceX, cfg, nil)
+	initOnce.Do(func() { Init(pkt.IssuanceX, cfg, nil) })
 	_, ldb, kvdb := util.CreateTestDB()
 
 	accountA := types.Account{
@@ -14,6 +14,8 @@ import (
 	"github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
 	pty "github.com/33cn/plugin/plugin/dapp/issuance/types"
+	oracleE "github.com/33cn/plugin/plugin/dapp/oracle/executor"
+	oty "github.com/33cn/plugin/plugin/dapp/oracle/types"
 	tokenE "github.com/33cn/plugin/plugin/dapp/token/executor"
 	"github.com/shopspring/decimal"
 )
@@ -154,6 +156,18 @@ func PriceKey() (key []byte) {
 	return key
 }
 
+// FeedConfigKey for IssuanceFeedConfig
+func FeedConfigKey() (key []byte) {
+	key = append(key, []byte("mavl-"+pty.IssuanceX+"-feed-config")...)
+	return key
+}
+
+// FeedRoundKey for multi-feeder price round
+func FeedRoundKey() (key []byte) {
+	key = append(key, []byte("mavl-"+pty.IssuanceX+"-feed-round")...)
+	return key
+}
+
 // Action struct
 type Action struct {
 	coinsAccount *account.DB // bty账户
@@ -302,6 +316,18 @@ func (action *Action) IssuanceManage(manage *pty.IssuanceManage) (*types.Receipt
 		return nil, pty.ErrPermissionDeny
 	}
 
+	feedCfg := manage.FeedConfig
+	if feedCfg != nil {
+		cfg := action.Issuance.GetAPI().GetConfig()
+		if !cfg.IsDappFork(action.height, pty.IssuanceX, pty.ForkIssuanceFeedQuorum) {
+			return nil, types.ErrNotSupport
+		}
+		if feedCfg.Quorum < 0 || feedCfg.Window < 0 || feedCfg.Deviation < 0 || feedCfg.Deviation >= 10000 {
+			clog.Error("IssuanceManage", "feedConfig", feedCfg, "error", types.ErrInvalidParam)
+			return nil, types.ErrInvalidParam
+		}
+	}
+
 	// 添加大户地址
 	var item types.ConfigItem
 	data, err := action.db.Get(AddrKey())
@@ -330,6 +356,13 @@ func (action *Action) IssuanceManage(manage *pty.IssuanceManage) (*types.Receipt
 		kv = append(kv, &types.KeyValue{Key: AddrKey(), Value: value})
 	}
 
+	// 喂价配置
+	if feedCfg != nil {
+		value := types.Encode(feedCfg)
+		action.db.Set(FeedConfigKey(), value)
+		kv = append(kv, &types.KeyValue{Key: FeedConfigKey(), Value: value})
+	}
+
 	receipt = &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: nil}
 	return receipt, nil
 }
@@ -902,7 +935,7 @@ func (action *Action) IssuanceFeed(feed *pty.IssuanceFeed) (*types.Receipt, erro
 	var logs []*types.ReceiptLog
 	var kv []*types.KeyValue
 
-	if feed == nil || len(feed.Price) == 0 || len(feed.Price) != len(feed.Volume) {
+	if feed == nil || (feed.OracleEventId == "" && len(feed.Price) == 0) || len(feed.Price) != len(feed.Volume) {
 		clog.Error("IssuancePriceFeed", types.ErrInvalidParam)
 		return nil, types.ErrInvalidParam
 	}
@@ -913,10 +946,45 @@ func (action *Action) IssuanceFeed(feed *pty.IssuanceFeed) (*types.Receipt, erro
 		return nil, pty.ErrPermissionDeny
 	}
 
-	price := pricePolicy(feed)
-	if price <= 0 {
-		clog.Error("IssuancePriceFeed", "price", price, "error", pty.ErrPriceInvalid)
-		return nil, pty.ErrPriceInvalid
+	cfg := action.Issuance.GetAPI().GetConfig()
+	if feed.OracleEventId != "" && !cfg.IsDappFork(action.height, pty.IssuanceX, pty.ForkIssuanceFeedQuorum) {
+		return nil, types.ErrNotSupport
+	}
+
+	feedCfg := getFeedConfig(action.db)
+	var price int64
+	if feed.OracleEventId != "" {
+		// 价格来自已公布结果的oracle事件
+		var err error
+		price, err = action.getOraclePrice(feed.OracleEventId, feedCfg)
+		if err != nil {
+			clog.Error("IssuancePriceFeed", "oracleEventId", feed.OracleEventId, "error", err)
+			return nil, err
+		}
+	} else {
+		price = pricePolicy(feed)
+		if price <= 0 {
+			clog.Error("IssuancePriceFeed", "price", price, "error", pty.ErrPriceInvalid)
+			return nil, pty.ErrPriceInvalid
+		}
+	}
+
+	// 多喂价人模式，报价数量达到要求后取中位数，oracle事件的价格同样作为喂价人的报价
+	if feedCfg.Quorum > 1 {
+		round := getFeedRound(action.db)
+		round.Submit(action.fromaddr, price, action.blocktime, feedCfg.Window)
+		median, ok := round.MedianPrice(feedCfg.Quorum, feedCfg.Deviation)
+		if ok {
+			round.Submissions = nil
+		}
+		roundkv := &types.KeyValue{Key: FeedRoundKey(), Value: types.Encode(round)}
+		action.db.Set(roundkv.Key, roundkv.Value)
+		kv = append(kv, roundkv)
+		if !ok {
+			clog.Debug("IssuancePriceFeed", "addr", action.fromaddr, "price", price, "submissions", len(round.Submissions))
+			return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+		}
+		price = median
 	}
 
 	ids, err := queryIssuanceByStatus(action.localDB, pty.IssuanceStatusCreated, "")
@@ -965,6 +1033,59 @@ func (action *Action) IssuanceFeed(feed *pty.IssuanceFeed) (*types.Receipt, erro
 	return receipt, nil
 }
 
+// 获取喂价配置，未配置时为单喂价人模式
+func getFeedConfig(db dbm.KV) *pty.IssuanceFeedConfig {
+	feedCfg := &pty.IssuanceFeedConfig{}
+	data, err := db.Get(FeedConfigKey())
+	if err != nil {
+		return feedCfg
+	}
+	err = types.Decode(data, feedCfg)
+	if err != nil {
+		clog.Error("getFeedConfig", "decode", err)
+		return &pty.IssuanceFeedConfig{}
+	}
+	return feedCfg
+}
+
+// 获取当前喂价轮次
+func getFeedRound(db dbm.KV) *oty.PriceFeedRound {
+	round := &oty.PriceFeedRound{}
+	data, err := db.Get(FeedRoundKey())
+	if err != nil {
+		return round
+	}
+	err = types.Decode(data, round)
+	if err != nil {
+		clog.Error("getFeedRound", "decode", err)
+		return &oty.PriceFeedRound{}
+	}
+	return round
+}
+
+// 从oracle事件结果获取价格，超出报价时间窗口的结果不可用
+func (action *Action) getOraclePrice(eventID string, feedCfg *pty.IssuanceFeedConfig) (int64, error) {
+	data, err := action.db.Get(oracleE.Key(eventID))
+	if err != nil {
+		return 0, oty.ErrEventIDNotFound
+	}
+	var status oty.OracleStatus
+	err = types.Decode(data, &status)
+	if err != nil {
+		return 0, err
+	}
+	// 事件必须是bty/ccny的价格事件
+	cfg := action.Issuance.GetAPI().GetConfig()
+	price, err := oty.ResultPrice(&status, oty.PricePair(cfg.GetCoinExec(), cfg.GetCoinSymbol(), pty.CCNYTokenName))
+	if err != nil {
+		return 0, err
+	}
+	if feedCfg.Window > 0 && action.blocktime-status.Time > feedCfg.Window {
+		return 0, oty.ErrPriceStale
+	}
+	return price, nil
+}
+
 // IssuanceClose 终止借贷
 func (action *Action) IssuanceClose(close *pty.IssuanceClose) (*types.Receipt, error) {
 	var logs []*types.ReceiptLog
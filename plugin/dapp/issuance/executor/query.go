@@ -130,6 +130,16 @@ func (c *Issuance) Query_IssuancePrice(req *pty.ReqIssuanceRecords) (types.Messa
 	return &pty.RepIssuancePrice{Price: price}, nil
 }
 
+//Query_IssuanceFeedConfig ...
+func (c *Issuance) Query_IssuanceFeedConfig(req *types.ReqNil) (types.Message, error) {
+	return getFeedConfig(c.GetStateDB()), nil
+}
+
+//Query_IssuanceFeedRound ...
+func (c *Issuance) Query_IssuanceFeedRound(req *types.ReqNil) (types.Message, error) {
+	return getFeedRound(c.GetStateDB()), nil
+}
+
 //Query_IssuanceUserBalance ...
 func (c *Issuance) Query_IssuanceUserBalance(req *pty.ReqIssuanceRecords) (types.Message, error) {
 	balance, err := queryIssuanceUserBalance(c.GetStateDB(), c.GetLocalDB(), req.Addr)
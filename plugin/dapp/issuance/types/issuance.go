@@ -31,6 +31,7 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(IssuanceX, "Enable", 0)
 	cfg.RegisterDappFork(IssuanceX, ForkIssuanceTableUpdate, 0)
 	cfg.RegisterDappFork(IssuanceX, ForkIssuancePrecision, 0)
+	cfg.RegisterDappFork(IssuanceX, ForkIssuanceFeedQuorum, 0)
 }
 
 //InitExecutor ...
@@ -251,7 +252,8 @@ func CreateRawIssuanceFeedTx(cfg *types.Chain33Config, parm *IssuanceFeedTx) (*t
 	}
 
 	v := &IssuanceFeed{
-		Volume: parm.Volume,
+		Volume:        parm.Volume,
+		OracleEventId: parm.OracleEventID,
 	}
 	for _, r := range parm.Price {
 		v.Price = append(v.Price, int64(math.Trunc(r*1e4)))
@@ -312,6 +314,13 @@ func CreateRawIssuanceManageTx(cfg *types.Chain33Config, parm *IssuanceManageTx)
 	}
 
 	v := &IssuanceManage{SuperAddrs: parm.Addr}
+	if parm.FeedQuorum != 0 || parm.FeedWindow != 0 || parm.FeedDeviation != 0 {
+		v.FeedConfig = &IssuanceFeedConfig{
+			Quorum:    parm.FeedQuorum,
+			Window:    parm.FeedWindow,
+			Deviation: int64(math.Trunc((parm.FeedDeviation + 0.0000001) * 1e4)),
+		}
+	}
 
 	manage := &IssuanceAction{
 		Ty:    IssuanceActionManage,
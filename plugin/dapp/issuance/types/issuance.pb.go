@@ -523,7 +523,8 @@ type IssuanceManage struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SuperAddrs []string `protobuf:"bytes,1,rep,name=superAddrs,proto3" json:"superAddrs,omitempty"` //大户地址
+	SuperAddrs []string            `protobuf:"bytes,1,rep,name=superAddrs,proto3" json:"superAddrs,omitempty"` //大户地址
+	FeedConfig *IssuanceFeedConfig `protobuf:"bytes,2,opt,name=feedConfig,proto3" json:"feedConfig,omitempty"` //喂价配置
 }
 
 func (x *IssuanceManage) Reset() {
@@ -565,6 +566,77 @@ func (x *IssuanceManage) GetSuperAddrs() []string {
 	return nil
 }
 
+func (x *IssuanceManage) GetFeedConfig() *IssuanceFeedConfig {
+	if x != nil {
+		return x.FeedConfig
+	}
+	return nil
+}
+
+// 喂价配置
+type IssuanceFeedConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quorum    int32 `protobuf:"varint,1,opt,name=quorum,proto3" json:"quorum,omitempty"`       //取中位数所需的最少报价数量，0或1表示单喂价人模式
+	Window    int64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`       //报价时间窗口(秒)，超出窗口的报价作废
+	Deviation int64 `protobuf:"varint,3,opt,name=deviation,proto3" json:"deviation,omitempty"` //允许偏离全部报价中位数的比例(万分比)，超出的报价在取中位数时剔除，0表示不剔除
+}
+
+func (x *IssuanceFeedConfig) Reset() {
+	*x = IssuanceFeedConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_issuance_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssuanceFeedConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssuanceFeedConfig) ProtoMessage() {}
+
+func (x *IssuanceFeedConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_issuance_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssuanceFeedConfig.ProtoReflect.Descriptor instead.
+func (*IssuanceFeedConfig) Descriptor() ([]byte, []int) {
+	return file_issuance_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *IssuanceFeedConfig) GetQuorum() int32 {
+	if x != nil {
+		return x.Quorum
+	}
+	return 0
+}
+
+func (x *IssuanceFeedConfig) GetWindow() int64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *IssuanceFeedConfig) GetDeviation() int64 {
+	if x != nil {
+		return x.Deviation
+	}
+	return 0
+}
+
 // 创建发行
 type IssuanceCreate struct {
 	state         protoimpl.MessageState
@@ -580,7 +652,7 @@ type IssuanceCreate struct {
 func (x *IssuanceCreate) Reset() {
 	*x = IssuanceCreate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuance_proto_msgTypes[5]
+		mi := &file_issuance_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -593,7 +665,7 @@ func (x *IssuanceCreate) String() string {
 func (*IssuanceCreate) ProtoMessage() {}
 
 func (x *IssuanceCreate) ProtoReflect() protoreflect.Message {
-	mi := &file_issuance_proto_msgTypes[5]
+	mi := &file_issuance_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -606,7 +678,7 @@ func (x *IssuanceCreate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IssuanceCreate.ProtoReflect.Descriptor instead.
 func (*IssuanceCreate) Descriptor() ([]byte, []int) {
-	return file_issuance_proto_rawDescGZIP(), []int{5}
+	return file_issuance_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *IssuanceCreate) GetTotalBalance() int64 {
@@ -650,7 +722,7 @@ type IssuanceDebt struct {
 func (x *IssuanceDebt) Reset() {
 	*x = IssuanceDebt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuance_proto_msgTypes[6]
+		mi := &file_issuance_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -663,7 +735,7 @@ func (x *IssuanceDebt) String() string {
 func (*IssuanceDebt) ProtoMessage() {}
 
 func (x *IssuanceDebt) ProtoReflect() protoreflect.Message {
-	mi := &file_issuance_proto_msgTypes[6]
+	mi := &file_issuance_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -676,7 +748,7 @@ func (x *IssuanceDebt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IssuanceDebt.ProtoReflect.Descriptor instead.
 func (*IssuanceDebt) Descriptor() ([]byte, []int) {
-	return file_issuance_proto_rawDescGZIP(), []int{6}
+	return file_issuance_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *IssuanceDebt) GetIssuanceId() string {
@@ -706,7 +778,7 @@ type IssuanceRepay struct {
 func (x *IssuanceRepay) Reset() {
 	*x = IssuanceRepay{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuance_proto_msgTypes[7]
+		mi := &file_issuance_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -719,7 +791,7 @@ func (x *IssuanceRepay) String() string {
 func (*IssuanceRepay) ProtoMessage() {}
 
 func (x *IssuanceRepay) ProtoReflect() protoreflect.Message {
-	mi := &file_issuance_proto_msgTypes[7]
+	mi := &file_issuance_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -732,7 +804,7 @@ func (x *IssuanceRepay) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IssuanceRepay.ProtoReflect.Descriptor instead.
 func (*IssuanceRepay) Descriptor() ([]byte, []int) {
-	return file_issuance_proto_rawDescGZIP(), []int{7}
+	return file_issuance_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *IssuanceRepay) GetIssuanceId() string {
@@ -755,15 +827,16 @@ type IssuanceFeed struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CollType int32   `protobuf:"varint,1,opt,name=collType,proto3" json:"collType,omitempty"`    //抵押物价格类型(1，bty，2，btc，3，eth...)
-	Price    []int64 `protobuf:"varint,2,rep,packed,name=price,proto3" json:"price,omitempty"`   //喂价
-	Volume   []int64 `protobuf:"varint,3,rep,packed,name=volume,proto3" json:"volume,omitempty"` //成交量
+	CollType      int32   `protobuf:"varint,1,opt,name=collType,proto3" json:"collType,omitempty"`          //抵押物价格类型(1，bty，2，btc，3，eth...)
+	Price         []int64 `protobuf:"varint,2,rep,packed,name=price,proto3" json:"price,omitempty"`         //喂价
+	Volume        []int64 `protobuf:"varint,3,rep,packed,name=volume,proto3" json:"volume,omitempty"`       //成交量
+	OracleEventId string  `protobuf:"bytes,4,opt,name=oracleEventId,proto3" json:"oracleEventId,omitempty"` //使用已公布结果的oracle价格事件作为价格，事件子类别为coins.bty/CCNY，结果为放大1e4的整数价格
 }
 
 func (x *IssuanceFeed) Reset() {
 	*x = IssuanceFeed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuance_proto_msgTypes[8]
+		mi := &file_issuance_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -776,7 +849,7 @@ func (x *IssuanceFeed) String() string {
 func (*IssuanceFeed) ProtoMessage() {}
 
 func (x *IssuanceFeed) ProtoReflect() protoreflect.Message {
-	mi := &file_issuance_proto_msgTypes[8]
+	mi := &file_issuance_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -789,7 +862,7 @@ func (x *IssuanceFeed) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IssuanceFeed.ProtoReflect.Descriptor instead.
 func (*IssuanceFeed) Descriptor() ([]byte, []int) {
-	return file_issuance_proto_rawDescGZIP(), []int{8}
+	return file_issuance_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *IssuanceFeed) GetCollType() int32 {
@@ -813,6 +886,13 @@ func (x *IssuanceFeed) GetVolume() []int64 {
 	return nil
 }
 
+func (x *IssuanceFeed) GetOracleEventId() string {
+	if x != nil {
+		return x.OracleEventId
+	}
+	return ""
+}
+
 // 借贷关闭
 type IssuanceClose struct {
 	state         protoimpl.MessageState
@@ -825,7 +905,7 @@ type IssuanceClose struct {
 func (x *IssuanceClose) Reset() {
 	*x = IssuanceClose{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuance_proto_msgTypes[9]
+		mi := &file_issuance_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -838,7 +918,7 @@ func (x *IssuanceClose) String() string {
 func (*IssuanceClose) ProtoMessage() {}
 
 func (x *IssuanceClose) ProtoReflect() protoreflect.Message {
-	mi := &file_issuance_proto_msgTypes[9]
+	mi := &file_issuance_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -851,7 +931,7 @@ func (x *IssuanceClose) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IssuanceClose.ProtoReflect.Descriptor instead.
 func (*IssuanceClose) Descriptor() ([]byte, []int) {
-	return file_issuance_proto_rawDescGZIP(), []int{9}
+	return file_issuance_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *IssuanceClose) GetIssuanceId() string {
@@ -876,7 +956,7 @@ type ReceiptIssuance struct {
 func (x *ReceiptIssuance) Reset() {
 	*x = ReceiptIssuance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuance_proto_msgTypes[10]
+		mi := &file_issuance_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -889,7 +969,7 @@ func (x *ReceiptIssuance) String() string {
 func (*ReceiptIssuance) ProtoMessage() {}
 
 func (x *ReceiptIssuance) ProtoReflect() protoreflect.Message {
-	mi := &file_issuance_proto_msgTypes[10]
+	mi := &file_issuance_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -902,7 +982,7 @@ func (x *ReceiptIssuance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptIssuance.ProtoReflect.Descriptor instead.
 func (*ReceiptIssuance) Descriptor() ([]byte, []int) {
-	return file_issuance_proto_rawDescGZIP(), []int{10}
+	return file_issuance_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ReceiptIssuance) GetIssuanceId() string {
@@ -946,7 +1026,7 @@ type ReceiptIssuanceID struct {
 func (x *ReceiptIssuanceID) Reset() {
 	*x = ReceiptIssuanceID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuance_proto_msgTypes[11]
+		mi := &file_issuance_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -959,7 +1039,7 @@ func (x *ReceiptIssuanceID) String() string {
 func (*ReceiptIssuanceID) ProtoMessage() {}
 
 func (x *ReceiptIssuanceID) ProtoReflect() protoreflect.Message {
-	mi := &file_issuance_proto_msgTypes[11]
+	mi := &file_issuance_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -972,7 +1052,7 @@ func (x *ReceiptIssuanceID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptIssuanceID.ProtoReflect.Descriptor instead.
 func (*ReceiptIssuanceID) Descriptor() ([]byte, []int) {
-	return file_issuance_proto_rawDescGZIP(), []int{11}
+	return file_issuance_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ReceiptIssuanceID) GetIssuanceId() string {
@@ -1001,7 +1081,7 @@ type IssuanceRecords struct {
 func (x *IssuanceRecords) Reset() {
 	*x = IssuanceRecords{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuance_proto_msgTypes[12]
+		mi := &file_issuance_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1014,7 +1094,7 @@ func (x *IssuanceRecords) String() string {
 func (*IssuanceRecords) ProtoMessage() {}
 
 func (x *IssuanceRecords) ProtoReflect() protoreflect.Message {
-	mi := &file_issuance_proto_msgTypes[12]
+	mi := &file_issuance_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1027,7 +1107,7 @@ func (x *IssuanceRecords) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IssuanceRecords.ProtoReflect.Descriptor instead.
 func (*IssuanceRecords) Descriptor() ([]byte, []int) {
-	return file_issuance_proto_rawDescGZIP(), []int{12}
+	return file_issuance_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *IssuanceRecords) GetRecords() []*ReceiptIssuance {
@@ -1049,7 +1129,7 @@ type ReqIssuanceInfo struct {
 func (x *ReqIssuanceInfo) Reset() {
 	*x = ReqIssuanceInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuance_proto_msgTypes[13]
+		mi := &file_issuance_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1062,7 +1142,7 @@ func (x *ReqIssuanceInfo) String() string {
 func (*ReqIssuanceInfo) ProtoMessage() {}
 
 func (x *ReqIssuanceInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_issuance_proto_msgTypes[13]
+	mi := &file_issuance_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1075,7 +1155,7 @@ func (x *ReqIssuanceInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqIssuanceInfo.ProtoReflect.Descriptor instead.
 func (*ReqIssuanceInfo) Descriptor() ([]byte, []int) {
-	return file_issuance_proto_rawDescGZIP(), []int{13}
+	return file_issuance_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ReqIssuanceInfo) GetIssuanceId() string {
@@ -1106,7 +1186,7 @@ type RepIssuanceCurrentInfo struct {
 func (x *RepIssuanceCurrentInfo) Reset() {
 	*x = RepIssuanceCurrentInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuance_proto_msgTypes[14]
+		mi := &file_issuance_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1119,7 +1199,7 @@ func (x *RepIssuanceCurrentInfo) String() string {
 func (*RepIssuanceCurrentInfo) ProtoMessage() {}
 
 func (x *RepIssuanceCurrentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_issuance_proto_msgTypes[14]
+	mi := &file_issuance_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1132,7 +1212,7 @@ func (x *RepIssuanceCurrentInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepIssuanceCurrentInfo.ProtoReflect.Descriptor instead.
 func (*RepIssuanceCurrentInfo) Descriptor() ([]byte, []int) {
-	return file_issuance_proto_rawDescGZIP(), []int{14}
+	return file_issuance_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *RepIssuanceCurrentInfo) GetStatus() int32 {
@@ -1217,7 +1297,7 @@ type ReqIssuanceInfos struct {
 func (x *ReqIssuanceInfos) Reset() {
 	*x = ReqIssuanceInfos{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuance_proto_msgTypes[15]
+		mi := &file_issuance_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1230,7 +1310,7 @@ func (x *ReqIssuanceInfos) String() string {
 func (*ReqIssuanceInfos) ProtoMessage() {}
 
 func (x *ReqIssuanceInfos) ProtoReflect() protoreflect.Message {
-	mi := &file_issuance_proto_msgTypes[15]
+	mi := &file_issuance_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1243,7 +1323,7 @@ func (x *ReqIssuanceInfos) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqIssuanceInfos.ProtoReflect.Descriptor instead.
 func (*ReqIssuanceInfos) Descriptor() ([]byte, []int) {
-	return file_issuance_proto_rawDescGZIP(), []int{15}
+	return file_issuance_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ReqIssuanceInfos) GetIssuanceIds() []string {
@@ -1265,7 +1345,7 @@ type RepIssuanceCurrentInfos struct {
 func (x *RepIssuanceCurrentInfos) Reset() {
 	*x = RepIssuanceCurrentInfos{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuance_proto_msgTypes[16]
+		mi := &file_issuance_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1278,7 +1358,7 @@ func (x *RepIssuanceCurrentInfos) String() string {
 func (*RepIssuanceCurrentInfos) ProtoMessage() {}
 
 func (x *RepIssuanceCurrentInfos) ProtoReflect() protoreflect.Message {
-	mi := &file_issuance_proto_msgTypes[16]
+	mi := &file_issuance_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1291,7 +1371,7 @@ func (x *RepIssuanceCurrentInfos) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepIssuanceCurrentInfos.ProtoReflect.Descriptor instead.
 func (*RepIssuanceCurrentInfos) Descriptor() ([]byte, []int) {
-	return file_issuance_proto_rawDescGZIP(), []int{16}
+	return file_issuance_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *RepIssuanceCurrentInfos) GetInfos() []*RepIssuanceCurrentInfo {
@@ -1314,7 +1394,7 @@ type ReqIssuanceByStatus struct {
 func (x *ReqIssuanceByStatus) Reset() {
 	*x = ReqIssuanceByStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuance_proto_msgTypes[17]
+		mi := &file_issuance_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1327,7 +1407,7 @@ func (x *ReqIssuanceByStatus) String() string {
 func (*ReqIssuanceByStatus) ProtoMessage() {}
 
 func (x *ReqIssuanceByStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_issuance_proto_msgTypes[17]
+	mi := &file_issuance_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1340,7 +1420,7 @@ func (x *ReqIssuanceByStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqIssuanceByStatus.ProtoReflect.Descriptor instead.
 func (*ReqIssuanceByStatus) Descriptor() ([]byte, []int) {
-	return file_issuance_proto_rawDescGZIP(), []int{17}
+	return file_issuance_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ReqIssuanceByStatus) GetStatus() int32 {
@@ -1369,7 +1449,7 @@ type RepIssuanceIDs struct {
 func (x *RepIssuanceIDs) Reset() {
 	*x = RepIssuanceIDs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuance_proto_msgTypes[18]
+		mi := &file_issuance_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1382,7 +1462,7 @@ func (x *RepIssuanceIDs) String() string {
 func (*RepIssuanceIDs) ProtoMessage() {}
 
 func (x *RepIssuanceIDs) ProtoReflect() protoreflect.Message {
-	mi := &file_issuance_proto_msgTypes[18]
+	mi := &file_issuance_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1395,7 +1475,7 @@ func (x *RepIssuanceIDs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepIssuanceIDs.ProtoReflect.Descriptor instead.
 func (*RepIssuanceIDs) Descriptor() ([]byte, []int) {
-	return file_issuance_proto_rawDescGZIP(), []int{18}
+	return file_issuance_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *RepIssuanceIDs) GetIDs() []string {
@@ -1420,7 +1500,7 @@ type ReqIssuanceRecords struct {
 func (x *ReqIssuanceRecords) Reset() {
 	*x = ReqIssuanceRecords{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuance_proto_msgTypes[19]
+		mi := &file_issuance_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1433,7 +1513,7 @@ func (x *ReqIssuanceRecords) String() string {
 func (*ReqIssuanceRecords) ProtoMessage() {}
 
 func (x *ReqIssuanceRecords) ProtoReflect() protoreflect.Message {
-	mi := &file_issuance_proto_msgTypes[19]
+	mi := &file_issuance_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1446,7 +1526,7 @@ func (x *ReqIssuanceRecords) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqIssuanceRecords.ProtoReflect.Descriptor instead.
 func (*ReqIssuanceRecords) Descriptor() ([]byte, []int) {
-	return file_issuance_proto_rawDescGZIP(), []int{19}
+	return file_issuance_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ReqIssuanceRecords) GetIssuanceId() string {
@@ -1489,7 +1569,7 @@ type RepIssuanceRecords struct {
 func (x *RepIssuanceRecords) Reset() {
 	*x = RepIssuanceRecords{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuance_proto_msgTypes[20]
+		mi := &file_issuance_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1502,7 +1582,7 @@ func (x *RepIssuanceRecords) String() string {
 func (*RepIssuanceRecords) ProtoMessage() {}
 
 func (x *RepIssuanceRecords) ProtoReflect() protoreflect.Message {
-	mi := &file_issuance_proto_msgTypes[20]
+	mi := &file_issuance_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1515,7 +1595,7 @@ func (x *RepIssuanceRecords) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepIssuanceRecords.ProtoReflect.Descriptor instead.
 func (*RepIssuanceRecords) Descriptor() ([]byte, []int) {
-	return file_issuance_proto_rawDescGZIP(), []int{20}
+	return file_issuance_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *RepIssuanceRecords) GetRecords() []*DebtRecord {
@@ -1537,7 +1617,7 @@ type RepIssuanceDebtInfo struct {
 func (x *RepIssuanceDebtInfo) Reset() {
 	*x = RepIssuanceDebtInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuance_proto_msgTypes[21]
+		mi := &file_issuance_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1550,7 +1630,7 @@ func (x *RepIssuanceDebtInfo) String() string {
 func (*RepIssuanceDebtInfo) ProtoMessage() {}
 
 func (x *RepIssuanceDebtInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_issuance_proto_msgTypes[21]
+	mi := &file_issuance_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1563,7 +1643,7 @@ func (x *RepIssuanceDebtInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepIssuanceDebtInfo.ProtoReflect.Descriptor instead.
 func (*RepIssuanceDebtInfo) Descriptor() ([]byte, []int) {
-	return file_issuance_proto_rawDescGZIP(), []int{21}
+	return file_issuance_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *RepIssuanceDebtInfo) GetRecord() *DebtRecord {
@@ -1585,7 +1665,7 @@ type RepIssuancePrice struct {
 func (x *RepIssuancePrice) Reset() {
 	*x = RepIssuancePrice{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuance_proto_msgTypes[22]
+		mi := &file_issuance_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1598,7 +1678,7 @@ func (x *RepIssuancePrice) String() string {
 func (*RepIssuancePrice) ProtoMessage() {}
 
 func (x *RepIssuancePrice) ProtoReflect() protoreflect.Message {
-	mi := &file_issuance_proto_msgTypes[22]
+	mi := &file_issuance_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1611,7 +1691,7 @@ func (x *RepIssuancePrice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepIssuancePrice.ProtoReflect.Descriptor instead.
 func (*RepIssuancePrice) Descriptor() ([]byte, []int) {
-	return file_issuance_proto_rawDescGZIP(), []int{22}
+	return file_issuance_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *RepIssuancePrice) GetPrice() int64 {
@@ -1633,7 +1713,7 @@ type RepIssuanceUserBalance struct {
 func (x *RepIssuanceUserBalance) Reset() {
 	*x = RepIssuanceUserBalance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuance_proto_msgTypes[23]
+		mi := &file_issuance_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1646,7 +1726,7 @@ func (x *RepIssuanceUserBalance) String() string {
 func (*RepIssuanceUserBalance) ProtoMessage() {}
 
 func (x *RepIssuanceUserBalance) ProtoReflect() protoreflect.Message {
-	mi := &file_issuance_proto_msgTypes[23]
+	mi := &file_issuance_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1659,7 +1739,7 @@ func (x *RepIssuanceUserBalance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepIssuanceUserBalance.ProtoReflect.Descriptor instead.
 func (*RepIssuanceUserBalance) Descriptor() ([]byte, []int) {
-	return file_issuance_proto_rawDescGZIP(), []int{23}
+	return file_issuance_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *RepIssuanceUserBalance) GetBalance() int64 {
@@ -1760,119 +1840,131 @@ var file_issuance_proto_rawDesc = []byte{
 	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x73, 0x73, 0x75,
 	0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x61,
 	0x6e, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x74, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x30, 0x0a,
+	0x52, 0x02, 0x74, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x6b, 0x0a,
 	0x0e, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x12,
 	0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x70, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x70, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x73, 0x22,
-	0x9a, 0x01, 0x0a, 0x0e, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x74, 0x43, 0x65,
-	0x69, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x62,
-	0x74, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x69, 0x71, 0x75,
-	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x10, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x61, 0x74, 0x69, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x44, 0x0a, 0x0c,
-	0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x62, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0x47, 0x0a, 0x0d, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x70, 0x61, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63,
-	0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x62, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x62, 0x74, 0x49, 0x64, 0x22, 0x58, 0x0a, 0x0c, 0x49,
-	0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63,
-	0x6f, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63,
-	0x6f, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x76,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x0d, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63,
-	0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x70, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12,
+	0x39, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x73, 0x73, 0x75,
+	0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a,
+	0x66, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x62, 0x0a, 0x12, 0x49, 0x73,
+	0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9a,
+	0x01, 0x0a, 0x0e, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x74, 0x43, 0x65, 0x69,
+	0x6c, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x62, 0x74,
+	0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61,
+	0x74, 0x69, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x44, 0x0a, 0x0c, 0x49,
+	0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x62, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
+	0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x47, 0x0a, 0x0d, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70,
+	0x61, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x62, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x62, 0x74, 0x49, 0x64, 0x22, 0x7e, 0x0a, 0x0c, 0x49, 0x73,
+	0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
+	0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x6f,
+	0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x2f, 0x0a, 0x0d, 0x49, 0x73,
+	0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
+	0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x83, 0x01, 0x0a, 0x0f,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x62, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x65, 0x62, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x4b, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x73, 0x73, 0x75,
+	0x61, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e,
 	0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x73, 0x73, 0x75,
-	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x83, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x65, 0x69,
-	0x70, 0x74, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73,
-	0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06,
-	0x64, 0x65, 0x62, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65,
-	0x62, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4b, 0x0a, 0x11,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49,
-	0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x43, 0x0a, 0x0f, 0x49, 0x73, 0x73,
-	0x75, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x07,
-	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x73, 0x73,
-	0x75, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x31,
-	0x0a, 0x0f, 0x52, 0x65, 0x71, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49,
-	0x64, 0x22, 0xd4, 0x02, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63,
-	0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x74,
-	0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64,
-	0x65, 0x62, 0x74, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x69,
-	0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61,
-	0x74, 0x65, 0x72, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65,
-	0x62, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64,
-	0x65, 0x62, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x49,
-	0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x20, 0x0a, 0x0b,
-	0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0b, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x73, 0x22, 0x4e,
-	0x0a, 0x17, 0x52, 0x65, 0x70, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x6e, 0x66,
-	0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x52, 0x65, 0x70, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x4d,
-	0x0a, 0x13, 0x52, 0x65, 0x71, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x79, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a,
-	0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x22, 0x0a,
-	0x0e, 0x52, 0x65, 0x70, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x73, 0x12,
-	0x10, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x49, 0x44,
-	0x73, 0x22, 0x78, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x61,
-	0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x73, 0x73,
-	0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x62, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x62, 0x74, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x12, 0x52,
-	0x65, 0x70, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x73, 0x12, 0x2b, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x62, 0x74, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x40,
-	0x0a, 0x13, 0x52, 0x65, 0x70, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x62,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65,
-	0x62, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x22, 0x28, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x32, 0x0a, 0x16, 0x52, 0x65,
-	0x70, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x0a,
-	0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x43,
+	0x0a, 0x0f, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x12, 0x30, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x22, 0x31, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e,
+	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x73, 0x73, 0x75,
+	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0xd4, 0x02, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x49, 0x73,
+	0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x62, 0x74, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x62, 0x74, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x12,
+	0x2a, 0x0a, 0x10, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61,
+	0x74, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
+	0x72, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x64, 0x65, 0x62, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x62, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x49, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x49, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x34, 0x0a,
+	0x10, 0x52, 0x65, 0x71, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x73, 0x22, 0x4e, 0x0a, 0x17, 0x52, 0x65, 0x70, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e,
+	0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x33,
+	0x0a, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63,
+	0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x6e,
+	0x66, 0x6f, 0x73, 0x22, 0x4d, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e,
+	0x63, 0x65, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x22, 0x22, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x44, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x03, 0x49, 0x44, 0x73, 0x22, 0x78, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x49, 0x73, 0x73,
+	0x75, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x62, 0x74,
+	0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x62, 0x74, 0x49, 0x64,
+	0x22, 0x41, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x44, 0x65, 0x62, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x22, 0x40, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e,
+	0x63, 0x65, 0x44, 0x65, 0x62, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x44, 0x65, 0x62, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0x28, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x49, 0x73, 0x73, 0x75,
+	0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22,
+	0x32, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1887,51 +1979,53 @@ func file_issuance_proto_rawDescGZIP() []byte {
 	return file_issuance_proto_rawDescData
 }
 
-var file_issuance_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_issuance_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_issuance_proto_goTypes = []interface{}{
 	(*Issuance)(nil),                 // 0: types.Issuance
 	(*DebtRecord)(nil),               // 1: types.DebtRecord
 	(*IssuanceAssetPriceRecord)(nil), // 2: types.IssuanceAssetPriceRecord
 	(*IssuanceAction)(nil),           // 3: types.IssuanceAction
 	(*IssuanceManage)(nil),           // 4: types.IssuanceManage
-	(*IssuanceCreate)(nil),           // 5: types.IssuanceCreate
-	(*IssuanceDebt)(nil),             // 6: types.IssuanceDebt
-	(*IssuanceRepay)(nil),            // 7: types.IssuanceRepay
-	(*IssuanceFeed)(nil),             // 8: types.IssuanceFeed
-	(*IssuanceClose)(nil),            // 9: types.IssuanceClose
-	(*ReceiptIssuance)(nil),          // 10: types.ReceiptIssuance
-	(*ReceiptIssuanceID)(nil),        // 11: types.ReceiptIssuanceID
-	(*IssuanceRecords)(nil),          // 12: types.IssuanceRecords
-	(*ReqIssuanceInfo)(nil),          // 13: types.ReqIssuanceInfo
-	(*RepIssuanceCurrentInfo)(nil),   // 14: types.RepIssuanceCurrentInfo
-	(*ReqIssuanceInfos)(nil),         // 15: types.ReqIssuanceInfos
-	(*RepIssuanceCurrentInfos)(nil),  // 16: types.RepIssuanceCurrentInfos
-	(*ReqIssuanceByStatus)(nil),      // 17: types.ReqIssuanceByStatus
-	(*RepIssuanceIDs)(nil),           // 18: types.RepIssuanceIDs
-	(*ReqIssuanceRecords)(nil),       // 19: types.ReqIssuanceRecords
-	(*RepIssuanceRecords)(nil),       // 20: types.RepIssuanceRecords
-	(*RepIssuanceDebtInfo)(nil),      // 21: types.RepIssuanceDebtInfo
-	(*RepIssuancePrice)(nil),         // 22: types.RepIssuancePrice
-	(*RepIssuanceUserBalance)(nil),   // 23: types.RepIssuanceUserBalance
+	(*IssuanceFeedConfig)(nil),       // 5: types.IssuanceFeedConfig
+	(*IssuanceCreate)(nil),           // 6: types.IssuanceCreate
+	(*IssuanceDebt)(nil),             // 7: types.IssuanceDebt
+	(*IssuanceRepay)(nil),            // 8: types.IssuanceRepay
+	(*IssuanceFeed)(nil),             // 9: types.IssuanceFeed
+	(*IssuanceClose)(nil),            // 10: types.IssuanceClose
+	(*ReceiptIssuance)(nil),          // 11: types.ReceiptIssuance
+	(*ReceiptIssuanceID)(nil),        // 12: types.ReceiptIssuanceID
+	(*IssuanceRecords)(nil),          // 13: types.IssuanceRecords
+	(*ReqIssuanceInfo)(nil),          // 14: types.ReqIssuanceInfo
+	(*RepIssuanceCurrentInfo)(nil),   // 15: types.RepIssuanceCurrentInfo
+	(*ReqIssuanceInfos)(nil),         // 16: types.ReqIssuanceInfos
+	(*RepIssuanceCurrentInfos)(nil),  // 17: types.RepIssuanceCurrentInfos
+	(*ReqIssuanceByStatus)(nil),      // 18: types.ReqIssuanceByStatus
+	(*RepIssuanceIDs)(nil),           // 19: types.RepIssuanceIDs
+	(*ReqIssuanceRecords)(nil),       // 20: types.ReqIssuanceRecords
+	(*RepIssuanceRecords)(nil),       // 21: types.RepIssuanceRecords
+	(*RepIssuanceDebtInfo)(nil),      // 22: types.RepIssuanceDebtInfo
+	(*RepIssuancePrice)(nil),         // 23: types.RepIssuancePrice
+	(*RepIssuanceUserBalance)(nil),   // 24: types.RepIssuanceUserBalance
 }
 var file_issuance_proto_depIdxs = []int32{
 	1,  // 0: types.Issuance.debtRecords:type_name -> types.DebtRecord
 	1,  // 1: types.Issuance.invalidRecords:type_name -> types.DebtRecord
-	5,  // 2: types.IssuanceAction.create:type_name -> types.IssuanceCreate
-	6,  // 3: types.IssuanceAction.debt:type_name -> types.IssuanceDebt
-	7,  // 4: types.IssuanceAction.repay:type_name -> types.IssuanceRepay
-	8,  // 5: types.IssuanceAction.feed:type_name -> types.IssuanceFeed
-	9,  // 6: types.IssuanceAction.close:type_name -> types.IssuanceClose
+	6,  // 2: types.IssuanceAction.create:type_name -> types.IssuanceCreate
+	7,  // 3: types.IssuanceAction.debt:type_name -> types.IssuanceDebt
+	8,  // 4: types.IssuanceAction.repay:type_name -> types.IssuanceRepay
+	9,  // 5: types.IssuanceAction.feed:type_name -> types.IssuanceFeed
+	10, // 6: types.IssuanceAction.close:type_name -> types.IssuanceClose
 	4,  // 7: types.IssuanceAction.manage:type_name -> types.IssuanceManage
-	10, // 8: types.IssuanceRecords.records:type_name -> types.ReceiptIssuance
-	14, // 9: types.RepIssuanceCurrentInfos.infos:type_name -> types.RepIssuanceCurrentInfo
-	1,  // 10: types.RepIssuanceRecords.records:type_name -> types.DebtRecord
-	1,  // 11: types.RepIssuanceDebtInfo.record:type_name -> types.DebtRecord
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	5,  // 8: types.IssuanceManage.feedConfig:type_name -> types.IssuanceFeedConfig
+	11, // 9: types.IssuanceRecords.records:type_name -> types.ReceiptIssuance
+	15, // 10: types.RepIssuanceCurrentInfos.infos:type_name -> types.RepIssuanceCurrentInfo
+	1,  // 11: types.RepIssuanceRecords.records:type_name -> types.DebtRecord
+	1,  // 12: types.RepIssuanceDebtInfo.record:type_name -> types.DebtRecord
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_issuance_proto_init() }
@@ -2001,7 +2095,7 @@ func file_issuance_proto_init() {
 			}
 		}
 		file_issuance_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IssuanceCreate); i {
+			switch v := v.(*IssuanceFeedConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2013,7 +2107,7 @@ func file_issuance_proto_init() {
 			}
 		}
 		file_issuance_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IssuanceDebt); i {
+			switch v := v.(*IssuanceCreate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2025,7 +2119,7 @@ func file_issuance_proto_init() {
 			}
 		}
 		file_issuance_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IssuanceRepay); i {
+			switch v := v.(*IssuanceDebt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2037,7 +2131,7 @@ func file_issuance_proto_init() {
 			}
 		}
 		file_issuance_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IssuanceFeed); i {
+			switch v := v.(*IssuanceRepay); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2049,7 +2143,7 @@ func file_issuance_proto_init() {
 			}
 		}
 		file_issuance_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IssuanceClose); i {
+			switch v := v.(*IssuanceFeed); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2061,7 +2155,7 @@ func file_issuance_proto_init() {
 			}
 		}
 		file_issuance_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptIssuance); i {
+			switch v := v.(*IssuanceClose); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2073,7 +2167,7 @@ func file_issuance_proto_init() {
 			}
 		}
 		file_issuance_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptIssuanceID); i {
+			switch v := v.(*ReceiptIssuance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2085,7 +2179,7 @@ func file_issuance_proto_init() {
 			}
 		}
 		file_issuance_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IssuanceRecords); i {
+			switch v := v.(*ReceiptIssuanceID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2097,7 +2191,7 @@ func file_issuance_proto_init() {
 			}
 		}
 		file_issuance_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqIssuanceInfo); i {
+			switch v := v.(*IssuanceRecords); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2109,7 +2203,7 @@ func file_issuance_proto_init() {
 			}
 		}
 		file_issuance_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepIssuanceCurrentInfo); i {
+			switch v := v.(*ReqIssuanceInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2121,7 +2215,7 @@ func file_issuance_proto_init() {
 			}
 		}
 		file_issuance_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqIssuanceInfos); i {
+			switch v := v.(*RepIssuanceCurrentInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2133,7 +2227,7 @@ func file_issuance_proto_init() {
 			}
 		}
 		file_issuance_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepIssuanceCurrentInfos); i {
+			switch v := v.(*ReqIssuanceInfos); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2145,7 +2239,7 @@ func file_issuance_proto_init() {
 			}
 		}
 		file_issuance_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqIssuanceByStatus); i {
+			switch v := v.(*RepIssuanceCurrentInfos); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2157,7 +2251,7 @@ func file_issuance_proto_init() {
 			}
 		}
 		file_issuance_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepIssuanceIDs); i {
+			switch v := v.(*ReqIssuanceByStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2169,7 +2263,7 @@ func file_issuance_proto_init() {
 			}
 		}
 		file_issuance_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqIssuanceRecords); i {
+			switch v := v.(*RepIssuanceIDs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2181,7 +2275,7 @@ func file_issuance_proto_init() {
 			}
 		}
 		file_issuance_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepIssuanceRecords); i {
+			switch v := v.(*ReqIssuanceRecords); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2193,7 +2287,7 @@ func file_issuance_proto_init() {
 			}
 		}
 		file_issuance_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepIssuanceDebtInfo); i {
+			switch v := v.(*RepIssuanceRecords); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2205,7 +2299,7 @@ func file_issuance_proto_init() {
 			}
 		}
 		file_issuance_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepIssuancePrice); i {
+			switch v := v.(*RepIssuanceDebtInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2217,6 +2311,18 @@ func file_issuance_proto_init() {
 			}
 		}
 		file_issuance_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepIssuancePrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_issuance_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RepIssuanceUserBalance); i {
 			case 0:
 				return &v.state
@@ -2243,7 +2349,7 @@ func file_issuance_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_issuance_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
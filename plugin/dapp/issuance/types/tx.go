@@ -29,9 +29,10 @@ type IssuanceRepayTx struct {
 
 // IssuanceFeedTx for construction
 type IssuanceFeedTx struct {
-	Price  []float64 `json:"price"`
-	Volume []int64   `json:"volume"`
-	Fee    int64     `json:"fee"`
+	Price         []float64 `json:"price"`
+	Volume        []int64   `json:"volume"`
+	OracleEventID string    `json:"oracleEventId"`
+	Fee           int64     `json:"fee"`
 }
 
 // IssuanceCloseTx for construction
@@ -42,6 +43,9 @@ type IssuanceCloseTx struct {
 
 // IssuanceManageTx for construction
 type IssuanceManageTx struct {
-	Addr []string `json:"addr"`
-	Fee  int64    `json:"fee"`
+	Addr          []string `json:"addr"`
+	FeedQuorum    int32    `json:"feedQuorum"`
+	FeedWindow    int64    `json:"feedWindow"`
+	FeedDeviation float64  `json:"feedDeviation"`
+	Fee           int64    `json:"fee"`
 }
@@ -23,8 +23,22 @@ var codecache *lru.Cache
 
 var isinit int64
 
+type subConfig struct {
+	// 随机数来源执行器，需支持RandNumHash查询，默认ticket
+	RandExecName string `json:"randExecName"`
+	// 单次调用最多执行的语句数
+	MaxSteps int64 `json:"maxSteps"`
+	// 每单位手续费可以执行的语句数，为0时不按手续费计量
+	StepsPerFee int64 `json:"stepsPerFee"`
+}
+
+var subCfg = subConfig{RandExecName: "ticket", MaxSteps: 1000000, StepsPerFee: 10}
+
 //Init 插件初始化
 func Init(name string, cfg *types.Chain33Config, sub []byte) {
+	if sub != nil {
+		types.MustDecode(sub, &subCfg)
+	}
 	if atomic.CompareAndSwapInt64(&isinit, 0, 1) {
 		//最新的64个code做cache
 		var err error
@@ -110,7 +124,12 @@ func (u *js) callVM(prefix string, payload *jsproto.Call, tx *types.Transaction,
 	if err != nil {
 		return nil, err
 	}
-	vm, err := u.createVM(payload.Name, tx, index)
+	var fee int64
+	if tx != nil {
+		fee = tx.Fee
+	}
+	meter := u.stepLimit(fee)
+	vm, err := u.createVM(payload.Name, tx, index, meter)
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +141,7 @@ func (u *js) callVM(prefix string, payload *jsproto.Call, tx *types.Transaction,
 	}
 	vm.Set("args", payload.Args)
 	callfunc := "callcode(context, f, args, loglist)"
-	jsvalue, err := vm.Run(callfunc)
+	jsvalue, err := meter.run(vm, callfunc)
 	//除非你知道怎么做，不要返回这样的操作，这会引起整个区块执行失败，从而引起严重的安全问题。
 	//要保证不能人工的创造这样的条件，也就是调用接口的输入，不能用户可以任意修改的。
 	if u.GetExecutorAPI().IsErr() {
@@ -250,7 +269,8 @@ func (u *js) randnumFunc(vm *otto.Otto, name string) {
 	vm.Set("randnum", func(call otto.FunctionCall) otto.Value {
 		hash := u.GetLastHash()
 		param := &types.ReqRandHash{
-			ExecName: "ticket",
+			ExecName: subCfg.RandExecName,
+			Height:   u.GetHeight() - 1,
 			BlockNum: 5,
 			Hash:     hash,
 		}
@@ -291,14 +311,24 @@ func (u *js) listdbFunc(vm *otto.Otto, name string) {
 	})
 }
 
-func (u *js) createVM(name string, tx *types.Transaction, index int) (*otto.Otto, error) {
+//codeItem 缓存的合约代码vm，以及顶层代码执行的步数
+type codeItem struct {
+	vm    *otto.Otto
+	steps int64
+}
+
+//createVM 创建合约的vm，合约代码顶层执行的步数计入meter，结果与节点是否cache了代码无关
+func (u *js) createVM(name string, tx *types.Transaction, index int, meter *stepMeter) (*otto.Otto, error) {
 	data, err := json.Marshal(u.getContext(tx, int64(index)))
 	if err != nil {
 		return nil, err
 	}
 	var vm *otto.Otto
-	if vmitem, ok := codecache.Get(name); ok {
-		vm = vmitem.(*otto.Otto).Copy()
+	if item, ok := codecache.Get(name); ok {
+		vm = item.(*codeItem).vm.Copy()
+		if err = meter.charge(item.(*codeItem).steps); err != nil {
+			return nil, err
+		}
 	} else {
 		code, err := u.GetStateDB().Get(calcCodeKey(name))
 		if err != nil {
@@ -306,9 +336,23 @@ func (u *js) createVM(name string, tx *types.Transaction, index int) (*otto.Otto
 		}
 		//cache 合约代码部分，不会cache 具体执行
 		cachevm := basevm.Copy()
-		cachevm.Run(code)
-		codecache.Add(name, cachevm)
-		vm = cachevm.Copy()
+		//顶层代码总是按maxSteps计量并记录步数，超限的代码不会被cache
+		top := newStepMeter(subCfg.MaxSteps)
+		if _, err = top.run(cachevm, code); err == ptypes.ErrOutOfGas {
+			if meter != nil {
+				return nil, err
+			}
+			//分叉前不限制步数
+			cachevm = basevm.Copy()
+			_, _ = cachevm.Run(code)
+			vm = cachevm
+		} else {
+			codecache.Add(name, &codeItem{vm: cachevm, steps: top.used})
+			vm = cachevm.Copy()
+			if err = meter.charge(top.used); err != nil {
+				return nil, err
+			}
+		}
 	}
 	vm.Set("context", string(data))
 	u.statedbFunc(vm, name)
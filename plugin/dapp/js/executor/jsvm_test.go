@@ -205,7 +205,7 @@ func TestCacheMemUsage(t *testing.T) {
 	dir, ldb, kvdb := util.CreateTestDB()
 	defer util.CloseTestDB(dir, ldb)
 	e := initExec(ldb, kvdb, jscode, t)
-	vm, err := e.createVM("test", nil, 0)
+	vm, err := e.createVM("test", nil, 0, nil)
 	assert.Nil(t, err)
 	n := 64
 	vms := make([]*otto.Otto, n)
@@ -227,3 +227,78 @@ func printMemUsage() {
 func bToMb(b uint64) uint64 {
 	return b / 1024 / 1024
 }
+
+var loopcode = `
+function Init(context) {
+    this.kvc = new kvcreator("init")
+    return this.kvc.receipt()
+}
+
+Exec.prototype.loop = function(args) {
+    while (true) {}
+}
+
+Exec.prototype.trycatch = function(args) {
+    try {
+        while (true) {}
+    } catch (e) {
+    }
+    return this.kvc.receipt()
+}
+
+Exec.prototype.count = function(args) {
+    for (var i = 0; i < 1000; i++) {}
+    this.kvc.add("count", i)
+    return this.kvc.receipt()
+}
+`
+
+func TestStepLimit(t *testing.T) {
+	dir, ldb, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, ldb)
+	e := initExec(ldb, kvdb, jscode, t)
+	c, tx := createCodeTx("steptest", loopcode)
+	receipt, err := e.Exec_Create(c, tx, 0)
+	assert.Nil(t, err)
+	util.SaveKVList(ldb, receipt.KV)
+
+	//死循环以及在try/catch中的死循环都会被中断
+	call, tx := callCodeTx("steptest", "loop", "{}")
+	_, err = e.callVM("exec", call, tx, 0, nil)
+	assert.Equal(t, ptypes.ErrOutOfGas, err)
+	call, tx = callCodeTx("steptest", "trycatch", "{}")
+	_, err = e.callVM("exec", call, tx, 0, nil)
+	assert.Equal(t, ptypes.ErrOutOfGas, err)
+
+	//按照手续费计量
+	call, tx = callCodeTx("steptest", "count", "{}")
+	_, err = e.callVM("exec", call, tx, 0, nil)
+	assert.Nil(t, err)
+	tx.Fee = 10
+	_, err = e.callVM("exec", call, tx, 0, nil)
+	assert.Equal(t, ptypes.ErrOutOfGas, err)
+	tx.Fee = 1000
+	_, err = e.callVM("exec", call, tx, 0, nil)
+	assert.Nil(t, err)
+
+	//合约代码顶层的死循环
+	c, tx = createCodeTx("steptest2", "while (true) {}")
+	_, err = e.Exec_Create(c, tx, 0)
+	assert.Equal(t, ptypes.ErrOutOfGas, err)
+
+	//顶层代码的步数每次调用都计入，与代码是否已经cache无关
+	c, tx = createCodeTx("steptest3", "for (var j = 0; j < 10000; j++) {}\n"+loopcode)
+	receipt, err = e.Exec_Create(c, tx, 0)
+	assert.Nil(t, err)
+	util.SaveKVList(ldb, receipt.KV)
+	call, tx = callCodeTx("steptest3", "count", "{}")
+	tx.Fee = 1000
+	_, err = e.callVM("exec", call, tx, 0, nil)
+	assert.Equal(t, ptypes.ErrOutOfGas, err)
+	codecache.Purge()
+	_, err = e.callVM("exec", call, tx, 0, nil)
+	assert.Equal(t, ptypes.ErrOutOfGas, err)
+	tx.Fee = 0
+	_, err = e.callVM("exec", call, tx, 0, nil)
+	assert.Nil(t, err)
+}
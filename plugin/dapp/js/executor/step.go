@@ -0,0 +1,71 @@
+package executor
+
+import (
+	ptypes "github.com/33cn/plugin/plugin/dapp/js/types"
+	"github.com/robertkrimen/otto"
+)
+
+// stepMeter 按照执行的语句数计量js合约的执行步数，结果只和代码及输入相关，各节点一致
+// 超过限制后通过panic中断otto的执行，js代码中的try/catch无法捕获
+type stepMeter struct {
+	limit int64
+	used  int64
+}
+
+func newStepMeter(limit int64) *stepMeter {
+	return &stepMeter{limit: limit}
+}
+
+// run 在计量下执行js代码，超过步数限制返回ErrOutOfGas
+func (m *stepMeter) run(vm *otto.Otto, src interface{}) (value otto.Value, err error) {
+	if m == nil {
+		return vm.Run(src)
+	}
+	// otto在执行每条语句前都会检查Interrupt，这里每次检查都计数一次并重新放回
+	var step func()
+	step = func() {
+		m.used++
+		if m.used > m.limit {
+			panic(m)
+		}
+		vm.Interrupt <- step
+	}
+	vm.Interrupt = make(chan func(), 1)
+	vm.Interrupt <- step
+	defer func() {
+		vm.Interrupt = nil
+		if caught := recover(); caught != nil {
+			if caught != m {
+				panic(caught)
+			}
+			err = ptypes.ErrOutOfGas
+		}
+	}()
+	return vm.Run(src)
+}
+
+// charge 计入已经执行的步数，超过步数限制返回ErrOutOfGas
+func (m *stepMeter) charge(steps int64) error {
+	if m == nil {
+		return nil
+	}
+	m.used += steps
+	if m.used > m.limit {
+		return ptypes.ErrOutOfGas
+	}
+	return nil
+}
+
+// stepLimit 计算本次调用允许执行的最大步数
+// 交易设置了手续费时按照手续费折算，最多不超过配置的maxSteps；交易组中的非首笔交易或者查询不带手续费，使用maxSteps
+func (u *js) stepLimit(fee int64) *stepMeter {
+	cfg := u.GetAPI().GetConfig()
+	if !cfg.IsDappFork(u.GetHeight(), ptypes.JsX, ptypes.ForkJsStepLimit) {
+		return nil
+	}
+	limit := subCfg.MaxSteps
+	if fee > 0 && subCfg.StepsPerFee > 0 && fee < limit/subCfg.StepsPerFee {
+		limit = fee * subCfg.StepsPerFee
+	}
+	return newStepMeter(limit)
+}
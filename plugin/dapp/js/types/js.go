@@ -52,8 +52,13 @@ var (
 	ErrDBType       = errors.New("chain33.js: ErrDBType")
 	// ErrJsCreator
 	ErrJsCreator = errors.New("ErrJsCreator")
+	// ErrOutOfGas 合约执行步数超过限制
+	ErrOutOfGas = errors.New("chain33.js: ErrOutOfGas")
 )
 
+// ForkJsStepLimit 合约执行步数限制
+const ForkJsStepLimit = "ForkJsStepLimit"
+
 func init() {
 	types.AllowUserExec = append(types.AllowUserExec, []byte(JsX))
 	types.RegFork(JsX, InitFork)
@@ -63,6 +68,7 @@ func init() {
 //InitFork ...
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(JsX, "Enable", 0)
+	cfg.RegisterDappFork(JsX, ForkJsStepLimit, 0)
 }
 
 //InitExecutor ...
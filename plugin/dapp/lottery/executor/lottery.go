@@ -19,9 +19,11 @@ var driverName = pty.LotteryX
 
 type subConfig struct {
 	ParaRemoteGrpcClient string `json:"paraRemoteGrpcClient"`
+	// 随机数来源执行器，需支持RandNumHash查询，默认ticket
+	RandExecName string `json:"randExecName"`
 }
 
-var subCfg subConfig
+var subCfg = subConfig{RandExecName: "ticket"}
 
 // Init lottery
 func Init(name string, cfg *types.Chain33Config, sub []byte) {
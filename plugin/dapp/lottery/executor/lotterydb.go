@@ -549,9 +549,11 @@ func (action *Action) findLuckyNum(isSolo bool, lott *LotteryDB) (int64, error)
 	} else {
 		//发消息给randnum模块
 		//在主链上，当前高度查询不到，如果要保证区块个数，高度传入action.height-1
+		//randbeacon按高度选取轮次，重放区块时结果不受之后完成的轮次影响
 		llog.Debug("findLuckyNum on randnum module")
 		param := &types.ReqRandHash{
-			ExecName: "ticket",
+			ExecName: subCfg.RandExecName,
+			Height:   action.height - 1,
 			BlockNum: blockNum,
 			Hash:     action.lottery.GetLastHash(),
 		}
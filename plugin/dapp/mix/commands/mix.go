@@ -603,6 +603,8 @@ func WalletCmd() *cobra.Command {
 	cmd.AddCommand(RescanStatusCmd())
 	cmd.AddCommand(EnableCmd())
 	cmd.AddCommand(SecretCmd())
+	cmd.AddCommand(ViewingKeyCmd())
+	cmd.AddCommand(AuditCmd())
 
 	return cmd
 }
@@ -776,6 +778,165 @@ func enablePrivacy(cmd *cobra.Command, args []string) {
 	ctx.Run()
 }
 
+// ViewingKeyCmd 只读查看key
+func ViewingKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "viewkey",
+		Short: "note viewing key cmd",
+	}
+	cmd.AddCommand(ShowViewingKeyCmd())
+	cmd.AddCommand(ImportViewingKeyCmd())
+
+	return cmd
+}
+
+// ShowViewingKeyCmd export account viewing key
+func ShowViewingKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "show account viewing key which can detect and decrypt notes without spending",
+		Run:   showViewingKey,
+	}
+	showViewingKeyCmdFlags(cmd)
+	return cmd
+}
+
+func showViewingKeyCmdFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("addr", "a", "", "user wallet addr")
+	cmd.Flags().StringP("priv", "p", "", "user wallet addr's privacy key,option")
+}
+
+func showViewingKey(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	priv, _ := cmd.Flags().GetString("priv")
+	addr, _ := cmd.Flags().GetString("addr")
+
+	if len(priv) == 0 && len(addr) == 0 {
+		fmt.Println("err: one of addr or priv should be fill")
+		return
+	}
+
+	var res mixTy.ViewingKey
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "mix.ShowViewingKey", &mixTy.PaymentKeysReq{PrivKey: priv, Addr: addr}, &res)
+	ctx.Run()
+}
+
+// ImportViewingKeyCmd import other addr's viewing key
+func ImportViewingKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "import viewing key of addr, rescan notes after import",
+		Run:   importViewingKey,
+	}
+	importViewingKeyCmdFlags(cmd)
+	return cmd
+}
+
+func importViewingKeyCmdFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("addr", "a", "", "addr of viewing key")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().StringP("receive", "r", "", "note receive key")
+	cmd.MarkFlagRequired("receive")
+	cmd.Flags().StringP("secret", "s", "", "secret priv key")
+	cmd.MarkFlagRequired("secret")
+	cmd.Flags().StringP("pub", "p", "", "secret pub key,option")
+}
+
+func importViewingKey(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	receive, _ := cmd.Flags().GetString("receive")
+	secret, _ := cmd.Flags().GetString("secret")
+	pub, _ := cmd.Flags().GetString("pub")
+
+	params := &mixTy.ViewingKey{Addr: addr, ReceiveKey: receive, SecretPrivKey: secret, SecretPubKey: pub}
+	var res types.ReqString
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "mix.ImportViewingKey", params, &res)
+	ctx.Run()
+}
+
+// AuditCmd 审计导出和校验
+func AuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "audit statement cmd",
+	}
+	cmd.AddCommand(ExportAuditCmd())
+	cmd.AddCommand(VerifyAuditCmd())
+
+	return cmd
+}
+
+// ExportAuditCmd export signed statement of notes, spends and balances
+func ExportAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "export signed statement of notes, spends and balances over height range",
+		Run:   exportAudit,
+	}
+	exportAuditCmdFlags(cmd)
+	return cmd
+}
+
+func exportAuditCmdFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("addr", "a", "", "account addr")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().Int64P("start", "s", 0, "start height")
+	cmd.Flags().Int64P("end", "e", 0, "end height")
+	cmd.MarkFlagRequired("end")
+	cmd.Flags().StringP("signer", "g", "", "sign addr in wallet, default addr,option")
+}
+
+func exportAudit(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	start, _ := cmd.Flags().GetInt64("start")
+	end, _ := cmd.Flags().GetInt64("end")
+	signer, _ := cmd.Flags().GetString("signer")
+
+	params := &mixTy.AuditStatementReq{Addr: addr, StartHeight: start, EndHeight: end, SignAddr: signer}
+	var res json.RawMessage
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "mix.ExportAuditStatement", params, &res)
+	ctx.Run()
+}
+
+// VerifyAuditCmd verify exported audit statement
+func VerifyAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "verify signature of exported audit statement",
+		Run:   verifyAudit,
+	}
+	verifyAuditCmdFlags(cmd)
+	return cmd
+}
+
+func verifyAuditCmdFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("file", "f", "", "statement json file")
+	cmd.MarkFlagRequired("file")
+}
+
+func verifyAudit(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	file, _ := cmd.Flags().GetString("file")
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var params mixTy.AuditStatement
+	err = types.JSONToPB(data, &params)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	var res rpctypes.Reply
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "mix.VerifyAuditStatement", &params, &res)
+	ctx.Run()
+}
+
 func SecretCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "secret",
@@ -148,3 +148,39 @@ func (c *Jrpc) CreateZkKeyFile(in *mixTy.CreateZkKeyFileReq, result *interface{}
 	*result = reply
 	return err
 }
+
+func (c *Jrpc) ShowViewingKey(in *mixTy.PaymentKeysReq, result *json.RawMessage) error {
+	reply, err := c.cli.ExecWalletFunc(mixTy.MixX, "ShowViewingKey", in)
+	if err != nil {
+		return err
+	}
+	*result, err = types.PBToJSON(reply)
+	return err
+}
+
+func (c *Jrpc) ImportViewingKey(in *mixTy.ViewingKey, result *json.RawMessage) error {
+	reply, err := c.cli.ExecWalletFunc(mixTy.MixX, "ImportViewingKey", in)
+	if err != nil {
+		return err
+	}
+	*result, err = types.PBToJSON(reply)
+	return err
+}
+
+func (c *Jrpc) ExportAuditStatement(in *mixTy.AuditStatementReq, result *json.RawMessage) error {
+	reply, err := c.cli.ExecWalletFunc(mixTy.MixX, "ExportAuditStatement", in)
+	if err != nil {
+		return err
+	}
+	*result, err = types.PBToJSON(reply)
+	return err
+}
+
+func (c *Jrpc) VerifyAuditStatement(in *mixTy.AuditStatement, result *json.RawMessage) error {
+	reply, err := c.cli.ExecWalletFunc(mixTy.MixX, "VerifyAuditStatement", in)
+	if err != nil {
+		return err
+	}
+	*result, err = types.PBToJSON(reply)
+	return err
+}
@@ -198,7 +198,7 @@ func (x *NoteKeyPair) GetSpendKey() string {
 }
 
 // diff-hellman crypto key pair
-// secretPrivKey = wallet private key * G_25519
+// secretPrivKey = sha256(salt + wallet private key * G_25519), 可作为只读查看key
 // secretPubKey  = secretPrivKey * G_25519
 type EncryptSecretKeyPair struct {
 	state         protoimpl.MessageState
@@ -953,6 +953,444 @@ func (x *PaymentKeysReq) GetDetail() bool {
 	return false
 }
 
+//只读查看key，可以检测和解密addr的note，但没有花费权限
+type ViewingKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr          string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	ReceiveKey    string `protobuf:"bytes,2,opt,name=receiveKey,proto3" json:"receiveKey,omitempty"`
+	SecretPrivKey string `protobuf:"bytes,3,opt,name=secretPrivKey,proto3" json:"secretPrivKey,omitempty"`
+	SecretPubKey  string `protobuf:"bytes,4,opt,name=secretPubKey,proto3" json:"secretPubKey,omitempty"`
+}
+
+func (x *ViewingKey) Reset() {
+	*x = ViewingKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixwallet_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ViewingKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViewingKey) ProtoMessage() {}
+
+func (x *ViewingKey) ProtoReflect() protoreflect.Message {
+	mi := &file_mixwallet_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViewingKey.ProtoReflect.Descriptor instead.
+func (*ViewingKey) Descriptor() ([]byte, []int) {
+	return file_mixwallet_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ViewingKey) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ViewingKey) GetReceiveKey() string {
+	if x != nil {
+		return x.ReceiveKey
+	}
+	return ""
+}
+
+func (x *ViewingKey) GetSecretPrivKey() string {
+	if x != nil {
+		return x.SecretPrivKey
+	}
+	return ""
+}
+
+func (x *ViewingKey) GetSecretPubKey() string {
+	if x != nil {
+		return x.SecretPubKey
+	}
+	return ""
+}
+
+//审计导出请求，高度区间[startHeight,endHeight]
+type AuditStatementReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr        string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	StartHeight int64  `protobuf:"varint,2,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	EndHeight   int64  `protobuf:"varint,3,opt,name=endHeight,proto3" json:"endHeight,omitempty"`
+	SignAddr    string `protobuf:"bytes,4,opt,name=signAddr,proto3" json:"signAddr,omitempty"` //签名地址，缺省为addr
+}
+
+func (x *AuditStatementReq) Reset() {
+	*x = AuditStatementReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixwallet_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditStatementReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditStatementReq) ProtoMessage() {}
+
+func (x *AuditStatementReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mixwallet_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditStatementReq.ProtoReflect.Descriptor instead.
+func (*AuditStatementReq) Descriptor() ([]byte, []int) {
+	return file_mixwallet_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AuditStatementReq) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *AuditStatementReq) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *AuditStatementReq) GetEndHeight() int64 {
+	if x != nil {
+		return x.EndHeight
+	}
+	return 0
+}
+
+func (x *AuditStatementReq) GetSignAddr() string {
+	if x != nil {
+		return x.SignAddr
+	}
+	return ""
+}
+
+type AuditNote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NoteHash    string     `protobuf:"bytes,1,opt,name=noteHash,proto3" json:"noteHash,omitempty"`
+	AssetExec   string     `protobuf:"bytes,2,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	AssetSymbol string     `protobuf:"bytes,3,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
+	Amount      string     `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Role        string     `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"` //receiver,returner,authorizer
+	Status      NoteStatus `protobuf:"varint,6,opt,name=status,proto3,enum=types.NoteStatus" json:"status,omitempty"`
+	Height      int64      `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	SpendHeight int64      `protobuf:"varint,8,opt,name=spendHeight,proto3" json:"spendHeight,omitempty"`
+}
+
+func (x *AuditNote) Reset() {
+	*x = AuditNote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixwallet_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditNote) ProtoMessage() {}
+
+func (x *AuditNote) ProtoReflect() protoreflect.Message {
+	mi := &file_mixwallet_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditNote.ProtoReflect.Descriptor instead.
+func (*AuditNote) Descriptor() ([]byte, []int) {
+	return file_mixwallet_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AuditNote) GetNoteHash() string {
+	if x != nil {
+		return x.NoteHash
+	}
+	return ""
+}
+
+func (x *AuditNote) GetAssetExec() string {
+	if x != nil {
+		return x.AssetExec
+	}
+	return ""
+}
+
+func (x *AuditNote) GetAssetSymbol() string {
+	if x != nil {
+		return x.AssetSymbol
+	}
+	return ""
+}
+
+func (x *AuditNote) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AuditNote) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AuditNote) GetStatus() NoteStatus {
+	if x != nil {
+		return x.Status
+	}
+	return NoteStatus_UNDEF
+}
+
+func (x *AuditNote) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *AuditNote) GetSpendHeight() int64 {
+	if x != nil {
+		return x.SpendHeight
+	}
+	return 0
+}
+
+//receiver角色的note余额，closing = opening + received - spent
+type AuditBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssetExec   string `protobuf:"bytes,1,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	AssetSymbol string `protobuf:"bytes,2,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
+	Opening     uint64 `protobuf:"varint,3,opt,name=opening,proto3" json:"opening,omitempty"`
+	Received    uint64 `protobuf:"varint,4,opt,name=received,proto3" json:"received,omitempty"`
+	Spent       uint64 `protobuf:"varint,5,opt,name=spent,proto3" json:"spent,omitempty"`
+	Closing     uint64 `protobuf:"varint,6,opt,name=closing,proto3" json:"closing,omitempty"`
+}
+
+func (x *AuditBalance) Reset() {
+	*x = AuditBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixwallet_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditBalance) ProtoMessage() {}
+
+func (x *AuditBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_mixwallet_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditBalance.ProtoReflect.Descriptor instead.
+func (*AuditBalance) Descriptor() ([]byte, []int) {
+	return file_mixwallet_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AuditBalance) GetAssetExec() string {
+	if x != nil {
+		return x.AssetExec
+	}
+	return ""
+}
+
+func (x *AuditBalance) GetAssetSymbol() string {
+	if x != nil {
+		return x.AssetSymbol
+	}
+	return ""
+}
+
+func (x *AuditBalance) GetOpening() uint64 {
+	if x != nil {
+		return x.Opening
+	}
+	return 0
+}
+
+func (x *AuditBalance) GetReceived() uint64 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *AuditBalance) GetSpent() uint64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *AuditBalance) GetClosing() uint64 {
+	if x != nil {
+		return x.Closing
+	}
+	return 0
+}
+
+type AuditStatement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr        string           `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	StartHeight int64            `protobuf:"varint,2,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	EndHeight   int64            `protobuf:"varint,3,opt,name=endHeight,proto3" json:"endHeight,omitempty"`
+	Notes       []*AuditNote     `protobuf:"bytes,4,rep,name=notes,proto3" json:"notes,omitempty"`   //区间内收到的note
+	Spends      []*AuditNote     `protobuf:"bytes,5,rep,name=spends,proto3" json:"spends,omitempty"` //区间内花费的note
+	Balances    []*AuditBalance  `protobuf:"bytes,6,rep,name=balances,proto3" json:"balances,omitempty"`
+	Signer      string           `protobuf:"bytes,7,opt,name=signer,proto3" json:"signer,omitempty"`
+	Signature   *types.Signature `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *AuditStatement) Reset() {
+	*x = AuditStatement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixwallet_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditStatement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditStatement) ProtoMessage() {}
+
+func (x *AuditStatement) ProtoReflect() protoreflect.Message {
+	mi := &file_mixwallet_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditStatement.ProtoReflect.Descriptor instead.
+func (*AuditStatement) Descriptor() ([]byte, []int) {
+	return file_mixwallet_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AuditStatement) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *AuditStatement) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *AuditStatement) GetEndHeight() int64 {
+	if x != nil {
+		return x.EndHeight
+	}
+	return 0
+}
+
+func (x *AuditStatement) GetNotes() []*AuditNote {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *AuditStatement) GetSpends() []*AuditNote {
+	if x != nil {
+		return x.Spends
+	}
+	return nil
+}
+
+func (x *AuditStatement) GetBalances() []*AuditBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *AuditStatement) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *AuditStatement) GetSignature() *types.Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
 type WalletNoteInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -970,7 +1408,7 @@ type WalletNoteInfo struct {
 func (x *WalletNoteInfo) Reset() {
 	*x = WalletNoteInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixwallet_proto_msgTypes[13]
+		mi := &file_mixwallet_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -983,7 +1421,7 @@ func (x *WalletNoteInfo) String() string {
 func (*WalletNoteInfo) ProtoMessage() {}
 
 func (x *WalletNoteInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_mixwallet_proto_msgTypes[13]
+	mi := &file_mixwallet_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -996,7 +1434,7 @@ func (x *WalletNoteInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletNoteInfo.ProtoReflect.Descriptor instead.
 func (*WalletNoteInfo) Descriptor() ([]byte, []int) {
-	return file_mixwallet_proto_rawDescGZIP(), []int{13}
+	return file_mixwallet_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *WalletNoteInfo) GetNoteHash() string {
@@ -1053,14 +1491,15 @@ type WalletDbMixInfo struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Info    *WalletNoteInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
-	TxIndex string          `protobuf:"bytes,2,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
+	Info       *WalletNoteInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	TxIndex    string          `protobuf:"bytes,2,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
+	SpendIndex string          `protobuf:"bytes,3,opt,name=spendIndex,proto3" json:"spendIndex,omitempty"` //note被花费的交易heightindex
 }
 
 func (x *WalletDbMixInfo) Reset() {
 	*x = WalletDbMixInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixwallet_proto_msgTypes[14]
+		mi := &file_mixwallet_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1073,7 +1512,7 @@ func (x *WalletDbMixInfo) String() string {
 func (*WalletDbMixInfo) ProtoMessage() {}
 
 func (x *WalletDbMixInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_mixwallet_proto_msgTypes[14]
+	mi := &file_mixwallet_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1086,7 +1525,7 @@ func (x *WalletDbMixInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletDbMixInfo.ProtoReflect.Descriptor instead.
 func (*WalletDbMixInfo) Descriptor() ([]byte, []int) {
-	return file_mixwallet_proto_rawDescGZIP(), []int{14}
+	return file_mixwallet_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *WalletDbMixInfo) GetInfo() *WalletNoteInfo {
@@ -1103,6 +1542,13 @@ func (x *WalletDbMixInfo) GetTxIndex() string {
 	return ""
 }
 
+func (x *WalletDbMixInfo) GetSpendIndex() string {
+	if x != nil {
+		return x.SpendIndex
+	}
+	return ""
+}
+
 type WalletMixIndexReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1121,7 +1567,7 @@ type WalletMixIndexReq struct {
 func (x *WalletMixIndexReq) Reset() {
 	*x = WalletMixIndexReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixwallet_proto_msgTypes[15]
+		mi := &file_mixwallet_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1134,7 +1580,7 @@ func (x *WalletMixIndexReq) String() string {
 func (*WalletMixIndexReq) ProtoMessage() {}
 
 func (x *WalletMixIndexReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mixwallet_proto_msgTypes[15]
+	mi := &file_mixwallet_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,7 +1593,7 @@ func (x *WalletMixIndexReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletMixIndexReq.ProtoReflect.Descriptor instead.
 func (*WalletMixIndexReq) Descriptor() ([]byte, []int) {
-	return file_mixwallet_proto_rawDescGZIP(), []int{15}
+	return file_mixwallet_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *WalletMixIndexReq) GetNoteHash() string {
@@ -1217,7 +1663,7 @@ type WalletNoteResp struct {
 func (x *WalletNoteResp) Reset() {
 	*x = WalletNoteResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixwallet_proto_msgTypes[16]
+		mi := &file_mixwallet_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1230,7 +1676,7 @@ func (x *WalletNoteResp) String() string {
 func (*WalletNoteResp) ProtoMessage() {}
 
 func (x *WalletNoteResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mixwallet_proto_msgTypes[16]
+	mi := &file_mixwallet_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1243,7 +1689,7 @@ func (x *WalletNoteResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletNoteResp.ProtoReflect.Descriptor instead.
 func (*WalletNoteResp) Descriptor() ([]byte, []int) {
-	return file_mixwallet_proto_rawDescGZIP(), []int{16}
+	return file_mixwallet_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *WalletNoteResp) GetNotes() []*WalletNoteInfo {
@@ -1266,7 +1712,7 @@ type WalletEnablePrivacyRst struct {
 func (x *WalletEnablePrivacyRst) Reset() {
 	*x = WalletEnablePrivacyRst{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixwallet_proto_msgTypes[17]
+		mi := &file_mixwallet_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1279,7 +1725,7 @@ func (x *WalletEnablePrivacyRst) String() string {
 func (*WalletEnablePrivacyRst) ProtoMessage() {}
 
 func (x *WalletEnablePrivacyRst) ProtoReflect() protoreflect.Message {
-	mi := &file_mixwallet_proto_msgTypes[17]
+	mi := &file_mixwallet_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1292,7 +1738,7 @@ func (x *WalletEnablePrivacyRst) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletEnablePrivacyRst.ProtoReflect.Descriptor instead.
 func (*WalletEnablePrivacyRst) Descriptor() ([]byte, []int) {
-	return file_mixwallet_proto_rawDescGZIP(), []int{17}
+	return file_mixwallet_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *WalletEnablePrivacyRst) GetAddr() string {
@@ -1327,7 +1773,7 @@ type WalletEnablePrivacyResp struct {
 func (x *WalletEnablePrivacyResp) Reset() {
 	*x = WalletEnablePrivacyResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixwallet_proto_msgTypes[18]
+		mi := &file_mixwallet_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1340,7 +1786,7 @@ func (x *WalletEnablePrivacyResp) String() string {
 func (*WalletEnablePrivacyResp) ProtoMessage() {}
 
 func (x *WalletEnablePrivacyResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mixwallet_proto_msgTypes[18]
+	mi := &file_mixwallet_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1353,7 +1799,7 @@ func (x *WalletEnablePrivacyResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletEnablePrivacyResp.ProtoReflect.Descriptor instead.
 func (*WalletEnablePrivacyResp) Descriptor() ([]byte, []int) {
-	return file_mixwallet_proto_rawDescGZIP(), []int{18}
+	return file_mixwallet_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *WalletEnablePrivacyResp) GetResps() []*WalletEnablePrivacyRst {
@@ -1376,7 +1822,7 @@ type PrivacyAddrResult struct {
 func (x *PrivacyAddrResult) Reset() {
 	*x = PrivacyAddrResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixwallet_proto_msgTypes[19]
+		mi := &file_mixwallet_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1389,7 +1835,7 @@ func (x *PrivacyAddrResult) String() string {
 func (*PrivacyAddrResult) ProtoMessage() {}
 
 func (x *PrivacyAddrResult) ProtoReflect() protoreflect.Message {
-	mi := &file_mixwallet_proto_msgTypes[19]
+	mi := &file_mixwallet_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1402,7 +1848,7 @@ func (x *PrivacyAddrResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrivacyAddrResult.ProtoReflect.Descriptor instead.
 func (*PrivacyAddrResult) Descriptor() ([]byte, []int) {
-	return file_mixwallet_proto_rawDescGZIP(), []int{19}
+	return file_mixwallet_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *PrivacyAddrResult) GetAddr() string {
@@ -1437,7 +1883,7 @@ type ReqEnablePrivacyRst struct {
 func (x *ReqEnablePrivacyRst) Reset() {
 	*x = ReqEnablePrivacyRst{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixwallet_proto_msgTypes[20]
+		mi := &file_mixwallet_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1450,7 +1896,7 @@ func (x *ReqEnablePrivacyRst) String() string {
 func (*ReqEnablePrivacyRst) ProtoMessage() {}
 
 func (x *ReqEnablePrivacyRst) ProtoReflect() protoreflect.Message {
-	mi := &file_mixwallet_proto_msgTypes[20]
+	mi := &file_mixwallet_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1463,7 +1909,7 @@ func (x *ReqEnablePrivacyRst) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqEnablePrivacyRst.ProtoReflect.Descriptor instead.
 func (*ReqEnablePrivacyRst) Descriptor() ([]byte, []int) {
-	return file_mixwallet_proto_rawDescGZIP(), []int{20}
+	return file_mixwallet_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ReqEnablePrivacyRst) GetResults() []*PrivacyAddrResult {
@@ -1574,91 +2020,157 @@ var file_mixwallet_proto_rawDesc = []byte{
 	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04,
 	0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72,
 	0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x90, 0x02, 0x0a, 0x0e, 0x57, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x6e,
-	0x6f, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
-	0x6f, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x75, 0x6c, 0x6c,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x53, 0x70, 0x65, 0x6e,
-	0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x6f,
-	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x29, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x56, 0x0a, 0x0f, 0x57,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x44, 0x62, 0x4d, 0x69, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x29,
-	0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x22, 0x89, 0x02, 0x0a, 0x11, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4d, 0x69,
-	0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x74,
+	0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x8a, 0x01, 0x0a, 0x0a, 0x56, 0x69, 0x65,
+	0x77, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x4b, 0x65,
+	0x79, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0x83, 0x01, 0x0a, 0x11, 0x41, 0x75, 0x64, 0x69, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12,
+	0x20, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x22, 0xf8, 0x01, 0x0a, 0x09,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x74,
 	0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x74,
-	0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
-	0x53, 0x70, 0x65, 0x6e, 0x64, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
-	0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x3d, 0x0a, 0x0e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x2b, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4e,
-	0x6f, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x52,
-	0x0a, 0x16, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72,
-	0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x69, 0x73, 0x4f, 0x4b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x4b,
-	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
-	0x73, 0x67, 0x22, 0x4e, 0x0a, 0x17, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x33, 0x0a,
-	0x05, 0x72, 0x65, 0x73, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x73, 0x74, 0x52, 0x05, 0x72, 0x65, 0x73,
-	0x70, 0x73, 0x22, 0x4d, 0x0a, 0x11, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x41, 0x64, 0x64,
-	0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x69,
-	0x73, 0x4f, 0x4b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x4b, 0x12,
-	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
-	0x67, 0x22, 0x49, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72,
-	0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2a, 0x46, 0x0a, 0x0a,
-	0x4e, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e,
-	0x44, 0x45, 0x46, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x01,
-	0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x52,
-	0x4f, 0x5a, 0x45, 0x4e, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x4e, 0x46, 0x52, 0x4f, 0x5a,
-	0x45, 0x4e, 0x10, 0x04, 0x2a, 0x3d, 0x0a, 0x15, 0x4d, 0x69, 0x78, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x08, 0x0a,
-	0x04, 0x49, 0x44, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x43, 0x41, 0x4e, 0x4e,
-	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45,
-	0x44, 0x10, 0x02, 0x32, 0xb4, 0x01, 0x0a, 0x0a, 0x6d, 0x69, 0x78, 0x50, 0x72, 0x69, 0x76, 0x61,
-	0x63, 0x79, 0x12, 0x34, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65,
-	0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x63,
-	0x61, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
-	0x65, 0x71, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0d, 0x45, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x12, 0x0f, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x41, 0x64, 0x64, 0x72, 0x73, 0x1a, 0x1a, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72,
-	0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x73, 0x74, 0x22, 0x00, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78,
+	0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45,
+	0x78, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xb4, 0x01, 0x0a, 0x0c, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x45, 0x78, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x45, 0x78, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e,
+	0x67, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x70,
+	0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x22, 0xaf, 0x02,
+	0x0a, 0x0e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x06,
+	0x73, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x06,
+	0x73, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12,
+	0x2e, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0x90, 0x02, 0x0a, 0x0e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x12,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x24, 0x0a, 0x0d,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x22, 0x76, 0x0a, 0x0f, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x44, 0x62, 0x4d, 0x69,
+	0x78, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f,
+	0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x70, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x89, 0x02, 0x0a, 0x11, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4d, 0x69, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71,
+	0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09,
+	0x6e, 0x75, 0x6c, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x48, 0x61, 0x73, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x0e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2b, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x52, 0x0a, 0x16, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61,
+	0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x4f, 0x4b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x4b, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x4e, 0x0a, 0x17, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x33, 0x0a, 0x05, 0x72, 0x65, 0x73, 0x70, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52,
+	0x73, 0x74, 0x52, 0x05, 0x72, 0x65, 0x73, 0x70, 0x73, 0x22, 0x4d, 0x0a, 0x11, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x63, 0x79, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64,
+	0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x4f, 0x4b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x69, 0x73, 0x4f, 0x4b, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x49, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x73, 0x74, 0x12,
+	0x32, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79,
+	0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x2a, 0x46, 0x0a, 0x0a, 0x4e, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x52, 0x4f, 0x5a, 0x45, 0x4e, 0x10, 0x03, 0x12, 0x0c, 0x0a,
+	0x08, 0x55, 0x4e, 0x46, 0x52, 0x4f, 0x5a, 0x45, 0x4e, 0x10, 0x04, 0x2a, 0x3d, 0x0a, 0x15, 0x4d,
+	0x69, 0x78, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x44, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x0c,
+	0x0a, 0x08, 0x53, 0x43, 0x41, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
+	0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x02, 0x32, 0xb4, 0x01, 0x0a, 0x0a, 0x6d,
+	0x69, 0x78, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x12, 0x34, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x10, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12,
+	0x30, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x0d,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x10, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22,
+	0x00, 0x12, 0x3e, 0x0a, 0x0d, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x63, 0x79, 0x12, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x41, 0x64,
+	0x64, 0x72, 0x73, 0x1a, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x73, 0x74, 0x22,
+	0x00, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1674,7 +2186,7 @@ func file_mixwallet_proto_rawDescGZIP() []byte {
 }
 
 var file_mixwallet_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_mixwallet_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_mixwallet_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_mixwallet_proto_goTypes = []interface{}{
 	(NoteStatus)(0),                 // 0: types.NoteStatus
 	(MixWalletRescanStatus)(0),      // 1: types.MixWalletRescanStatus
@@ -1691,47 +2203,58 @@ var file_mixwallet_proto_goTypes = []interface{}{
 	(*ShieldAmountRst)(nil),         // 12: types.ShieldAmountRst
 	(*CreateRawTxReq)(nil),          // 13: types.CreateRawTxReq
 	(*PaymentKeysReq)(nil),          // 14: types.PaymentKeysReq
-	(*WalletNoteInfo)(nil),          // 15: types.WalletNoteInfo
-	(*WalletDbMixInfo)(nil),         // 16: types.WalletDbMixInfo
-	(*WalletMixIndexReq)(nil),       // 17: types.WalletMixIndexReq
-	(*WalletNoteResp)(nil),          // 18: types.WalletNoteResp
-	(*WalletEnablePrivacyRst)(nil),  // 19: types.WalletEnablePrivacyRst
-	(*WalletEnablePrivacyResp)(nil), // 20: types.WalletEnablePrivacyResp
-	(*PrivacyAddrResult)(nil),       // 21: types.PrivacyAddrResult
-	(*ReqEnablePrivacyRst)(nil),     // 22: types.ReqEnablePrivacyRst
-	(*SecretData)(nil),              // 23: types.SecretData
-	(*DHSecretGroup)(nil),           // 24: types.DHSecretGroup
-	(*types.ReqNil)(nil),            // 25: types.ReqNil
-	(*types.ReqAddrs)(nil),          // 26: types.ReqAddrs
-	(*types.ReqString)(nil),         // 27: types.ReqString
+	(*ViewingKey)(nil),              // 15: types.ViewingKey
+	(*AuditStatementReq)(nil),       // 16: types.AuditStatementReq
+	(*AuditNote)(nil),               // 17: types.AuditNote
+	(*AuditBalance)(nil),            // 18: types.AuditBalance
+	(*AuditStatement)(nil),          // 19: types.AuditStatement
+	(*WalletNoteInfo)(nil),          // 20: types.WalletNoteInfo
+	(*WalletDbMixInfo)(nil),         // 21: types.WalletDbMixInfo
+	(*WalletMixIndexReq)(nil),       // 22: types.WalletMixIndexReq
+	(*WalletNoteResp)(nil),          // 23: types.WalletNoteResp
+	(*WalletEnablePrivacyRst)(nil),  // 24: types.WalletEnablePrivacyRst
+	(*WalletEnablePrivacyResp)(nil), // 25: types.WalletEnablePrivacyResp
+	(*PrivacyAddrResult)(nil),       // 26: types.PrivacyAddrResult
+	(*ReqEnablePrivacyRst)(nil),     // 27: types.ReqEnablePrivacyRst
+	(*SecretData)(nil),              // 28: types.SecretData
+	(*DHSecretGroup)(nil),           // 29: types.DHSecretGroup
+	(*types.Signature)(nil),         // 30: types.Signature
+	(*types.ReqNil)(nil),            // 31: types.ReqNil
+	(*types.ReqAddrs)(nil),          // 32: types.ReqAddrs
+	(*types.ReqString)(nil),         // 33: types.ReqString
 }
 var file_mixwallet_proto_depIdxs = []int32{
 	2,  // 0: types.DepositTxReq.deposit:type_name -> types.DepositInfo
-	23, // 1: types.DepositProofResp.proof:type_name -> types.SecretData
-	24, // 2: types.DepositProofResp.secrets:type_name -> types.DHSecretGroup
+	28, // 1: types.DepositProofResp.proof:type_name -> types.SecretData
+	29, // 2: types.DepositProofResp.secrets:type_name -> types.DHSecretGroup
 	2,  // 3: types.TransferOutputTxReq.deposit:type_name -> types.DepositInfo
 	7,  // 4: types.TransferTxReq.input:type_name -> types.TransferInputTxReq
 	8,  // 5: types.TransferTxReq.output:type_name -> types.TransferOutputTxReq
 	11, // 6: types.ShieldAmountRst.inputs:type_name -> types.ShieldAmount
 	11, // 7: types.ShieldAmountRst.output:type_name -> types.ShieldAmount
 	11, // 8: types.ShieldAmountRst.change:type_name -> types.ShieldAmount
-	0,  // 9: types.WalletNoteInfo.status:type_name -> types.NoteStatus
-	23, // 10: types.WalletNoteInfo.secret:type_name -> types.SecretData
-	15, // 11: types.WalletDbMixInfo.info:type_name -> types.WalletNoteInfo
-	15, // 12: types.WalletNoteResp.notes:type_name -> types.WalletNoteInfo
-	19, // 13: types.WalletEnablePrivacyResp.resps:type_name -> types.WalletEnablePrivacyRst
-	21, // 14: types.ReqEnablePrivacyRst.results:type_name -> types.PrivacyAddrResult
-	25, // 15: types.mixPrivacy.GetRescanStatus:input_type -> types.ReqNil
-	25, // 16: types.mixPrivacy.RescanNotes:input_type -> types.ReqNil
-	26, // 17: types.mixPrivacy.EnablePrivacy:input_type -> types.ReqAddrs
-	27, // 18: types.mixPrivacy.GetRescanStatus:output_type -> types.ReqString
-	27, // 19: types.mixPrivacy.RescanNotes:output_type -> types.ReqString
-	22, // 20: types.mixPrivacy.EnablePrivacy:output_type -> types.ReqEnablePrivacyRst
-	18, // [18:21] is the sub-list for method output_type
-	15, // [15:18] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	0,  // 9: types.AuditNote.status:type_name -> types.NoteStatus
+	17, // 10: types.AuditStatement.notes:type_name -> types.AuditNote
+	17, // 11: types.AuditStatement.spends:type_name -> types.AuditNote
+	18, // 12: types.AuditStatement.balances:type_name -> types.AuditBalance
+	30, // 13: types.AuditStatement.signature:type_name -> types.Signature
+	0,  // 14: types.WalletNoteInfo.status:type_name -> types.NoteStatus
+	28, // 15: types.WalletNoteInfo.secret:type_name -> types.SecretData
+	20, // 16: types.WalletDbMixInfo.info:type_name -> types.WalletNoteInfo
+	20, // 17: types.WalletNoteResp.notes:type_name -> types.WalletNoteInfo
+	24, // 18: types.WalletEnablePrivacyResp.resps:type_name -> types.WalletEnablePrivacyRst
+	26, // 19: types.ReqEnablePrivacyRst.results:type_name -> types.PrivacyAddrResult
+	31, // 20: types.mixPrivacy.GetRescanStatus:input_type -> types.ReqNil
+	31, // 21: types.mixPrivacy.RescanNotes:input_type -> types.ReqNil
+	32, // 22: types.mixPrivacy.EnablePrivacy:input_type -> types.ReqAddrs
+	33, // 23: types.mixPrivacy.GetRescanStatus:output_type -> types.ReqString
+	33, // 24: types.mixPrivacy.RescanNotes:output_type -> types.ReqString
+	27, // 25: types.mixPrivacy.EnablePrivacy:output_type -> types.ReqEnablePrivacyRst
+	23, // [23:26] is the sub-list for method output_type
+	20, // [20:23] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_mixwallet_proto_init() }
@@ -1898,7 +2421,7 @@ func file_mixwallet_proto_init() {
 			}
 		}
 		file_mixwallet_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalletNoteInfo); i {
+			switch v := v.(*ViewingKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1910,7 +2433,7 @@ func file_mixwallet_proto_init() {
 			}
 		}
 		file_mixwallet_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalletDbMixInfo); i {
+			switch v := v.(*AuditStatementReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1922,7 +2445,7 @@ func file_mixwallet_proto_init() {
 			}
 		}
 		file_mixwallet_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalletMixIndexReq); i {
+			switch v := v.(*AuditNote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1934,7 +2457,7 @@ func file_mixwallet_proto_init() {
 			}
 		}
 		file_mixwallet_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalletNoteResp); i {
+			switch v := v.(*AuditBalance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1946,7 +2469,7 @@ func file_mixwallet_proto_init() {
 			}
 		}
 		file_mixwallet_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalletEnablePrivacyRst); i {
+			switch v := v.(*AuditStatement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1958,7 +2481,7 @@ func file_mixwallet_proto_init() {
 			}
 		}
 		file_mixwallet_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalletEnablePrivacyResp); i {
+			switch v := v.(*WalletNoteInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1970,7 +2493,7 @@ func file_mixwallet_proto_init() {
 			}
 		}
 		file_mixwallet_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrivacyAddrResult); i {
+			switch v := v.(*WalletDbMixInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1982,6 +2505,66 @@ func file_mixwallet_proto_init() {
 			}
 		}
 		file_mixwallet_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletMixIndexReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixwallet_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletNoteResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixwallet_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletEnablePrivacyRst); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixwallet_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletEnablePrivacyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixwallet_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrivacyAddrResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixwallet_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReqEnablePrivacyRst); i {
 			case 0:
 				return &v.state
@@ -2000,7 +2583,7 @@ func file_mixwallet_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mixwallet_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   21,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
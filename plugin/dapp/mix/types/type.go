@@ -13,6 +13,9 @@ import (
 	token "github.com/33cn/plugin/plugin/dapp/token/types"
 )
 
+// ForkMixViewingKey 分叉后新账户的加解密key由花费key单向推导，可以导出为只读查看key
+const ForkMixViewingKey = "ForkMixViewingKey"
+
 var (
 	// ParaX paracross exec name
 	MixX = "mix"
@@ -30,6 +33,7 @@ func init() {
 //InitFork ...
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(MixX, "Enable", 0)
+	cfg.RegisterDappFork(MixX, ForkMixViewingKey, 0)
 
 }
 
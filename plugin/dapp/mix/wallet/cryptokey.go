@@ -20,6 +20,8 @@ import (
 
 const CECBLOCKSIZE = 32
 
+const viewKeySalt = "mix-viewing-key"
+
 /*
  从secp256k1根私钥创建支票需要的私钥和公钥
  payPrivKey = rootPrivKey *G_X25519 这样很难泄露rootPrivKey
@@ -40,20 +42,46 @@ func newPrivacyKey(rootPrivKey []byte) *mixTy.AccountPrivacyKey {
 	paymentKey.SpendKey = mixTy.Byte2Str(payPrivKey[:])
 	paymentKey.ReceiveKey = mixTy.Byte2Str(mimcHashByte([][]byte{mixTy.Str2Byte(paymentKey.SpendKey)}))
 
-	encryptKeyPair := &mixTy.EncryptSecretKeyPair{}
-	pubkey := ecdh.PublicKey(payPrivKey)
-	//加解密是在x25519域，需要Hex编码，不要使用fr.string, 模范围不同
-	encryptKeyPair.SecretPrivKey = hex.EncodeToString(payPrivKey[:])
-	pubData := pubkey.([32]byte)
-	encryptKeyPair.SecretPubKey = hex.EncodeToString(pubData[:])
-
 	privacy := &mixTy.AccountPrivacyKey{}
 	privacy.PaymentKey = paymentKey
-	privacy.SecretKey = encryptKeyPair
+	privacy.SecretKey = newSecretKeyPair(payPrivKey[:])
 
 	return privacy
 }
 
+/*
+ ForkMixViewingKey之后新账户的加解密key由花费key单向推导，可以作为只读的查看key交给第三方，不泄露花费权限
+ DH加解密key: secretPrivKey= sha256(viewKeySalt+payPrivKey), encryptPubKey= secretPrivKey *G_X25519
+*/
+func newViewablePrivacyKey(rootPrivKey []byte) *mixTy.AccountPrivacyKey {
+	key := X25519().PublicKey(rootPrivKey)
+	payPrivKey := key.([32]byte)
+	privacy := newPrivacyKey(rootPrivKey)
+	privacy.SecretKey = newSecretKeyPair(common.Sha256(append([]byte(viewKeySalt), payPrivKey[:]...)))
+	return privacy
+}
+
+func newSecretKeyPair(privKey []byte) *mixTy.EncryptSecretKeyPair {
+	var priv [32]byte
+	copy(priv[:], privKey)
+	pubkey := X25519().PublicKey(priv)
+	//加解密是在x25519域，需要Hex编码，不要使用fr.string, 模范围不同
+	encryptKeyPair := &mixTy.EncryptSecretKeyPair{}
+	encryptKeyPair.SecretPrivKey = hex.EncodeToString(priv[:])
+	pubData := pubkey.([32]byte)
+	encryptKeyPair.SecretPubKey = hex.EncodeToString(pubData[:])
+	return encryptKeyPair
+}
+
+//isLegacySecretKey 加解密key和花费key相同
+func isLegacySecretKey(privacy *mixTy.AccountPrivacyKey) bool {
+	priv, err := hex.DecodeString(privacy.SecretKey.SecretPrivKey)
+	if err != nil {
+		return false
+	}
+	return mixTy.Byte2Str(priv) == privacy.PaymentKey.SpendKey
+}
+
 //CEC加密需要保证明文是秘钥的倍数，如果不是，则需要填充明文，在解密时候把填充物去掉
 //填充算法有pkcs5,pkcs7, 比如Pkcs5的思想填充的值为填充的长度，比如加密he,不足8
 //则填充为he666666, 解密后直接算最后一个值为6，把解密值的后6个Byte去掉即可
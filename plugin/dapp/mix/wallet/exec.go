@@ -22,8 +22,12 @@ func (p *mixPolicy) On_ShowAccountPrivacyInfo(req *mixTy.PaymentKeysReq) (types.
 		if err != nil {
 			return nil, errors.Wrapf(err, "privkey fromHex error,key=%s", req.PrivKey)
 		}
+		addr, err := privKeyAddr(prikeybyte)
+		if err != nil {
+			return nil, err
+		}
 		var ret mixTy.WalletAddrPrivacy
-		ret.Privacy = newPrivacyKey(prikeybyte)
+		ret.Privacy = p.createPrivacyKey(addr, prikeybyte)
 		if !req.Detail {
 			ret.Privacy.SecretKey.SecretPrivKey = ""
 			ret.Privacy.PaymentKey.SpendKey = ""
@@ -80,3 +84,25 @@ func (p *mixPolicy) On_CreateRawTransaction(req *mixTy.CreateRawTxReq) (types.Me
 func (p *mixPolicy) On_CreateZkKeyFile(req *mixTy.CreateZkKeyFileReq) (types.Message, error) {
 	return p.createZkKeyFile(req)
 }
+
+//导出只读查看key，可以检测和解密note，但不能花费
+func (p *mixPolicy) On_ShowViewingKey(req *mixTy.PaymentKeysReq) (types.Message, error) {
+	return p.getViewingKey(req)
+}
+
+//导入其他地址的查看key，需要重新扫描notes
+func (p *mixPolicy) On_ImportViewingKey(req *mixTy.ViewingKey) (types.Message, error) {
+	return p.importViewingKey(req)
+}
+
+func (p *mixPolicy) On_ExportAuditStatement(req *mixTy.AuditStatementReq) (types.Message, error) {
+	return p.exportAuditStatement(req)
+}
+
+func (p *mixPolicy) On_VerifyAuditStatement(req *mixTy.AuditStatement) (types.Message, error) {
+	err := verifyAuditStatement(req)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Reply{IsOk: true}, nil
+}
@@ -24,6 +24,8 @@ const (
 	MixRescanStatus = prefix + "RescanStatus"
 	MixCommitHash   = prefix + "CommitHash"
 	MixNullifier    = prefix + "Nullifier"
+	//导入的只读查看key
+	MixViewingKey = prefix + "ViewingKey"
 )
 
 // calcPrivacyAddrKey 获取隐私账户私钥对保存在钱包中的索引串
@@ -38,3 +40,11 @@ func calcMixPrivacyEnable() []byte {
 func calcRescanNoteStatus() []byte {
 	return []byte(MixRescanStatus)
 }
+
+func calcViewingKeyPrefix() []byte {
+	return []byte(fmt.Sprintf("%s-", MixViewingKey))
+}
+
+func calcViewingKey(addr string) []byte {
+	return []byte(fmt.Sprintf("%s-%s", MixViewingKey, address.FormatAddrKey(addr)))
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/common/crypto"
 	"github.com/33cn/chain33/types"
 
@@ -83,7 +84,7 @@ func (p *mixPolicy) savePrivacyPair(addr string) (*mixTy.WalletAddrPrivacy, erro
 	}
 
 	bizlog.Info("savePrivacyPair", "pri", common.ToHex(priv.Bytes()), "addr", addr)
-	newPrivacy := newPrivacyKey(priv.Bytes())
+	newPrivacy := p.createPrivacyKey(addr, priv.Bytes())
 
 	password := []byte(p.getWalletOperate().GetPassword())
 	encryptered := encryptDataWithPadding(password, types.Encode(newPrivacy))
@@ -92,33 +93,177 @@ func (p *mixPolicy) savePrivacyPair(addr string) (*mixTy.WalletAddrPrivacy, erro
 	return &mixTy.WalletAddrPrivacy{Privacy: newPrivacy, Addr: addr}, nil
 }
 
-//查询钱包里面所有的地址对应的PrivacyKeys
+//查询钱包里面所有的地址对应的PrivacyKeys,以及导入的只读查看key
 func (p *mixPolicy) getWalletPrivacyKeys() ([]*mixTy.WalletAddrPrivacy, error) {
 	//通过Account前缀查找获取钱包中的所有账户信息
 	WalletAccStores, err := p.store.GetAccountByPrefix("Account")
-	if err != nil || len(WalletAccStores) == 0 {
+	if err != nil {
 		bizlog.Info("getPrivacyKeyPairs", "store getAccountByPrefix error", err)
-		return nil, err
 	}
 
 	var infoPriRes []*mixTy.WalletAddrPrivacy
+	accounts := make(map[string]bool)
 	for _, AccStore := range WalletAccStores {
 		if len(AccStore.Addr) != 0 {
+			accounts[AccStore.Addr] = true
 			if privacyInfo, err := p.getAccountPrivacyKey(AccStore.Addr); err == nil {
 				infoPriRes = append(infoPriRes, privacyInfo)
 			}
 		}
 	}
 
+	//钱包里已有账户的查看key不需要重复解密
+	for _, key := range p.getImportedViewingKeys() {
+		if !accounts[key.Addr] {
+			infoPriRes = append(infoPriRes, viewingKeyPrivacy(key))
+		}
+	}
+
 	if 0 == len(infoPriRes) {
 		bizlog.Error("mixCoin getPrivacyKeyPairs null")
-		return nil, nil
+		return nil, err
 	}
 
 	return infoPriRes, nil
 
 }
 
+//createPrivacyKey ForkMixViewingKey之前以及链上已注册早期版本secret pubkey的账户保持早期的推导方式，否则无法解密发给自己的note
+func (p *mixPolicy) createPrivacyKey(addr string, rootPrivKey []byte) *mixTy.AccountPrivacyKey {
+	legacy := newPrivacyKey(rootPrivKey)
+	operater := p.getWalletOperate()
+	if !operater.GetAPI().GetConfig().IsDappFork(operater.GetBlockHeight(), mixTy.MixX, mixTy.ForkMixViewingKey) {
+		return legacy
+	}
+	key, err := p.getPaymentKey(addr)
+	if err == nil && key.SecretReceiveKey == legacy.SecretKey.SecretPubKey {
+		return legacy
+	}
+	//查询失败时不能确定链上注册的key，保持早期推导方式
+	if err != nil && errors.Cause(err) != types.ErrNotFound {
+		bizlog.Error("createPrivacyKey getPaymentKey", "addr", addr, "err", err)
+		return legacy
+	}
+	return newViewablePrivacyKey(rootPrivKey)
+}
+
+//privKeyAddr secp256k1私钥对应的地址
+func privKeyAddr(prikeybyte []byte) (string, error) {
+	cr, err := crypto.Load(types.GetSignName("", types.SECP256K1), -1)
+	if err != nil {
+		return "", errors.Wrap(err, "load secp256k1")
+	}
+	priv, err := cr.PrivKeyFromBytes(prikeybyte)
+	if err != nil {
+		return "", errors.Wrapf(err, "privkey from bytes")
+	}
+	return address.PubKeyToAddr(address.DefaultID, priv.PubKey().Bytes()), nil
+}
+
+//getViewingKey 从花费key推导出只读查看key，早期版本的加解密key和花费key相同，不能导出
+func (p *mixPolicy) getViewingKey(req *mixTy.PaymentKeysReq) (*mixTy.ViewingKey, error) {
+	if len(req.Addr) == 0 && len(req.PrivKey) == 0 {
+		return nil, errors.Wrapf(types.ErrInvalidParam, "addr or privkey need be set")
+	}
+
+	var keys *mixTy.WalletAddrPrivacy
+	if len(req.PrivKey) > 0 {
+		prikeybyte, err := common.FromHex(req.PrivKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "privkey fromHex error,key=%s", req.PrivKey)
+		}
+		addr, err := privKeyAddr(prikeybyte)
+		if err != nil {
+			return nil, err
+		}
+		keys = &mixTy.WalletAddrPrivacy{Privacy: p.createPrivacyKey(addr, prikeybyte), Addr: addr}
+	} else {
+		var err error
+		keys, err = p.getAccountPrivacyKey(req.Addr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get account =%s privacy key", req.Addr)
+		}
+	}
+
+	if isLegacySecretKey(keys.Privacy) {
+		return nil, errors.Wrapf(types.ErrNotAllow, "addr=%s secret key is same as spend key", keys.Addr)
+	}
+	return &mixTy.ViewingKey{
+		Addr:          keys.Addr,
+		ReceiveKey:    keys.Privacy.PaymentKey.ReceiveKey,
+		SecretPrivKey: keys.Privacy.SecretKey.SecretPrivKey,
+		SecretPubKey:  keys.Privacy.SecretKey.SecretPubKey,
+	}, nil
+}
+
+//importViewingKey 导入其他地址的查看key，重新扫描后可以检测和解密其note
+func (p *mixPolicy) importViewingKey(key *mixTy.ViewingKey) (*types.ReqString, error) {
+	if len(key.Addr) == 0 || len(key.ReceiveKey) == 0 || len(key.SecretPrivKey) == 0 {
+		return nil, errors.Wrapf(types.ErrInvalidParam, "addr=%s,receiveKey=%s", key.Addr, key.ReceiveKey)
+	}
+	priv, err := hex.DecodeString(key.SecretPrivKey)
+	if err != nil || len(priv) != 32 {
+		return nil, errors.Wrapf(types.ErrInvalidParam, "secret priv key=%s", key.SecretPrivKey)
+	}
+	pair := newSecretKeyPair(priv)
+	if len(key.SecretPubKey) > 0 && key.SecretPubKey != pair.SecretPubKey {
+		return nil, errors.Wrapf(types.ErrInvalidParam, "secret pub key=%s not match, expect=%s", key.SecretPubKey, pair.SecretPubKey)
+	}
+	key.SecretPubKey = pair.SecretPubKey
+
+	password := []byte(p.getWalletOperate().GetPassword())
+	err = p.store.setViewingKey(key.Addr, encryptDataWithPadding(password, types.Encode(key)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "save viewing key addr=%s", key.Addr)
+	}
+	p.store.enablePrivacy()
+	return &types.ReqString{Data: "ok"}, nil
+}
+
+func (p *mixPolicy) getImportedViewingKeys() []*mixTy.ViewingKey {
+	password := []byte(p.getWalletOperate().GetPassword())
+	var keys []*mixTy.ViewingKey
+	for _, data := range p.store.listViewingKeys() {
+		decrypted, err := decryptDataWithPading(password, data)
+		if err != nil {
+			bizlog.Error("getImportedViewingKeys decrypt", "err", err)
+			continue
+		}
+		var key mixTy.ViewingKey
+		err = types.Decode(decrypted, &key)
+		if err != nil {
+			bizlog.Error("getImportedViewingKeys decode", "err", err)
+			continue
+		}
+		keys = append(keys, &key)
+	}
+	return keys
+}
+
+//getAddrViewingPrivacy 获取addr的查看key信息，优先钱包账户，其次导入的查看key
+func (p *mixPolicy) getAddrViewingPrivacy(addr string) (*mixTy.WalletAddrPrivacy, error) {
+	if _, err := p.store.GetAccountByAddr(addr); err == nil {
+		return p.getAccountPrivacyKey(addr)
+	}
+	for _, key := range p.getImportedViewingKeys() {
+		if key.Addr == addr {
+			return viewingKeyPrivacy(key), nil
+		}
+	}
+	return nil, errors.Wrapf(types.ErrNotFound, "no privacy or viewing key for addr=%s", addr)
+}
+
+//viewingKeyPrivacy 查看key没有spendKey，不能用于花费
+func viewingKeyPrivacy(key *mixTy.ViewingKey) *mixTy.WalletAddrPrivacy {
+	return &mixTy.WalletAddrPrivacy{
+		Addr: key.Addr,
+		Privacy: &mixTy.AccountPrivacyKey{
+			PaymentKey: &mixTy.NoteKeyPair{ReceiveKey: key.ReceiveKey},
+			SecretKey:  &mixTy.EncryptSecretKeyPair{SecretPrivKey: key.SecretPrivKey, SecretPubKey: key.SecretPubKey},
+		},
+	}
+}
+
 func (p *mixPolicy) getRescanStatus() string {
 	status := p.store.getRescanNoteStatus()
 	return mixTy.MixWalletRescanStatus(status).String()
@@ -0,0 +1,238 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	commondb "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	mixTy "github.com/33cn/plugin/plugin/dapp/mix/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	auditPageCount = 100
+
+	auditRoleReceiver   = "receiver"
+	auditRoleReturner   = "returner"
+	auditRoleAuthorizer = "authorizer"
+)
+
+//heightOfIndex 从dapp.HeightIndexStr格式的前缀解析出高度
+func heightOfIndex(heightIndex string) int64 {
+	if len(heightIndex) < 18 {
+		return -1
+	}
+	v, err := strconv.ParseInt(heightIndex[:18], 10, 64)
+	if err != nil {
+		return -1
+	}
+	return v / types.MaxTxsPerBlock
+}
+
+//auditSpendHeight 未花费返回-1，升级前标记为used的note没有记录花费高度，不能当作未花费统计，需要重新扫描notes补齐
+func auditSpendHeight(info *mixTy.WalletDbMixInfo) (int64, error) {
+	if info.Info.Status != mixTy.NoteStatus_USED {
+		return -1, nil
+	}
+	height := heightOfIndex(info.SpendIndex)
+	if height < 0 {
+		return -1, errors.Wrapf(types.ErrNotAllow, "note=%s used but spend height unknown, rescan notes first", info.Info.NoteHash)
+	}
+	return height, nil
+}
+
+func auditNoteRole(receiveKey string, secret *mixTy.SecretData) string {
+	switch receiveKey {
+	case secret.ReceiverKey:
+		return auditRoleReceiver
+	case secret.ReturnKey:
+		return auditRoleReturner
+	default:
+		return auditRoleAuthorizer
+	}
+}
+
+//listAccountNotes 分页获取钱包中addr的所有note
+func (p *mixPolicy) listAccountNotes(addr string) ([]*mixTy.WalletDbMixInfo, error) {
+	localDb := p.getWalletOperate().GetDBStore()
+	query := NewMixTable(localDb).GetQuery(commondb.NewKVDB(localDb))
+	cur := &MixRow{WalletDbMixInfo: &mixTy.WalletDbMixInfo{Info: &mixTy.WalletNoteInfo{Account: addr}}}
+	prefix, err := cur.Get("account")
+	if err != nil {
+		return nil, err
+	}
+
+	var primary []byte
+	var infos []*mixTy.WalletDbMixInfo
+	for {
+		rows, err := query.ListIndex("account", prefix, primary, auditPageCount, 0)
+		if err == types.ErrNotFound {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "list notes of addr=%s", addr)
+		}
+		for _, row := range rows {
+			r, ok := row.Data.(*mixTy.WalletDbMixInfo)
+			if !ok {
+				return nil, types.ErrDecode
+			}
+			infos = append(infos, r)
+		}
+		if len(rows) < auditPageCount {
+			break
+		}
+		primary = rows[len(rows)-1].Primary
+	}
+	return infos, nil
+}
+
+//exportAuditStatement 导出addr在高度区间内收到和花费的note以及各资产余额，并签名
+func (p *mixPolicy) exportAuditStatement(req *mixTy.AuditStatementReq) (*mixTy.AuditStatement, error) {
+	if len(req.Addr) == 0 || req.StartHeight < 0 || req.EndHeight < req.StartHeight {
+		return nil, errors.Wrapf(types.ErrInvalidParam, "addr=%s,start=%d,end=%d", req.Addr, req.StartHeight, req.EndHeight)
+	}
+	if p.getWalletOperate().IsWalletLocked() {
+		return nil, types.ErrWalletIsLocked
+	}
+	signAddr := req.SignAddr
+	if len(signAddr) == 0 {
+		signAddr = req.Addr
+	}
+	priv, err := p.getPrivKeyByAddr(signAddr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get sign addr=%s privkey", signAddr)
+	}
+
+	keys, err := p.getAddrViewingPrivacy(req.Addr)
+	if err != nil {
+		return nil, err
+	}
+	infos, err := p.listAccountNotes(req.Addr)
+	if err != nil {
+		return nil, err
+	}
+
+	statement := &mixTy.AuditStatement{
+		Addr:        req.Addr,
+		StartHeight: req.StartHeight,
+		EndHeight:   req.EndHeight,
+		Signer:      signAddr,
+	}
+	balances := make(map[string]*mixTy.AuditBalance)
+	for _, info := range infos {
+		note := info.Info
+		if note.Secret == nil {
+			continue
+		}
+		height := heightOfIndex(info.TxIndex)
+		if height > req.EndHeight {
+			continue
+		}
+		spendHeight, err := auditSpendHeight(info)
+		if err != nil {
+			return nil, err
+		}
+		auditNote := &mixTy.AuditNote{
+			NoteHash:    note.NoteHash,
+			AssetExec:   note.Secret.AssetExec,
+			AssetSymbol: note.Secret.AssetSymbol,
+			Amount:      note.Secret.Amount,
+			Role:        auditNoteRole(keys.Privacy.PaymentKey.ReceiveKey, note.Secret),
+			Status:      note.Status,
+			Height:      height,
+			SpendHeight: spendHeight,
+		}
+		if height >= req.StartHeight {
+			statement.Notes = append(statement.Notes, auditNote)
+		}
+		spentInRange := spendHeight >= req.StartHeight && spendHeight <= req.EndHeight
+		if spentInRange {
+			statement.Spends = append(statement.Spends, auditNote)
+		}
+
+		//余额只统计作为receiver的note
+		if auditNote.Role != auditRoleReceiver {
+			continue
+		}
+		amount, err := strconv.ParseUint(note.Secret.Amount, 0, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "note=%s amount=%s", note.NoteHash, note.Secret.Amount)
+		}
+		asset := note.Secret.AssetExec + "-" + note.Secret.AssetSymbol
+		bal, ok := balances[asset]
+		if !ok {
+			bal = &mixTy.AuditBalance{AssetExec: note.Secret.AssetExec, AssetSymbol: note.Secret.AssetSymbol}
+			balances[asset] = bal
+		}
+		if height < req.StartHeight {
+			if spendHeight < 0 || spendHeight >= req.StartHeight {
+				bal.Opening += amount
+			}
+		} else {
+			bal.Received += amount
+		}
+		if spentInRange {
+			bal.Spent += amount
+		}
+	}
+
+	for _, bal := range balances {
+		bal.Closing = bal.Opening + bal.Received - bal.Spent
+		statement.Balances = append(statement.Balances, bal)
+	}
+	sort.Slice(statement.Notes, func(i, j int) bool { return statement.Notes[i].Height < statement.Notes[j].Height })
+	sort.Slice(statement.Spends, func(i, j int) bool { return statement.Spends[i].SpendHeight < statement.Spends[j].SpendHeight })
+	sort.Slice(statement.Balances, func(i, j int) bool {
+		return statement.Balances[i].AssetExec+statement.Balances[i].AssetSymbol < statement.Balances[j].AssetExec+statement.Balances[j].AssetSymbol
+	})
+
+	statement.Signature = &types.Signature{
+		Ty:        types.SECP256K1,
+		Pubkey:    priv.PubKey().Bytes(),
+		Signature: priv.Sign(auditStatementHash(statement)).Bytes(),
+	}
+	return statement, nil
+}
+
+//auditStatementHash 签名不参与hash
+func auditStatementHash(statement *mixTy.AuditStatement) []byte {
+	data := types.Clone(statement).(*mixTy.AuditStatement)
+	data.Signature = nil
+	return common.Sha256(types.Encode(data))
+}
+
+//verifyAuditStatement 校验审计声明的签名以及签名地址
+func verifyAuditStatement(statement *mixTy.AuditStatement) error {
+	sign := statement.GetSignature()
+	if sign == nil {
+		return errors.Wrap(types.ErrInvalidParam, "statement without signature")
+	}
+	if address.PubKeyToAddr(address.DefaultID, sign.Pubkey) != statement.Signer {
+		return errors.Wrapf(types.ErrFromAddr, "signer=%s not match pubkey", statement.Signer)
+	}
+	cr, err := crypto.Load(types.GetSignName("", int(sign.Ty)), -1)
+	if err != nil {
+		return errors.Wrapf(err, "load sign ty=%d", sign.Ty)
+	}
+	pub, err := cr.PubKeyFromBytes(sign.Pubkey)
+	if err != nil {
+		return errors.Wrap(err, "pubkey from bytes")
+	}
+	signature, err := cr.SignatureFromBytes(sign.Signature)
+	if err != nil {
+		return errors.Wrap(err, "signature from bytes")
+	}
+	if !pub.VerifyBytes(auditStatementHash(statement), signature) {
+		return types.ErrSign
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+
+	mixTy "github.com/33cn/plugin/plugin/dapp/mix/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViewingKeyDecodeSecret(t *testing.T) {
+	prikey := "4257D8692EF7FE13C68B65D6A52F03933DB2FA5CE8FAF210B5B8B80C721CED01"
+	keyByte, err := hex.DecodeString(prikey)
+	assert.Nil(t, err)
+
+	privacy := newViewablePrivacyKey(keyByte)
+	assert.False(t, isLegacySecretKey(privacy))
+	legacy := newPrivacyKey(keyByte)
+	assert.True(t, isLegacySecretKey(legacy))
+	assert.Equal(t, legacy.PaymentKey, privacy.PaymentKey)
+	assert.NotEqual(t, privacy.SecretKey.SecretPubKey, legacy.SecretKey.SecretPubKey)
+
+	view := viewingKeyPrivacy(&mixTy.ViewingKey{
+		Addr:          "addr1",
+		ReceiveKey:    privacy.PaymentKey.ReceiveKey,
+		SecretPrivKey: privacy.SecretKey.SecretPrivKey,
+		SecretPubKey:  privacy.SecretKey.SecretPubKey,
+	})
+	assert.Equal(t, "", view.Privacy.PaymentKey.SpendKey)
+
+	secret := &mixTy.SecretData{
+		ReceiverKey:  privacy.PaymentKey.ReceiveKey,
+		ReturnKey:    "0",
+		AuthorizeKey: "0",
+		NoteRandom:   "2824204835",
+		Amount:       "28242048",
+		AssetExec:    "coins",
+		AssetSymbol:  "bty",
+	}
+	dhSecret, err := encryptData(privacy.SecretKey.SecretPubKey, types.Encode(secret))
+	assert.Nil(t, err)
+
+	p := &mixPolicy{}
+	info, err := p.decodeSecret("notehash1", hex.EncodeToString(types.Encode(dhSecret)), []*mixTy.WalletAddrPrivacy{view})
+	assert.Nil(t, err)
+	assert.NotNil(t, info)
+	assert.Equal(t, "addr1", info.Account)
+	assert.Equal(t, mixTy.NoteStatus_VALID, info.Status)
+	assert.Equal(t, mixTy.Byte2Str(mimcHashString([]string{secret.NoteRandom})), info.Nullifier)
+
+	//旧的secret key不能解密
+	view.Privacy.SecretKey = legacy.SecretKey
+	info, err = p.decodeSecret("notehash1", hex.EncodeToString(types.Encode(dhSecret)), []*mixTy.WalletAddrPrivacy{view})
+	assert.Nil(t, err)
+	assert.Nil(t, info)
+}
+
+func TestHeightOfIndex(t *testing.T) {
+	assert.Equal(t, int64(123), heightOfIndex(dapp.HeightIndexStr(123, 5)+"notehash"))
+	assert.Equal(t, int64(0), heightOfIndex(dapp.HeightIndexStr(0, 1)))
+	assert.Equal(t, int64(-1), heightOfIndex(""))
+}
+
+func TestAuditSpendHeight(t *testing.T) {
+	info := &mixTy.WalletDbMixInfo{Info: &mixTy.WalletNoteInfo{NoteHash: "note1", Status: mixTy.NoteStatus_VALID}}
+	height, err := auditSpendHeight(info)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(-1), height)
+
+	info.Info.Status = mixTy.NoteStatus_USED
+	info.SpendIndex = dapp.HeightIndexStr(15, 2)
+	height, err = auditSpendHeight(info)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(15), height)
+
+	//升级前标记为used的note没有花费高度
+	info.SpendIndex = ""
+	_, err = auditSpendHeight(info)
+	assert.NotNil(t, err)
+}
+
+func TestAuditStatementSign(t *testing.T) {
+	cr, err := crypto.Load(types.GetSignName("", types.SECP256K1), -1)
+	assert.Nil(t, err)
+	priv, err := cr.GenKey()
+	assert.Nil(t, err)
+
+	statement := &mixTy.AuditStatement{
+		Addr:        "addr1",
+		StartHeight: 10,
+		EndHeight:   20,
+		Notes:       []*mixTy.AuditNote{{NoteHash: "note1", Amount: "100", Height: 12, SpendHeight: -1}},
+		Balances:    []*mixTy.AuditBalance{{AssetExec: "coins", AssetSymbol: "bty", Received: 100, Closing: 100}},
+		Signer:      address.PubKeyToAddr(address.DefaultID, priv.PubKey().Bytes()),
+	}
+	assert.NotNil(t, verifyAuditStatement(statement))
+
+	statement.Signature = &types.Signature{
+		Ty:        types.SECP256K1,
+		Pubkey:    priv.PubKey().Bytes(),
+		Signature: priv.Sign(auditStatementHash(statement)).Bytes(),
+	}
+	assert.Nil(t, verifyAuditStatement(statement))
+
+	//篡改余额
+	statement.Balances[0].Closing = 200
+	assert.Equal(t, types.ErrSign, verifyAuditStatement(statement))
+	statement.Balances[0].Closing = 100
+
+	//签名地址不匹配
+	statement.Signer = "addr1"
+	assert.NotNil(t, verifyAuditStatement(statement))
+}
@@ -72,7 +72,7 @@ func (p *mixPolicy) processMixTx(tx *types.Transaction, height, index int64) (*t
 			nullHash := v.NullifierHash.GetWitnessValue(ecc.BN254)
 			nulls = append(nulls, nullHash.String())
 		}
-		p.processNullifiers(nulls, table)
+		p.processNullifiers(nulls, dapp.HeightIndexStr(height, index), table)
 
 	//nullifier hash更新为used， newcommit解密存储
 	case mixTy.MixActionTransfer:
@@ -120,7 +120,7 @@ func (p *mixPolicy) processTransfer(transfer *mixTy.MixTransferAction, heightInd
 		nullHash := v.NullifierHash.GetWitnessValue(ecc.BN254)
 		nulls = append(nulls, nullHash.String())
 	}
-	p.processNullifiers(nulls, table)
+	p.processNullifiers(nulls, heightIndex, table)
 
 	//out
 	var out mixTy.TransferOutputCircuit
@@ -159,10 +159,10 @@ func (p *mixPolicy) processAuth(auth *mixTy.MixAuthorizeAction, table *table.Tab
 
 }
 
-func (p *mixPolicy) processNullifiers(nulls []string, table *table.Table) {
+func (p *mixPolicy) processNullifiers(nulls []string, heightIndex string, table *table.Table) {
 
 	for _, n := range nulls {
-		err := updateNullifier(table, n)
+		err := updateNullifier(table, n, heightIndex)
 		if err != nil {
 			bizlog.Error("processNullifiers", "nullifier", n, "err", err)
 		}
@@ -170,7 +170,7 @@ func (p *mixPolicy) processNullifiers(nulls []string, table *table.Table) {
 
 }
 
-func updateNullifier(ldb *table.Table, nullifier string) error {
+func updateNullifier(ldb *table.Table, nullifier, heightIndex string) error {
 	xs, err := ldb.ListIndex("nullifier", []byte(nullifier), nil, 1, 0)
 	if err != nil || len(xs) != 1 {
 		bizlog.Error("updateNullifier update query List failed", "key", nullifier, "err", err, "len", len(xs))
@@ -183,6 +183,7 @@ func updateNullifier(ldb *table.Table, nullifier string) error {
 
 	}
 	u.Info.Status = mixTy.NoteStatus_USED
+	u.SpendIndex = heightIndex
 	return ldb.Update([]byte(u.TxIndex), u)
 }
 
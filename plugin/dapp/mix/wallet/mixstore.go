@@ -46,6 +46,18 @@ func (store *mixStore) setAccountPrivacy(addr string, data []byte) error {
 	return nil
 }
 
+func (store *mixStore) setViewingKey(addr string, data []byte) error {
+	if len(addr) == 0 || len(data) == 0 {
+		bizlog.Error("setViewingKey addr or data is nil")
+		return types.ErrInvalidParam
+	}
+	return store.Set(calcViewingKey(addr), data)
+}
+
+func (store *mixStore) listViewingKeys() [][]byte {
+	return store.NewListHelper().PrefixScan(calcViewingKeyPrefix())
+}
+
 func (store *mixStore) enablePrivacy() {
 	newbatch := store.NewBatch(true)
 	newbatch.Set(calcMixPrivacyEnable(), []byte("true"))
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/33cn/chain33/common"
 	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
 	"github.com/pkg/errors"
 
@@ -47,6 +48,7 @@ func MultiSigAccountCmd() *cobra.Command {
 		CreateMultiSigAccCreateCmd(),
 		CreateMultiSigAccWeightModifyCmd(),
 		CreateMultiSigAccDailyLimitModifyCmd(),
+		CreateMultiSigAccTimeLockModifyCmd(),
 		GetMultiSigAccCountCmd(),
 		GetMultiSigAccountsCmd(),
 		GetMultiSigAccountInfoCmd(),
@@ -85,6 +87,9 @@ func MultiSigTxCmd() *cobra.Command {
 		CreateMultiSigConfirmTxCmd(),
 		CreateMultiSigAccTransferInCmd(),
 		CreateMultiSigAccTransferOutCmd(),
+		CreateMultiSigExecCallCmd(),
+		CreateMultiSigCancelTxCmd(),
+		CreateMultiSigExecuteTxCmd(),
 		GetMultiSigAccTxCountCmd(),
 		GetMultiSigTxidsCmd(),
 		GetMultiSigTxInfoCmd(),
@@ -123,6 +128,9 @@ func createMultiSigAccTransferFlags(cmd *cobra.Command) {
 
 	cmd.Flags().Float64P("daily_limit", "d", 0, "daily_limit of assets ")
 	cmd.MarkFlagRequired("daily_limit")
+
+	cmd.Flags().Int64P("time_lock", "l", 0, "seconds to wait before a confirmed tx can be executed")
+	cmd.Flags().Int64P("expiry", "x", 0, "seconds a submitted tx stays valid, 0 means never expire")
 }
 
 func createMultiSigAccTransfer(cmd *cobra.Command, args []string) {
@@ -199,10 +207,15 @@ func createMultiSigAccTransfer(cmd *cobra.Command, args []string) {
 		DailyLimit: uint64(dailylimitInt64),
 	}
 
+	timeLock, _ := cmd.Flags().GetInt64("time_lock")
+	expiry, _ := cmd.Flags().GetInt64("expiry")
+
 	params := &mty.MultiSigAccCreate{
 		Owners:         owners,
 		RequiredWeight: requiredweight,
 		DailyLimit:     symboldailylimit,
+		TimeLock:       timeLock,
+		Expiry:         expiry,
 	}
 	var res string
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigAccCreateTx", params, &res)
@@ -394,6 +407,42 @@ func createMultiSigAccWeightModifyTransfer(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+// CreateMultiSigAccTimeLockModifyCmd create raw MultiSigAccTimeLockModify transaction
+func CreateMultiSigAccTimeLockModifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timelock",
+		Short: "Create a modify time lock and expiry transaction",
+		Run:   createMultiSigAccTimeLockModifyTransfer,
+	}
+	createMultiSigAccTimeLockModifyTransferFlags(cmd)
+	return cmd
+}
+
+func createMultiSigAccTimeLockModifyTransferFlags(cmd *cobra.Command) {
+
+	cmd.Flags().StringP("multisig_addr", "a", "", "address of multisig account")
+	cmd.MarkFlagRequired("multisig_addr")
+	cmd.Flags().Int64P("time_lock", "l", 0, "seconds to wait before a confirmed tx can be executed")
+	cmd.Flags().Int64P("expiry", "x", 0, "seconds a submitted tx stays valid, 0 means never expire")
+}
+
+func createMultiSigAccTimeLockModifyTransfer(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
+	timeLock, _ := cmd.Flags().GetInt64("time_lock")
+	expiry, _ := cmd.Flags().GetInt64("expiry")
+
+	params := &mty.MultiSigAccOperate{
+		MultiSigAccAddr: multiSigAddr,
+		TimeLockOp:      true,
+		NewTimeLock:     timeLock,
+		NewExpiry:       expiry,
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigAccOperateTx", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
 // CreateMultiSigAccDailyLimitModifyCmd create raw MultiSigAccDailyLimitModify transaction
 func CreateMultiSigAccDailyLimitModifyCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -502,6 +551,65 @@ func createMultiSigConfirmTransfer(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+// CreateMultiSigCancelTxCmd create raw MultiSigCancelTx transaction
+func CreateMultiSigCancelTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel",
+		Short: "Create a cancel transaction for a pending tx",
+		Run:   createMultiSigCancelTransfer,
+	}
+	createMultiSigTxidFlags(cmd)
+	return cmd
+}
+
+func createMultiSigCancelTransfer(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
+	txid, _ := cmd.Flags().GetUint64("txid")
+
+	params := &mty.MultiSigCancelTx{
+		MultiSigAccAddr: multiSigAddr,
+		TxId:            txid,
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigCancelTx", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateMultiSigExecuteTxCmd create raw MultiSigExecuteTx transaction
+func CreateMultiSigExecuteTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute",
+		Short: "Create an execute transaction for a tx whose time lock has expired",
+		Run:   createMultiSigExecuteTransfer,
+	}
+	createMultiSigTxidFlags(cmd)
+	return cmd
+}
+
+func createMultiSigExecuteTransfer(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
+	txid, _ := cmd.Flags().GetUint64("txid")
+
+	params := &mty.MultiSigExecuteTx{
+		MultiSigAccAddr: multiSigAddr,
+		TxId:            txid,
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigExecuteTx", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
+func createMultiSigTxidFlags(cmd *cobra.Command) {
+
+	cmd.Flags().StringP("multisig_addr", "a", "", "address of multisig account")
+	cmd.MarkFlagRequired("multisig_addr")
+
+	cmd.Flags().Uint64P("txid", "i", 0, "txid of  multisig transaction")
+	cmd.MarkFlagRequired("txid")
+}
+
 // CreateMultiSigAccTransferInCmd create raw MultiSigAccTransferInCmd transaction
 func CreateMultiSigAccTransferInCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -630,6 +738,57 @@ func createMultiSigAccTransferOut(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+// CreateMultiSigExecCallCmd create raw MultiSigExecCall transaction
+func CreateMultiSigExecCallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call",
+		Short: "Create a transaction which calls other executor by multisig account",
+		Run:   createMultiSigExecCall,
+	}
+	createMultiSigExecCallFlags(cmd)
+	return cmd
+}
+
+func createMultiSigExecCallFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("multisig_addr", "a", "", "address of multisig account")
+	cmd.MarkFlagRequired("multisig_addr")
+
+	cmd.Flags().StringP("data", "d", "", "unsigned raw transaction of the inner call")
+	cmd.MarkFlagRequired("data")
+
+	cmd.Flags().StringP("note", "n", "", "transaction note info")
+}
+
+func createMultiSigExecCall(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
+	data, _ := cmd.Flags().GetString("data")
+	note, _ := cmd.Flags().GetString("note")
+
+	//内部交易只使用execer，payload以及to地址
+	txByte, err := common.FromHex(data)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "FromHex.data"))
+		return
+	}
+	var tx types.Transaction
+	err = types.Decode(txByte, &tx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "Decode.data"))
+		return
+	}
+	params := &mty.MultiSigExecCall{
+		MultiSigAccAddr: multiSigAddr,
+		Execer:          string(tx.Execer),
+		Payload:         tx.Payload,
+		To:              tx.To,
+		Note:            note,
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigExecCallTx", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
 //GetMultiSigAccCountCmd 获取已经创建的多重签名账户数量
 func GetMultiSigAccCountCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -767,6 +926,8 @@ func parseAccInfo(view ...interface{}) (interface{}, error) {
 		DailyLimits:    dailyLimitResults,
 		TxCount:        res.TxCount,
 		RequiredWeight: res.RequiredWeight,
+		TimeLock:       res.TimeLock,
+		Expiry:         res.Expiry,
 	}
 
 	return result, nil
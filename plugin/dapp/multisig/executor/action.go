@@ -30,13 +30,15 @@ type action struct {
 	index        int32
 	execaddr     string
 	api          client.QueueProtocolAPI
+	multiSig     *MultiSig
+	tx           *types.Transaction
 }
 
 func newAction(t *MultiSig, tx *types.Transaction, index int32) *action {
 	hash := tx.Hash()
 	fromaddr := tx.From()
 	return &action{t.GetCoinsAccount(), t.GetStateDB(), t.GetLocalDB(), hash, fromaddr,
-		t.GetBlockTime(), t.GetHeight(), index, dapp.ExecAddress(string(tx.Execer)), t.GetAPI()}
+		t.GetBlockTime(), t.GetHeight(), index, dapp.ExecAddress(string(tx.Execer)), t.GetAPI(), t, tx}
 }
 
 //MultiSigAccCreate 创建多重签名账户
@@ -77,6 +79,8 @@ func (a *action) MultiSigAccCreate(accountCreate *mty.MultiSigAccCreate) (*types
 	multiSigAccount.Owners = accountCreate.Owners
 	multiSigAccount.TxCount = 0
 	multiSigAccount.RequiredWeight = accountCreate.RequiredWeight
+	multiSigAccount.TimeLock = accountCreate.TimeLock
+	multiSigAccount.Expiry = accountCreate.Expiry
 
 	//获取资产的每日限额设置
 	if accountCreate.DailyLimit != nil {
@@ -102,6 +106,10 @@ func (a *action) MultiSigAccCreate(accountCreate *mty.MultiSigAccCreate) (*types
 	}
 
 	multiSigAccount.MultiSigAddr = addr
+	//记录创建交易的hash，用于合约调用时构造多重签名地址的内部交易
+	if a.api.GetConfig().IsDappFork(a.height, mty.MultiSigX, mty.ForkMultiSigExecCallX) {
+		multiSigAccount.CreateTxHash = hex.EncodeToString(a.txhash)
+	}
 	receiptLog := &types.ReceiptLog{}
 	receiptLog.Ty = mty.TyLogMultiSigAccCreate
 	receiptLog.Log = types.Encode(multiSigAccount)
@@ -141,7 +149,7 @@ func (a *action) MultiSigAccOperate(AccountOperate *mty.MultiSigAccOperate) (*ty
 	}
 
 	//dailylimit每日限额属性的修改需要校验assets资产的合法性
-	if !AccountOperate.OperateFlag {
+	if !AccountOperate.TimeLockOp && !AccountOperate.OperateFlag {
 		execer := AccountOperate.DailyLimit.Execer
 		symbol := AccountOperate.DailyLimit.Symbol
 		err := mty.IsAssetsInvalid(execer, symbol)
@@ -159,6 +167,7 @@ func (a *action) MultiSigAccOperate(AccountOperate *mty.MultiSigAccOperate) (*ty
 	newMultiSigTx.MultiSigAddr = multiSigAccAddr
 	confirmOwner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
 	newMultiSigTx.ConfirmedOwner = append(newMultiSigTx.ConfirmedOwner, confirmOwner)
+	a.setExpireTime(multiSigAccount, newMultiSigTx)
 
 	return a.executeAccOperateTx(multiSigAccount, newMultiSigTx, AccountOperate, confirmOwner, true)
 }
@@ -196,6 +205,7 @@ func (a *action) MultiSigOwnerOperate(AccOwnerOperate *mty.MultiSigOwnerOperate)
 	newMultiSigTx.MultiSigAddr = multiSigAccAddr
 	confirmOwner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
 	newMultiSigTx.ConfirmedOwner = append(newMultiSigTx.ConfirmedOwner, confirmOwner)
+	a.setExpireTime(multiSigAccount, newMultiSigTx)
 
 	return a.executeOwnerOperateTx(multiSigAccount, newMultiSigTx, AccOwnerOperate, confirmOwner, true)
 }
@@ -242,6 +252,7 @@ func (a *action) MultiSigExecTransferFrom(multiSigAccTransfer *mty.MultiSigExecT
 	newMultiSigTx.MultiSigAddr = multiSigAccAddr
 	confirmOwner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
 	newMultiSigTx.ConfirmedOwner = append(newMultiSigTx.ConfirmedOwner, confirmOwner)
+	a.setExpireTime(multiSigAcc, newMultiSigTx)
 
 	//确认并执行此交易
 	return a.executeTransferTx(multiSigAcc, newMultiSigTx, multiSigAccTransfer, confirmOwner, mty.IsSubmit)
@@ -336,6 +347,13 @@ func (a *action) MultiSigConfirmTx(ConfirmTx *mty.MultiSigConfirmTx) (*types.Rec
 	if multiSigTx.Executed {
 		return nil, mty.ErrTxHasExecuted
 	}
+	//已经被取消或者过期的交易不可以再确认/撤销
+	if multiSigTx.Cancelled {
+		return nil, mty.ErrTxCancelled
+	}
+	if isExpired(a.blocktime, multiSigTx) {
+		return nil, mty.ErrTxExpired
+	}
 	//此owneraddr是否已经确认过此txid对应的交易
 	findindex, exist := isOwnerConfirmedTx(multiSigTx, owneraddr)
 
@@ -353,6 +371,10 @@ func (a *action) MultiSigConfirmTx(ConfirmTx *mty.MultiSigConfirmTx) (*types.Rec
 	//首先处理撤销确认交易，将owneraddr的确认信息从交易确认列表中删除
 	if exist && !ConfirmTx.ConfirmOrRevoke {
 		multiSigTx.ConfirmedOwner = append(multiSigTx.ConfirmedOwner[0:findindex], multiSigTx.ConfirmedOwner[findindex+1:]...)
+		//权重不再满足时需要重新计算时间锁
+		if !isConfirmed(multiSigAcc.RequiredWeight, multiSigTx) {
+			multiSigTx.ExecutableTime = 0
+		}
 	} else if !exist && ConfirmTx.ConfirmOrRevoke {
 		//增加此owner的确认信息到multiSigTx的确认列表中
 		multiSigTx.ConfirmedOwner = append(multiSigTx.ConfirmedOwner, owner)
@@ -365,7 +387,11 @@ func (a *action) MultiSigConfirmTx(ConfirmTx *mty.MultiSigConfirmTx) (*types.Rec
 	if !isConfirm || !ConfirmTx.ConfirmOrRevoke {
 		return a.confirmTransaction(multiSigTx, multiSigTxOwner, ConfirmTx.ConfirmOrRevoke)
 	}
-	//获取txhash对应交易详细信息
+	return a.executeMultiSigTx(multiSigAcc, multiSigTx, owner)
+}
+
+//获取txhash对应交易详细信息并执行，owner为本次新增的确认owner，时间锁到期后的执行没有新增确认owner
+func (a *action) executeMultiSigTx(multiSigAcc *mty.MultiSig, multiSigTx *mty.MultiSigTx, owner *mty.Owner) (*types.Receipt, error) {
 	tx, err := getTxByHash(a.api, multiSigTx.TxHash)
 	if err != nil {
 		return nil, err
@@ -385,8 +411,11 @@ func (a *action) MultiSigConfirmTx(ConfirmTx *mty.MultiSigConfirmTx) (*types.Rec
 	} else if multiSigTx.TxType == mty.TransferOperate {
 		transfer := payload.GetMultiSigExecTransferFrom()
 		return a.executeTransferTx(multiSigAcc, multiSigTx, transfer, owner, mty.IsConfirm)
+	} else if multiSigTx.TxType == mty.ContractCallOperate {
+		call := payload.GetMultiSigExecCall()
+		return a.executeContractCallTx(multiSigAcc, multiSigTx, call, owner, mty.IsConfirm)
 	}
-	multisiglog.Error("MultiSigConfirmTx:GetMultiSigTx", "multiSigAccAddr", multiSigAccAddr, "Confirm TxId", ConfirmTx.TxId, "TxType unknown", multiSigTx.TxType)
+	multisiglog.Error("executeMultiSigTx", "multiSigAccAddr", multiSigAcc.MultiSigAddr, "TxId", multiSigTx.Txid, "TxType unknown", multiSigTx.TxType)
 	return nil, mty.ErrTxTypeNoMatch
 }
 
@@ -750,9 +779,9 @@ func (a *action) executeTransferTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty
 		return nil, mty.ErrDailyLimitIsZero
 	}
 
-	//确认此交易额度是否在每日限额之内，或者权重已达到要求
+	//确认此交易额度是否在每日限额之内，或者权重已达到要求并且时间锁到期
 	amount := transfer.Amount
-	confirmed := isConfirmed(multiSigAcc.RequiredWeight, newMultiSigTx)
+	confirmed, queueLog := a.isExecutable(multiSigAcc, newMultiSigTx)
 	underLimit, newlastday := isUnderLimit(a.blocktime, uint64(amount), curDailyLimit)
 
 	//新的一天更新lastday和spenttoday的值
@@ -767,11 +796,12 @@ func (a *action) executeTransferTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty
 	var kv []*types.KeyValue
 
 	//权重满足或者小于每日限额，允许执行此交易，如果转账交易执行失败，不应该直接返回，需要继续更新多重签名账户和tx列表的状态信息
-	if confirmed || underLimit {
+	//ForkMultiSigTimeLock之后每日限额只在提交交易时使用
+	cfg := a.api.GetConfig()
+	if confirmed || underLimit && (subOrConfirm || !cfg.IsDappFork(a.height, mty.MultiSigX, mty.ForkMultiSigTimeLockX)) {
 
 		//执行此交易，从多重签名账户转币到指定账户，在multiSig合约中转账
 		symbol := getRealSymbol(transfer.Symbol)
-		cfg := a.api.GetConfig()
 		execerAccDB, err := account.NewAccountDB(cfg, transfer.Execname, symbol, a.db)
 		if err != nil {
 			multisiglog.Error("executeTransaction:NewAccountDB", "From", transfer.From, "To", transfer.To,
@@ -811,6 +841,10 @@ func (a *action) executeTransferTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty
 	if err != nil {
 		multisiglog.Error("executeTransaction:receiptDailyLimitUpdate", "error", err)
 	}
+	//权重满足等待时间锁到期
+	if queueLog != nil && !newMultiSigTx.Executed {
+		logs = append(logs, queueLog)
+	}
 	//更新newMultiSigTx的状态：MultiSigTx增加一个确认owner，交易的执行状态可能有更新
 	keyvaluetx, receiptlogtx := a.receiptMultiSigTx(newMultiSigTx, confOwner, prevExecuted, subOrConfirm)
 
@@ -832,8 +866,8 @@ func (a *action) executeTransferTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty
 //确认并执行操作账户属性的交易：区分submitTx和confirmtx阶段。
 func (a *action) executeAccOperateTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty.MultiSigTx, accountOperate *mty.MultiSigAccOperate, confOwner *mty.Owner, subOrConfirm bool) (*types.Receipt, error) {
 
-	//确认权重是否已达到要求
-	confirmed := isConfirmed(multiSigAcc.RequiredWeight, newMultiSigTx)
+	//确认权重是否已达到要求并且时间锁到期
+	confirmed, queueLog := a.isExecutable(multiSigAcc, newMultiSigTx)
 	prevExecuted := newMultiSigTx.Executed
 
 	var logs []*types.ReceiptLog
@@ -845,8 +879,14 @@ func (a *action) executeAccOperateTx(multiSigAcc *mty.MultiSig, newMultiSigTx *m
 
 	//权重满足允许执行此交易，需要继续更新多重签名账户和tx列表的状态信息
 	if confirmed {
-		//修改账户RequiredWeight的操作
-		if accountOperate.OperateFlag {
+		//修改账户时间锁和有效期的操作
+		if accountOperate.TimeLockOp {
+			accAttrkv, accAttrReceiptLog, err = a.multiSigTimeLockModify(multiSigAcc.MultiSigAddr, accountOperate.NewTimeLock, accountOperate.NewExpiry)
+			if err != nil {
+				multisiglog.Error("executeAccOperateTx", "multiSigTimeLockModify", err)
+				return nil, err
+			}
+		} else if accountOperate.OperateFlag { //修改账户RequiredWeight的操作
 			accAttrkv, accAttrReceiptLog, err = a.multiSigWeightModify(multiSigAcc.MultiSigAddr, accountOperate.NewRequiredWeight)
 			if err != nil {
 				multisiglog.Error("executeAccOperateTx", "multiSigWeightModify", err)
@@ -874,6 +914,10 @@ func (a *action) executeAccOperateTx(multiSigAcc *mty.MultiSig, newMultiSigTx *m
 		kv = append(kv, keyvalue)
 		logs = append(logs, receiptlog)
 	}
+	//权重满足等待时间锁到期
+	if queueLog != nil && !newMultiSigTx.Executed {
+		logs = append(logs, queueLog)
+	}
 	//更新newMultiSigTx的状态：MultiSigTx增加一个确认owner，交易的执行状态可能有更新
 	keyvaluetx, receiptlogtx := a.receiptMultiSigTx(newMultiSigTx, confOwner, prevExecuted, subOrConfirm)
 	logs = append(logs, receiptlogtx)
@@ -893,8 +937,8 @@ func (a *action) executeOwnerOperateTx(multiSigAccount *mty.MultiSig, newMultiSi
 	var multiSigkv *types.KeyValue
 	var receiptLog *types.ReceiptLog
 	var err error
-	//确认权重是否已达到要求
-	confirmed := isConfirmed(multiSigAccount.RequiredWeight, newMultiSigTx)
+	//确认权重是否已达到要求并且时间锁到期
+	confirmed, queueLog := a.isExecutable(multiSigAccount, newMultiSigTx)
 	prevExecuted := newMultiSigTx.Executed
 
 	flag := accountOperate.OperateFlag
@@ -947,6 +991,10 @@ func (a *action) executeOwnerOperateTx(multiSigAccount *mty.MultiSig, newMultiSi
 		kv = append(kv, keyvalue)
 		logs = append(logs, receiptlog)
 	}
+	//权重满足等待时间锁到期
+	if queueLog != nil && !newMultiSigTx.Executed {
+		logs = append(logs, queueLog)
+	}
 	//更新newMultiSigTx的状态：MultiSigTx增加一个确认owner，交易的执行状态可能有更新
 	keyvaluetx, receiptlogtx := a.receiptMultiSigTx(newMultiSigTx, confOwner, prevExecuted, subOrConfirm)
 	logs = append(logs, receiptlogtx)
@@ -11,6 +11,9 @@ import (
 
 //Exec_MultiSigAccCreate 创建多重签名账户
 func (m *MultiSig) Exec_MultiSigAccCreate(payload *mty.MultiSigAccCreate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if (payload.TimeLock != 0 || payload.Expiry != 0) && !m.isTimeLockFork() {
+		return nil, types.ErrActionNotSupport
+	}
 	action := newAction(m, tx, int32(index))
 	return action.MultiSigAccCreate(payload)
 }
@@ -23,6 +26,9 @@ func (m *MultiSig) Exec_MultiSigOwnerOperate(payload *mty.MultiSigOwnerOperate,
 
 //Exec_MultiSigAccOperate 多重签名账户属性的修改：weight权重以及每日限额的修改
 func (m *MultiSig) Exec_MultiSigAccOperate(payload *mty.MultiSigAccOperate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if payload.TimeLockOp && !m.isTimeLockFork() {
+		return nil, types.ErrActionNotSupport
+	}
 	action := newAction(m, tx, int32(index))
 	return action.MultiSigAccOperate(payload)
 }
@@ -44,3 +50,35 @@ func (m *MultiSig) Exec_MultiSigExecTransferFrom(payload *mty.MultiSigExecTransf
 	action := newAction(m, tx, int32(index))
 	return action.MultiSigExecTransferFrom(payload)
 }
+
+//Exec_MultiSigExecCall 多重签名账户调用其他合约，权重满足后以多重签名地址执行内部交易
+func (m *MultiSig) Exec_MultiSigExecCall(payload *mty.MultiSigExecCall, tx *types.Transaction, index int) (*types.Receipt, error) {
+	cfg := m.GetAPI().GetConfig()
+	if !cfg.IsDappFork(m.GetHeight(), mty.MultiSigX, mty.ForkMultiSigExecCallX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newAction(m, tx, int32(index))
+	return action.MultiSigExecCall(payload)
+}
+
+//Exec_MultiSigCancelTx 取消多重签名账户上还未执行的交易
+func (m *MultiSig) Exec_MultiSigCancelTx(payload *mty.MultiSigCancelTx, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !m.isTimeLockFork() {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newAction(m, tx, int32(index))
+	return action.MultiSigCancelTx(payload)
+}
+
+//Exec_MultiSigExecuteTx 时间锁到期之后执行多重签名账户上的交易
+func (m *MultiSig) Exec_MultiSigExecuteTx(payload *mty.MultiSigExecuteTx, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !m.isTimeLockFork() {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newAction(m, tx, int32(index))
+	return action.MultiSigExecuteTx(payload)
+}
+
+func (m *MultiSig) isTimeLockFork() bool {
+	return m.GetAPI().GetConfig().IsDappFork(m.GetHeight(), mty.MultiSigX, mty.ForkMultiSigTimeLockX)
+}
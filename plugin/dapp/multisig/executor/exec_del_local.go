@@ -58,7 +58,11 @@ func (m *MultiSig) ExecDelLocal_MultiSigConfirmTx(payload *mty.MultiSigConfirmTx
 	if err != nil {
 		return nil, err
 	}
-	return &types.LocalDBSet{KV: kv}, nil
+	callKV, err := m.execLocalContractCall(receiptData, tx, index, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: append(kv, callKV...)}, nil
 }
 
 //ExecDelLocal_MultiSigExecTransferTo 合约中外部账户转账到多重签名账户，Addr --->multiSigAddr
@@ -86,3 +90,50 @@ func (m *MultiSig) ExecDelLocal_MultiSigExecTransferFrom(payload *mty.MultiSigEx
 	}
 	return &types.LocalDBSet{KV: kv}, nil
 }
+
+//ExecDelLocal_MultiSigExecCall 多重签名账户调用其他合约
+func (m *MultiSig) ExecDelLocal_MultiSigExecCall(payload *mty.MultiSigExecCall, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, false)
+	if err != nil {
+		return nil, err
+	}
+	callKV, err := m.execLocalContractCall(receiptData, tx, index, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: append(kv, callKV...)}, nil
+}
+
+//ExecDelLocal_MultiSigCancelTx 取消多重签名账户上还未执行的交易
+func (m *MultiSig) ExecDelLocal_MultiSigCancelTx(payload *mty.MultiSigCancelTx, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
+
+//ExecDelLocal_MultiSigExecuteTx 时间锁到期之后执行多重签名账户上的交易
+func (m *MultiSig) ExecDelLocal_MultiSigExecuteTx(payload *mty.MultiSigExecuteTx, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, false)
+	if err != nil {
+		return nil, err
+	}
+	callKV, err := m.execLocalContractCall(receiptData, tx, index, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: append(kv, callKV...)}, nil
+}
@@ -61,7 +61,12 @@ func (m *MultiSig) ExecLocal_MultiSigConfirmTx(payload *mty.MultiSigConfirmTx, t
 		multisiglog.Error("ExecLocal_MultiSigConfirmTx", "err", err)
 		return nil, err
 	}
-	return &types.LocalDBSet{KV: kv}, nil
+	callKV, err := m.execLocalContractCall(receiptData, tx, index, true)
+	if err != nil {
+		multisiglog.Error("ExecLocal_MultiSigConfirmTx", "execLocalContractCall err", err)
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: append(kv, callKV...)}, nil
 }
 
 //ExecLocal_MultiSigExecTransferTo 合约中外部账户转账到多重签名账户，Addr --->multiSigAddr
@@ -91,3 +96,55 @@ func (m *MultiSig) ExecLocal_MultiSigExecTransferFrom(payload *mty.MultiSigExecT
 	}
 	return &types.LocalDBSet{KV: kv}, nil
 }
+
+//ExecLocal_MultiSigExecCall 多重签名账户调用其他合约
+func (m *MultiSig) ExecLocal_MultiSigExecCall(payload *mty.MultiSigExecCall, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, true)
+	if err != nil {
+		multisiglog.Error("ExecLocal_MultiSigExecCall", "err", err)
+		return nil, err
+	}
+	callKV, err := m.execLocalContractCall(receiptData, tx, index, true)
+	if err != nil {
+		multisiglog.Error("ExecLocal_MultiSigExecCall", "execLocalContractCall err", err)
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: append(kv, callKV...)}, nil
+}
+
+//ExecLocal_MultiSigCancelTx 取消多重签名账户上还未执行的交易
+func (m *MultiSig) ExecLocal_MultiSigCancelTx(payload *mty.MultiSigCancelTx, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, true)
+	if err != nil {
+		multisiglog.Error("ExecLocal_MultiSigCancelTx", "err", err)
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
+
+//ExecLocal_MultiSigExecuteTx 时间锁到期之后执行多重签名账户上的交易
+func (m *MultiSig) ExecLocal_MultiSigExecuteTx(payload *mty.MultiSigExecuteTx, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, true)
+	if err != nil {
+		multisiglog.Error("ExecLocal_MultiSigExecuteTx", "err", err)
+		return nil, err
+	}
+	callKV, err := m.execLocalContractCall(receiptData, tx, index, true)
+	if err != nil {
+		multisiglog.Error("ExecLocal_MultiSigExecuteTx", "execLocalContractCall err", err)
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: append(kv, callKV...)}, nil
+}
@@ -0,0 +1,317 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/address/btc"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
+)
+
+/*
+ * 多重签名账户调用其他合约：owner提交execer+payload，权重满足后构造一笔内部交易，
+ * 内部交易使用创建账户的交易hash作为多重签名公钥，from地址即为多重签名地址，然后调用被调用合约的Exec执行。
+ * 内部交易写入的每个key按框架的写权限规则由key所属的执行器判定：multisig自身的key以及multisig合约地址下的账户可以直接写入，
+ * 其他执行器的key需要所属执行器的IsFriend允许multisig写入(比如token的friendExecer配置了multisig)，否则调用失败。
+ * 被调用合约的ExecLocal在multisig的ExecLocal中执行，localdb只能写入multisig前缀的key，其他前缀的key不会被保存。
+ * fork之前创建的账户没有记录创建交易的hash，提交调用时由owner提供，校验与多重签名地址一致后记录到账户中。
+ */
+
+//MultiSigExecCall 提交一笔合约调用的交易，权重满足时直接执行
+func (a *action) MultiSigExecCall(call *mty.MultiSigExecCall) (*types.Receipt, error) {
+	if call == nil {
+		return nil, types.ErrInvalidParam
+	}
+	multiSigAccAddr := call.MultiSigAccAddr
+	multiSigAcc, err := getMultiSigAccFromDb(a.db, multiSigAccAddr)
+	if err != nil {
+		multisiglog.Error("MultiSigExecCall", "MultiSigAccAddr", multiSigAccAddr, "err", err)
+		return nil, err
+	}
+	//校验交易提交者是否是本账户的owner
+	owneraddr := a.fromaddr
+	ownerWeight, isowner := isOwner(multiSigAcc, owneraddr)
+	if !isowner {
+		return nil, mty.ErrIsNotOwner
+	}
+
+	//没有记录创建交易hash的账户需要owner提供，否则无法构造内部交易的签名
+	var backfill []*types.KeyValue
+	if multiSigAcc.CreateTxHash == "" {
+		if !isCreateTxHashOf(call.CreateTxHash, multiSigAccAddr) {
+			multisiglog.Error("MultiSigExecCall: createTxHash not match", "MultiSigAccAddr", multiSigAccAddr, "createTxHash", call.CreateTxHash)
+			return nil, mty.ErrExecCallNotSupport
+		}
+		multiSigAcc.CreateTxHash = call.CreateTxHash
+		key, value := setMultiSigAccToDb(a.db, multiSigAcc)
+		backfill = append(backfill, &types.KeyValue{Key: key, Value: value})
+	}
+
+	//生成新的txid,并将此交易信息添加到Txs列表中
+	newMultiSigTx := &mty.MultiSigTx{}
+	newMultiSigTx.Txid = multiSigAcc.TxCount
+	newMultiSigTx.TxHash = hex.EncodeToString(a.txhash)
+	newMultiSigTx.Executed = false
+	newMultiSigTx.TxType = mty.ContractCallOperate
+	newMultiSigTx.MultiSigAddr = multiSigAccAddr
+	confirmOwner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
+	newMultiSigTx.ConfirmedOwner = append(newMultiSigTx.ConfirmedOwner, confirmOwner)
+	a.setExpireTime(multiSigAcc, newMultiSigTx)
+
+	receipt, err := a.executeContractCallTx(multiSigAcc, newMultiSigTx, call, confirmOwner, mty.IsSubmit)
+	if err != nil {
+		return nil, err
+	}
+	receipt.KV = append(backfill, receipt.KV...)
+	return receipt, nil
+}
+
+//isCreateTxHashOf 多重签名地址由创建交易的hash生成，校验hash与地址是否一致
+func isCreateTxHashOf(createTxHash, multiSigAddr string) bool {
+	hash, err := hex.DecodeString(createTxHash)
+	if err != nil || len(hash) == 0 {
+		return false
+	}
+	return btc.FormatBtcAddr(address.MultiSignVer, hash) == multiSigAddr
+}
+
+//确认并执行合约调用的交易：区分submitTx和confirmtx阶段。内部交易执行失败时整个交易失败
+func (a *action) executeContractCallTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty.MultiSigTx, call *mty.MultiSigExecCall, confOwner *mty.Owner, subOrConfirm bool) (*types.Receipt, error) {
+	confirmed, queueLog := a.isExecutable(multiSigAcc, newMultiSigTx)
+	prevExecuted := newMultiSigTx.Executed
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+
+	if confirmed {
+		receipt, err := a.execContractCall(multiSigAcc, newMultiSigTx, call)
+		if err != nil {
+			multisiglog.Error("executeContractCallTx", "multiSigAddr", multiSigAcc.MultiSigAddr, "txid", newMultiSigTx.Txid, "execer", call.Execer, "err", err)
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+		//标识此交易已经被执行
+		newMultiSigTx.Executed = true
+	}
+
+	//更新multiSigAcc状态:txcount有增加在submit阶段
+	if subOrConfirm {
+		keyvalue, receiptlog, err := a.receiptTxCountUpdate(multiSigAcc.MultiSigAddr)
+		if err != nil {
+			multisiglog.Error("executeContractCallTx:receiptTxCountUpdate", "error", err)
+			return nil, err
+		}
+		kv = append(kv, keyvalue)
+		logs = append(logs, receiptlog)
+	}
+	//权重满足等待时间锁到期
+	if queueLog != nil && !newMultiSigTx.Executed {
+		logs = append(logs, queueLog)
+	}
+	//更新newMultiSigTx的状态：MultiSigTx增加一个确认owner，交易的执行状态可能有更新
+	keyvaluetx, receiptlogtx := a.receiptMultiSigTx(newMultiSigTx, confOwner, prevExecuted, subOrConfirm)
+	logs = append(logs, receiptlogtx)
+	kv = append(kv, keyvaluetx)
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+//newContractCallTx 构造以多重签名地址作为from的内部交易, nonce取自提交交易的hash, 保证每笔内部交易的hash唯一
+func newContractCallTx(cfg *types.Chain33Config, multiSigAcc *mty.MultiSig, multiSigTx *mty.MultiSigTx, call *mty.MultiSigExecCall) (*types.Transaction, error) {
+	pubKey, err := hex.DecodeString(multiSigAcc.CreateTxHash)
+	if err != nil {
+		return nil, err
+	}
+	submitHash, err := hex.DecodeString(multiSigTx.TxHash)
+	if err != nil || len(submitHash) < 8 {
+		return nil, mty.ErrTxHashNoMatch
+	}
+	tx := &types.Transaction{
+		Execer:  []byte(call.Execer),
+		Payload: call.Payload,
+		To:      call.To,
+		Nonce:   int64(binary.BigEndian.Uint64(submitHash) >> 1),
+		ChainID: cfg.GetChainID(),
+	}
+	if tx.To == "" {
+		tx.To = address.ExecAddress(call.Execer)
+	}
+	tx.Signature = &types.Signature{Ty: types.EncodeSignID(types.SECP256K1, btc.MultiSignAddressID), Pubkey: pubKey}
+	if tx.From() != multiSigAcc.MultiSigAddr {
+		multisiglog.Error("newContractCallTx", "multiSigAddr", multiSigAcc.MultiSigAddr, "from", tx.From())
+		return nil, mty.ErrExecCallNotSupport
+	}
+	return tx, nil
+}
+
+//execContractCall 加载被调用的合约并执行内部交易
+func (a *action) execContractCall(multiSigAcc *mty.MultiSig, multiSigTx *mty.MultiSigTx, call *mty.MultiSigExecCall) (*types.Receipt, error) {
+	cfg := a.api.GetConfig()
+	tx, err := newContractCallTx(cfg, multiSigAcc, multiSigTx, call)
+	if err != nil {
+		return nil, err
+	}
+	index := int(a.index)
+	driver, err := a.multiSig.loadCallDriver(tx, index)
+	if err != nil {
+		return nil, err
+	}
+	driver.SetCoinsAccount(a.coinsAccount)
+	driver.SetStateDB(a.db)
+
+	if err = driver.CheckTx(tx, index); err != nil {
+		return nil, err
+	}
+	receipt, err := driver.Exec(tx, index)
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil || receipt.Ty != types.ExecOk {
+		return nil, mty.ErrExecCallFailed
+	}
+	for _, kv := range receipt.KV {
+		if !a.isAllowCallKey(kv.Key, index) {
+			multisiglog.Error("execContractCall: key not allow", "execer", call.Execer, "key", string(kv.Key))
+			return nil, types.ErrNotAllowKey
+		}
+	}
+
+	receiptCall := &mty.ReceiptMultiSigExecCall{
+		MultiSigAddr: multiSigAcc.MultiSigAddr,
+		Txid:         multiSigTx.Txid,
+		Execer:       call.Execer,
+		InnerTxHash:  hex.EncodeToString(tx.Hash()),
+		LogCount:     int32(len(receipt.Logs)),
+		InnerTx:      types.Encode(tx),
+	}
+	receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: mty.TyLogMultiSigExecCall, Log: types.Encode(receiptCall)})
+	return receipt, nil
+}
+
+//isAllowCallKey 内部交易的key由所属的执行器判定multisig是否可以写入，和框架对multisig交易的写权限检查一致
+func (a *action) isAllowCallKey(key []byte, index int) bool {
+	keyExecer, err := types.FindExecer(key)
+	if err != nil {
+		return false
+	}
+	cfg := a.api.GetConfig()
+	execer := cfg.ExecName(mty.MultiSigX)
+	if string(keyExecer) == execer || string(keyExecer) == mty.MultiSigX {
+		return true
+	}
+	if keyExecAddr, ok := types.GetExecKey(key); ok && keyExecAddr == a.execaddr {
+		return true
+	}
+	owner := drivers.LoadDriverAllow(a.api, &types.Transaction{Execer: keyExecer}, index, a.height)
+	owner.SetEnv(a.height, a.blocktime, a.multiSig.GetDifficulty())
+	owner.SetStateDB(a.db)
+	return owner.IsFriend(keyExecer, key, a.tx)
+}
+
+//loadCallDriver 加载被调用的合约，执行环境和multisig保持一致
+func (m *MultiSig) loadCallDriver(tx *types.Transaction, index int) (drivers.Driver, error) {
+	driver := drivers.LoadDriverAllow(m.GetAPI(), tx, index, m.GetHeight())
+	if driver.GetDriverName() == "none" {
+		multisiglog.Error("loadCallDriver: driver not allow", "execer", string(tx.Execer))
+		return nil, mty.ErrInvalidExec
+	}
+	driver.SetLocalDB(m.GetLocalDB())
+	driver.SetEnv(m.GetHeight(), m.GetBlockTime(), m.GetDifficulty())
+	driver.SetBlockInfo(m.GetParentHash(), m.GetLastHash(), m.GetMainHeight())
+	driver.SetTxs(m.GetTxs())
+	driver.SetReceipt(m.GetReceipt())
+	driver.SetName(string(types.GetRealExecName(tx.Execer)))
+	driver.SetCurrentExecName(string(tx.Execer))
+	return driver, nil
+}
+
+//execLocalContractCall 执行被调用合约的ExecLocal或者ExecDelLocal，内部交易使用的日志记录在ReceiptMultiSigExecCall之前
+//被调用合约写入localdb的数据先缓存，只返回multisig交易允许写入的key
+func (m *MultiSig) execLocalContractCall(receiptData *types.ReceiptData, tx *types.Transaction, index int, addOrRollback bool) ([]*types.KeyValue, error) {
+	var set []*types.KeyValue
+	logs := receiptData.GetLogs()
+	for i, log := range logs {
+		if log.Ty != mty.TyLogMultiSigExecCall {
+			continue
+		}
+		var receipt mty.ReceiptMultiSigExecCall
+		if err := types.Decode(log.Log, &receipt); err != nil {
+			return nil, err
+		}
+		var innerTx types.Transaction
+		if err := types.Decode(receipt.InnerTx, &innerTx); err != nil {
+			return nil, err
+		}
+		start := i - int(receipt.LogCount)
+		if start < 0 {
+			return nil, types.ErrInvalidParam
+		}
+		driver, err := m.loadCallDriver(&innerTx, index)
+		if err != nil {
+			return nil, err
+		}
+		localDB := newCallLocalDB(m.GetLocalDB())
+		driver.SetLocalDB(localDB)
+		driver.SetStateDB(m.GetStateDB())
+		innerReceipt := &types.ReceiptData{Ty: types.ExecOk, Logs: logs[start:i]}
+		var localSet *types.LocalDBSet
+		if addOrRollback {
+			localSet, err = driver.ExecLocal(&innerTx, innerReceipt, index)
+		} else {
+			localSet, err = driver.ExecDelLocal(&innerTx, innerReceipt, index)
+		}
+		if err == types.ErrActionNotSupport {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		prefix := types.CalcLocalPrefix(tx.Execer)
+		for _, kv := range append(localDB.kvs, localSet.GetKV()...) {
+			if !bytes.HasPrefix(kv.Key, prefix) {
+				multisiglog.Error("execLocalContractCall: local key not allow", "execer", receipt.Execer, "key", string(kv.Key))
+				continue
+			}
+			set = append(set, kv)
+		}
+	}
+	return set, nil
+}
+
+// callLocalDB 缓存被调用合约ExecLocal直接写入localdb的数据，由multisig统一返回
+type callLocalDB struct {
+	dbm.KVDB
+	cache map[string][]byte
+	kvs   []*types.KeyValue
+}
+
+func newCallLocalDB(parent dbm.KVDB) *callLocalDB {
+	return &callLocalDB{KVDB: parent, cache: make(map[string][]byte)}
+}
+
+// Get 优先读取被调用合约的写入
+func (c *callLocalDB) Get(key []byte) ([]byte, error) {
+	if value, ok := c.cache[string(key)]; ok {
+		if value == nil {
+			return nil, types.ErrNotFound
+		}
+		return value, nil
+	}
+	return c.KVDB.Get(key)
+}
+
+// Set 写入缓存
+func (c *callLocalDB) Set(key []byte, value []byte) error {
+	c.cache[string(key)] = value
+	c.kvs = append(c.kvs, &types.KeyValue{Key: key, Value: value})
+	return nil
+}
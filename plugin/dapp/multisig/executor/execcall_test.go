@@ -0,0 +1,144 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	apimock "github.com/33cn/chain33/client/mocks"
+	dbm "github.com/33cn/chain33/common/db"
+	dbmock "github.com/33cn/chain33/common/db/mocks"
+	"github.com/33cn/chain33/types"
+	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
+	token "github.com/33cn/plugin/plugin/dapp/token/executor"
+	tokenty "github.com/33cn/plugin/plugin/dapp/token/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func init() {
+	token.Init("token", chainTestCfg, []byte(`{"friendExecer":["multisig"]}`))
+}
+
+func TestMultiSigExecCall(t *testing.T) {
+	env := execEnv{1539918074, 10, 2, 1539918074, "hash"}
+
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 100)
+	localDB := new(dbmock.KVDB)
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chainTestCfg, nil)
+
+	driver := newMultiSig()
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	driver.SetAPI(api)
+	driver.SetStateDB(stateDB)
+	driver.SetLocalDB(localDB)
+
+	multiSigAddr, err := testMultiSigAccCreate(t, driver, env, localDB)
+	assert.Nil(t, err)
+	multiSigAcc, err := getMultiSigAccFromDb(stateDB, multiSigAddr)
+	assert.Nil(t, err)
+	assert.NotEqual(t, "", multiSigAcc.CreateTxHash)
+
+	accToken, _ := account.NewAccountDB(chainTestCfg, "token", "TEST", stateDB)
+	accToken.SaveAccount(&types.Account{Addr: multiSigAddr, Balance: 1000})
+
+	transfer := &tokenty.TokenAction{
+		Ty:    tokenty.ActionTransfer,
+		Value: &tokenty.TokenAction_Transfer{Transfer: &types.AssetsTransfer{Cointoken: "TEST", Amount: 100, To: AddrB}},
+	}
+	call := &mty.MultiSigExecCall{MultiSigAccAddr: multiSigAddr, Execer: "token", Payload: types.Encode(transfer), To: AddrB}
+
+	// 不允许调用multisig合约自身
+	tx, _ := multiSigExecCall(&mty.MultiSigExecCall{MultiSigAccAddr: multiSigAddr, Execer: mty.MultiSigX})
+	tx, _ = signTx(tx, PrivKeyC)
+	assert.Equal(t, mty.ErrInvalidExec, driver.CheckTx(tx, env.index))
+
+	// addrC 权重不够，只提交不执行
+	tx, _ = multiSigExecCall(call)
+	tx, _ = signTx(tx, PrivKeyC)
+	assert.Nil(t, driver.CheckTx(tx, env.index))
+	receipt, err := driver.Exec(tx, env.index)
+	assert.Nil(t, err)
+	var receiptTx mty.ReceiptMultiSigTx
+	assert.Nil(t, types.Decode(receipt.Logs[len(receipt.Logs)-1].Log, &receiptTx))
+	assert.False(t, receiptTx.CurExecuted)
+	assert.Equal(t, mty.ContractCallOperate, receiptTx.TxType)
+	assert.Equal(t, int64(0), accToken.LoadAccount(AddrB).Balance)
+
+	txDetails := &types.TransactionDetails{Txs: []*types.TransactionDetail{{Tx: tx}}}
+	api.On("GetTransactionByHash", &types.ReqHashes{Hashes: [][]byte{tx.Hash()}}).Return(txDetails, nil)
+
+	// addrD 确认之后，以多重签名地址执行token转账
+	confirm, _ := multiSigConfirmTx(&mty.MultiSigConfirmTx{MultiSigAccAddr: multiSigAddr, TxId: receiptTx.MultiSigTxOwner.Txid, ConfirmOrRevoke: true})
+	confirm, _ = signTx(confirm, PrivKeyD)
+	receipt, err = driver.Exec(confirm, env.index)
+	assert.Nil(t, err)
+	var receiptCall mty.ReceiptMultiSigExecCall
+	for _, log := range receipt.Logs {
+		if log.Ty == mty.TyLogMultiSigExecCall {
+			assert.Nil(t, types.Decode(log.Log, &receiptCall))
+		}
+	}
+	assert.Equal(t, multiSigAddr, receiptCall.MultiSigAddr)
+	assert.Equal(t, "token", receiptCall.Execer)
+	assert.Equal(t, int64(100), accToken.LoadAccount(AddrB).Balance)
+	assert.Equal(t, int64(900), accToken.LoadAccount(multiSigAddr).Balance)
+
+	multiSigTx, err := getMultiSigAccTxFromDb(stateDB, multiSigAddr, receiptTx.MultiSigTxOwner.Txid)
+	assert.Nil(t, err)
+	assert.True(t, multiSigTx.Executed)
+
+	// 被调用合约的ExecLocal只保留multisig可以写入的key
+	callLocalDB := new(dbmock.KVDB)
+	callLocalDB.On("Get", mock.Anything).Return(nil, types.ErrNotFound)
+	driver.SetLocalDB(callLocalDB)
+	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	callKV, err := driver.(*MultiSig).execLocalContractCall(receiptData, confirm, env.index, true)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(callKV))
+	driver.SetLocalDB(localDB)
+
+	// fork之前创建的账户没有createTxHash，需要owner提供并校验
+	createTxHash := multiSigAcc.CreateTxHash
+	multiSigAcc, err = getMultiSigAccFromDb(stateDB, multiSigAddr)
+	assert.Nil(t, err)
+	multiSigAcc.CreateTxHash = ""
+	setMultiSigAccToDb(stateDB, multiSigAcc)
+	tx, _ = multiSigExecCall(call)
+	tx, _ = signTx(tx, PrivKeyD)
+	_, err = driver.Exec(tx, env.index)
+	assert.Equal(t, mty.ErrExecCallNotSupport, err)
+
+	call.CreateTxHash = createTxHash
+	tx, _ = multiSigExecCall(call)
+	tx, _ = signTx(tx, PrivKeyD)
+	_, err = driver.Exec(tx, env.index)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(200), accToken.LoadAccount(AddrB).Balance)
+	multiSigAcc, err = getMultiSigAccFromDb(stateDB, multiSigAddr)
+	assert.Nil(t, err)
+	assert.Equal(t, createTxHash, multiSigAcc.CreateTxHash)
+
+	// 内部交易执行失败时整个交易失败
+	transfer.GetTransfer().Amount = 10000
+	call.Payload = types.Encode(transfer)
+	tx, _ = multiSigExecCall(call)
+	tx, _ = signTx(tx, PrivKeyD)
+	_, err = driver.Exec(tx, env.index)
+	assert.Equal(t, types.ErrNoBalance, err)
+}
+
+func multiSigExecCall(parm *mty.MultiSigExecCall) (*types.Transaction, error) {
+	if parm == nil {
+		return nil, types.ErrInvalidParam
+	}
+	multiSig := &mty.MultiSigAction{
+		Ty:    mty.ActionMultiSigExecCall,
+		Value: &mty.MultiSigAction_MultiSigExecCall{MultiSigExecCall: parm},
+	}
+	return types.CreateFormatTx(chainTestCfg, chainTestCfg.ExecName(mty.MultiSigX), types.Encode(multiSig))
+}
@@ -12,6 +12,8 @@ multiSig合约主要实现如下功能：
 //多重签名账户交易的确认和撤销
 //合约中外部账户转账到多重签名账户，Addr --->multiSigAddr
 //合约中多重签名账户转账到外部账户，multiSigAddr--->Addr
+//多重签名账户调用其他合约
+//多重签名账户交易的时间锁，有效期以及取消
 */
 
 import (
@@ -136,6 +138,24 @@ func (m *MultiSig) CheckTx(tx *types.Transaction, index int) error {
 		//assets check
 		return mty.IsAssetsInvalid(ato.GetExecname(), ato.GetSymbol())
 	}
+	//MultiSigExecCall 交易的检测
+	if ato, ok := payload.(*mty.MultiSigExecCall); ok {
+		return checkExecCallTx(ato, m.GetHeight(), multiSignDriver)
+	}
+	//MultiSigCancelTx 交易的检测
+	if ato, ok := payload.(*mty.MultiSigCancelTx); ok {
+		if err := multiSignDriver.ValidateAddr(ato.GetMultiSigAccAddr()); err != nil {
+			return types.ErrInvalidAddress
+		}
+		return nil
+	}
+	//MultiSigExecuteTx 交易的检测
+	if ato, ok := payload.(*mty.MultiSigExecuteTx); ok {
+		if err := multiSignDriver.ValidateAddr(ato.GetMultiSigAccAddr()); err != nil {
+			return types.ErrInvalidAddress
+		}
+		return nil
+	}
 
 	return nil
 }
@@ -180,6 +200,9 @@ func checkAccountCreateTx(ato *mty.MultiSigAccCreate, blockHeight int64) error {
 	if ownerCount > mty.MaxOwnersCount {
 		return mty.ErrMaxOwnerCount
 	}
+	if err := checkTimeLock(ato.GetTimeLock(), ato.GetExpiry()); err != nil {
+		return err
+	}
 
 	dailyLimit := ato.GetDailyLimit()
 	//assets check
@@ -232,6 +255,10 @@ func checkAccountOperateTx(ato *mty.MultiSigAccOperate, multiSignDriver address.
 	if err := multiSignDriver.ValidateAddr(MultiSigAccAddr); err != nil {
 		return types.ErrInvalidAddress
 	}
+	//时间锁和有效期的修改
+	if ato.GetTimeLockOp() {
+		return checkTimeLock(ato.GetNewTimeLock(), ato.GetNewExpiry())
+	}
 
 	if ato.OperateFlag == mty.AccWeightOp {
 		NewWeight := ato.GetNewRequiredWeight()
@@ -247,6 +274,23 @@ func checkAccountOperateTx(ato *mty.MultiSigAccOperate, multiSignDriver address.
 	return nil
 }
 
+//合约调用交易的检测，不允许调用multisig合约自身
+func checkExecCallTx(ato *mty.MultiSigExecCall, blockHeight int64, multiSignDriver address.Driver) error {
+	if err := multiSignDriver.ValidateAddr(ato.GetMultiSigAccAddr()); err != nil {
+		return types.ErrInvalidAddress
+	}
+	execer := ato.GetExecer()
+	if execer == "" || string(types.GetRealExecName([]byte(execer))) == mty.MultiSigX {
+		return mty.ErrInvalidExec
+	}
+	if ato.GetTo() != "" {
+		if err := address.CheckAddress(ato.GetTo(), blockHeight); err != nil {
+			return types.ErrInvalidAddress
+		}
+	}
+	return nil
+}
+
 //多重签名交易的Receipt处理
 func (m *MultiSig) execLocalMultiSigReceipt(receiptData *types.ReceiptData, tx *types.Transaction, addOrRollback bool) ([]*types.KeyValue, error) {
 	var set []*types.KeyValue
@@ -373,6 +417,32 @@ func (m *MultiSig) execLocalMultiSigReceipt(receiptData *types.ReceiptData, tx *
 					set = append(set, kv2...)
 				}
 			}
+		case mty.TyLogMultiSigTimeLockModify:
+			{
+				var receipt mty.ReceiptTimeLockModify
+				err := types.Decode(log.Log, &receipt)
+				if err != nil {
+					return nil, err
+				}
+				kv, err := m.saveMultiSigAccTimeLock(receipt, addOrRollback)
+				if err != nil {
+					return nil, err
+				}
+				set = append(set, kv...)
+			}
+		case mty.TyLogMultiSigTxCancel:
+			{
+				var receipt mty.ReceiptMultiSigTxState
+				err := types.Decode(log.Log, &receipt)
+				if err != nil {
+					return nil, err
+				}
+				kv, err := m.saveMultiSigTxCancel(receipt, addOrRollback)
+				if err != nil {
+					return nil, err
+				}
+				set = append(set, kv...)
+			}
 		case mty.TyLogTxCountUpdate:
 			{
 				var receipt mty.ReceiptTxCountUpdate
@@ -426,12 +496,20 @@ func (m *MultiSig) saveMultiSigTransfer(tx *types.Transaction, SubmitOrConfirm,
 			return set, nil
 		}
 	} else {
-		if action.Ty != mty.ActionMultiSigConfirmTx || action.GetMultiSigConfirmTx() == nil {
+		//交易在确认阶段或者时间锁到期后被执行
+		var multiSigAccAddr string
+		var txid uint64
+		if action.Ty == mty.ActionMultiSigConfirmTx && action.GetMultiSigConfirmTx() != nil {
+			multiSigAccAddr = action.GetMultiSigConfirmTx().MultiSigAccAddr
+			txid = action.GetMultiSigConfirmTx().TxId
+		} else if action.Ty == mty.ActionMultiSigExecuteTx && action.GetMultiSigExecuteTx() != nil {
+			multiSigAccAddr = action.GetMultiSigExecuteTx().MultiSigAccAddr
+			txid = action.GetMultiSigExecuteTx().TxId
+		} else {
 			return nil, mty.ErrActionTyNoMatch
 		}
 		//通过需要确认的txid从数据库中获取对应的multiSigTx信息，然后根据txhash查询具体的交易详情
-		multiSigConfirmTx := action.GetMultiSigConfirmTx()
-		multiSigTx, err := getMultiSigTx(m.GetLocalDB(), multiSigConfirmTx.MultiSigAccAddr, multiSigConfirmTx.TxId)
+		multiSigTx, err := getMultiSigTx(m.GetLocalDB(), multiSigAccAddr, txid)
 		if err != nil {
 			return set, err
 		}
@@ -771,6 +849,23 @@ func (m *MultiSig) saveMultiSigTx(execTx mty.ReceiptMultiSigTx, addOrRollback bo
 		multiSigTx = temMultiSigTx
 	}
 
+	//时间锁到期后执行的交易没有新增的确认owner，只更新交易的执行状态
+	if owner == nil {
+		if addOrRollback {
+			if prevExecuted != multiSigTx.Executed {
+				return nil, mty.ErrExecutedNoMatch
+			}
+			multiSigTx.Executed = curExecuted
+		} else {
+			multiSigTx.Executed = prevExecuted
+		}
+		err = setMultiSigTx(m.GetLocalDB(), multiSigTx, true)
+		if err != nil {
+			return nil, err
+		}
+		return []*types.KeyValue{getMultiSigTxKV(multiSigTx, true)}, nil
+	}
+
 	index, exist := isOwnerConfirmedTx(multiSigTx, owner.OwnerAddr)
 	if addOrRollback { //正常添加交易
 		if !exist { //add Confirmed Owner and modify Executed
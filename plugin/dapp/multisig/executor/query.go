@@ -166,8 +166,8 @@ func (m *MultiSig) Query_MultiSigTxids(in *mty.ReqMultiSigTxids) (types.Message,
 			continue
 		}
 		findTxid := txid
-		//查找Pending/Executed的交易txid
-		if in.Pending && !multiSigTx.Executed || in.Executed && multiSigTx.Executed {
+		//查找Pending/Executed的交易txid, 已经取消的交易不属于Pending
+		if in.Pending && !multiSigTx.Executed && !multiSigTx.Cancelled || in.Executed && multiSigTx.Executed {
 			multiSigTxids.Txids = append(multiSigTxids.Txids, findTxid)
 		}
 	}
@@ -204,6 +204,12 @@ func (m *MultiSig) Query_MultiSigTxInfo(in *mty.ReqMultiSigTxInfo) (types.Messag
 		multiSigTx = &mty.MultiSigTx{}
 	} else { //由于代码中使用hex.EncodeToString()接口转换的，没有加0x，为了方便上层统一处理再次返回时增加0x即可
 		multiSigTx.TxHash = "0x" + multiSigTx.TxHash
+		//时间锁相关的状态只记录在statedb中
+		if stateTx, err := getMultiSigAccTxFromDb(m.GetStateDB(), addr, txid); err == nil {
+			multiSigTx.ExecutableTime = stateTx.ExecutableTime
+			multiSigTx.ExpireTime = stateTx.ExpireTime
+			multiSigTx.Cancelled = stateTx.Cancelled
+		}
 	}
 	return multiSigTx, nil
 }
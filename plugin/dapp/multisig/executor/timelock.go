@@ -0,0 +1,200 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
+)
+
+/*
+ * 多重签名交易的时间锁和有效期：
+ * 账户设置了timeLock时，交易权重满足之后记录可以执行的时间，时间锁到期之前交易不会被执行，到期之后由owner发送MultiSigExecuteTx执行，
+ * 在此期间任意owner都可以取消这笔交易。账户设置了expiry时，交易提交时记录过期时间，过期的交易不能再被确认和执行。
+ * 每日限额之内的转账交易不受时间锁的限制，提交时直接执行。
+ */
+
+//时间锁和有效期的合法性校验，有效期必须大于时间锁，否则交易永远无法执行
+func checkTimeLock(timeLock, expiry int64) error {
+	if timeLock < 0 || expiry < 0 {
+		return mty.ErrInvalidTimeLock
+	}
+	if expiry != 0 && expiry <= timeLock {
+		return mty.ErrInvalidTimeLock
+	}
+	return nil
+}
+
+//交易是否已经过期
+func isExpired(blocktime int64, multiSigTx *mty.MultiSigTx) bool {
+	return multiSigTx.ExpireTime > 0 && blocktime >= multiSigTx.ExpireTime
+}
+
+//交易提交时根据账户的有效期设置交易的过期时间
+func (a *action) setExpireTime(multiSigAcc *mty.MultiSig, multiSigTx *mty.MultiSigTx) {
+	if multiSigAcc.Expiry > 0 {
+		multiSigTx.ExpireTime = a.blocktime + multiSigAcc.Expiry
+	}
+}
+
+//确认交易权重是否满足并且时间锁已经到期，权重第一次满足时记录交易可以执行的时间并返回TyLogMultiSigTxQueued
+func (a *action) isExecutable(multiSigAcc *mty.MultiSig, multiSigTx *mty.MultiSigTx) (bool, *types.ReceiptLog) {
+	if !isConfirmed(multiSigAcc.RequiredWeight, multiSigTx) {
+		return false, nil
+	}
+	if multiSigTx.ExecutableTime != 0 {
+		return a.blocktime >= multiSigTx.ExecutableTime, nil
+	}
+	//没有设置时间锁，权重满足直接执行
+	if multiSigAcc.TimeLock == 0 {
+		return true, nil
+	}
+	multiSigTx.ExecutableTime = a.blocktime + multiSigAcc.TimeLock
+	receiptLog := a.receiptMultiSigTxState(mty.TyLogMultiSigTxQueued, multiSigTx)
+	return false, receiptLog
+}
+
+func (a *action) receiptMultiSigTxState(ty int32, multiSigTx *mty.MultiSigTx) *types.ReceiptLog {
+	receipt := &mty.ReceiptMultiSigTxState{
+		MultiSigAddr:   multiSigTx.MultiSigAddr,
+		Txid:           multiSigTx.Txid,
+		ExecutableTime: multiSigTx.ExecutableTime,
+		ExpireTime:     multiSigTx.ExpireTime,
+		Cancelled:      multiSigTx.Cancelled,
+		Operator:       a.fromaddr,
+	}
+	return &types.ReceiptLog{Ty: ty, Log: types.Encode(receipt)}
+}
+
+//获取owner可以操作的交易：还没有执行，没有被取消
+func (a *action) getPendingMultiSigTx(multiSigAccAddr string, txid uint64) (*mty.MultiSig, *mty.MultiSigTx, error) {
+	multiSigAcc, err := getMultiSigAccFromDb(a.db, multiSigAccAddr)
+	if err != nil {
+		multisiglog.Error("getPendingMultiSigTx:getMultiSigAccFromDb", "MultiSigAccAddr", multiSigAccAddr, "err", err)
+		return nil, nil, err
+	}
+	if _, isowner := isOwner(multiSigAcc, a.fromaddr); !isowner {
+		return nil, nil, mty.ErrIsNotOwner
+	}
+	if txid >= multiSigAcc.TxCount {
+		return nil, nil, mty.ErrInvalidTxid
+	}
+	multiSigTx, err := getMultiSigAccTxFromDb(a.db, multiSigAccAddr, txid)
+	if err != nil {
+		multisiglog.Error("getPendingMultiSigTx:getMultiSigAccTxFromDb", "MultiSigAccAddr", multiSigAccAddr, "txid", txid, "err", err)
+		return nil, nil, mty.ErrTxidNotExist
+	}
+	if multiSigTx.Executed {
+		return nil, nil, mty.ErrTxHasExecuted
+	}
+	if multiSigTx.Cancelled {
+		return nil, nil, mty.ErrTxCancelled
+	}
+	return multiSigAcc, multiSigTx, nil
+}
+
+//MultiSigCancelTx 取消还未执行的交易，任意owner都可以取消
+func (a *action) MultiSigCancelTx(cancel *mty.MultiSigCancelTx) (*types.Receipt, error) {
+	if cancel == nil {
+		return nil, types.ErrInvalidParam
+	}
+	_, multiSigTx, err := a.getPendingMultiSigTx(cancel.MultiSigAccAddr, cancel.TxId)
+	if err != nil {
+		return nil, err
+	}
+	multiSigTx.Cancelled = true
+
+	receiptLog := a.receiptMultiSigTxState(mty.TyLogMultiSigTxCancel, multiSigTx)
+	key, value := setMultiSigAccTxToDb(a.db, multiSigTx)
+	kv := []*types.KeyValue{{Key: key, Value: value}}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: []*types.ReceiptLog{receiptLog}}, nil
+}
+
+//MultiSigExecuteTx 权重满足并且时间锁到期的交易由owner执行
+func (a *action) MultiSigExecuteTx(execute *mty.MultiSigExecuteTx) (*types.Receipt, error) {
+	if execute == nil {
+		return nil, types.ErrInvalidParam
+	}
+	multiSigAcc, multiSigTx, err := a.getPendingMultiSigTx(execute.MultiSigAccAddr, execute.TxId)
+	if err != nil {
+		return nil, err
+	}
+	if isExpired(a.blocktime, multiSigTx) {
+		return nil, mty.ErrTxExpired
+	}
+	if executable, _ := a.isExecutable(multiSigAcc, multiSigTx); !executable {
+		multisiglog.Error("MultiSigExecuteTx", "MultiSigAccAddr", execute.MultiSigAccAddr, "txid", execute.TxId,
+			"executableTime", multiSigTx.ExecutableTime, "blocktime", a.blocktime)
+		return nil, mty.ErrTxTimeLocked
+	}
+	//执行交易时没有新增的确认owner
+	return a.executeMultiSigTx(multiSigAcc, multiSigTx, nil)
+}
+
+//修改多重签名账户的时间锁和有效期,返回新的KeyValue对和ReceiptLog信息
+func (a *action) multiSigTimeLockModify(multiSigAccAddr string, newTimeLock, newExpiry int64) (*types.KeyValue, *types.ReceiptLog, error) {
+	multiSigAccount, err := getMultiSigAccFromDb(a.db, multiSigAccAddr)
+	if err != nil {
+		multisiglog.Error("multiSigTimeLockModify", "MultiSigAccAddr", multiSigAccAddr, "err", err)
+		return nil, nil, err
+	}
+	if err = checkTimeLock(newTimeLock, newExpiry); err != nil {
+		return nil, nil, err
+	}
+
+	receipt := &mty.ReceiptTimeLockModify{
+		MultiSigAddr: multiSigAccount.MultiSigAddr,
+		PrevTimeLock: multiSigAccount.TimeLock,
+		CurTimeLock:  newTimeLock,
+		PrevExpiry:   multiSigAccount.Expiry,
+		CurExpiry:    newExpiry,
+	}
+	multiSigAccount.TimeLock = newTimeLock
+	multiSigAccount.Expiry = newExpiry
+	receiptLog := &types.ReceiptLog{Ty: mty.TyLogMultiSigTimeLockModify, Log: types.Encode(receipt)}
+
+	key, value := setMultiSigAccToDb(a.db, multiSigAccount)
+	return &types.KeyValue{Key: key, Value: value}, receiptLog, nil
+}
+
+//账户时间锁和有效期的修改
+func (m *MultiSig) saveMultiSigAccTimeLock(accountOp mty.ReceiptTimeLockModify, addOrRollback bool) ([]*types.KeyValue, error) {
+	multiSig, err := getMultiSigAccount(m.GetLocalDB(), accountOp.MultiSigAddr)
+	if err != nil || multiSig == nil {
+		return nil, err
+	}
+	if addOrRollback {
+		multiSig.TimeLock = accountOp.CurTimeLock
+		multiSig.Expiry = accountOp.CurExpiry
+	} else {
+		multiSig.TimeLock = accountOp.PrevTimeLock
+		multiSig.Expiry = accountOp.PrevExpiry
+	}
+
+	err = setMultiSigAccount(m.GetLocalDB(), multiSig, true)
+	if err != nil {
+		return nil, err
+	}
+	return []*types.KeyValue{getMultiSigAccountKV(multiSig, true)}, nil
+}
+
+//交易被取消，回滚时恢复交易的状态
+func (m *MultiSig) saveMultiSigTxCancel(txState mty.ReceiptMultiSigTxState, addOrRollback bool) ([]*types.KeyValue, error) {
+	multiSigTx, err := getMultiSigTx(m.GetLocalDB(), txState.MultiSigAddr, txState.Txid)
+	if err != nil {
+		return nil, err
+	}
+	if multiSigTx == nil {
+		multisiglog.Error("saveMultiSigTxCancel", "addOrRollback", addOrRollback, "txState", txState)
+		return nil, mty.ErrTxidNotExist
+	}
+	multiSigTx.Cancelled = addOrRollback
+
+	err = setMultiSigTx(m.GetLocalDB(), multiSigTx, true)
+	if err != nil {
+		return nil, err
+	}
+	return []*types.KeyValue{getMultiSigTxKV(multiSigTx, true)}, nil
+}
@@ -0,0 +1,260 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	dbmock "github.com/33cn/chain33/common/db/mocks"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMultiSigTimeLock(t *testing.T) {
+	env := execEnv{1539918074, 10, 2, 1539918074, "hash"}
+
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 100)
+	localDB := new(dbmock.KVDB)
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chainTestCfg, nil)
+
+	driver := newMultiSig()
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	driver.SetAPI(api)
+	driver.SetStateDB(stateDB)
+	driver.SetLocalDB(localDB)
+
+	multiSigAddr, err := testMultiSigAccCreate(t, driver, env, localDB)
+	assert.Nil(t, err)
+
+	exec := func(tx *types.Transaction, priv string) (*types.Receipt, error) {
+		tx, _ = signTx(tx, priv)
+		if err := driver.CheckTx(tx, env.index); err != nil {
+			return nil, err
+		}
+		receipt, err := driver.Exec(tx, env.index)
+		if err == nil {
+			txDetails := &types.TransactionDetails{Txs: []*types.TransactionDetail{{Tx: tx}}}
+			api.On("GetTransactionByHash", &types.ReqHashes{Hashes: [][]byte{tx.Hash()}}).Return(txDetails, nil)
+		}
+		return receipt, err
+	}
+	hasLog := func(receipt *types.Receipt, ty int32) bool {
+		for _, log := range receipt.Logs {
+			if log.Ty == ty {
+				return true
+			}
+		}
+		return false
+	}
+
+	// 有效期必须大于时间锁
+	tx, _ := multiSigAccOperate(&mty.MultiSigAccOperate{MultiSigAccAddr: multiSigAddr, TimeLockOp: true, NewTimeLock: 100, NewExpiry: 100})
+	_, err = exec(tx, PrivKeyD)
+	assert.Equal(t, mty.ErrInvalidTimeLock, err)
+
+	// addrD 权重满足，还没有时间锁直接执行
+	tx, _ = multiSigAccOperate(&mty.MultiSigAccOperate{MultiSigAccAddr: multiSigAddr, TimeLockOp: true, NewTimeLock: 100, NewExpiry: 1000})
+	receipt, err := exec(tx, PrivKeyD)
+	assert.Nil(t, err)
+	assert.True(t, hasLog(receipt, mty.TyLogMultiSigTimeLockModify))
+	multiSigAcc, err := getMultiSigAccFromDb(stateDB, multiSigAddr)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(100), multiSigAcc.TimeLock)
+	assert.Equal(t, int64(1000), multiSigAcc.Expiry)
+
+	// 权重满足之后等待时间锁到期
+	tx, _ = multiSigAccOperate(&mty.MultiSigAccOperate{MultiSigAccAddr: multiSigAddr, NewRequiredWeight: 6, OperateFlag: mty.AccWeightOp})
+	receipt, err = exec(tx, PrivKeyD)
+	assert.Nil(t, err)
+	assert.True(t, hasLog(receipt, mty.TyLogMultiSigTxQueued))
+	assert.False(t, hasLog(receipt, mty.TyLogMultiSigAccWeightModify))
+	txid := multiSigAcc.TxCount
+	multiSigTx, err := getMultiSigAccTxFromDb(stateDB, multiSigAddr, txid)
+	assert.Nil(t, err)
+	assert.False(t, multiSigTx.Executed)
+	assert.Equal(t, env.blockTime+100, multiSigTx.ExecutableTime)
+	assert.Equal(t, env.blockTime+1000, multiSigTx.ExpireTime)
+
+	execute := &mty.MultiSigExecuteTx{MultiSigAccAddr: multiSigAddr, TxId: txid}
+	tx, _ = multiSigExecuteTx(execute)
+	_, err = exec(tx, PrivKeyC)
+	assert.Equal(t, mty.ErrTxTimeLocked, err)
+	tx, _ = multiSigExecuteTx(execute)
+	_, err = exec(tx, PrivKeyA)
+	assert.Equal(t, mty.ErrIsNotOwner, err)
+
+	driver.SetEnv(env.blockHeight+1, env.blockTime+100, env.difficulty)
+	tx, _ = multiSigExecuteTx(execute)
+	receipt, err = exec(tx, PrivKeyC)
+	assert.Nil(t, err)
+	assert.True(t, hasLog(receipt, mty.TyLogMultiSigAccWeightModify))
+	multiSigAcc, _ = getMultiSigAccFromDb(stateDB, multiSigAddr)
+	assert.Equal(t, uint64(6), multiSigAcc.RequiredWeight)
+	tx, _ = multiSigExecuteTx(execute)
+	_, err = exec(tx, PrivKeyC)
+	assert.Equal(t, mty.ErrTxHasExecuted, err)
+
+	// 还未执行的交易被取消之后不能再确认和执行
+	tx, _ = multiSigAccOperate(&mty.MultiSigAccOperate{MultiSigAccAddr: multiSigAddr, NewRequiredWeight: 5, OperateFlag: mty.AccWeightOp})
+	_, err = exec(tx, PrivKeyD)
+	assert.Nil(t, err)
+	txid++
+	tx, _ = multiSigCancelTx(&mty.MultiSigCancelTx{MultiSigAccAddr: multiSigAddr, TxId: txid})
+	receipt, err = exec(tx, PrivKeyC)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(mty.TyLogMultiSigTxCancel), receipt.Logs[0].Ty)
+	driver.SetEnv(env.blockHeight+2, env.blockTime+300, env.difficulty)
+	tx, _ = multiSigExecuteTx(&mty.MultiSigExecuteTx{MultiSigAccAddr: multiSigAddr, TxId: txid})
+	_, err = exec(tx, PrivKeyD)
+	assert.Equal(t, mty.ErrTxCancelled, err)
+	tx, _ = multiSigConfirmTx(&mty.MultiSigConfirmTx{MultiSigAccAddr: multiSigAddr, TxId: txid, ConfirmOrRevoke: true})
+	_, err = exec(tx, PrivKeyC)
+	assert.Equal(t, mty.ErrTxCancelled, err)
+
+	// 过期的交易不能再确认
+	tx, _ = multiSigAccOperate(&mty.MultiSigAccOperate{MultiSigAccAddr: multiSigAddr, NewRequiredWeight: 5, OperateFlag: mty.AccWeightOp})
+	_, err = exec(tx, PrivKeyC)
+	assert.Nil(t, err)
+	txid++
+	driver.SetEnv(env.blockHeight+3, env.blockTime+1300, env.difficulty)
+	tx, _ = multiSigConfirmTx(&mty.MultiSigConfirmTx{MultiSigAccAddr: multiSigAddr, TxId: txid, ConfirmOrRevoke: true})
+	_, err = exec(tx, PrivKeyD)
+	assert.Equal(t, mty.ErrTxExpired, err)
+}
+
+func TestSaveMultiSigTxExecuted(t *testing.T) {
+	_, _, kvdb := util.CreateTestDB()
+	m := newMultiSig().(*MultiSig)
+	m.SetLocalDB(kvdb)
+
+	multiSigTx := &mty.MultiSigTx{MultiSigAddr: AddrA, Txid: 1, ConfirmedOwner: []*mty.Owner{{OwnerAddr: AddrC, Weight: AddrCWeight}}}
+	assert.Nil(t, setMultiSigTx(kvdb, multiSigTx, true))
+
+	// 时间锁到期后执行的交易没有确认owner
+	receipt := mty.ReceiptMultiSigTx{MultiSigTxOwner: &mty.MultiSigTxOwner{MultiSigAddr: AddrA, Txid: 1}, CurExecuted: true}
+	_, err := m.saveMultiSigTx(receipt, true)
+	assert.Nil(t, err)
+	localTx, err := getMultiSigTx(kvdb, AddrA, 1)
+	assert.Nil(t, err)
+	assert.True(t, localTx.Executed)
+	assert.Equal(t, 1, len(localTx.ConfirmedOwner))
+
+	_, err = m.saveMultiSigTx(receipt, false)
+	assert.Nil(t, err)
+	localTx, _ = getMultiSigTx(kvdb, AddrA, 1)
+	assert.False(t, localTx.Executed)
+
+	_, err = m.saveMultiSigTxCancel(mty.ReceiptMultiSigTxState{MultiSigAddr: AddrA, Txid: 1, Cancelled: true}, true)
+	assert.Nil(t, err)
+	localTx, _ = getMultiSigTx(kvdb, AddrA, 1)
+	assert.True(t, localTx.Cancelled)
+}
+
+func multiSigCancelTx(parm *mty.MultiSigCancelTx) (*types.Transaction, error) {
+	if parm == nil {
+		return nil, types.ErrInvalidParam
+	}
+	multiSig := &mty.MultiSigAction{
+		Ty:    mty.ActionMultiSigCancelTx,
+		Value: &mty.MultiSigAction_MultiSigCancelTx{MultiSigCancelTx: parm},
+	}
+	return types.CreateFormatTx(chainTestCfg, chainTestCfg.ExecName(mty.MultiSigX), types.Encode(multiSig))
+}
+
+func multiSigExecuteTx(parm *mty.MultiSigExecuteTx) (*types.Transaction, error) {
+	if parm == nil {
+		return nil, types.ErrInvalidParam
+	}
+	multiSig := &mty.MultiSigAction{
+		Ty:    mty.ActionMultiSigExecuteTx,
+		Value: &mty.MultiSigAction_MultiSigExecuteTx{MultiSigExecuteTx: parm},
+	}
+	return types.CreateFormatTx(chainTestCfg, chainTestCfg.ExecName(mty.MultiSigX), types.Encode(multiSig))
+}
+
+func TestMultiSigTransferUnderLimitConfirm(t *testing.T) {
+	// 分叉前确认时额度在每日限额之内也会执行，分叉后只有提交时使用每日限额
+	testTransferUnderLimitConfirm(t, 100, true)
+	testTransferUnderLimitConfirm(t, 0, false)
+}
+
+func testTransferUnderLimitConfirm(t *testing.T, forkHeight int64, executed bool) {
+	env := execEnv{1539918074, 10, 2, 1539918074, "hash"}
+	//local title的分叉高度都为0
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	cfg.SetDappFork(mty.MultiSigX, mty.ForkMultiSigTimeLockX, forkHeight)
+
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 100)
+	localDB := new(dbmock.KVDB)
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+	accD := account.NewCoinsAccount(cfg)
+	accD.SetDB(stateDB)
+	accD.SaveExecAccount(address.ExecAddress(mty.MultiSigX), &types.Account{Balance: 100000, Addr: AddrD})
+
+	driver := newMultiSig()
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	driver.SetAPI(api)
+	driver.SetStateDB(stateDB)
+	driver.SetLocalDB(localDB)
+
+	multiSigAddr, err := testMultiSigAccCreate(t, driver, env, localDB)
+	assert.Nil(t, err)
+	exec := func(tx *types.Transaction, priv string) (*types.Receipt, error) {
+		tx, _ = signTx(tx, priv)
+		receipt, err := driver.Exec(tx, env.index)
+		if err == nil {
+			for _, kv := range receipt.KV {
+				stateDB.Set(kv.Key, kv.Value)
+			}
+		}
+		return receipt, err
+	}
+
+	tx, _ := multiSigExecTransferTo(&mty.MultiSigExecTransferTo{Symbol: Symbol, Amount: 1000, Execname: Asset, To: multiSigAddr}, false)
+	_, err = exec(tx, PrivKeyD)
+	assert.Nil(t, err)
+
+	// addrC 权重不够，超过每日限额不执行
+	amount := int64(CoinsBtyDailylimit + 50)
+	tx, _ = multiSigExecTransferFrom(&mty.MultiSigExecTransferFrom{Symbol: Symbol, Amount: amount, Execname: Asset, From: multiSigAddr, To: AddrC}, true)
+	_, err = exec(tx, PrivKeyC)
+	assert.Nil(t, err)
+	multiSigAcc, err := getMultiSigAccFromDb(stateDB, multiSigAddr)
+	assert.Nil(t, err)
+	txid := multiSigAcc.TxCount - 1
+	multiSigTx, err := getMultiSigAccTxFromDb(stateDB, multiSigAddr, txid)
+	assert.Nil(t, err)
+	assert.False(t, multiSigTx.Executed)
+
+	// 确认阶段权重仍然不够，但额度在每日限额之内
+	tx, _ = multiSigConfirmTx(&mty.MultiSigConfirmTx{MultiSigAccAddr: multiSigAddr, TxId: txid, ConfirmOrRevoke: true})
+	tx, _ = signTx(tx, PrivKeyC)
+	a := newAction(driver.(*MultiSig), tx, int32(env.index))
+	amount = int64(CoinsBtyDailylimit / 2)
+	transfer := &mty.MultiSigExecTransferFrom{Symbol: Symbol, Amount: amount, Execname: Asset, From: multiSigAddr, To: AddrC}
+	receipt, err := a.executeTransferTx(multiSigAcc, multiSigTx, transfer, multiSigTx.ConfirmedOwner[0], mty.IsConfirm)
+	assert.Nil(t, err)
+	for _, kv := range receipt.KV {
+		stateDB.Set(kv.Key, kv.Value)
+	}
+	assert.Equal(t, executed, multiSigTx.Executed)
+	accC := account.NewCoinsAccount(cfg)
+	accC.SetDB(stateDB)
+	balance := int64(0)
+	if executed {
+		balance = amount
+	}
+	assert.Equal(t, balance, accC.LoadExecAccount(AddrC, address.ExecAddress(mty.MultiSigX)).Balance)
+}
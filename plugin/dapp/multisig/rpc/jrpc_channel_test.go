@@ -48,6 +48,9 @@ func TestJRPCChannel(t *testing.T) {
 		{fn: testCreateMultiSigConfirmTxCmd},
 		{fn: testCreateMultiSigAccTransferInCmd},
 		{fn: testCreateMultiSigAccTransferOutCmd},
+		{fn: testCreateMultiSigExecCallCmd},
+		{fn: testCreateMultiSigCancelTxCmd},
+		{fn: testCreateMultiSigExecuteTxCmd},
 
 		{fn: testGetMultiSigAccCountCmd},
 		{fn: testGetMultiSigAccountsCmd},
@@ -115,6 +118,18 @@ func testCreateMultiSigAccTransferOutCmd(t *testing.T, jrpc *jsonclient.JSONClie
 	params := &mty.MultiSigExecTransferFrom{}
 	return jrpc.Call("multisig.MultiSigAccTransferOutTx", params, nil)
 }
+func testCreateMultiSigExecCallCmd(t *testing.T, jrpc *jsonclient.JSONClient) error {
+	params := &mty.MultiSigExecCall{}
+	return jrpc.Call("multisig.MultiSigExecCallTx", params, nil)
+}
+func testCreateMultiSigCancelTxCmd(t *testing.T, jrpc *jsonclient.JSONClient) error {
+	params := &mty.MultiSigCancelTx{}
+	return jrpc.Call("multisig.MultiSigCancelTx", params, nil)
+}
+func testCreateMultiSigExecuteTxCmd(t *testing.T, jrpc *jsonclient.JSONClient) error {
+	params := &mty.MultiSigExecuteTx{}
+	return jrpc.Call("multisig.MultiSigExecuteTx", params, nil)
+}
 
 //get 多重签名账户信息
 func testGetMultiSigAccCountCmd(t *testing.T, jrpc *jsonclient.JSONClient) error {
@@ -97,6 +97,48 @@ func (c *Jrpc) MultiSigAccTransferOutTx(param *mty.MultiSigExecTransferFrom, res
 	return nil
 }
 
+// MultiSigExecCallTx :构造多重签名账户调用其他合约的交易
+func (c *Jrpc) MultiSigExecCallTx(param *mty.MultiSigExecCall, result *interface{}) error {
+	if param == nil {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(mty.MultiSigX), "MultiSigExecCall", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// MultiSigCancelTx :构造取消多重签名账户上还未执行的交易的交易
+func (c *Jrpc) MultiSigCancelTx(param *mty.MultiSigCancelTx, result *interface{}) error {
+	if param == nil {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(mty.MultiSigX), "MultiSigCancelTx", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// MultiSigExecuteTx :构造时间锁到期之后执行多重签名账户上的交易的交易
+func (c *Jrpc) MultiSigExecuteTx(param *mty.MultiSigExecuteTx, result *interface{}) error {
+	if param == nil {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(mty.MultiSigX), "MultiSigExecuteTx", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
 // MultiSigAddresList 获取owner地址上的多重签名账户列表{multiSigAddr，owneraddr，weight}
 func (c *Jrpc) MultiSigAddresList(in *types.ReqString, result *interface{}) error {
 	v := *in
@@ -20,10 +20,11 @@ var (
 	//AccWeightOp 账户属性的操作
 	AccWeightOp     = true
 	AccDailyLimitOp = false
-	//OwnerOperate 多重签名交易类型：转账，owner操作，account操作
-	OwnerOperate    uint64 = 1
-	AccountOperate  uint64 = 2
-	TransferOperate uint64 = 3
+	//OwnerOperate 多重签名交易类型：转账，owner操作，account操作，合约调用
+	OwnerOperate        uint64 = 1
+	AccountOperate      uint64 = 2
+	TransferOperate     uint64 = 3
+	ContractCallOperate uint64 = 4
 	//IsSubmit ：
 	IsSubmit  = true
 	IsConfirm = false
@@ -45,8 +46,17 @@ const (
 	ActionMultiSigConfirmTx        = 10003
 	ActionMultiSigExecTransferTo   = 10004
 	ActionMultiSigExecTransferFrom = 10005
+	ActionMultiSigExecCall         = 10006
+	ActionMultiSigCancelTx         = 10007
+	ActionMultiSigExecuteTx        = 10008
 )
 
+// ForkMultiSigExecCallX 支持多重签名账户调用其他合约
+const ForkMultiSigExecCallX = "ForkMultiSigExecCall"
+
+// ForkMultiSigTimeLockX 支持多重签名交易的时间锁，有效期以及取消
+const ForkMultiSigTimeLockX = "ForkMultiSigTimeLock"
+
 //多重签名账户执行输出的logid
 const (
 	TyLogMultiSigAccCreate = 10000 //只输出多重签名的账户地址
@@ -66,7 +76,11 @@ const (
 	TyLogDailyLimitUpdate = 10010 //DailyLimit更新，DailyLimit在Submit和Confirm阶段都可能有变化
 	TyLogMultiSigTx       = 10011 //在Submit提交交易阶段才会有更新
 	TyLogTxCountUpdate    = 10012 //txcount只在在Submit阶段提交新的交易是才会增加计数
+	TyLogMultiSigExecCall = 10013 //合约调用被执行，输出被调用的合约以及内部交易的hash
 
+	TyLogMultiSigTimeLockModify = 10014 //输出修改前后的时间锁和有效期
+	TyLogMultiSigTxQueued       = 10015 //交易权重满足，等待时间锁到期之后执行
+	TyLogMultiSigTxCancel       = 10016 //还未执行的交易被owner取消
 )
 
 //AccAssetsResult 账户资产cli的显示，主要是amount需要转换成浮点型字符串
@@ -97,6 +111,8 @@ type MultiSigResult struct {
 	DailyLimits    []*DailyLimitResult `json:"dailyLimits,omitempty"`
 	TxCount        uint64              `json:"txCount,omitempty"`
 	RequiredWeight uint64              `json:"requiredWeight,omitempty"`
+	TimeLock       int64               `json:"timeLock,omitempty"`
+	Expiry         int64               `json:"expiry,omitempty"`
 }
 
 //UnSpentAssetsResult 每日限额之内未花费额度的显示cli
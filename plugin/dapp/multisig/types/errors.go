@@ -41,4 +41,10 @@ var (
 	ErrInvalidExec          = errors.New("ErrInvalidExec")
 	ErrInvalidWeight        = errors.New("ErrInvalidWeight")
 	ErrInvalidDailyLimit    = errors.New("ErrInvalidDailyLimit")
+	ErrExecCallNotSupport   = errors.New("ErrExecCallNotSupport")
+	ErrExecCallFailed       = errors.New("ErrExecCallFailed")
+	ErrInvalidTimeLock      = errors.New("ErrInvalidTimeLock")
+	ErrTxExpired            = errors.New("ErrTxExpired")
+	ErrTxCancelled          = errors.New("ErrTxCancelled")
+	ErrTxTimeLocked         = errors.New("ErrTxTimeLocked")
 )
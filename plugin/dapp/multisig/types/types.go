@@ -19,6 +19,8 @@ func init() {
 //InitFork ...
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(MultiSigX, "Enable", 0)
+	cfg.RegisterDappFork(MultiSigX, ForkMultiSigExecCallX, 0)
+	cfg.RegisterDappFork(MultiSigX, ForkMultiSigTimeLockX, 0)
 }
 
 //InitExecutor ...
@@ -58,6 +60,9 @@ func (m *MultiSigType) GetTypeMap() map[string]int32 {
 		"MultiSigConfirmTx":        ActionMultiSigConfirmTx,
 		"MultiSigExecTransferTo":   ActionMultiSigExecTransferTo,
 		"MultiSigExecTransferFrom": ActionMultiSigExecTransferFrom,
+		"MultiSigExecCall":         ActionMultiSigExecCall,
+		"MultiSigCancelTx":         ActionMultiSigCancelTx,
+		"MultiSigExecuteTx":        ActionMultiSigExecuteTx,
 	}
 }
 
@@ -81,6 +86,11 @@ func (m *MultiSigType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogDailyLimitUpdate: {Ty: reflect.TypeOf(ReceiptAccDailyLimitUpdate{}), Name: "LogAccDailyLimitUpdate"},
 		TyLogMultiSigTx:       {Ty: reflect.TypeOf(ReceiptMultiSigTx{}), Name: "LogMultiSigAccTx"},
 		TyLogTxCountUpdate:    {Ty: reflect.TypeOf(ReceiptTxCountUpdate{}), Name: "LogTxCountUpdate"},
+		TyLogMultiSigExecCall: {Ty: reflect.TypeOf(ReceiptMultiSigExecCall{}), Name: "LogMultiSigExecCall"},
+
+		TyLogMultiSigTimeLockModify: {Ty: reflect.TypeOf(ReceiptTimeLockModify{}), Name: "LogMultiSigTimeLockModify"},
+		TyLogMultiSigTxQueued:       {Ty: reflect.TypeOf(ReceiptMultiSigTxState{}), Name: "LogMultiSigTxQueued"},
+		TyLogMultiSigTxCancel:       {Ty: reflect.TypeOf(ReceiptMultiSigTxState{}), Name: "LogMultiSigTxCancel"},
 	}
 }
 
@@ -113,6 +123,12 @@ func (m MultiSigType) ActionName(tx *types.Transaction) string {
 		return "MultiSigExecTransfer"
 	} else if g.Ty == ActionMultiSigExecTransferFrom && g.GetMultiSigExecTransferFrom() != nil {
 		return "MultiSigAccExecTransfer"
+	} else if g.Ty == ActionMultiSigExecCall && g.GetMultiSigExecCall() != nil {
+		return "MultiSigExecCall"
+	} else if g.Ty == ActionMultiSigCancelTx && g.GetMultiSigCancelTx() != nil {
+		return "MultiSigCancelTx"
+	} else if g.Ty == ActionMultiSigExecuteTx && g.GetMultiSigExecuteTx() != nil {
+		return "MultiSigExecuteTx"
 	}
 	return "unknown"
 }
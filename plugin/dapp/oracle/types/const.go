@@ -85,4 +85,8 @@ var (
 	ErrParamStatusInvalid         = errors.New("ErrParamStatusInvalid")
 	ErrParamAddressMustnotEmpty   = errors.New("ErrParamAddressMustnotEmpty")
 	ErrParamTypeMustNotEmpty      = errors.New("ErrParamTypeMustNotEmpty")
+	ErrResultNotPublished         = errors.New("ErrResultNotPublished")
+	ErrResultPriceInvalid         = errors.New("ErrResultPriceInvalid")
+	ErrPriceStale                 = errors.New("ErrPriceStale")
+	ErrPricePairMismatch          = errors.New("ErrPricePairMismatch")
 )
@@ -973,6 +973,118 @@ func (x *ReplyOracleStatusList) GetStatus() []*OracleStatus {
 	return nil
 }
 
+// 喂价人提交的价格
+type PriceSubmission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr  string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`    //喂价人地址
+	Price int64  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"` //价格(精度1e4)
+	Time  int64  `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`   //提交时间
+}
+
+func (x *PriceSubmission) Reset() {
+	*x = PriceSubmission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceSubmission) ProtoMessage() {}
+
+func (x *PriceSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceSubmission.ProtoReflect.Descriptor instead.
+func (*PriceSubmission) Descriptor() ([]byte, []int) {
+	return file_oracle_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PriceSubmission) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *PriceSubmission) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PriceSubmission) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+// 一轮多喂价人报价，达到法定数量后取中位数
+type PriceFeedRound struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Submissions []*PriceSubmission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+}
+
+func (x *PriceFeedRound) Reset() {
+	*x = PriceFeedRound{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceFeedRound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceFeedRound) ProtoMessage() {}
+
+func (x *PriceFeedRound) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceFeedRound.ProtoReflect.Descriptor instead.
+func (*PriceFeedRound) Descriptor() ([]byte, []int) {
+	return file_oracle_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PriceFeedRound) GetSubmissions() []*PriceSubmission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
 var File_oracle_proto protoreflect.FileDescriptor
 
 var file_oracle_proto_rawDesc = []byte{
@@ -1076,9 +1188,18 @@ var file_oracle_proto_rawDesc = []byte{
 	0x6c, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4c, 0x69,
 	0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
-	0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x4f, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x22, 0x4a, 0x0a, 0x0e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x38, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0a, 0x5a, 0x08,
+	0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1093,7 +1214,7 @@ func file_oracle_proto_rawDescGZIP() []byte {
 	return file_oracle_proto_rawDescData
 }
 
-var file_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_oracle_proto_goTypes = []interface{}{
 	(*OracleStatus)(nil),          // 0: types.OracleStatus
 	(*OracleAction)(nil),          // 1: types.OracleAction
@@ -1109,21 +1230,24 @@ var file_oracle_proto_goTypes = []interface{}{
 	(*QueryEventID)(nil),          // 11: types.QueryEventID
 	(*ReceiptOracle)(nil),         // 12: types.ReceiptOracle
 	(*ReplyOracleStatusList)(nil), // 13: types.ReplyOracleStatusList
+	(*PriceSubmission)(nil),       // 14: types.PriceSubmission
+	(*PriceFeedRound)(nil),        // 15: types.PriceFeedRound
 }
 var file_oracle_proto_depIdxs = []int32{
-	2, // 0: types.OracleStatus.status:type_name -> types.EventStatus
-	2, // 1: types.OracleStatus.preStatus:type_name -> types.EventStatus
-	3, // 2: types.OracleAction.eventPublish:type_name -> types.EventPublish
-	4, // 3: types.OracleAction.eventAbort:type_name -> types.EventAbort
-	5, // 4: types.OracleAction.resultPrePublish:type_name -> types.ResultPrePublish
-	6, // 5: types.OracleAction.resultPublish:type_name -> types.ResultPublish
-	7, // 6: types.OracleAction.resultAbort:type_name -> types.ResultAbort
-	0, // 7: types.ReplyOracleStatusList.status:type_name -> types.OracleStatus
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	2,  // 0: types.OracleStatus.status:type_name -> types.EventStatus
+	2,  // 1: types.OracleStatus.preStatus:type_name -> types.EventStatus
+	3,  // 2: types.OracleAction.eventPublish:type_name -> types.EventPublish
+	4,  // 3: types.OracleAction.eventAbort:type_name -> types.EventAbort
+	5,  // 4: types.OracleAction.resultPrePublish:type_name -> types.ResultPrePublish
+	6,  // 5: types.OracleAction.resultPublish:type_name -> types.ResultPublish
+	7,  // 6: types.OracleAction.resultAbort:type_name -> types.ResultAbort
+	0,  // 7: types.ReplyOracleStatusList.status:type_name -> types.OracleStatus
+	14, // 8: types.PriceFeedRound.submissions:type_name -> types.PriceSubmission
+	9,  // [9:9] is the sub-list for method output_type
+	9,  // [9:9] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_oracle_proto_init() }
@@ -1300,6 +1424,30 @@ func file_oracle_proto_init() {
 				return nil
 			}
 		}
+		file_oracle_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceSubmission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceFeedRound); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_oracle_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*OracleAction_EventPublish)(nil),
@@ -1314,7 +1462,7 @@ func file_oracle_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_oracle_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
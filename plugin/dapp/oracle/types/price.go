@@ -0,0 +1,95 @@
+/*
+ * Copyright Fuzamei Corp. 2018 All Rights Reserved.
+ * Use of this source code is governed by a BSD-style
+ * license that can be found in the LICENSE file.
+ */
+
+package types
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// PricePrecision 喂价精度
+	PricePrecision = 1e4
+	// PriceEventType 价格事件的类别
+	PriceEventType = "price"
+)
+
+// PricePair 价格事件的子类别为交易对，格式为 资产执行器.资产符号/计价资产符号，比如 coins.bty/ccny
+func PricePair(assetExec, assetSymbol, quoteSymbol string) string {
+	return assetExec + "." + assetSymbol + "/" + quoteSymbol
+}
+
+// ResultPrice 从已公布结果的价格事件中解析交易对pair的价格，结果为按PricePrecision放大的整数价格
+func ResultPrice(status *OracleStatus, pair string) (int64, error) {
+	if status == nil || status.Status == nil || status.Status.Status != ResultPublished {
+		return 0, ErrResultNotPublished
+	}
+	if status.Type != PriceEventType || status.SubType != pair {
+		return 0, ErrPricePairMismatch
+	}
+	price, err := strconv.ParseInt(strings.TrimSpace(status.Result), 10, 64)
+	if err != nil || price <= 0 {
+		return 0, ErrResultPriceInvalid
+	}
+	return price, nil
+}
+
+// Submit 记录喂价人报价，同一喂价人只保留最新报价，并丢弃超出时间窗口的报价
+// 报价都先记录，偏离过大的报价在MedianPrice中剔除，避免第一个报价决定其他报价能否提交
+func (r *PriceFeedRound) Submit(addr string, price, now, window int64) {
+	var subs []*PriceSubmission
+	for _, sub := range r.Submissions {
+		if sub.Addr == addr {
+			continue
+		}
+		if window > 0 && now-sub.Time > window {
+			continue
+		}
+		subs = append(subs, sub)
+	}
+	r.Submissions = append(subs, &PriceSubmission{Addr: addr, Price: price, Time: now})
+}
+
+// MedianPrice 剔除偏离全部报价中位数过大的报价后，剩余报价数量达到quorum时返回中位数价格
+// deviation为允许偏离中位数的比例(万分比)，0表示不剔除
+func (r *PriceFeedRound) MedianPrice(quorum int32, deviation int64) (int64, bool) {
+	if len(r.Submissions) < int(quorum) || len(r.Submissions) == 0 {
+		return 0, false
+	}
+	median := medianPrice(r.Submissions)
+	if deviation <= 0 {
+		return median, true
+	}
+	var subs []*PriceSubmission
+	for _, sub := range r.Submissions {
+		diff := sub.Price - median
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff*PricePrecision <= median*deviation {
+			subs = append(subs, sub)
+		}
+	}
+	if len(subs) < int(quorum) || len(subs) == 0 {
+		return 0, false
+	}
+	return medianPrice(subs), true
+}
+
+func medianPrice(subs []*PriceSubmission) int64 {
+	prices := make([]int64, 0, len(subs))
+	for _, sub := range subs {
+		prices = append(prices, sub.Price)
+	}
+	sort.Slice(prices, func(i, j int) bool { return prices[i] < prices[j] })
+	n := len(prices)
+	if n%2 == 1 {
+		return prices[n/2]
+	}
+	return (prices[n/2-1] + prices[n/2]) / 2
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright Fuzamei Corp. 2018 All Rights Reserved.
+ * Use of this source code is governed by a BSD-style
+ * license that can be found in the LICENSE file.
+ */
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResultPrice(t *testing.T) {
+	pair := PricePair("coins", "bty", "ccny")
+	status := &OracleStatus{Type: PriceEventType, SubType: pair, Result: "12500", Status: &EventStatus{Status: ResultPrePublished}}
+	_, err := ResultPrice(status, pair)
+	assert.Equal(t, ErrResultNotPublished, err)
+
+	status.Status.Status = ResultPublished
+	price, err := ResultPrice(status, pair)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(12500), price)
+
+	// 事件必须是对应交易对的价格事件
+	_, err = ResultPrice(status, PricePair("token", "TEST", "ccny"))
+	assert.Equal(t, ErrPricePairMismatch, err)
+
+	// 结果必须是整数价格
+	status.Result = "1.25"
+	_, err = ResultPrice(status, pair)
+	assert.Equal(t, ErrResultPriceInvalid, err)
+	status.Result = "win"
+	_, err = ResultPrice(status, pair)
+	assert.Equal(t, ErrResultPriceInvalid, err)
+}
+
+func TestPriceFeedRound(t *testing.T) {
+	round := &PriceFeedRound{}
+	round.Submit("a", 10000, 100, 60)
+	round.Submit("b", 10200, 110, 60)
+	_, ok := round.MedianPrice(3, 1000)
+	assert.False(t, ok)
+
+	// 同一喂价人只保留最新报价
+	round.Submit("a", 10100, 120, 60)
+	assert.Equal(t, 2, len(round.Submissions))
+
+	// 超出时间窗口的报价被丢弃
+	round.Submit("c", 9900, 175, 60)
+	assert.Equal(t, 2, len(round.Submissions))
+	_, ok = round.MedianPrice(3, 1000)
+	assert.False(t, ok)
+
+	round.Submit("d", 10000, 176, 60)
+	price, ok := round.MedianPrice(3, 1000)
+	assert.True(t, ok)
+	assert.Equal(t, int64(10000), price)
+
+	// 第一个报价偏离过大，后续正常报价仍然可以提交，取中位数时剔除偏离的报价，时间窗口为0也不会卡住
+	round = &PriceFeedRound{}
+	round.Submit("a", 20000, 100, 0)
+	round.Submit("b", 10000, 100, 0)
+	round.Submit("c", 10100, 100, 0)
+	assert.Equal(t, 3, len(round.Submissions))
+	_, ok = round.MedianPrice(3, 1000)
+	assert.False(t, ok)
+	round.Submit("d", 10200, 100, 0)
+	price, ok = round.MedianPrice(3, 1000)
+	assert.True(t, ok)
+	assert.Equal(t, int64(10100), price)
+
+	// 不剔除偏离的报价
+	price, ok = round.MedianPrice(3, 0)
+	assert.True(t, ok)
+	assert.Equal(t, int64(10150), price)
+}
@@ -0,0 +1,11 @@
+#!/bin/bash
+# 官方ci集成脚本
+#strpwd=$(pwd)
+#strcmd=${strpwd##*dapp/}
+#strapp=${strcmd%/cmd*}
+
+#OUT_DIR="${1}/$strapp"
+#FLAG=$2
+
+#mkdir -p "${OUT_DIR}"
+#cp ./build/* "${OUT_DIR}"
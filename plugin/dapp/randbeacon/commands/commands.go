@@ -0,0 +1,194 @@
+/*Package commands implement dapp client commands*/
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/common"
+	jsonrpc "github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	rty "github.com/33cn/plugin/plugin/dapp/randbeacon/types"
+	"github.com/spf13/cobra"
+)
+
+/*
+ * 实现合约对应客户端
+ */
+
+// Cmd randbeacon client command
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "randbeacon",
+		Short: "commit-reveal random beacon command",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		//create tx
+		registerCMD(),
+		commitCMD(),
+		revealCMD(),
+		finalizeCMD(),
+		exitCMD(),
+		//query rpc
+		memberCMD(),
+		roundCMD(),
+		randCMD(),
+	)
+	return cmd
+}
+
+func markRequired(cmd *cobra.Command, params ...string) {
+	for _, param := range params {
+		_ = cmd.MarkFlagRequired(param)
+	}
+}
+
+func sendCreateTxRPC(cmd *cobra.Command, actionName string, req types.Message) {
+	rpcAddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	payLoad := types.MustPBToJSON(req)
+	pm := &rpctypes.CreateTxIn{
+		Execer:     types.GetExecName(rty.RandBeaconX, paraName),
+		ActionName: actionName,
+		Payload:    payLoad,
+	}
+
+	var res string
+	ctx := jsonrpc.NewRPCCtx(rpcAddr, "Chain33.CreateTransaction", pm, &res)
+	ctx.RunWithoutMarshal()
+}
+
+func sendQueryRPC(cmd *cobra.Command, funcName string, req, reply types.Message) {
+	rpcAddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	payLoad := types.MustPBToJSON(req)
+	query := &rpctypes.Query4Jrpc{
+		Execer:   types.GetExecName(rty.RandBeaconX, paraName),
+		FuncName: funcName,
+		Payload:  payLoad,
+	}
+
+	ctx := jsonrpc.NewRPCCtx(rpcAddr, "Chain33.Query", query, reply)
+	ctx.Run()
+}
+
+func registerCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register",
+		Short: "create tx(join committee, freeze deposit in exec account)",
+		Run: func(cmd *cobra.Command, args []string) {
+			sendCreateTxRPC(cmd, rty.NameRegisterAction, &rty.BeaconRegister{})
+		},
+	}
+	return cmd
+}
+
+func commitCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "commit",
+		Short:   "create tx(commit sha256 hash of secret for round)",
+		Run:     commit,
+		Example: "commit -r=10 -s=mysecret",
+	}
+	cmd.Flags().Int64P("round", "r", 0, "round number")
+	cmd.Flags().StringP("secret", "s", "", "secret, only sha256 hash is committed")
+	markRequired(cmd, "round", "secret")
+	return cmd
+}
+
+func commit(cmd *cobra.Command, args []string) {
+	round, _ := cmd.Flags().GetInt64("round")
+	secret, _ := cmd.Flags().GetString("secret")
+	sendCreateTxRPC(cmd, rty.NameCommitAction, &rty.BeaconCommit{Round: round, Hash: common.Sha256([]byte(secret))})
+}
+
+func revealCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reveal",
+		Short:   "create tx(reveal committed secret for round)",
+		Run:     reveal,
+		Example: "reveal -r=10 -s=mysecret",
+	}
+	cmd.Flags().Int64P("round", "r", 0, "round number")
+	cmd.Flags().StringP("secret", "s", "", "committed secret")
+	markRequired(cmd, "round", "secret")
+	return cmd
+}
+
+func reveal(cmd *cobra.Command, args []string) {
+	round, _ := cmd.Flags().GetInt64("round")
+	secret, _ := cmd.Flags().GetString("secret")
+	sendCreateTxRPC(cmd, rty.NameRevealAction, &rty.BeaconReveal{Round: round, Secret: []byte(secret)})
+}
+
+func finalizeCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize",
+		Short: "create tx(finalize round after reveal phase)",
+		Run: func(cmd *cobra.Command, args []string) {
+			round, _ := cmd.Flags().GetInt64("round")
+			sendCreateTxRPC(cmd, rty.NameFinalizeAction, &rty.BeaconFinalize{Round: round})
+		},
+	}
+	cmd.Flags().Int64P("round", "r", 0, "round number")
+	markRequired(cmd, "round")
+	return cmd
+}
+
+func exitCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exit",
+		Short: "create tx(leave committee, unfreeze remaining deposit)",
+		Run: func(cmd *cobra.Command, args []string) {
+			sendCreateTxRPC(cmd, rty.NameExitAction, &rty.BeaconExit{})
+		},
+	}
+	return cmd
+}
+
+func memberCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "member",
+		Short: "query committee member",
+		Run: func(cmd *cobra.Command, args []string) {
+			addr, _ := cmd.Flags().GetString("addr")
+			sendQueryRPC(cmd, "GetMember", &rty.ReqBeaconMember{Addr: addr}, &rty.BeaconMember{})
+		},
+	}
+	cmd.Flags().StringP("addr", "a", "", "member address")
+	markRequired(cmd, "addr")
+	return cmd
+}
+
+func roundCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "round",
+		Short: "query round info, latest finalized round if round < 0",
+		Run: func(cmd *cobra.Command, args []string) {
+			round, _ := cmd.Flags().GetInt64("round")
+			sendQueryRPC(cmd, "GetRound", &rty.ReqBeaconRound{Round: round}, &rty.BeaconRound{})
+		},
+	}
+	cmd.Flags().Int64P("round", "r", -1, "round number")
+	return cmd
+}
+
+func randCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rand",
+		Short: "query random hash mixed with block hash",
+		Run: func(cmd *cobra.Command, args []string) {
+			hash, _ := cmd.Flags().GetString("hash")
+			bhash, err := common.FromHex(hash)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				return
+			}
+			sendQueryRPC(cmd, "RandNumHash", &types.ReqRandHash{ExecName: rty.RandBeaconX, Hash: bhash}, &types.ReplyHash{})
+		},
+	}
+	cmd.Flags().StringP("hash", "s", "", "block hash in hex")
+	return cmd
+}
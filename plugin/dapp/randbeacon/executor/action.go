@@ -0,0 +1,358 @@
+package executor
+
+import (
+	"bytes"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	rty "github.com/33cn/plugin/plugin/dapp/randbeacon/types"
+)
+
+// 按高度查询随机数时最多向前查找的轮次数量
+const maxLookbackRounds = 256
+
+type action struct {
+	coinsAccount *account.DB
+	db           dbm.KV
+	txHash       []byte
+	fromAddr     string
+	height       int64
+	execAddr     string
+	index        int
+}
+
+func newAction(r *randBeacon, tx *types.Transaction, index int) *action {
+	return &action{
+		coinsAccount: r.GetCoinsAccount(),
+		db:           r.GetStateDB(),
+		txHash:       tx.Hash(),
+		fromAddr:     tx.From(),
+		height:       r.GetHeight(),
+		execAddr:     dapp.ExecAddress(string(tx.Execer)),
+		index:        index,
+	}
+}
+
+// 轮次由区块高度决定，每轮先提交后揭示
+func calcRound(height int64) (round, offset int64) {
+	length := subCfg.CommitBlocks + subCfg.RevealBlocks
+	return height / length, height % length
+}
+
+func readStateDB(db dbm.KV, key []byte, msg types.Message) error {
+	val, err := db.Get(key)
+	if err != nil {
+		return err
+	}
+	return types.Decode(val, msg)
+}
+
+func getMember(db dbm.KV, addr string) (*rty.BeaconMember, error) {
+	member := &rty.BeaconMember{}
+	err := readStateDB(db, memberKey(addr), member)
+	if err == types.ErrNotFound {
+		err = rty.ErrMemberNotFound
+	}
+	return member, err
+}
+
+func getRound(db dbm.KV, round int64) (*rty.BeaconRound, error) {
+	info := &rty.BeaconRound{}
+	err := readStateDB(db, roundKey(round), info)
+	if err == types.ErrNotFound {
+		err = rty.ErrRoundNotFound
+	}
+	return info, err
+}
+
+func getLatestRound(db dbm.KV) (*rty.BeaconRound, error) {
+	latest := &types.Int64{}
+	err := readStateDB(db, latestRoundKey(), latest)
+	if err == types.ErrNotFound {
+		return nil, rty.ErrNoRandomness
+	}
+	if err != nil {
+		return nil, err
+	}
+	return getRound(db, latest.Data)
+}
+
+// getRoundAtHeight 查找在height时或之前完成的最近轮次，轮次在height之前的揭示阶段结束后才能完成，
+// 从height所在轮次的前一轮开始向前查找，最多查找maxLookbackRounds个轮次
+func getRoundAtHeight(db dbm.KV, height, latest int64) (*rty.BeaconRound, error) {
+	round, _ := calcRound(height)
+	round--
+	if round > latest {
+		round = latest
+	}
+	for i := 0; i < maxLookbackRounds && round >= 0; i, round = i+1, round-1 {
+		info, err := getRound(db, round)
+		if err == rty.ErrRoundNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if info.Status == rty.RoundStatusFinalized && info.FinalizeHeight <= height {
+			return info, nil
+		}
+	}
+	return nil, rty.ErrNoRandomness
+}
+
+func (a *action) getActiveMember() (*rty.BeaconMember, error) {
+	member, err := getMember(a.db, a.fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	if member.Status != rty.MemberStatusActive {
+		return nil, rty.ErrMemberNotFound
+	}
+	return member, nil
+}
+
+func (a *action) saveMember(receipt *types.Receipt, member *rty.BeaconMember, logTy int32) {
+	value := types.Encode(member)
+	a.db.Set(memberKey(member.Addr), value)
+	receipt.KV = append(receipt.KV, &types.KeyValue{Key: memberKey(member.Addr), Value: value})
+	if logTy > 0 {
+		receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: logTy, Log: value})
+	}
+}
+
+func (a *action) saveRound(receipt *types.Receipt, info *rty.BeaconRound, logTy int32) {
+	value := types.Encode(info)
+	a.db.Set(roundKey(info.Round), value)
+	receipt.KV = append(receipt.KV, &types.KeyValue{Key: roundKey(info.Round), Value: value})
+	receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: logTy, Log: value})
+}
+
+func mergeReceipt(receipt, sub *types.Receipt) {
+	receipt.KV = append(receipt.KV, sub.KV...)
+	receipt.Logs = append(receipt.Logs, sub.Logs...)
+}
+
+func (a *action) register(payload *rty.BeaconRegister) (*types.Receipt, error) {
+	member, err := getMember(a.db, a.fromAddr)
+	if err != nil && err != rty.ErrMemberNotFound {
+		return nil, err
+	}
+	if err == nil && member.Status == rty.MemberStatusActive {
+		return nil, rty.ErrMemberExist
+	}
+
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	if subCfg.Deposit > 0 {
+		accReceipt, err := a.coinsAccount.ExecFrozen(a.fromAddr, a.execAddr, subCfg.Deposit)
+		if err != nil {
+			elog.Error("register", "addr", a.fromAddr, "deposit", subCfg.Deposit, "ExecFrozen err", err)
+			return nil, err
+		}
+		mergeReceipt(receipt, accReceipt)
+	}
+
+	member = &rty.BeaconMember{
+		Addr:            a.fromAddr,
+		Status:          rty.MemberStatusActive,
+		Deposit:         subCfg.Deposit,
+		RegisterHeight:  a.height,
+		LastCommitRound: -1,
+	}
+	a.saveMember(receipt, member, rty.TyLogBeaconRegister)
+	return receipt, nil
+}
+
+func (a *action) commit(payload *rty.BeaconCommit) (*types.Receipt, error) {
+	if len(payload.Hash) != common.Sha256Len {
+		return nil, rty.ErrCommitmentInvalid
+	}
+	member, err := a.getActiveMember()
+	if err != nil {
+		return nil, err
+	}
+	round, offset := calcRound(a.height)
+	if payload.Round != round || offset >= subCfg.CommitBlocks {
+		elog.Error("commit", "addr", a.fromAddr, "round", payload.Round, "height", a.height, "err", rty.ErrRoundPhase)
+		return nil, rty.ErrRoundPhase
+	}
+
+	info, err := getRound(a.db, round)
+	if err == rty.ErrRoundNotFound {
+		info = &rty.BeaconRound{Round: round, Status: rty.RoundStatusOpen}
+	} else if err != nil {
+		return nil, err
+	}
+	for _, c := range info.Commitments {
+		if c.Addr == a.fromAddr {
+			return nil, rty.ErrAlreadyCommitted
+		}
+	}
+	info.Commitments = append(info.Commitments, &rty.BeaconCommitment{Addr: a.fromAddr, Hash: payload.Hash})
+	member.LastCommitRound = round
+
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	a.saveMember(receipt, member, 0)
+	a.saveRound(receipt, info, rty.TyLogBeaconCommit)
+	return receipt, nil
+}
+
+func (a *action) reveal(payload *rty.BeaconReveal) (*types.Receipt, error) {
+	round, offset := calcRound(a.height)
+	if payload.Round != round || offset < subCfg.CommitBlocks {
+		elog.Error("reveal", "addr", a.fromAddr, "round", payload.Round, "height", a.height, "err", rty.ErrRoundPhase)
+		return nil, rty.ErrRoundPhase
+	}
+	info, err := getRound(a.db, round)
+	if err != nil {
+		return nil, err
+	}
+
+	var commitment *rty.BeaconCommitment
+	for _, c := range info.Commitments {
+		if c.Addr == a.fromAddr {
+			commitment = c
+			break
+		}
+	}
+	if commitment == nil {
+		return nil, rty.ErrCommitmentInvalid
+	}
+	if len(commitment.Secret) > 0 {
+		return nil, rty.ErrAlreadyRevealed
+	}
+	if len(payload.Secret) == 0 || !bytes.Equal(common.Sha256(payload.Secret), commitment.Hash) {
+		return nil, rty.ErrRevealMismatch
+	}
+	commitment.Secret = payload.Secret
+
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	a.saveRound(receipt, info, rty.TyLogBeaconReveal)
+	return receipt, nil
+}
+
+// finalize 揭示阶段结束后任何人都可以结束轮次
+// 提交但未揭示的成员被扣除押金，平分给本轮揭示的成员，本轮无人揭示时不扣押金，只累计未揭示次数
+func (a *action) finalize(payload *rty.BeaconFinalize) (*types.Receipt, error) {
+	current, _ := calcRound(a.height)
+	if payload.Round >= current {
+		return nil, rty.ErrRoundPending
+	}
+	info, err := getRound(a.db, payload.Round)
+	if err != nil {
+		return nil, err
+	}
+	if info.Status != rty.RoundStatusOpen {
+		return nil, rty.ErrRoundFinalized
+	}
+
+	var revealers []string
+	var secrets [][]byte
+	for _, c := range info.Commitments {
+		if len(c.Secret) > 0 {
+			revealers = append(revealers, c.Addr)
+			secrets = append(secrets, c.Secret)
+		} else {
+			info.Missed = append(info.Missed, c.Addr)
+		}
+	}
+
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	var share int64
+	if len(revealers) > 0 {
+		share = subCfg.Penalty / int64(len(revealers))
+	}
+	for _, addr := range info.Missed {
+		member, err := getMember(a.db, addr)
+		if err != nil {
+			elog.Error("finalize", "round", info.Round, "missed", addr, "getMember err", err)
+			return nil, err
+		}
+		for _, revealer := range revealers {
+			if share <= 0 || member.Deposit < share {
+				break
+			}
+			accReceipt, err := a.coinsAccount.ExecTransferFrozen(addr, revealer, a.execAddr, share)
+			if err != nil {
+				elog.Error("finalize", "round", info.Round, "missed", addr, "ExecTransferFrozen err", err)
+				return nil, err
+			}
+			mergeReceipt(receipt, accReceipt)
+			member.Deposit -= share
+		}
+		member.Missed++
+		if subCfg.MaxMissed > 0 && member.Missed >= subCfg.MaxMissed && member.Status == rty.MemberStatusActive {
+			// 移出委员会，退还剩余押金
+			if member.Deposit > 0 {
+				accReceipt, err := a.coinsAccount.ExecActive(addr, a.execAddr, member.Deposit)
+				if err != nil {
+					elog.Error("finalize", "round", info.Round, "missed", addr, "ExecActive err", err)
+					return nil, err
+				}
+				mergeReceipt(receipt, accReceipt)
+			}
+			member.Deposit = 0
+			member.Status = rty.MemberStatusExit
+		}
+		a.saveMember(receipt, member, 0)
+	}
+	info.Penalty = share * int64(len(revealers))
+
+	minReveals := int(subCfg.MinReveals)
+	if minReveals < 1 {
+		minReveals = 1
+	}
+	info.FinalizeHeight = a.height
+	if len(revealers) < minReveals {
+		info.Status = rty.RoundStatusFailed
+		a.saveRound(receipt, info, rty.TyLogBeaconFinalize)
+		return receipt, nil
+	}
+
+	info.Status = rty.RoundStatusFinalized
+	info.Seed = common.Sha256(bytes.Join(secrets, nil))
+	a.saveRound(receipt, info, rty.TyLogBeaconFinalize)
+
+	// 轮次可能乱序结束，只记录更新的轮次
+	latest, err := getLatestRound(a.db)
+	if err == nil && latest.Round > info.Round {
+		return receipt, nil
+	}
+	value := types.Encode(&types.Int64{Data: info.Round})
+	a.db.Set(latestRoundKey(), value)
+	receipt.KV = append(receipt.KV, &types.KeyValue{Key: latestRoundKey(), Value: value})
+	return receipt, nil
+}
+
+func (a *action) exit(payload *rty.BeaconExit) (*types.Receipt, error) {
+	member, err := a.getActiveMember()
+	if err != nil {
+		return nil, err
+	}
+	// 最近提交的轮次结束前不能退出，保证未揭示可以被惩罚
+	if member.LastCommitRound >= 0 {
+		info, err := getRound(a.db, member.LastCommitRound)
+		if err != nil {
+			return nil, err
+		}
+		if info.Status == rty.RoundStatusOpen {
+			return nil, rty.ErrRoundPending
+		}
+	}
+
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	if member.Deposit > 0 {
+		accReceipt, err := a.coinsAccount.ExecActive(a.fromAddr, a.execAddr, member.Deposit)
+		if err != nil {
+			elog.Error("exit", "addr", a.fromAddr, "ExecActive err", err)
+			return nil, err
+		}
+		mergeReceipt(receipt, accReceipt)
+	}
+	member.Deposit = 0
+	member.Status = rty.MemberStatusExit
+	a.saveMember(receipt, member, rty.TyLogBeaconExit)
+	return receipt, nil
+}
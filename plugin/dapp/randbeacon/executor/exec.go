@@ -0,0 +1,36 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+	rty "github.com/33cn/plugin/plugin/dapp/randbeacon/types"
+)
+
+/*
+ * 实现交易的链上执行接口
+ * 关键数据上链（statedb）并生成交易回执（log）
+ */
+
+func (r *randBeacon) Exec_Register(payload *rty.BeaconRegister, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(r, tx, index)
+	return action.register(payload)
+}
+
+func (r *randBeacon) Exec_Commit(payload *rty.BeaconCommit, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(r, tx, index)
+	return action.commit(payload)
+}
+
+func (r *randBeacon) Exec_Reveal(payload *rty.BeaconReveal, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(r, tx, index)
+	return action.reveal(payload)
+}
+
+func (r *randBeacon) Exec_Finalize(payload *rty.BeaconFinalize, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(r, tx, index)
+	return action.finalize(payload)
+}
+
+func (r *randBeacon) Exec_Exit(payload *rty.BeaconExit, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(r, tx, index)
+	return action.exit(payload)
+}
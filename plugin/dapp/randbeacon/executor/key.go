@@ -0,0 +1,26 @@
+package executor
+
+import "fmt"
+
+/*
+ * 用户合约存取kv数据时，key值前缀需要满足一定规范
+ * 即key = keyPrefix + userKey
+ */
+
+var (
+	//keyPrefixStateDB state db key必须前缀
+	keyPrefixStateDB = "mavl-randbeacon-"
+)
+
+func memberKey(addr string) []byte {
+	return []byte(keyPrefixStateDB + "member-" + addr)
+}
+
+func roundKey(round int64) []byte {
+	return []byte(fmt.Sprintf("%sround-%020d", keyPrefixStateDB, round))
+}
+
+// 最近一个已生成随机数的轮次
+func latestRoundKey() []byte {
+	return []byte(keyPrefixStateDB + "latest")
+}
@@ -0,0 +1,41 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	rty "github.com/33cn/plugin/plugin/dapp/randbeacon/types"
+)
+
+// Query_GetMember 查询委员会成员
+func (r *randBeacon) Query_GetMember(in *rty.ReqBeaconMember) (types.Message, error) {
+	if in.GetAddr() == "" {
+		return nil, types.ErrInvalidParam
+	}
+	return getMember(r.GetStateDB(), in.Addr)
+}
+
+// Query_GetRound 查询轮次信息，round小于0时查询最近一个已生成随机数的轮次
+func (r *randBeacon) Query_GetRound(in *rty.ReqBeaconRound) (types.Message, error) {
+	if in.GetRound() < 0 {
+		return getLatestRound(r.GetStateDB())
+	}
+	return getRound(r.GetStateDB(), in.Round)
+}
+
+// Query_RandNumHash 兼容GetRandNum接口，请求高度时或之前完成的轮次的随机数与传入的区块哈希混合，高度为0时使用最近完成的轮次
+func (r *randBeacon) Query_RandNumHash(in *types.ReqRandHash) (types.Message, error) {
+	info, err := getLatestRound(r.GetStateDB())
+	if err != nil {
+		elog.Error("Query_RandNumHash", "err", err)
+		return nil, err
+	}
+	if in.GetHeight() > 0 && info.FinalizeHeight > in.GetHeight() {
+		info, err = getRoundAtHeight(r.GetStateDB(), in.GetHeight(), info.Round)
+		if err != nil {
+			elog.Error("Query_RandNumHash", "height", in.GetHeight(), "err", err)
+			return nil, err
+		}
+	}
+	seed := append(append([]byte{}, info.Seed...), in.GetHash()...)
+	return &types.ReplyHash{Hash: common.Sha256(seed)}, nil
+}
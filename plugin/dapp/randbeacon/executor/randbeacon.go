@@ -0,0 +1,92 @@
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	rty "github.com/33cn/plugin/plugin/dapp/randbeacon/types"
+)
+
+/*
+ * 执行器相关定义
+ * 重载基类相关接口
+ */
+
+var (
+	//日志
+	elog = log.New("module", "randbeacon.executor")
+)
+
+var driverName = rty.RandBeaconX
+
+type subConfig struct {
+	// 加入委员会需冻结的押金
+	Deposit int64 `json:"deposit"`
+	// 每轮提交阶段区块数
+	CommitBlocks int64 `json:"commitBlocks"`
+	// 每轮揭示阶段区块数
+	RevealBlocks int64 `json:"revealBlocks"`
+	// 生成随机数所需的最少揭示数量
+	MinReveals int32 `json:"minReveals"`
+	// 每次未揭示扣除的押金，分给本轮揭示的成员
+	Penalty int64 `json:"penalty"`
+	// 累计未揭示次数达到后移出委员会，0表示不移出
+	MaxMissed int32 `json:"maxMissed"`
+}
+
+var subCfg = subConfig{
+	Deposit:      100 * types.DefaultCoinPrecision,
+	CommitBlocks: 10,
+	RevealBlocks: 10,
+	MinReveals:   1,
+	Penalty:      10 * types.DefaultCoinPrecision,
+	MaxMissed:    3,
+}
+
+// Init register dapp
+func Init(name string, cfg *types.Chain33Config, sub []byte) {
+	if sub != nil {
+		types.MustDecode(sub, &subCfg)
+	}
+	if subCfg.CommitBlocks <= 0 || subCfg.RevealBlocks <= 0 || subCfg.Deposit < 0 || subCfg.Penalty < 0 {
+		panic("randbeacon sub config invalid")
+	}
+	drivers.Register(cfg, GetName(), newRandBeacon, cfg.GetDappFork(driverName, "Enable"))
+	InitExecType()
+}
+
+// InitExecType Init Exec Type
+func InitExecType() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&randBeacon{}))
+}
+
+type randBeacon struct {
+	drivers.DriverBase
+}
+
+func newRandBeacon() drivers.Driver {
+	t := &randBeacon{}
+	t.SetChild(t)
+	t.SetExecutorType(types.LoadExecutorType(driverName))
+	return t
+}
+
+// GetName get driver name
+func GetName() string {
+	return newRandBeacon().GetName()
+}
+
+func (r *randBeacon) GetDriverName() string {
+	return driverName
+}
+
+// CheckTx 交易检查在执行时进行
+func (r *randBeacon) CheckTx(tx *types.Transaction, index int) error {
+	return nil
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (r *randBeacon) CheckReceiptExecOk() bool {
+	return true
+}
@@ -0,0 +1,246 @@
+package executor
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	rty "github.com/33cn/plugin/plugin/dapp/randbeacon/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testPrivKeys = []string{
+		"0x6da92a632ab7deb67d38c0f6560bcfed28167998f6496db64c258d5e8393a81b", // 1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4
+		"0x19c069234f9d3e61135fefbeb7791b149cdf6af536f26bebb310d4cd22c3fee4", // 1JRNjdEqp4LJ5fqycUBm9ayCKSeeskgMKR
+		"0xc2b31057b8692a56c7dd18199df71c1d21b781c0b6858c52997c9dbf778e8550", // 12evczYyX9ZKPYvwSEvRkRyTjpSrJuLudg
+	}
+	testAddrs = []string{
+		"1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4",
+		"1JRNjdEqp4LJ5fqycUBm9ayCKSeeskgMKR",
+		"12evczYyX9ZKPYvwSEvRkRyTjpSrJuLudg",
+	}
+	testBalance = 1000 * types.DefaultCoinPrecision
+	initOnce    sync.Once
+)
+
+type testEnv struct {
+	cfg      *types.Chain33Config
+	exec     *randBeacon
+	stateDB  dbm.DB
+	coins    *account.DB
+	execAddr string
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	initOnce.Do(func() { Init(rty.RandBeaconX, cfg, nil) })
+
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+	stateDB, _ := dbm.NewGoMemDB("randbeacon", "test", 128)
+	_, _, localDB := util.CreateTestDB()
+
+	env := &testEnv{cfg: cfg, stateDB: stateDB, execAddr: dapp.ExecAddress(rty.RandBeaconX)}
+	env.coins = account.NewCoinsAccount(cfg)
+	env.coins.SetDB(stateDB)
+	for _, addr := range testAddrs {
+		env.coins.SaveExecAccount(env.execAddr, &types.Account{Addr: addr, Balance: testBalance})
+	}
+
+	env.exec = newRandBeacon().(*randBeacon)
+	env.exec.SetAPI(api)
+	env.exec.SetStateDB(stateDB)
+	env.exec.SetLocalDB(localDB)
+	return env
+}
+
+func (env *testEnv) execTx(t *testing.T, height int64, key int, actionName string, payload types.Message) error {
+	tx, err := types.LoadExecutorType(rty.RandBeaconX).CreateTransaction(actionName, payload)
+	require.Nil(t, err)
+	tx, err = types.FormatTx(env.cfg, rty.RandBeaconX, tx)
+	require.Nil(t, err)
+	c, err := crypto.Load(types.GetSignName(rty.RandBeaconX, types.SECP256K1), -1)
+	require.Nil(t, err)
+	privBytes, err := common.FromHex(testPrivKeys[key])
+	require.Nil(t, err)
+	priv, err := c.PrivKeyFromBytes(privBytes)
+	require.Nil(t, err)
+	tx.Sign(types.SECP256K1, priv)
+
+	env.exec.SetEnv(height, types.Now().Unix(), 1)
+	receipt, err := env.exec.Exec(tx, 0)
+	if err != nil {
+		return err
+	}
+	util.SaveKVList(env.stateDB, receipt.KV)
+	return nil
+}
+
+func TestRandBeacon(t *testing.T) {
+	env := newTestEnv(t)
+	length := subCfg.CommitBlocks + subCfg.RevealBlocks
+	secrets := [][]byte{[]byte("secret-a"), []byte("secret-b"), []byte("secret-c")}
+
+	// 没有完成的轮次时不能提供随机数
+	_, err := env.exec.Query_RandNumHash(&types.ReqRandHash{Hash: []byte("block")})
+	require.Equal(t, rty.ErrNoRandomness, err)
+
+	for i := range testAddrs {
+		require.Nil(t, env.execTx(t, 1, i, rty.NameRegisterAction, &rty.BeaconRegister{}))
+		acc := env.coins.LoadExecAccount(testAddrs[i], env.execAddr)
+		require.Equal(t, subCfg.Deposit, acc.Frozen)
+	}
+	require.Equal(t, rty.ErrMemberExist, env.execTx(t, 1, 0, rty.NameRegisterAction, &rty.BeaconRegister{}))
+
+	// 提交阶段
+	round := int64(1)
+	height := round * length
+	for i := range testAddrs {
+		require.Nil(t, env.execTx(t, height, i, rty.NameCommitAction, &rty.BeaconCommit{Round: round, Hash: common.Sha256(secrets[i])}))
+	}
+	require.Equal(t, rty.ErrAlreadyCommitted, env.execTx(t, height, 0, rty.NameCommitAction, &rty.BeaconCommit{Round: round, Hash: common.Sha256(secrets[0])}))
+	require.Equal(t, rty.ErrRoundPhase, env.execTx(t, height, 0, rty.NameRevealAction, &rty.BeaconReveal{Round: round, Secret: secrets[0]}))
+	// 提交后不能退出
+	require.Equal(t, rty.ErrRoundPending, env.execTx(t, height, 0, rty.NameExitAction, &rty.BeaconExit{}))
+
+	// 揭示阶段，第三个成员不揭示
+	height += subCfg.CommitBlocks
+	require.Equal(t, rty.ErrRoundPhase, env.execTx(t, height, 0, rty.NameCommitAction, &rty.BeaconCommit{Round: round, Hash: common.Sha256(secrets[0])}))
+	require.Equal(t, rty.ErrRevealMismatch, env.execTx(t, height, 0, rty.NameRevealAction, &rty.BeaconReveal{Round: round, Secret: secrets[1]}))
+	require.Nil(t, env.execTx(t, height, 0, rty.NameRevealAction, &rty.BeaconReveal{Round: round, Secret: secrets[0]}))
+	require.Nil(t, env.execTx(t, height, 1, rty.NameRevealAction, &rty.BeaconReveal{Round: round, Secret: secrets[1]}))
+	require.Equal(t, rty.ErrAlreadyRevealed, env.execTx(t, height, 1, rty.NameRevealAction, &rty.BeaconReveal{Round: round, Secret: secrets[1]}))
+	require.Equal(t, rty.ErrRoundPending, env.execTx(t, height, 0, rty.NameFinalizeAction, &rty.BeaconFinalize{Round: round}))
+
+	// 揭示阶段结束后结束轮次
+	height = (round + 1) * length
+	require.Nil(t, env.execTx(t, height, 0, rty.NameFinalizeAction, &rty.BeaconFinalize{Round: round}))
+	require.Equal(t, rty.ErrRoundFinalized, env.execTx(t, height, 0, rty.NameFinalizeAction, &rty.BeaconFinalize{Round: round}))
+
+	msg, err := env.exec.Query_GetRound(&rty.ReqBeaconRound{Round: -1})
+	require.Nil(t, err)
+	info := msg.(*rty.BeaconRound)
+	require.Equal(t, round, info.Round)
+	require.Equal(t, int32(rty.RoundStatusFinalized), info.Status)
+	require.Equal(t, common.Sha256(append(append([]byte{}, secrets[0]...), secrets[1]...)), info.Seed)
+	require.Equal(t, []string{testAddrs[2]}, info.Missed)
+
+	// 未揭示的押金平分给揭示的成员
+	share := subCfg.Penalty / 2
+	require.Equal(t, testBalance-subCfg.Deposit+share, env.coins.LoadExecAccount(testAddrs[0], env.execAddr).Balance)
+	require.Equal(t, subCfg.Deposit-2*share, env.coins.LoadExecAccount(testAddrs[2], env.execAddr).Frozen)
+	msg, err = env.exec.Query_GetMember(&rty.ReqBeaconMember{Addr: testAddrs[2]})
+	require.Nil(t, err)
+	require.Equal(t, int32(1), msg.(*rty.BeaconMember).Missed)
+
+	// GetRandNum兼容查询
+	msg, err = env.exec.Query_RandNumHash(&types.ReqRandHash{Hash: []byte("block")})
+	require.Nil(t, err)
+	require.Equal(t, common.Sha256(append(append([]byte{}, info.Seed...), []byte("block")...)), msg.(*types.ReplyHash).Hash)
+
+	// 轮次结束后可以退出，退还剩余押金
+	require.Nil(t, env.execTx(t, height, 2, rty.NameExitAction, &rty.BeaconExit{}))
+	acc := env.coins.LoadExecAccount(testAddrs[2], env.execAddr)
+	require.Equal(t, int64(0), acc.Frozen)
+	require.Equal(t, testBalance-2*share, acc.Balance)
+	require.Equal(t, rty.ErrMemberNotFound, env.execTx(t, height, 2, rty.NameCommitAction, &rty.BeaconCommit{Round: round + 1, Hash: common.Sha256(secrets[2])}))
+}
+
+func TestRandBeaconEvict(t *testing.T) {
+	env := newTestEnv(t)
+	length := subCfg.CommitBlocks + subCfg.RevealBlocks
+	require.Nil(t, env.execTx(t, 1, 0, rty.NameRegisterAction, &rty.BeaconRegister{}))
+	require.Nil(t, env.execTx(t, 1, 1, rty.NameRegisterAction, &rty.BeaconRegister{}))
+
+	// 成员1一直不揭示，达到次数后被移出委员会
+	for round := int64(1); round <= int64(subCfg.MaxMissed); round++ {
+		height := round * length
+		secret := []byte{byte(round)}
+		require.Nil(t, env.execTx(t, height, 0, rty.NameCommitAction, &rty.BeaconCommit{Round: round, Hash: common.Sha256(secret)}))
+		require.Nil(t, env.execTx(t, height, 1, rty.NameCommitAction, &rty.BeaconCommit{Round: round, Hash: common.Sha256(secret)}))
+		require.Nil(t, env.execTx(t, height+subCfg.CommitBlocks, 0, rty.NameRevealAction, &rty.BeaconReveal{Round: round, Secret: secret}))
+		require.Nil(t, env.execTx(t, height+length, 0, rty.NameFinalizeAction, &rty.BeaconFinalize{Round: round}))
+	}
+	msg, err := env.exec.Query_GetMember(&rty.ReqBeaconMember{Addr: testAddrs[1]})
+	require.Nil(t, err)
+	member := msg.(*rty.BeaconMember)
+	require.Equal(t, int32(rty.MemberStatusExit), member.Status)
+	penalty := int64(subCfg.MaxMissed) * subCfg.Penalty
+	acc := env.coins.LoadExecAccount(testAddrs[1], env.execAddr)
+	require.Equal(t, int64(0), acc.Frozen)
+	require.Equal(t, testBalance-penalty, acc.Balance)
+	require.Equal(t, testBalance-subCfg.Deposit+penalty, env.coins.LoadExecAccount(testAddrs[0], env.execAddr).Balance)
+
+	// 揭示数量不足时轮次失败，不更新随机数
+	round := int64(subCfg.MaxMissed) + 1
+	require.Nil(t, env.execTx(t, round*length, 0, rty.NameCommitAction, &rty.BeaconCommit{Round: round, Hash: common.Sha256([]byte("x"))}))
+	require.Nil(t, env.execTx(t, (round+1)*length, 1, rty.NameFinalizeAction, &rty.BeaconFinalize{Round: round}))
+	msg, err = env.exec.Query_GetRound(&rty.ReqBeaconRound{Round: round})
+	require.Nil(t, err)
+	require.Equal(t, int32(rty.RoundStatusFailed), msg.(*rty.BeaconRound).Status)
+	msg, err = env.exec.Query_GetRound(&rty.ReqBeaconRound{Round: -1})
+	require.Nil(t, err)
+	require.Equal(t, round-1, msg.(*rty.BeaconRound).Round)
+
+	// 按高度查询随机数，使用该高度时或之前完成的轮次
+	hashAt := func(height int64) ([]byte, error) {
+		msg, err := env.exec.Query_RandNumHash(&types.ReqRandHash{Height: height, Hash: []byte("block")})
+		if err != nil {
+			return nil, err
+		}
+		return msg.(*types.ReplyHash).Hash, nil
+	}
+	_, err = hashAt(2*length - 1)
+	require.Equal(t, rty.ErrNoRandomness, err)
+	hash, err := hashAt(2*length + 1)
+	require.Nil(t, err)
+	require.Equal(t, common.Sha256(append(common.Sha256([]byte{1}), []byte("block")...)), hash)
+	latest, err := hashAt(0)
+	require.Nil(t, err)
+	hash, err = hashAt((round + 1) * length)
+	require.Nil(t, err)
+	require.Equal(t, latest, hash)
+	require.NotEqual(t, common.Sha256(append(common.Sha256([]byte{1}), []byte("block")...)), latest)
+}
+
+func TestRandBeaconReplay(t *testing.T) {
+	env := newTestEnv(t)
+	length := subCfg.CommitBlocks + subCfg.RevealBlocks
+	require.Nil(t, env.execTx(t, 1, 0, rty.NameRegisterAction, &rty.BeaconRegister{}))
+	require.Nil(t, env.execTx(t, 1, 1, rty.NameRegisterAction, &rty.BeaconRegister{}))
+	finalize := func(round int64) {
+		height := round * length
+		secret := []byte{byte(round)}
+		require.Nil(t, env.execTx(t, height, 0, rty.NameCommitAction, &rty.BeaconCommit{Round: round, Hash: common.Sha256(secret)}))
+		require.Nil(t, env.execTx(t, height, 1, rty.NameCommitAction, &rty.BeaconCommit{Round: round, Hash: common.Sha256(secret)}))
+		require.Nil(t, env.execTx(t, height+subCfg.CommitBlocks, 0, rty.NameRevealAction, &rty.BeaconReveal{Round: round, Secret: secret}))
+		require.Nil(t, env.execTx(t, height+subCfg.CommitBlocks, 1, rty.NameRevealAction, &rty.BeaconReveal{Round: round, Secret: secret}))
+		require.Nil(t, env.execTx(t, height+length, 0, rty.NameFinalizeAction, &rty.BeaconFinalize{Round: round}))
+	}
+
+	// 调用方在区块中按上一区块高度查询
+	finalize(1)
+	req := &types.ReqRandHash{Height: 2*length + 1 - 1, Hash: []byte("block")}
+	msg, err := env.exec.Query_RandNumHash(req)
+	require.Nil(t, err)
+	hash := msg.(*types.ReplyHash).Hash
+
+	// 之后的轮次完成后重放该区块，随机数不变
+	finalize(2)
+	msg, err = env.exec.Query_RandNumHash(req)
+	require.Nil(t, err)
+	require.Equal(t, hash, msg.(*types.ReplyHash).Hash)
+	msg, err = env.exec.Query_RandNumHash(&types.ReqRandHash{Hash: []byte("block")})
+	require.Nil(t, err)
+	require.NotEqual(t, hash, msg.(*types.ReplyHash).Hash)
+}
@@ -0,0 +1,22 @@
+package randbeacon
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/plugin/plugin/dapp/randbeacon/commands"
+	"github.com/33cn/plugin/plugin/dapp/randbeacon/executor"
+	rty "github.com/33cn/plugin/plugin/dapp/randbeacon/types"
+)
+
+/*
+ * 初始化dapp相关的组件
+ */
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     rty.RandBeaconX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.Cmd,
+		RPC:      nil,
+	})
+}
@@ -0,0 +1,18 @@
+package types
+
+import "errors"
+
+// randbeacon errors
+var (
+	ErrMemberExist       = errors.New("ErrMemberExist")
+	ErrMemberNotFound    = errors.New("ErrMemberNotFound")
+	ErrRoundPhase        = errors.New("ErrRoundPhase")
+	ErrRoundNotFound     = errors.New("ErrRoundNotFound")
+	ErrRoundFinalized    = errors.New("ErrRoundFinalized")
+	ErrAlreadyCommitted  = errors.New("ErrAlreadyCommitted")
+	ErrAlreadyRevealed   = errors.New("ErrAlreadyRevealed")
+	ErrCommitmentInvalid = errors.New("ErrCommitmentInvalid")
+	ErrRevealMismatch    = errors.New("ErrRevealMismatch")
+	ErrRoundPending      = errors.New("ErrRoundPending")
+	ErrNoRandomness      = errors.New("ErrNoRandomness")
+)
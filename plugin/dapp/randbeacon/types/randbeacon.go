@@ -0,0 +1,118 @@
+package types
+
+import (
+	"reflect"
+
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+)
+
+/*
+ * 交易相关类型定义
+ * 委员会成员按轮次提交随机数哈希，之后揭示随机数，轮次结束后生成随机数
+ */
+
+// action类型id和name
+const (
+	TyUnknowAction = iota
+	TyRegisterAction
+	TyCommitAction
+	TyRevealAction
+	TyFinalizeAction
+	TyExitAction
+
+	NameRegisterAction = "Register"
+	NameCommitAction   = "Commit"
+	NameRevealAction   = "Reveal"
+	NameFinalizeAction = "Finalize"
+	NameExitAction     = "Exit"
+)
+
+// log类型id值
+const (
+	TyLogBeaconRegister = 1201
+	TyLogBeaconCommit   = 1202
+	TyLogBeaconReveal   = 1203
+	TyLogBeaconFinalize = 1204
+	TyLogBeaconExit     = 1205
+)
+
+// 成员状态
+const (
+	MemberStatusActive = 1 + iota
+	MemberStatusExit
+)
+
+// 轮次状态
+const (
+	RoundStatusOpen = 1 + iota
+	RoundStatusFinalized
+	RoundStatusFailed
+)
+
+var (
+	//RandBeaconX 执行器名称定义
+	RandBeaconX = "randbeacon"
+	//定义actionMap
+	actionMap = map[string]int32{
+		NameRegisterAction: TyRegisterAction,
+		NameCommitAction:   TyCommitAction,
+		NameRevealAction:   TyRevealAction,
+		NameFinalizeAction: TyFinalizeAction,
+		NameExitAction:     TyExitAction,
+	}
+	//定义log的id和具体log类型及名称
+	logMap = map[int64]*types.LogInfo{
+		TyLogBeaconRegister: {Ty: reflect.TypeOf(BeaconMember{}), Name: "LogBeaconRegister"},
+		TyLogBeaconCommit:   {Ty: reflect.TypeOf(BeaconRound{}), Name: "LogBeaconCommit"},
+		TyLogBeaconReveal:   {Ty: reflect.TypeOf(BeaconRound{}), Name: "LogBeaconReveal"},
+		TyLogBeaconFinalize: {Ty: reflect.TypeOf(BeaconRound{}), Name: "LogBeaconFinalize"},
+		TyLogBeaconExit:     {Ty: reflect.TypeOf(BeaconMember{}), Name: "LogBeaconExit"},
+	}
+	tlog = log.New("module", "randbeacon.types")
+)
+
+// init defines a register function
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(RandBeaconX))
+	//注册合约启用高度
+	types.RegFork(RandBeaconX, InitFork)
+	types.RegExec(RandBeaconX, InitExecutor)
+}
+
+// InitFork defines register fork
+func InitFork(cfg *types.Chain33Config) {
+	cfg.RegisterDappFork(RandBeaconX, "Enable", 0)
+}
+
+// InitExecutor defines register executor
+func InitExecutor(cfg *types.Chain33Config) {
+	types.RegistorExecutor(RandBeaconX, NewType(cfg))
+}
+
+type randBeaconType struct {
+	types.ExecTypeBase
+}
+
+// NewType new randbeacon exec type
+func NewType(cfg *types.Chain33Config) *randBeaconType {
+	c := &randBeaconType{}
+	c.SetChild(c)
+	c.SetConfig(cfg)
+	return c
+}
+
+// GetPayload 获取合约action结构
+func (r *randBeaconType) GetPayload() types.Message {
+	return &RandBeaconAction{}
+}
+
+// GetTypeMap 获取合约action的id和name信息
+func (r *randBeaconType) GetTypeMap() map[string]int32 {
+	return actionMap
+}
+
+// GetLogMap 获取合约log相关信息
+func (r *randBeaconType) GetLogMap() map[int64]*types.LogInfo {
+	return logMap
+}
@@ -17,7 +17,9 @@ func Cmd() *cobra.Command {
 		Args:  cobra.MinimumNArgs(1),
 	}
 	cmd.AddCommand(
-	//add sub command
+		PaillierKeyCmd(),
+		EncryptCmd(),
+		DecryptCmd(),
 	)
 	return cmd
 }
@@ -0,0 +1,132 @@
+package commands
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/crypto/paillier"
+	storagetypes "github.com/33cn/plugin/plugin/dapp/storage/types"
+	"github.com/spf13/cobra"
+)
+
+/*
+ * 同态加密存证(EncryptNotaryAdd)使用paillier密文，密文格式为[2字节nlen][n][ciphertext]
+ */
+
+// PaillierKeyCmd 生成paillier密钥对
+func PaillierKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "paillier_key",
+		Short: "Generate paillier key pair for homomorphic encrypt storage",
+		Run:   paillierKey,
+	}
+	cmd.Flags().IntP("bits", "b", 2048, "bit length of the modulus n")
+	return cmd
+}
+
+func paillierKey(cmd *cobra.Command, args []string) {
+	bits, _ := cmd.Flags().GetInt("bits")
+	priv, err := paillier.GenerateKey(nil, bits)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	result := map[string]string{
+		"publicKey":  hex.EncodeToString(priv.PublicKey.Bytes()),
+		"privateKey": hex.EncodeToString(priv.Bytes()),
+	}
+	data, err := json.MarshalIndent(result, "", "    ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(string(data))
+}
+
+// EncryptCmd 使用paillier公钥加密整数
+func EncryptCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "encrypt",
+		Short: "Encrypt an integer value with paillier public key",
+		Run:   encrypt,
+	}
+	cmd.Flags().StringP("pubkey", "p", "", "paillier public key (hex), or an existing ciphertext encrypted by the same key")
+	cmd.MarkFlagRequired("pubkey")
+	cmd.Flags().StringP("value", "v", "", "integer value to encrypt")
+	cmd.MarkFlagRequired("value")
+	return cmd
+}
+
+func encrypt(cmd *cobra.Command, args []string) {
+	pubKey, _ := cmd.Flags().GetString("pubkey")
+	value, _ := cmd.Flags().GetString("value")
+	m, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "value is not a valid integer")
+		return
+	}
+	ciphertext, err := paillier.EncryptHex(pubKey, m)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(ciphertext)
+}
+
+// DecryptCmd 使用paillier私钥解密密文或者链上的同态加密存证
+func DecryptCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decrypt",
+		Short: "Decrypt a paillier ciphertext or an encrypt storage record",
+		Run:   decrypt,
+	}
+	cmd.Flags().StringP("privkey", "p", "", "paillier private key (hex)")
+	cmd.MarkFlagRequired("privkey")
+	cmd.Flags().StringP("key", "k", "", "storage key or tx hash of the encrypt storage record")
+	cmd.Flags().StringP("cipher", "c", "", "ciphertext (hex), used when key is empty")
+	return cmd
+}
+
+func decrypt(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	privKey, _ := cmd.Flags().GetString("privkey")
+	key, _ := cmd.Flags().GetString("key")
+	ciphertext, _ := cmd.Flags().GetString("cipher")
+
+	if key != "" {
+		var params rpctypes.Query4Jrpc
+		params.Execer = storagetypes.StorageX
+		params.FuncName = storagetypes.FuncNameQueryStorage
+		params.Payload = types.MustPBToJSON(&storagetypes.QueryStorage{TxHash: key})
+
+		var res storagetypes.Storage
+		ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+		_, err := ctx.RunResult()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		if res.GetEncryptStorage() == nil {
+			fmt.Fprintln(os.Stderr, "the record is not an encrypt storage")
+			return
+		}
+		ciphertext = hex.EncodeToString(res.GetEncryptStorage().GetEncryptContent())
+	}
+	if ciphertext == "" {
+		fmt.Fprintln(os.Stderr, "key or cipher is required")
+		return
+	}
+
+	m, err := paillier.DecryptHex(privKey, ciphertext)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(m.String())
+}
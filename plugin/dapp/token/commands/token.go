@@ -49,6 +49,14 @@ func TokenCmd() *cobra.Command {
 		GetTokenLogsCmd(),
 		GetTokenCmd(),
 		QueryTxCmd(),
+		CreateRawTokenFreezeTxCmd(),
+		CreateRawTokenUnfreezeTxCmd(),
+		CreateRawTokenPauseTxCmd(),
+		GetTokenFreezeInfoCmd(),
+		CreateRawTokenTransferOwnershipTxCmd(),
+		CreateRawTokenAcceptOwnershipTxCmd(),
+		CreateRawTokenUpdateInfoTxCmd(),
+		GetTokenOwnershipCmd(),
 	)
 
 	return cmd
@@ -749,3 +757,199 @@ func queryTx(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
 	ctx.Run()
 }
+
+// CreateRawTokenFreezeTxCmd create raw token freeze account transaction
+func CreateRawTokenFreezeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze",
+		Short: "Create a freeze token account transaction",
+		Run:   tokenFreeze,
+	}
+	addTokenFreezeFlags(cmd)
+	return cmd
+}
+
+func addTokenFreezeFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("addr", "a", "", "account address")
+	cmd.MarkFlagRequired("addr")
+}
+
+func tokenFreeze(cmd *cobra.Command, args []string) {
+	symbol, _ := cmd.Flags().GetString("symbol")
+	addr, _ := cmd.Flags().GetString("addr")
+	createTokenTx(cmd, "TokenFreezeAccount", &tokenty.TokenFreezeAccount{Symbol: symbol, Addr: addr})
+}
+
+// CreateRawTokenUnfreezeTxCmd create raw token unfreeze account transaction
+func CreateRawTokenUnfreezeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze",
+		Short: "Create a unfreeze token account transaction",
+		Run:   tokenUnfreeze,
+	}
+	addTokenFreezeFlags(cmd)
+	return cmd
+}
+
+func tokenUnfreeze(cmd *cobra.Command, args []string) {
+	symbol, _ := cmd.Flags().GetString("symbol")
+	addr, _ := cmd.Flags().GetString("addr")
+	createTokenTx(cmd, "TokenUnfreezeAccount", &tokenty.TokenUnfreezeAccount{Symbol: symbol, Addr: addr})
+}
+
+// CreateRawTokenPauseTxCmd create raw token pause transaction
+func CreateRawTokenPauseTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause",
+		Short: "Create a pause or resume token transfer transaction",
+		Run:   tokenPause,
+	}
+	addTokenPauseFlags(cmd)
+	return cmd
+}
+
+func addTokenPauseFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().BoolP("resume", "r", false, "resume token transfer")
+}
+
+func tokenPause(cmd *cobra.Command, args []string) {
+	symbol, _ := cmd.Flags().GetString("symbol")
+	resume, _ := cmd.Flags().GetBool("resume")
+	createTokenTx(cmd, "TokenPause", &tokenty.TokenPause{Symbol: symbol, Paused: !resume})
+}
+
+func createTokenTx(cmd *cobra.Command, actionName string, params types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	pm := &rpctypes.CreateTxIn{
+		Execer:     getRealExecName(paraName, "token"),
+		ActionName: actionName,
+		Payload:    types.MustPBToJSON(params),
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", pm, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// GetTokenFreezeInfoCmd get token pause status and frozen accounts
+func GetTokenFreezeInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze_info",
+		Short: "Get token pause status and frozen accounts",
+		Run:   getTokenFreezeInfo,
+	}
+	getTokenLogsFlags(cmd)
+	return cmd
+}
+
+func getTokenFreezeInfo(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	symbol, _ := cmd.Flags().GetString("symbol")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = getRealExecName(paraName, "token")
+	params.FuncName = "GetTokenFreezeInfo"
+	params.Payload = types.MustPBToJSON(&types.ReqString{Data: symbol})
+
+	var res tokenty.ReplyTokenFreezeInfo
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// CreateRawTokenTransferOwnershipTxCmd create raw token transfer ownership transaction
+func CreateRawTokenTransferOwnershipTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer_ownership",
+		Short: "Create a transfer token ownership transaction, empty new owner to cancel",
+		Run:   tokenTransferOwnership,
+	}
+	addTokenTransferOwnershipFlags(cmd)
+	return cmd
+}
+
+func addTokenTransferOwnershipFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("owner", "o", "", "new owner address")
+}
+
+func tokenTransferOwnership(cmd *cobra.Command, args []string) {
+	symbol, _ := cmd.Flags().GetString("symbol")
+	owner, _ := cmd.Flags().GetString("owner")
+	createTokenTx(cmd, "TokenTransferOwnership", &tokenty.TokenTransferOwnership{Symbol: symbol, NewOwner: owner})
+}
+
+// CreateRawTokenAcceptOwnershipTxCmd create raw token accept ownership transaction
+func CreateRawTokenAcceptOwnershipTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept_ownership",
+		Short: "Create a accept token ownership transaction",
+		Run:   tokenAcceptOwnership,
+	}
+	getTokenLogsFlags(cmd)
+	return cmd
+}
+
+func tokenAcceptOwnership(cmd *cobra.Command, args []string) {
+	symbol, _ := cmd.Flags().GetString("symbol")
+	createTokenTx(cmd, "TokenAcceptOwnership", &tokenty.TokenAcceptOwnership{Symbol: symbol})
+}
+
+// CreateRawTokenUpdateInfoTxCmd create raw token update info transaction
+func CreateRawTokenUpdateInfoTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update_info",
+		Short: "Create a update token introduction transaction",
+		Run:   tokenUpdateInfo,
+	}
+	addTokenUpdateInfoFlags(cmd)
+	return cmd
+}
+
+func addTokenUpdateInfoFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("introduction", "i", "", "token introduction")
+	cmd.MarkFlagRequired("introduction")
+}
+
+func tokenUpdateInfo(cmd *cobra.Command, args []string) {
+	symbol, _ := cmd.Flags().GetString("symbol")
+	introduction, _ := cmd.Flags().GetString("introduction")
+	createTokenTx(cmd, "TokenUpdateInfo", &tokenty.TokenUpdateInfo{Symbol: symbol, Introduction: introduction})
+}
+
+// GetTokenOwnershipCmd get token owner and pending owner
+func GetTokenOwnershipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ownership",
+		Short: "Get token owner and pending owner",
+		Run:   getTokenOwnership,
+	}
+	getTokenLogsFlags(cmd)
+	return cmd
+}
+
+func getTokenOwnership(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	symbol, _ := cmd.Flags().GetString("symbol")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = getRealExecName(paraName, "token")
+	params.FuncName = "GetTokenOwnership"
+	params.Payload = types.MustPBToJSON(&types.ReqString{Data: symbol})
+
+	var res tokenty.ReplyTokenOwnership
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
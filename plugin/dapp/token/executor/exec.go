@@ -6,6 +6,7 @@ package executor
 
 import (
 	"github.com/33cn/chain33/account"
+	drivers "github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
 	tokenty "github.com/33cn/plugin/plugin/dapp/token/types"
 )
@@ -17,6 +18,14 @@ func (t *token) Exec_Transfer(payload *types.AssetsTransfer, tx *types.Transacti
 	if err != nil {
 		return nil, err
 	}
+	//转入合约地址时只检查转出地址
+	addrs := []string{tx.From()}
+	if !drivers.IsDriverAddress(tx.GetRealToAddr(), t.GetHeight()) {
+		addrs = append(addrs, tx.GetRealToAddr())
+	}
+	if err := t.checkTokenTransfer(token, addrs...); err != nil {
+		return nil, err
+	}
 	tokenAction := tokenty.TokenAction{
 		Ty: tokenty.ActionTransfer,
 		Value: &tokenty.TokenAction_Transfer{
@@ -33,6 +42,9 @@ func (t *token) Exec_Withdraw(payload *types.AssetsWithdraw, tx *types.Transacti
 	if err != nil {
 		return nil, err
 	}
+	if err := t.checkTokenTransfer(token, tx.From()); err != nil {
+		return nil, err
+	}
 	tokenAction := tokenty.TokenAction{
 		Ty: tokenty.ActionWithdraw,
 		Value: &tokenty.TokenAction_Withdraw{
@@ -65,6 +77,9 @@ func (t *token) Exec_TransferToExec(payload *types.AssetsTransferToExec, tx *typ
 	if err != nil {
 		return nil, err
 	}
+	if err := t.checkTokenTransfer(token, tx.From()); err != nil {
+		return nil, err
+	}
 	tokenAction := tokenty.TokenAction{
 		Ty: tokenty.TokenActionTransferToExec,
 		Value: &tokenty.TokenAction_TransferToExec{
@@ -83,3 +98,57 @@ func (t *token) Exec_TokenBurn(payload *tokenty.TokenBurn, tx *types.Transaction
 	action := newTokenAction(t, "", tx)
 	return action.burn(payload)
 }
+
+func (t *token) Exec_TokenFreezeAccount(payload *tokenty.TokenFreezeAccount, tx *types.Transaction, index int) (*types.Receipt, error) {
+	cfg := t.GetAPI().GetConfig()
+	if !cfg.IsDappFork(t.GetHeight(), t.GetDriverName(), tokenty.ForkTokenFreezeX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newTokenAction(t, "", tx)
+	return action.freezeAccount(payload.GetSymbol(), payload.GetAddr(), true)
+}
+
+func (t *token) Exec_TokenUnfreezeAccount(payload *tokenty.TokenUnfreezeAccount, tx *types.Transaction, index int) (*types.Receipt, error) {
+	cfg := t.GetAPI().GetConfig()
+	if !cfg.IsDappFork(t.GetHeight(), t.GetDriverName(), tokenty.ForkTokenFreezeX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newTokenAction(t, "", tx)
+	return action.freezeAccount(payload.GetSymbol(), payload.GetAddr(), false)
+}
+
+func (t *token) Exec_TokenPause(payload *tokenty.TokenPause, tx *types.Transaction, index int) (*types.Receipt, error) {
+	cfg := t.GetAPI().GetConfig()
+	if !cfg.IsDappFork(t.GetHeight(), t.GetDriverName(), tokenty.ForkTokenFreezeX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newTokenAction(t, "", tx)
+	return action.pause(payload)
+}
+
+func (t *token) Exec_TokenTransferOwnership(payload *tokenty.TokenTransferOwnership, tx *types.Transaction, index int) (*types.Receipt, error) {
+	cfg := t.GetAPI().GetConfig()
+	if !cfg.IsDappFork(t.GetHeight(), t.GetDriverName(), tokenty.ForkTokenOwnershipX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newTokenAction(t, "", tx)
+	return action.transferOwnership(payload)
+}
+
+func (t *token) Exec_TokenAcceptOwnership(payload *tokenty.TokenAcceptOwnership, tx *types.Transaction, index int) (*types.Receipt, error) {
+	cfg := t.GetAPI().GetConfig()
+	if !cfg.IsDappFork(t.GetHeight(), t.GetDriverName(), tokenty.ForkTokenOwnershipX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newTokenAction(t, "", tx)
+	return action.acceptOwnership(payload)
+}
+
+func (t *token) Exec_TokenUpdateInfo(payload *tokenty.TokenUpdateInfo, tx *types.Transaction, index int) (*types.Receipt, error) {
+	cfg := t.GetAPI().GetConfig()
+	if !cfg.IsDappFork(t.GetHeight(), t.GetDriverName(), tokenty.ForkTokenOwnershipX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newTokenAction(t, "", tx)
+	return action.updateInfo(payload)
+}
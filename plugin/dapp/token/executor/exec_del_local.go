@@ -187,3 +187,25 @@ func (t *token) ExecDelLocal_TokenBurn(payload *tokenty.TokenBurn, tx *types.Tra
 
 	return &types.LocalDBSet{KV: set}, nil
 }
+
+func (t *token) ExecDelLocal_TokenFreezeAccount(payload *tokenty.TokenFreezeAccount, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	key := calcTokenFreezeKeyLocal(payload.Symbol, payload.Addr)
+	return &types.LocalDBSet{KV: []*types.KeyValue{{Key: key, Value: nil}}}, nil
+}
+
+func (t *token) ExecDelLocal_TokenUnfreezeAccount(payload *tokenty.TokenUnfreezeAccount, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	key := calcTokenFreezeKeyLocal(payload.Symbol, payload.Addr)
+	return &types.LocalDBSet{KV: []*types.KeyValue{{Key: key, Value: []byte(payload.Addr)}}}, nil
+}
+
+func (t *token) ExecDelLocal_TokenTransferOwnership(payload *tokenty.TokenTransferOwnership, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalOwnership(receiptData, tx, index, true)
+}
+
+func (t *token) ExecDelLocal_TokenAcceptOwnership(payload *tokenty.TokenAcceptOwnership, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalOwnership(receiptData, tx, index, true)
+}
+
+func (t *token) ExecDelLocal_TokenUpdateInfo(payload *tokenty.TokenUpdateInfo, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalOwnership(receiptData, tx, index, true)
+}
@@ -0,0 +1,132 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+)
+
+/*
+ * token 的所有者可以冻结某个地址上的token, 或者暂停该token的所有转账
+ * 冻结和暂停只对token执行器中的 transfer, withdraw, transferToExec 生效
+ */
+
+func isTokenAccountFrozen(db dbm.KV, symbol, addr string) bool {
+	value, err := db.Get(calcTokenFreezeKey(symbol, addr))
+	if err != nil {
+		return false
+	}
+	var freeze pty.ReceiptTokenFreeze
+	if err = types.Decode(value, &freeze); err != nil {
+		return false
+	}
+	return freeze.Frozen
+}
+
+func isTokenPaused(db dbm.KV, symbol string) bool {
+	value, err := db.Get(calcTokenPauseKey(symbol))
+	if err != nil {
+		return false
+	}
+	var pause pty.ReceiptTokenPause
+	if err = types.Decode(value, &pause); err != nil {
+		return false
+	}
+	return pause.Paused
+}
+
+// checkTokenTransfer 检查token是否暂停以及相关地址是否被冻结
+func (t *token) checkTokenTransfer(symbol string, addrs ...string) error {
+	cfg := t.GetAPI().GetConfig()
+	if !cfg.IsDappFork(t.GetHeight(), pty.TokenX, pty.ForkTokenFreezeX) {
+		return nil
+	}
+	db := t.GetStateDB()
+	if isTokenPaused(db, symbol) {
+		return pty.ErrTokenPaused
+	}
+	for _, addr := range addrs {
+		if isTokenAccountFrozen(db, symbol, addr) {
+			tokenlog.Error("checkTokenTransfer", "symbol", symbol, "addr", addr, "err", pty.ErrTokenAccountFrozen)
+			return pty.ErrTokenAccountFrozen
+		}
+	}
+	return nil
+}
+
+// loadOwnerToken 加载已经创建完成的token, 并检查操作人是否为token的所有者
+func (action *tokenAction) loadOwnerToken(symbol string) (*tokenDB, error) {
+	tokendb, err := loadTokenDB(action.db, symbol)
+	if err != nil {
+		return nil, err
+	}
+	if tokendb.token.Status != pty.TokenStatusCreated {
+		return nil, pty.ErrTokenNotExist
+	}
+	if tokendb.token.Owner != action.fromaddr {
+		tokenlog.Error("loadOwnerToken", "symbol", symbol, "from", action.fromaddr, "owner", tokendb.token.Owner)
+		return nil, types.ErrNotAllow
+	}
+	return tokendb, nil
+}
+
+func (action *tokenAction) freezeAccount(symbol, addr string, frozen bool) (*types.Receipt, error) {
+	if symbol == "" {
+		return nil, types.ErrInvalidParam
+	}
+	if err := address.CheckAddress(addr, action.height); err != nil {
+		return nil, err
+	}
+	if _, err := action.loadOwnerToken(symbol); err != nil {
+		return nil, err
+	}
+	if isTokenAccountFrozen(action.db, symbol, addr) == frozen {
+		return nil, pty.ErrTokenFreezeStatus
+	}
+
+	ty := int32(pty.TyLogTokenFreezeAccount)
+	if !frozen {
+		ty = pty.TyLogTokenUnfreezeAccount
+	}
+	value := types.Encode(&pty.ReceiptTokenFreeze{Symbol: symbol, Addr: addr, Frozen: frozen})
+	kvs := []*types.KeyValue{{Key: calcTokenFreezeKey(symbol, addr), Value: value}}
+	logs := []*types.ReceiptLog{{Ty: ty, Log: value}}
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+func (action *tokenAction) pause(pause *pty.TokenPause) (*types.Receipt, error) {
+	if pause == nil || pause.GetSymbol() == "" {
+		return nil, types.ErrInvalidParam
+	}
+	if _, err := action.loadOwnerToken(pause.GetSymbol()); err != nil {
+		return nil, err
+	}
+	if isTokenPaused(action.db, pause.GetSymbol()) == pause.GetPaused() {
+		return nil, pty.ErrTokenFreezeStatus
+	}
+
+	value := types.Encode(&pty.ReceiptTokenPause{Symbol: pause.GetSymbol(), Paused: pause.GetPaused()})
+	kvs := []*types.KeyValue{{Key: calcTokenPauseKey(pause.GetSymbol()), Value: value}}
+	logs := []*types.ReceiptLog{{Ty: pty.TyLogTokenPause, Log: value}}
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+func (t *token) getTokenFreezeInfo(symbol string) (types.Message, error) {
+	if symbol == "" {
+		return nil, types.ErrInvalidParam
+	}
+	reply := &pty.ReplyTokenFreezeInfo{Symbol: symbol, Paused: isTokenPaused(t.GetStateDB(), symbol)}
+	values, err := t.GetLocalDB().List(calcTokenFreezeKeyPrefixLocal(symbol), nil, 0, 0)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	for _, value := range values {
+		reply.Addrs = append(reply.Addrs, string(value))
+	}
+	return reply, nil
+}
@@ -0,0 +1,155 @@
+package executor
+
+import (
+	"strings"
+	"testing"
+
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+//tokenTestEnv token执行器测试环境，交易依次在新的高度执行并保存statedb和localdb
+type tokenTestEnv struct {
+	t       *testing.T
+	cfg     *types.Chain33Config
+	exec    *token
+	stateDB dbm.KV
+	localDB dbm.KVDB
+	height  int64
+}
+
+//newTokenTestEnv 创建测试环境，并由Nodes[0]创建好Symbol token
+func newTokenTestEnv(t *testing.T) *tokenTestEnv {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	InitExecType()
+	tokenTotal := int64(10000 * 1e8)
+
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 100)
+	_, _, kvdb := util.CreateTestDB()
+	for key, value := range map[string]string{"mavl-manage-token-blacklist": "bty", "mavl-manage-token-finisher": string(Nodes[0])} {
+		item := &types.ConfigItem{
+			Key:   key,
+			Value: &types.ConfigItem_Arr{Arr: &types.ArrayConfig{Value: []string{value}}},
+		}
+		stateDB.Set([]byte(item.Key), types.Encode(item))
+	}
+
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+	exec := newToken().(*token)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	env := &tokenTestEnv{t: t, cfg: cfg, exec: exec, stateDB: stateDB, localDB: kvdb, height: 10}
+
+	_, _, err := env.run("TokenPreCreate", &pty.TokenPreCreate{Name: Symbol, Symbol: Symbol, Introduction: Symbol, Total: tokenTotal, Owner: string(Nodes[0])}, PrivKeyA)
+	assert.Nil(t, err)
+	_, _, err = env.run("TokenFinishCreate", &pty.TokenFinishCreate{Symbol: Symbol, Owner: string(Nodes[0])}, PrivKeyA)
+	assert.Nil(t, err)
+	return env
+}
+
+func (env *tokenTestEnv) run(action string, param types.Message, priv string) (*types.Receipt, *types.Transaction, error) {
+	tx, err := types.CallCreateTransaction(pty.TokenX, action, param)
+	assert.Nil(env.t, err)
+	switch v := param.(type) {
+	case *types.AssetsTransfer:
+		tx.To = v.To
+	case *types.AssetsTransferToExec:
+		tx.To = v.To
+	}
+	tx, err = signTx(tx, priv)
+	assert.Nil(env.t, err)
+	env.height++
+	env.exec.SetEnv(env.height, 1539918074+env.height, 0)
+	receipt, err := env.exec.Exec(tx, 1)
+	if err != nil {
+		return nil, tx, err
+	}
+	for _, kv := range receipt.KV {
+		env.stateDB.Set(kv.Key, kv.Value)
+	}
+	set, err := env.exec.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 1)
+	assert.Nil(env.t, err)
+	for _, kv := range set.KV {
+		env.localDB.Set(kv.Key, kv.Value)
+	}
+	return receipt, tx, nil
+}
+
+//rollback 回滚交易的localdb
+func (env *tokenTestEnv) rollback(tx *types.Transaction, receipt *types.Receipt) {
+	set, err := env.exec.ExecDelLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 1)
+	assert.Nil(env.t, err)
+	for _, kv := range set.KV {
+		env.localDB.Set(kv.Key, kv.Value)
+	}
+}
+
+func TestTokenFreeze(t *testing.T) {
+	env := newTokenTestEnv(t)
+	run, exec := env.run, env.exec
+
+	transfer := &types.AssetsTransfer{Cointoken: Symbol, Amount: 1e8, To: string(Nodes[1])}
+	_, _, err := run("Transfer", transfer, PrivKeyA)
+	assert.Nil(t, err)
+
+	// only owner can freeze
+	_, _, err = run("TokenFreezeAccount", &pty.TokenFreezeAccount{Symbol: Symbol, Addr: string(Nodes[1])}, PrivKeyB)
+	assert.Equal(t, types.ErrNotAllow, err)
+	receipt, freezeTx, err := run("TokenFreezeAccount", &pty.TokenFreezeAccount{Symbol: Symbol, Addr: string(Nodes[1])}, PrivKeyA)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(pty.TyLogTokenFreezeAccount), receipt.Logs[0].Ty)
+	_, _, err = run("TokenFreezeAccount", &pty.TokenFreezeAccount{Symbol: Symbol, Addr: string(Nodes[1])}, PrivKeyA)
+	assert.Equal(t, pty.ErrTokenFreezeStatus, err)
+
+	// frozen addr can neither send nor receive
+	_, _, err = run("Transfer", &types.AssetsTransfer{Cointoken: Symbol, Amount: 1e8, To: string(Nodes[2])}, PrivKeyB)
+	assert.Equal(t, pty.ErrTokenAccountFrozen, err)
+	_, _, err = run("Transfer", transfer, PrivKeyA)
+	assert.Equal(t, pty.ErrTokenAccountFrozen, err)
+	_, _, err = run("TransferToExec", &types.AssetsTransferToExec{Cointoken: Symbol, Amount: 1e8, ExecName: "trade", To: address.ExecAddress("trade")}, PrivKeyB)
+	assert.Equal(t, pty.ErrTokenAccountFrozen, err)
+
+	reply, err := exec.Query_GetTokenFreezeInfo(&types.ReqString{Data: Symbol})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{string(Nodes[1])}, reply.(*pty.ReplyTokenFreezeInfo).Addrs)
+
+	// rollback the freeze tx
+	env.rollback(freezeTx, receipt)
+	reply, err = exec.Query_GetTokenFreezeInfo(&types.ReqString{Data: Symbol})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(reply.(*pty.ReplyTokenFreezeInfo).Addrs))
+
+	_, _, err = run("TokenUnfreezeAccount", &pty.TokenUnfreezeAccount{Symbol: Symbol, Addr: string(Nodes[1])}, PrivKeyA)
+	assert.Nil(t, err)
+	_, _, err = run("Transfer", transfer, PrivKeyA)
+	assert.Nil(t, err)
+
+	// pause all transfer
+	_, _, err = run("TokenPause", &pty.TokenPause{Symbol: Symbol, Paused: true}, PrivKeyB)
+	assert.Equal(t, types.ErrNotAllow, err)
+	_, _, err = run("TokenPause", &pty.TokenPause{Symbol: Symbol, Paused: true}, PrivKeyA)
+	assert.Nil(t, err)
+	_, _, err = run("Transfer", transfer, PrivKeyA)
+	assert.Equal(t, pty.ErrTokenPaused, err)
+	reply, err = exec.Query_GetTokenFreezeInfo(&types.ReqString{Data: Symbol})
+	assert.Nil(t, err)
+	assert.True(t, reply.(*pty.ReplyTokenFreezeInfo).Paused)
+
+	_, _, err = run("TokenPause", &pty.TokenPause{Symbol: Symbol, Paused: false}, PrivKeyA)
+	assert.Nil(t, err)
+	_, _, err = run("Transfer", transfer, PrivKeyA)
+	assert.Nil(t, err)
+
+	// actions are not supported before fork
+	env.cfg.SetDappFork(pty.TokenX, pty.ForkTokenFreezeX, env.height+10)
+	_, _, err = run("TokenPause", &pty.TokenPause{Symbol: Symbol, Paused: true}, PrivKeyA)
+	assert.Equal(t, types.ErrActionNotSupport, err)
+}
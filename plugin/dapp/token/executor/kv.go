@@ -56,3 +56,26 @@ func calcTokenStatusTokenKeyPrefixLocal(status int32, token string) []byte {
 func calcAddrKey(token string, addr string) []byte {
 	return []byte(fmt.Sprintf("LODB-token-%s-Addr:%s", token, address.FormatAddrKey(addr)))
 }
+
+//冻结地址和暂停转账的状态
+func calcTokenFreezeKey(token string, addr string) []byte {
+	return []byte(fmt.Sprintf(tokenCreated+"freeze-%s-%s", token, address.FormatAddrKey(addr)))
+}
+
+func calcTokenPauseKey(token string) []byte {
+	return []byte(fmt.Sprintf(tokenCreated+"pause-%s", token))
+}
+
+//记录token下被冻结的地址，用于查询
+func calcTokenFreezeKeyLocal(token string, addr string) []byte {
+	return []byte(fmt.Sprintf("LODB-token-freeze-%s-%s", token, address.FormatAddrKey(addr)))
+}
+
+func calcTokenFreezeKeyPrefixLocal(token string) []byte {
+	return []byte(fmt.Sprintf("LODB-token-freeze-%s-", token))
+}
+
+//待接收token所有权的地址
+func calcTokenPendingOwnerKey(token string) []byte {
+	return []byte(fmt.Sprintf(tokenCreated+"pending-owner-%s", token))
+}
@@ -0,0 +1,192 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"encoding/hex"
+
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+)
+
+/*
+ * token 所有权转移分两步：当前所有者指定新的所有者，新的所有者确认接收后所有权才发生变更
+ * 所有者还可以更新token的介绍信息，相关的操作记录在token的变更历史中
+ */
+
+func getTokenOwnership(db dbm.KV, symbol string) *pty.ReceiptTokenOwnership {
+	value, err := db.Get(calcTokenPendingOwnerKey(symbol))
+	if err != nil {
+		return &pty.ReceiptTokenOwnership{Symbol: symbol}
+	}
+	var ownership pty.ReceiptTokenOwnership
+	if err = types.Decode(value, &ownership); err != nil {
+		return &pty.ReceiptTokenOwnership{Symbol: symbol}
+	}
+	return &ownership
+}
+
+// update 更新token信息, 所有者变更时删除旧所有者对应的key
+func (t *tokenDB) update(ty int32, prevOwner string, prev *pty.Token) ([]*types.KeyValue, []*types.ReceiptLog) {
+	kvs := append(t.getKVSet(calcTokenKey(t.token.Symbol)), t.getKVSet(calcTokenAddrNewKeyS(t.token.Symbol, t.token.Owner))...)
+	if prevOwner != t.token.Owner {
+		kvs = append(kvs, &types.KeyValue{Key: calcTokenAddrNewKeyS(t.token.Symbol, prevOwner), Value: nil})
+	}
+	logs := []*types.ReceiptLog{{Ty: ty, Log: types.Encode(&pty.ReceiptTokenAmount{Prev: prev, Current: &t.token})}}
+	return kvs, logs
+}
+
+func (action *tokenAction) transferOwnership(transfer *pty.TokenTransferOwnership) (*types.Receipt, error) {
+	if transfer == nil || transfer.GetSymbol() == "" {
+		return nil, types.ErrInvalidParam
+	}
+	if transfer.GetNewOwner() != "" {
+		if err := address.CheckAddress(transfer.GetNewOwner(), action.height); err != nil {
+			return nil, err
+		}
+	}
+	tokendb, err := action.loadOwnerToken(transfer.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+	if transfer.GetNewOwner() == tokendb.token.Owner {
+		return nil, types.ErrInvalidParam
+	}
+
+	value := types.Encode(&pty.ReceiptTokenOwnership{Symbol: transfer.GetSymbol(), Owner: tokendb.token.Owner, PendingOwner: transfer.GetNewOwner()})
+	kvs := []*types.KeyValue{{Key: calcTokenPendingOwnerKey(transfer.GetSymbol()), Value: value}}
+	logs := []*types.ReceiptLog{{Ty: pty.TyLogTokenTransferOwnership, Log: value}}
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+func (action *tokenAction) acceptOwnership(accept *pty.TokenAcceptOwnership) (*types.Receipt, error) {
+	if accept == nil || accept.GetSymbol() == "" {
+		return nil, types.ErrInvalidParam
+	}
+	tokendb, err := loadTokenDB(action.db, accept.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+	if tokendb.token.Status != pty.TokenStatusCreated {
+		return nil, pty.ErrTokenNotExist
+	}
+	ownership := getTokenOwnership(action.db, accept.GetSymbol())
+	if ownership.PendingOwner == "" || ownership.PendingOwner != action.fromaddr {
+		tokenlog.Error("acceptOwnership", "symbol", accept.GetSymbol(), "from", action.fromaddr, "pendingOwner", ownership.PendingOwner)
+		return nil, pty.ErrTokenNotPendingOwner
+	}
+
+	prev := types.Clone(&tokendb.token).(*pty.Token)
+	tokendb.token.Owner = action.fromaddr
+	kvs, logs := tokendb.update(pty.TyLogTokenAcceptOwnership, prev.Owner, prev)
+	value := types.Encode(&pty.ReceiptTokenOwnership{Symbol: accept.GetSymbol(), Owner: action.fromaddr})
+	kvs = append(kvs, &types.KeyValue{Key: calcTokenPendingOwnerKey(accept.GetSymbol()), Value: value})
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+func (action *tokenAction) updateInfo(update *pty.TokenUpdateInfo) (*types.Receipt, error) {
+	if update == nil || update.GetSymbol() == "" {
+		return nil, types.ErrInvalidParam
+	}
+	tokendb, err := action.loadOwnerToken(update.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+
+	prev := types.Clone(&tokendb.token).(*pty.Token)
+	tokendb.token.Introduction = update.GetIntroduction()
+	kvs, logs := tokendb.update(pty.TyLogTokenUpdateInfo, prev.Owner, prev)
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+// changeLocalToken 按照receipt中的变更前后的信息修改localdb中的token, isDel为true时回滚
+func (t *token) changeLocalToken(receipt *pty.ReceiptTokenAmount, isDel bool) ([]*types.KeyValue, error) {
+	from, to := receipt.GetPrev(), receipt.GetCurrent()
+	if isDel {
+		from, to = to, from
+	}
+	localToken, err := loadLocalToken(from.Symbol, from.Owner, pty.TokenStatusCreated, t.GetLocalDB())
+	if err != nil {
+		return nil, err
+	}
+	localToken.Owner = to.Owner
+	localToken.Introduction = to.Introduction
+	var set []*types.KeyValue
+	if from.Owner != to.Owner {
+		set = append(set, &types.KeyValue{Key: calcTokenStatusKeyLocal(from.Symbol, from.Owner, pty.TokenStatusCreated), Value: nil})
+	}
+	set = append(set, &types.KeyValue{Key: calcTokenStatusKeyLocal(to.Symbol, to.Owner, pty.TokenStatusCreated), Value: types.Encode(localToken)})
+	return set, nil
+}
+
+// addTokenHistory 在token的变更历史中增加或者删除一条记录
+func (t *token) addTokenHistory(history *pty.LocalLogs, tx *types.Transaction, index int, isDel bool) ([]*types.KeyValue, error) {
+	table := NewLogsTable(t.GetLocalDB())
+	history.TxIndex = dapp.HeightIndexStr(t.GetHeight(), int64(index))
+	history.TxHash = "0x" + hex.EncodeToString(tx.Hash())
+	var err error
+	if isDel {
+		err = table.Del([]byte(history.TxIndex))
+	} else {
+		err = table.Add(history)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return table.Save()
+}
+
+func (t *token) execLocalOwnership(receiptData *types.ReceiptData, tx *types.Transaction, index int, isDel bool) (*types.LocalDBSet, error) {
+	var set []*types.KeyValue
+	var history *pty.LocalLogs
+	for _, log := range receiptData.GetLogs() {
+		switch log.Ty {
+		case pty.TyLogTokenTransferOwnership:
+			var receipt pty.ReceiptTokenOwnership
+			if err := types.Decode(log.Log, &receipt); err != nil {
+				return nil, err
+			}
+			history = &pty.LocalLogs{Symbol: receipt.Symbol, ActionType: pty.TokenActionTransferOwnership, Owner: receipt.Owner, PendingOwner: receipt.PendingOwner}
+		case pty.TyLogTokenAcceptOwnership, pty.TyLogTokenUpdateInfo:
+			var receipt pty.ReceiptTokenAmount
+			if err := types.Decode(log.Log, &receipt); err != nil {
+				return nil, err
+			}
+			kvs, err := t.changeLocalToken(&receipt, isDel)
+			if err != nil {
+				return nil, err
+			}
+			set = append(set, kvs...)
+			history = &pty.LocalLogs{Symbol: receipt.Current.Symbol, ActionType: pty.TokenActionUpdateInfo, Owner: receipt.Current.Owner}
+			if log.Ty == pty.TyLogTokenAcceptOwnership {
+				history.ActionType = pty.TokenActionAcceptOwnership
+			}
+		}
+	}
+	if history == nil {
+		return &types.LocalDBSet{}, nil
+	}
+	kvs, err := t.addTokenHistory(history, tx, index, isDel)
+	if err != nil {
+		return nil, err
+	}
+	set = append(set, kvs...)
+	return &types.LocalDBSet{KV: set}, nil
+}
+
+func (t *token) getTokenOwnership(symbol string) (types.Message, error) {
+	if symbol == "" {
+		return nil, types.ErrInvalidParam
+	}
+	tokendb, err := loadTokenDB(t.GetStateDB(), symbol)
+	if err != nil {
+		return nil, err
+	}
+	ownership := getTokenOwnership(t.GetStateDB(), symbol)
+	return &pty.ReplyTokenOwnership{Symbol: symbol, Owner: tokendb.token.Owner, PendingOwner: ownership.PendingOwner}, nil
+}
@@ -0,0 +1,83 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenOwnership(t *testing.T) {
+	env := newTokenTestEnv(t)
+	run, exec := env.run, env.exec
+
+	// only owner can transfer ownership and update info
+	_, _, err := run("TokenTransferOwnership", &pty.TokenTransferOwnership{Symbol: Symbol, NewOwner: string(Nodes[1])}, PrivKeyB)
+	assert.Equal(t, types.ErrNotAllow, err)
+	_, _, err = run("TokenUpdateInfo", &pty.TokenUpdateInfo{Symbol: Symbol, Introduction: "new intro"}, PrivKeyB)
+	assert.Equal(t, types.ErrNotAllow, err)
+
+	_, _, err = run("TokenUpdateInfo", &pty.TokenUpdateInfo{Symbol: Symbol, Introduction: "new intro"}, PrivKeyA)
+	assert.Nil(t, err)
+	_, _, err = run("TokenTransferOwnership", &pty.TokenTransferOwnership{Symbol: Symbol, NewOwner: string(Nodes[1])}, PrivKeyA)
+	assert.Nil(t, err)
+	reply, err := exec.Query_GetTokenOwnership(&types.ReqString{Data: Symbol})
+	assert.Nil(t, err)
+	assert.Equal(t, string(Nodes[0]), reply.(*pty.ReplyTokenOwnership).Owner)
+	assert.Equal(t, string(Nodes[1]), reply.(*pty.ReplyTokenOwnership).PendingOwner)
+
+	// only pending owner can accept
+	_, _, err = run("TokenAcceptOwnership", &pty.TokenAcceptOwnership{Symbol: Symbol}, PrivKeyC)
+	assert.Equal(t, pty.ErrTokenNotPendingOwner, err)
+	receipt, acceptTx, err := run("TokenAcceptOwnership", &pty.TokenAcceptOwnership{Symbol: Symbol}, PrivKeyB)
+	assert.Nil(t, err)
+	reply, err = exec.Query_GetTokenOwnership(&types.ReqString{Data: Symbol})
+	assert.Nil(t, err)
+	assert.Equal(t, string(Nodes[1]), reply.(*pty.ReplyTokenOwnership).Owner)
+	assert.Equal(t, "", reply.(*pty.ReplyTokenOwnership).PendingOwner)
+
+	// the old owner key is deleted from statedb
+	oldKey := string(calcTokenAddrNewKeyS(Symbol, string(Nodes[0])))
+	newKey := string(calcTokenAddrNewKeyS(Symbol, string(Nodes[1])))
+	var deleted, saved bool
+	for _, kv := range receipt.KV {
+		switch string(kv.Key) {
+		case oldKey:
+			deleted = kv.Value == nil
+		case newKey:
+			saved = kv.Value != nil
+		}
+	}
+	assert.True(t, deleted)
+	assert.True(t, saved)
+
+	info, err := exec.Query_GetTokenInfo(&types.ReqString{Data: Symbol})
+	assert.Nil(t, err)
+	assert.Equal(t, string(Nodes[1]), info.(*pty.LocalToken).Owner)
+	assert.Equal(t, "new intro", info.(*pty.LocalToken).Introduction)
+
+	history, err := exec.Query_GetTokenHistory(&types.ReqString{Data: Symbol})
+	assert.Nil(t, err)
+	logs := history.(*pty.ReplyTokenLogs).Logs
+	assert.Equal(t, 4, len(logs))
+	assert.Equal(t, int32(pty.TokenActionAcceptOwnership), logs[0].ActionType)
+	assert.Equal(t, int32(pty.TokenActionTransferOwnership), logs[1].ActionType)
+	assert.Equal(t, string(Nodes[1]), logs[1].PendingOwner)
+	assert.Equal(t, int32(pty.TokenActionUpdateInfo), logs[2].ActionType)
+
+	// rollback the accept tx
+	env.rollback(acceptTx, receipt)
+	info, err = exec.Query_GetTokenInfo(&types.ReqString{Data: Symbol})
+	assert.Nil(t, err)
+	assert.Equal(t, string(Nodes[0]), info.(*pty.LocalToken).Owner)
+	history, err = exec.Query_GetTokenHistory(&types.ReqString{Data: Symbol})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(history.(*pty.ReplyTokenLogs).Logs))
+
+	// old owner lost the permission, new owner got it
+	_, _, err = run("TokenUpdateInfo", &pty.TokenUpdateInfo{Symbol: Symbol, Introduction: "old owner"}, PrivKeyA)
+	assert.Equal(t, types.ErrNotAllow, err)
+	_, _, err = run("TokenPause", &pty.TokenPause{Symbol: Symbol, Paused: true}, PrivKeyB)
+	assert.Nil(t, err)
+}
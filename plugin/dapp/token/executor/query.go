@@ -91,3 +91,19 @@ func (t *token) Query_GetTokenHistory(in *types.ReqString) (types.Message, error
 	}
 	return &replys, nil
 }
+
+// Query_GetTokenFreezeInfo 获取token的暂停状态和被冻结的地址
+func (t *token) Query_GetTokenFreezeInfo(in *types.ReqString) (types.Message, error) {
+	if in == nil {
+		return nil, types.ErrInvalidParam
+	}
+	return t.getTokenFreezeInfo(in.GetData())
+}
+
+// Query_GetTokenOwnership 获取token当前的所有者和待接收所有权的地址
+func (t *token) Query_GetTokenOwnership(in *types.ReqString) (types.Message, error) {
+	if in == nil {
+		return nil, types.ErrInvalidParam
+	}
+	return t.getTokenOwnership(in.GetData())
+}
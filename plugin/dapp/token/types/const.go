@@ -23,6 +23,18 @@ const (
 	TokenActionMint = 12
 	// TokenActionBurn for token burn
 	TokenActionBurn = 13
+	// TokenActionFreezeAccount for token freeze account
+	TokenActionFreezeAccount = 14
+	// TokenActionUnfreezeAccount for token unfreeze account
+	TokenActionUnfreezeAccount = 15
+	// TokenActionPause for token pause or resume transfer
+	TokenActionPause = 16
+	// TokenActionTransferOwnership for token transfer ownership
+	TokenActionTransferOwnership = 17
+	// TokenActionAcceptOwnership for token accept ownership
+	TokenActionAcceptOwnership = 18
+	// TokenActionUpdateInfo for token update info
+	TokenActionUpdateInfo = 19
 )
 
 // token status
@@ -51,6 +63,10 @@ var (
 	ForkTokenCheckX = "ForkTokenCheck"
 	//ForkTokenEvm  token asset can be transfer by evm
 	ForkTokenEvm = "ForkTokenEvm"
+	//ForkTokenFreezeX token owner can freeze address and pause transfer
+	ForkTokenFreezeX = "ForkTokenFreeze"
+	//ForkTokenOwnershipX token owner can transfer ownership and update info
+	ForkTokenOwnershipX = "ForkTokenOwnership"
 )
 
 const (
@@ -84,6 +100,18 @@ const (
 	TyLogTokenMint = 323
 	// TyLogTokenBurn log for token burn
 	TyLogTokenBurn = 324
+	// TyLogTokenFreezeAccount log for token freeze account
+	TyLogTokenFreezeAccount = 325
+	// TyLogTokenUnfreezeAccount log for token unfreeze account
+	TyLogTokenUnfreezeAccount = 326
+	// TyLogTokenPause log for token pause or resume transfer
+	TyLogTokenPause = 327
+	// TyLogTokenTransferOwnership log for token transfer ownership
+	TyLogTokenTransferOwnership = 328
+	// TyLogTokenAcceptOwnership log for token accept ownership
+	TyLogTokenAcceptOwnership = 329
+	// TyLogTokenUpdateInfo log for token update info
+	TyLogTokenUpdateInfo = 330
 )
 
 const (
@@ -37,4 +37,12 @@ var (
 	ErrTokenNotExist = errors.New("ErrTokenSymbolNotExist")
 	// ErrTokenBlacklistNotInit error token hasn't init blacklist
 	ErrTokenBlacklistNotInit = errors.New("ErrTokenBlacklistNotInit")
+	// ErrTokenAccountFrozen error token account frozen by owner
+	ErrTokenAccountFrozen = errors.New("ErrTokenAccountFrozen")
+	// ErrTokenPaused error token transfer paused by owner
+	ErrTokenPaused = errors.New("ErrTokenPaused")
+	// ErrTokenFreezeStatus error token account freeze status not changed
+	ErrTokenFreezeStatus = errors.New("ErrTokenFreezeStatusNotChanged")
+	// ErrTokenNotPendingOwner error token ownership not transferred to this address
+	ErrTokenNotPendingOwner = errors.New("ErrTokenNotPendingOwner")
 )
@@ -42,6 +42,12 @@ type TokenAction struct {
 	//	*TokenAction_TransferToExec
 	//	*TokenAction_TokenMint
 	//	*TokenAction_TokenBurn
+	//	*TokenAction_TokenFreezeAccount
+	//	*TokenAction_TokenUnfreezeAccount
+	//	*TokenAction_TokenPause
+	//	*TokenAction_TokenTransferOwnership
+	//	*TokenAction_TokenAcceptOwnership
+	//	*TokenAction_TokenUpdateInfo
 	Value isTokenAction_Value `protobuf_oneof:"value"`
 	Ty    int32               `protobuf:"varint,7,opt,name=Ty,proto3" json:"Ty,omitempty"`
 }
@@ -148,6 +154,48 @@ func (x *TokenAction) GetTokenBurn() *TokenBurn {
 	return nil
 }
 
+func (x *TokenAction) GetTokenFreezeAccount() *TokenFreezeAccount {
+	if x, ok := x.GetValue().(*TokenAction_TokenFreezeAccount); ok {
+		return x.TokenFreezeAccount
+	}
+	return nil
+}
+
+func (x *TokenAction) GetTokenUnfreezeAccount() *TokenUnfreezeAccount {
+	if x, ok := x.GetValue().(*TokenAction_TokenUnfreezeAccount); ok {
+		return x.TokenUnfreezeAccount
+	}
+	return nil
+}
+
+func (x *TokenAction) GetTokenPause() *TokenPause {
+	if x, ok := x.GetValue().(*TokenAction_TokenPause); ok {
+		return x.TokenPause
+	}
+	return nil
+}
+
+func (x *TokenAction) GetTokenTransferOwnership() *TokenTransferOwnership {
+	if x, ok := x.GetValue().(*TokenAction_TokenTransferOwnership); ok {
+		return x.TokenTransferOwnership
+	}
+	return nil
+}
+
+func (x *TokenAction) GetTokenAcceptOwnership() *TokenAcceptOwnership {
+	if x, ok := x.GetValue().(*TokenAction_TokenAcceptOwnership); ok {
+		return x.TokenAcceptOwnership
+	}
+	return nil
+}
+
+func (x *TokenAction) GetTokenUpdateInfo() *TokenUpdateInfo {
+	if x, ok := x.GetValue().(*TokenAction_TokenUpdateInfo); ok {
+		return x.TokenUpdateInfo
+	}
+	return nil
+}
+
 func (x *TokenAction) GetTy() int32 {
 	if x != nil {
 		return x.Ty
@@ -195,6 +243,30 @@ type TokenAction_TokenBurn struct {
 	TokenBurn *TokenBurn `protobuf:"bytes,10,opt,name=tokenBurn,proto3,oneof"`
 }
 
+type TokenAction_TokenFreezeAccount struct {
+	TokenFreezeAccount *TokenFreezeAccount `protobuf:"bytes,11,opt,name=tokenFreezeAccount,proto3,oneof"`
+}
+
+type TokenAction_TokenUnfreezeAccount struct {
+	TokenUnfreezeAccount *TokenUnfreezeAccount `protobuf:"bytes,12,opt,name=tokenUnfreezeAccount,proto3,oneof"`
+}
+
+type TokenAction_TokenPause struct {
+	TokenPause *TokenPause `protobuf:"bytes,13,opt,name=tokenPause,proto3,oneof"`
+}
+
+type TokenAction_TokenTransferOwnership struct {
+	TokenTransferOwnership *TokenTransferOwnership `protobuf:"bytes,14,opt,name=tokenTransferOwnership,proto3,oneof"`
+}
+
+type TokenAction_TokenAcceptOwnership struct {
+	TokenAcceptOwnership *TokenAcceptOwnership `protobuf:"bytes,15,opt,name=tokenAcceptOwnership,proto3,oneof"`
+}
+
+type TokenAction_TokenUpdateInfo struct {
+	TokenUpdateInfo *TokenUpdateInfo `protobuf:"bytes,16,opt,name=tokenUpdateInfo,proto3,oneof"`
+}
+
 func (*TokenAction_TokenPreCreate) isTokenAction_Value() {}
 
 func (*TokenAction_TokenFinishCreate) isTokenAction_Value() {}
@@ -213,6 +285,18 @@ func (*TokenAction_TokenMint) isTokenAction_Value() {}
 
 func (*TokenAction_TokenBurn) isTokenAction_Value() {}
 
+func (*TokenAction_TokenFreezeAccount) isTokenAction_Value() {}
+
+func (*TokenAction_TokenUnfreezeAccount) isTokenAction_Value() {}
+
+func (*TokenAction_TokenPause) isTokenAction_Value() {}
+
+func (*TokenAction_TokenTransferOwnership) isTokenAction_Value() {}
+
+func (*TokenAction_TokenAcceptOwnership) isTokenAction_Value() {}
+
+func (*TokenAction_TokenUpdateInfo) isTokenAction_Value() {}
+
 //创建token，支持最大精确度是8位小数,即存入数据库的实际总额需要放大1e8倍
 type TokenPreCreate struct {
 	state         protoimpl.MessageState
@@ -529,6 +613,333 @@ func (x *TokenBurn) GetAmount() int64 {
 	return 0
 }
 
+// 冻结地址上的token，冻结后该地址不能转出和转入
+type TokenFreezeAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Addr   string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+}
+
+func (x *TokenFreezeAccount) Reset() {
+	*x = TokenFreezeAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_token_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenFreezeAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenFreezeAccount) ProtoMessage() {}
+
+func (x *TokenFreezeAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_token_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenFreezeAccount.ProtoReflect.Descriptor instead.
+func (*TokenFreezeAccount) Descriptor() ([]byte, []int) {
+	return file_token_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TokenFreezeAccount) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *TokenFreezeAccount) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+type TokenUnfreezeAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Addr   string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+}
+
+func (x *TokenUnfreezeAccount) Reset() {
+	*x = TokenUnfreezeAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_token_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenUnfreezeAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenUnfreezeAccount) ProtoMessage() {}
+
+func (x *TokenUnfreezeAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_token_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenUnfreezeAccount.ProtoReflect.Descriptor instead.
+func (*TokenUnfreezeAccount) Descriptor() ([]byte, []int) {
+	return file_token_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TokenUnfreezeAccount) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *TokenUnfreezeAccount) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+// 暂停或者恢复token的所有转账
+type TokenPause struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Paused bool   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (x *TokenPause) Reset() {
+	*x = TokenPause{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_token_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenPause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenPause) ProtoMessage() {}
+
+func (x *TokenPause) ProtoReflect() protoreflect.Message {
+	mi := &file_token_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenPause.ProtoReflect.Descriptor instead.
+func (*TokenPause) Descriptor() ([]byte, []int) {
+	return file_token_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TokenPause) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *TokenPause) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+// 转移token所有权的第一步，由当前所有者指定新的所有者，newOwner为空时取消转移
+type TokenTransferOwnership struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol   string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	NewOwner string `protobuf:"bytes,2,opt,name=newOwner,proto3" json:"newOwner,omitempty"`
+}
+
+func (x *TokenTransferOwnership) Reset() {
+	*x = TokenTransferOwnership{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_token_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenTransferOwnership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenTransferOwnership) ProtoMessage() {}
+
+func (x *TokenTransferOwnership) ProtoReflect() protoreflect.Message {
+	mi := &file_token_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenTransferOwnership.ProtoReflect.Descriptor instead.
+func (*TokenTransferOwnership) Descriptor() ([]byte, []int) {
+	return file_token_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TokenTransferOwnership) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *TokenTransferOwnership) GetNewOwner() string {
+	if x != nil {
+		return x.NewOwner
+	}
+	return ""
+}
+
+// 转移token所有权的第二步，由新的所有者确认接收
+type TokenAcceptOwnership struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+}
+
+func (x *TokenAcceptOwnership) Reset() {
+	*x = TokenAcceptOwnership{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_token_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenAcceptOwnership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenAcceptOwnership) ProtoMessage() {}
+
+func (x *TokenAcceptOwnership) ProtoReflect() protoreflect.Message {
+	mi := &file_token_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenAcceptOwnership.ProtoReflect.Descriptor instead.
+func (*TokenAcceptOwnership) Descriptor() ([]byte, []int) {
+	return file_token_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TokenAcceptOwnership) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+// 更新token的介绍信息
+type TokenUpdateInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol       string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Introduction string `protobuf:"bytes,2,opt,name=introduction,proto3" json:"introduction,omitempty"`
+}
+
+func (x *TokenUpdateInfo) Reset() {
+	*x = TokenUpdateInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_token_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenUpdateInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenUpdateInfo) ProtoMessage() {}
+
+func (x *TokenUpdateInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_token_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenUpdateInfo.ProtoReflect.Descriptor instead.
+func (*TokenUpdateInfo) Descriptor() ([]byte, []int) {
+	return file_token_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TokenUpdateInfo) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *TokenUpdateInfo) GetIntroduction() string {
+	if x != nil {
+		return x.Introduction
+	}
+	return ""
+}
+
 // state db
 type Token struct {
 	state         protoimpl.MessageState
@@ -549,7 +960,7 @@ type Token struct {
 func (x *Token) Reset() {
 	*x = Token{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_token_proto_msgTypes[6]
+		mi := &file_token_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -562,7 +973,7 @@ func (x *Token) String() string {
 func (*Token) ProtoMessage() {}
 
 func (x *Token) ProtoReflect() protoreflect.Message {
-	mi := &file_token_proto_msgTypes[6]
+	mi := &file_token_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -575,7 +986,7 @@ func (x *Token) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Token.ProtoReflect.Descriptor instead.
 func (*Token) Descriptor() ([]byte, []int) {
-	return file_token_proto_rawDescGZIP(), []int{6}
+	return file_token_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Token) GetName() string {
@@ -655,7 +1066,7 @@ type ReceiptToken struct {
 func (x *ReceiptToken) Reset() {
 	*x = ReceiptToken{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_token_proto_msgTypes[7]
+		mi := &file_token_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -668,7 +1079,7 @@ func (x *ReceiptToken) String() string {
 func (*ReceiptToken) ProtoMessage() {}
 
 func (x *ReceiptToken) ProtoReflect() protoreflect.Message {
-	mi := &file_token_proto_msgTypes[7]
+	mi := &file_token_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -681,7 +1092,7 @@ func (x *ReceiptToken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptToken.ProtoReflect.Descriptor instead.
 func (*ReceiptToken) Descriptor() ([]byte, []int) {
-	return file_token_proto_rawDescGZIP(), []int{7}
+	return file_token_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ReceiptToken) GetSymbol() string {
@@ -717,7 +1128,7 @@ type ReceiptTokenAmount struct {
 func (x *ReceiptTokenAmount) Reset() {
 	*x = ReceiptTokenAmount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_token_proto_msgTypes[8]
+		mi := &file_token_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -730,7 +1141,7 @@ func (x *ReceiptTokenAmount) String() string {
 func (*ReceiptTokenAmount) ProtoMessage() {}
 
 func (x *ReceiptTokenAmount) ProtoReflect() protoreflect.Message {
-	mi := &file_token_proto_msgTypes[8]
+	mi := &file_token_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -743,7 +1154,7 @@ func (x *ReceiptTokenAmount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptTokenAmount.ProtoReflect.Descriptor instead.
 func (*ReceiptTokenAmount) Descriptor() ([]byte, []int) {
-	return file_token_proto_rawDescGZIP(), []int{8}
+	return file_token_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ReceiptTokenAmount) GetPrev() *Token {
@@ -760,6 +1171,187 @@ func (x *ReceiptTokenAmount) GetCurrent() *Token {
 	return nil
 }
 
+type ReceiptTokenFreeze struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Addr   string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Frozen bool   `protobuf:"varint,3,opt,name=frozen,proto3" json:"frozen,omitempty"`
+}
+
+func (x *ReceiptTokenFreeze) Reset() {
+	*x = ReceiptTokenFreeze{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_token_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptTokenFreeze) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptTokenFreeze) ProtoMessage() {}
+
+func (x *ReceiptTokenFreeze) ProtoReflect() protoreflect.Message {
+	mi := &file_token_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptTokenFreeze.ProtoReflect.Descriptor instead.
+func (*ReceiptTokenFreeze) Descriptor() ([]byte, []int) {
+	return file_token_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReceiptTokenFreeze) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ReceiptTokenFreeze) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ReceiptTokenFreeze) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+type ReceiptTokenPause struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Paused bool   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (x *ReceiptTokenPause) Reset() {
+	*x = ReceiptTokenPause{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_token_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptTokenPause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptTokenPause) ProtoMessage() {}
+
+func (x *ReceiptTokenPause) ProtoReflect() protoreflect.Message {
+	mi := &file_token_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptTokenPause.ProtoReflect.Descriptor instead.
+func (*ReceiptTokenPause) Descriptor() ([]byte, []int) {
+	return file_token_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReceiptTokenPause) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ReceiptTokenPause) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type ReceiptTokenOwnership struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol       string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Owner        string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	PendingOwner string `protobuf:"bytes,3,opt,name=pendingOwner,proto3" json:"pendingOwner,omitempty"`
+}
+
+func (x *ReceiptTokenOwnership) Reset() {
+	*x = ReceiptTokenOwnership{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_token_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptTokenOwnership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptTokenOwnership) ProtoMessage() {}
+
+func (x *ReceiptTokenOwnership) ProtoReflect() protoreflect.Message {
+	mi := &file_token_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptTokenOwnership.ProtoReflect.Descriptor instead.
+func (*ReceiptTokenOwnership) Descriptor() ([]byte, []int) {
+	return file_token_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReceiptTokenOwnership) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ReceiptTokenOwnership) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ReceiptTokenOwnership) GetPendingOwner() string {
+	if x != nil {
+		return x.PendingOwner
+	}
+	return ""
+}
+
 // local
 type LocalToken struct {
 	state         protoimpl.MessageState
@@ -789,7 +1381,7 @@ type LocalToken struct {
 func (x *LocalToken) Reset() {
 	*x = LocalToken{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_token_proto_msgTypes[9]
+		mi := &file_token_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -802,7 +1394,7 @@ func (x *LocalToken) String() string {
 func (*LocalToken) ProtoMessage() {}
 
 func (x *LocalToken) ProtoReflect() protoreflect.Message {
-	mi := &file_token_proto_msgTypes[9]
+	mi := &file_token_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -815,7 +1407,7 @@ func (x *LocalToken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocalToken.ProtoReflect.Descriptor instead.
 func (*LocalToken) Descriptor() ([]byte, []int) {
-	return file_token_proto_rawDescGZIP(), []int{9}
+	return file_token_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *LocalToken) GetName() string {
@@ -946,12 +1538,15 @@ type LocalLogs struct {
 	TxIndex    string `protobuf:"bytes,2,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
 	ActionType int32  `protobuf:"varint,3,opt,name=actionType,proto3" json:"actionType,omitempty"`
 	TxHash     string `protobuf:"bytes,4,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	// 所有权转移相关的操作记录交易执行后的所有者和待接收的所有者
+	Owner        string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	PendingOwner string `protobuf:"bytes,6,opt,name=pendingOwner,proto3" json:"pendingOwner,omitempty"`
 }
 
 func (x *LocalLogs) Reset() {
 	*x = LocalLogs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_token_proto_msgTypes[10]
+		mi := &file_token_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -964,7 +1559,7 @@ func (x *LocalLogs) String() string {
 func (*LocalLogs) ProtoMessage() {}
 
 func (x *LocalLogs) ProtoReflect() protoreflect.Message {
-	mi := &file_token_proto_msgTypes[10]
+	mi := &file_token_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -977,7 +1572,7 @@ func (x *LocalLogs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocalLogs.ProtoReflect.Descriptor instead.
 func (*LocalLogs) Descriptor() ([]byte, []int) {
-	return file_token_proto_rawDescGZIP(), []int{10}
+	return file_token_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *LocalLogs) GetSymbol() string {
@@ -1008,6 +1603,20 @@ func (x *LocalLogs) GetTxHash() string {
 	return ""
 }
 
+func (x *LocalLogs) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *LocalLogs) GetPendingOwner() string {
+	if x != nil {
+		return x.PendingOwner
+	}
+	return ""
+}
+
 // query
 type ReqTokens struct {
 	state         protoimpl.MessageState
@@ -1023,7 +1632,7 @@ type ReqTokens struct {
 func (x *ReqTokens) Reset() {
 	*x = ReqTokens{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_token_proto_msgTypes[11]
+		mi := &file_token_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1036,7 +1645,7 @@ func (x *ReqTokens) String() string {
 func (*ReqTokens) ProtoMessage() {}
 
 func (x *ReqTokens) ProtoReflect() protoreflect.Message {
-	mi := &file_token_proto_msgTypes[11]
+	mi := &file_token_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1049,7 +1658,7 @@ func (x *ReqTokens) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqTokens.ProtoReflect.Descriptor instead.
 func (*ReqTokens) Descriptor() ([]byte, []int) {
-	return file_token_proto_rawDescGZIP(), []int{11}
+	return file_token_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ReqTokens) GetQueryAll() bool {
@@ -1091,7 +1700,7 @@ type ReplyTokens struct {
 func (x *ReplyTokens) Reset() {
 	*x = ReplyTokens{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_token_proto_msgTypes[12]
+		mi := &file_token_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1104,7 +1713,7 @@ func (x *ReplyTokens) String() string {
 func (*ReplyTokens) ProtoMessage() {}
 
 func (x *ReplyTokens) ProtoReflect() protoreflect.Message {
-	mi := &file_token_proto_msgTypes[12]
+	mi := &file_token_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1117,7 +1726,7 @@ func (x *ReplyTokens) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyTokens.ProtoReflect.Descriptor instead.
 func (*ReplyTokens) Descriptor() ([]byte, []int) {
-	return file_token_proto_rawDescGZIP(), []int{12}
+	return file_token_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ReplyTokens) GetTokens() []*LocalToken {
@@ -1139,7 +1748,7 @@ type TokenRecv struct {
 func (x *TokenRecv) Reset() {
 	*x = TokenRecv{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_token_proto_msgTypes[13]
+		mi := &file_token_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1152,7 +1761,7 @@ func (x *TokenRecv) String() string {
 func (*TokenRecv) ProtoMessage() {}
 
 func (x *TokenRecv) ProtoReflect() protoreflect.Message {
-	mi := &file_token_proto_msgTypes[13]
+	mi := &file_token_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1165,7 +1774,7 @@ func (x *TokenRecv) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenRecv.ProtoReflect.Descriptor instead.
 func (*TokenRecv) Descriptor() ([]byte, []int) {
-	return file_token_proto_rawDescGZIP(), []int{13}
+	return file_token_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *TokenRecv) GetToken() string {
@@ -1193,7 +1802,7 @@ type ReplyAddrRecvForTokens struct {
 func (x *ReplyAddrRecvForTokens) Reset() {
 	*x = ReplyAddrRecvForTokens{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_token_proto_msgTypes[14]
+		mi := &file_token_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1206,7 +1815,7 @@ func (x *ReplyAddrRecvForTokens) String() string {
 func (*ReplyAddrRecvForTokens) ProtoMessage() {}
 
 func (x *ReplyAddrRecvForTokens) ProtoReflect() protoreflect.Message {
-	mi := &file_token_proto_msgTypes[14]
+	mi := &file_token_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1219,7 +1828,7 @@ func (x *ReplyAddrRecvForTokens) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyAddrRecvForTokens.ProtoReflect.Descriptor instead.
 func (*ReplyAddrRecvForTokens) Descriptor() ([]byte, []int) {
-	return file_token_proto_rawDescGZIP(), []int{14}
+	return file_token_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ReplyAddrRecvForTokens) GetTokenRecvs() []*TokenRecv {
@@ -1242,7 +1851,7 @@ type ReqTokenBalance struct {
 func (x *ReqTokenBalance) Reset() {
 	*x = ReqTokenBalance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_token_proto_msgTypes[15]
+		mi := &file_token_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1255,7 +1864,7 @@ func (x *ReqTokenBalance) String() string {
 func (*ReqTokenBalance) ProtoMessage() {}
 
 func (x *ReqTokenBalance) ProtoReflect() protoreflect.Message {
-	mi := &file_token_proto_msgTypes[15]
+	mi := &file_token_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1268,7 +1877,7 @@ func (x *ReqTokenBalance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqTokenBalance.ProtoReflect.Descriptor instead.
 func (*ReqTokenBalance) Descriptor() ([]byte, []int) {
-	return file_token_proto_rawDescGZIP(), []int{15}
+	return file_token_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ReqTokenBalance) GetAddresses() []string {
@@ -1304,7 +1913,7 @@ type ReqAccountTokenAssets struct {
 func (x *ReqAccountTokenAssets) Reset() {
 	*x = ReqAccountTokenAssets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_token_proto_msgTypes[16]
+		mi := &file_token_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1317,7 +1926,7 @@ func (x *ReqAccountTokenAssets) String() string {
 func (*ReqAccountTokenAssets) ProtoMessage() {}
 
 func (x *ReqAccountTokenAssets) ProtoReflect() protoreflect.Message {
-	mi := &file_token_proto_msgTypes[16]
+	mi := &file_token_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1330,7 +1939,7 @@ func (x *ReqAccountTokenAssets) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqAccountTokenAssets.ProtoReflect.Descriptor instead.
 func (*ReqAccountTokenAssets) Descriptor() ([]byte, []int) {
-	return file_token_proto_rawDescGZIP(), []int{16}
+	return file_token_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ReqAccountTokenAssets) GetAddress() string {
@@ -1359,7 +1968,7 @@ type TokenAsset struct {
 func (x *TokenAsset) Reset() {
 	*x = TokenAsset{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_token_proto_msgTypes[17]
+		mi := &file_token_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1372,7 +1981,7 @@ func (x *TokenAsset) String() string {
 func (*TokenAsset) ProtoMessage() {}
 
 func (x *TokenAsset) ProtoReflect() protoreflect.Message {
-	mi := &file_token_proto_msgTypes[17]
+	mi := &file_token_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1385,7 +1994,7 @@ func (x *TokenAsset) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenAsset.ProtoReflect.Descriptor instead.
 func (*TokenAsset) Descriptor() ([]byte, []int) {
-	return file_token_proto_rawDescGZIP(), []int{17}
+	return file_token_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *TokenAsset) GetSymbol() string {
@@ -1413,7 +2022,7 @@ type ReplyAccountTokenAssets struct {
 func (x *ReplyAccountTokenAssets) Reset() {
 	*x = ReplyAccountTokenAssets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_token_proto_msgTypes[18]
+		mi := &file_token_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1426,7 +2035,7 @@ func (x *ReplyAccountTokenAssets) String() string {
 func (*ReplyAccountTokenAssets) ProtoMessage() {}
 
 func (x *ReplyAccountTokenAssets) ProtoReflect() protoreflect.Message {
-	mi := &file_token_proto_msgTypes[18]
+	mi := &file_token_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1439,7 +2048,7 @@ func (x *ReplyAccountTokenAssets) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyAccountTokenAssets.ProtoReflect.Descriptor instead.
 func (*ReplyAccountTokenAssets) Descriptor() ([]byte, []int) {
-	return file_token_proto_rawDescGZIP(), []int{18}
+	return file_token_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ReplyAccountTokenAssets) GetTokenAssets() []*TokenAsset {
@@ -1465,7 +2074,7 @@ type ReqAddrTokens struct {
 func (x *ReqAddrTokens) Reset() {
 	*x = ReqAddrTokens{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_token_proto_msgTypes[19]
+		mi := &file_token_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1478,7 +2087,7 @@ func (x *ReqAddrTokens) String() string {
 func (*ReqAddrTokens) ProtoMessage() {}
 
 func (x *ReqAddrTokens) ProtoReflect() protoreflect.Message {
-	mi := &file_token_proto_msgTypes[19]
+	mi := &file_token_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1491,7 +2100,7 @@ func (x *ReqAddrTokens) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqAddrTokens.ProtoReflect.Descriptor instead.
 func (*ReqAddrTokens) Descriptor() ([]byte, []int) {
-	return file_token_proto_rawDescGZIP(), []int{19}
+	return file_token_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ReqAddrTokens) GetAddr() string {
@@ -1554,7 +2163,7 @@ type ReqTokenTx struct {
 func (x *ReqTokenTx) Reset() {
 	*x = ReqTokenTx{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_token_proto_msgTypes[20]
+		mi := &file_token_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1567,7 +2176,7 @@ func (x *ReqTokenTx) String() string {
 func (*ReqTokenTx) ProtoMessage() {}
 
 func (x *ReqTokenTx) ProtoReflect() protoreflect.Message {
-	mi := &file_token_proto_msgTypes[20]
+	mi := &file_token_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1580,7 +2189,7 @@ func (x *ReqTokenTx) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqTokenTx.ProtoReflect.Descriptor instead.
 func (*ReqTokenTx) Descriptor() ([]byte, []int) {
-	return file_token_proto_rawDescGZIP(), []int{20}
+	return file_token_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ReqTokenTx) GetSymbol() string {
@@ -1643,7 +2252,7 @@ type ReplyTokenLogs struct {
 func (x *ReplyTokenLogs) Reset() {
 	*x = ReplyTokenLogs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_token_proto_msgTypes[21]
+		mi := &file_token_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1656,7 +2265,7 @@ func (x *ReplyTokenLogs) String() string {
 func (*ReplyTokenLogs) ProtoMessage() {}
 
 func (x *ReplyTokenLogs) ProtoReflect() protoreflect.Message {
-	mi := &file_token_proto_msgTypes[21]
+	mi := &file_token_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1669,7 +2278,7 @@ func (x *ReplyTokenLogs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyTokenLogs.ProtoReflect.Descriptor instead.
 func (*ReplyTokenLogs) Descriptor() ([]byte, []int) {
-	return file_token_proto_rawDescGZIP(), []int{21}
+	return file_token_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ReplyTokenLogs) GetLogs() []*LocalLogs {
@@ -1679,13 +2288,139 @@ func (x *ReplyTokenLogs) GetLogs() []*LocalLogs {
 	return nil
 }
 
+type ReplyTokenFreezeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Paused bool     `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	Addrs  []string `protobuf:"bytes,3,rep,name=addrs,proto3" json:"addrs,omitempty"`
+}
+
+func (x *ReplyTokenFreezeInfo) Reset() {
+	*x = ReplyTokenFreezeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_token_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplyTokenFreezeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyTokenFreezeInfo) ProtoMessage() {}
+
+func (x *ReplyTokenFreezeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_token_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyTokenFreezeInfo.ProtoReflect.Descriptor instead.
+func (*ReplyTokenFreezeInfo) Descriptor() ([]byte, []int) {
+	return file_token_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ReplyTokenFreezeInfo) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ReplyTokenFreezeInfo) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *ReplyTokenFreezeInfo) GetAddrs() []string {
+	if x != nil {
+		return x.Addrs
+	}
+	return nil
+}
+
+type ReplyTokenOwnership struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol       string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Owner        string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	PendingOwner string `protobuf:"bytes,3,opt,name=pendingOwner,proto3" json:"pendingOwner,omitempty"`
+}
+
+func (x *ReplyTokenOwnership) Reset() {
+	*x = ReplyTokenOwnership{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_token_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplyTokenOwnership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyTokenOwnership) ProtoMessage() {}
+
+func (x *ReplyTokenOwnership) ProtoReflect() protoreflect.Message {
+	mi := &file_token_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyTokenOwnership.ProtoReflect.Descriptor instead.
+func (*ReplyTokenOwnership) Descriptor() ([]byte, []int) {
+	return file_token_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReplyTokenOwnership) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ReplyTokenOwnership) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ReplyTokenOwnership) GetPendingOwner() string {
+	if x != nil {
+		return x.PendingOwner
+	}
+	return ""
+}
+
 var File_token_proto protoreflect.FileDescriptor
 
 var file_token_proto_rawDesc = []byte{
 	0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74,
 	0x79, 0x70, 0x65, 0x73, 0x1a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
 	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc2, 0x04, 0x0a, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x87, 0x08, 0x0a, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
 	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x50,
 	0x72, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
 	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x65, 0x43,
@@ -1720,62 +2455,132 @@ var file_token_proto_rawDesc = []byte{
 	0x12, 0x30, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x75, 0x72, 0x6e, 0x18, 0x0a, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
 	0x6e, 0x42, 0x75, 0x72, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x75,
-	0x72, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x54, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x54, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x0e,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e,
-	0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x41, 0x0a, 0x11,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22,
-	0x41, 0x0a, 0x11, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x22, 0x3b, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x69, 0x6e, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x3b, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x75, 0x72, 0x6e, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe7, 0x01, 0x0a,
-	0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x54, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
-	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x72, 0x6e, 0x12, 0x4b, 0x0a, 0x12, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x12, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x51, 0x0a, 0x14, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x6e, 0x66, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x14, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x33, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x16, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x48, 0x00, 0x52, 0x16, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x12, 0x51, 0x0a, 0x14, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x48, 0x00, 0x52, 0x14, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x12, 0x42, 0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x54, 0x79, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x54, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0xbe, 0x01, 0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x65, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12,
+	0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x22, 0x41, 0x0a, 0x11, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x14,
 	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5e, 0x0a, 0x12,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x04,
-	0x70, 0x72, 0x65, 0x76, 0x12, 0x26, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0xaa, 0x04, 0x0a,
+	0x77, 0x6e, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x11, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x3b, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x4d, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3b, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x75, 0x72,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x40, 0x0a, 0x12, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61,
+	0x64, 0x64, 0x72, 0x22, 0x42, 0x0a, 0x14, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x6e, 0x66, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x22, 0x3c, 0x0a, 0x0a, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0x4c, 0x0a, 0x16, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x22, 0x2e, 0x0a, 0x14, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x22, 0x4d, 0x0a, 0x0f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x22,
+	0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xe7, 0x01, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x54, 0x0a, 0x0c,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x5e, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x76,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x04, 0x70, 0x72, 0x65, 0x76, 0x12, 0x26, 0x0a, 0x07, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x22, 0x58, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x22, 0x43, 0x0a, 0x11,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x64, 0x22, 0x69, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0xaa, 0x04, 0x0a,
 	0x0a, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
 	0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
@@ -1810,86 +2615,102 @@ var file_token_proto_rawDesc = []byte{
 	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03,
 	0x52, 0x0b, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
 	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x75, 0x0a, 0x09, 0x4c, 0x6f, 0x63,
-	0x61, 0x6c, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x18,
-	0x0a, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61,
-	0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68,
-	0x22, 0x77, 0x0a, 0x09, 0x52, 0x65, 0x71, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x1a, 0x0a,
-	0x08, 0x71, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x71, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x79, 0x6d,
-	0x62, 0x6f, 0x6c, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x38, 0x0a, 0x0b, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x73, 0x22, 0x35, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x63, 0x76,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x63, 0x76, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x65, 0x63, 0x76, 0x22, 0x4a, 0x0a, 0x16, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x63, 0x76, 0x46, 0x6f, 0x72, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x63,
-	0x76, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x63, 0x76, 0x52, 0x0a, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x52, 0x65, 0x63, 0x76, 0x73, 0x22, 0x69, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x65,
-	0x63, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65,
-	0x72, 0x22, 0x49, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65, 0x72, 0x22, 0x4e, 0x0a, 0x0a,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0xaf, 0x01, 0x0a, 0x09, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x77, 0x0a, 0x09, 0x52,
+	0x65, 0x71, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4f, 0x6e,
+	0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x38, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x35,
+	0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x63, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x63, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x72, 0x65, 0x63, 0x76, 0x22, 0x4a, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x41, 0x64,
+	0x64, 0x72, 0x52, 0x65, 0x63, 0x76, 0x46, 0x6f, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12,
+	0x30, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x63, 0x76, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x63, 0x76, 0x52, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x63, 0x76,
+	0x73, 0x22, 0x69, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65, 0x72, 0x22, 0x49, 0x0a, 0x15,
+	0x52, 0x65, 0x71, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x65, 0x78, 0x65, 0x63, 0x65, 0x72, 0x22, 0x4e, 0x0a, 0x0a, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x28, 0x0a,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4e, 0x0a, 0x17, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x73, 0x12, 0x33, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x41,
+	0x64, 0x64, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x4b, 0x65, 0x79, 0x22, 0xae, 0x01, 0x0a, 0x0a, 0x52, 0x65,
+	0x71, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x66, 0x6c, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x22, 0x36, 0x0a, 0x0e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x24, 0x0a, 0x04,
+	0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x04, 0x6c, 0x6f,
+	0x67, 0x73, 0x22, 0x5c, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79,
 	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x12, 0x28, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4e, 0x0a, 0x17,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
-	0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x22, 0x9f, 0x01, 0x0a,
-	0x0d, 0x52, 0x65, 0x71, 0x41, 0x64, 0x64, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64,
-	0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x4b, 0x65, 0x79, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x4b, 0x65, 0x79, 0x22, 0xae,
-	0x01, 0x0a, 0x0a, 0x52, 0x65, 0x71, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x78, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
-	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x61,
-	0x64, 0x64, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x22,
-	0x36, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x6f, 0x67,
-	0x73, 0x12, 0x24, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x6f, 0x67,
-	0x73, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x32, 0x45, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x3c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x0f, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x42, 0x0a,
-	0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64,
+	0x64, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73,
+	0x22, 0x67, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x32, 0x45, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x3c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x71, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x0f, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x00,
+	0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1904,7 +2725,7 @@ func file_token_proto_rawDescGZIP() []byte {
 	return file_token_proto_rawDescData
 }
 
-var file_token_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_token_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_token_proto_goTypes = []interface{}{
 	(*TokenAction)(nil),                // 0: types.TokenAction
 	(*TokenPreCreate)(nil),             // 1: types.TokenPreCreate
@@ -1912,53 +2733,70 @@ var file_token_proto_goTypes = []interface{}{
 	(*TokenRevokeCreate)(nil),          // 3: types.TokenRevokeCreate
 	(*TokenMint)(nil),                  // 4: types.TokenMint
 	(*TokenBurn)(nil),                  // 5: types.TokenBurn
-	(*Token)(nil),                      // 6: types.Token
-	(*ReceiptToken)(nil),               // 7: types.ReceiptToken
-	(*ReceiptTokenAmount)(nil),         // 8: types.ReceiptTokenAmount
-	(*LocalToken)(nil),                 // 9: types.LocalToken
-	(*LocalLogs)(nil),                  // 10: types.LocalLogs
-	(*ReqTokens)(nil),                  // 11: types.ReqTokens
-	(*ReplyTokens)(nil),                // 12: types.ReplyTokens
-	(*TokenRecv)(nil),                  // 13: types.TokenRecv
-	(*ReplyAddrRecvForTokens)(nil),     // 14: types.ReplyAddrRecvForTokens
-	(*ReqTokenBalance)(nil),            // 15: types.ReqTokenBalance
-	(*ReqAccountTokenAssets)(nil),      // 16: types.ReqAccountTokenAssets
-	(*TokenAsset)(nil),                 // 17: types.TokenAsset
-	(*ReplyAccountTokenAssets)(nil),    // 18: types.ReplyAccountTokenAssets
-	(*ReqAddrTokens)(nil),              // 19: types.ReqAddrTokens
-	(*ReqTokenTx)(nil),                 // 20: types.ReqTokenTx
-	(*ReplyTokenLogs)(nil),             // 21: types.ReplyTokenLogs
-	(*types.AssetsTransfer)(nil),       // 22: types.AssetsTransfer
-	(*types.AssetsWithdraw)(nil),       // 23: types.AssetsWithdraw
-	(*types.AssetsGenesis)(nil),        // 24: types.AssetsGenesis
-	(*types.AssetsTransferToExec)(nil), // 25: types.AssetsTransferToExec
-	(*types.Account)(nil),              // 26: types.Account
-	(*types.Accounts)(nil),             // 27: types.Accounts
+	(*TokenFreezeAccount)(nil),         // 6: types.TokenFreezeAccount
+	(*TokenUnfreezeAccount)(nil),       // 7: types.TokenUnfreezeAccount
+	(*TokenPause)(nil),                 // 8: types.TokenPause
+	(*TokenTransferOwnership)(nil),     // 9: types.TokenTransferOwnership
+	(*TokenAcceptOwnership)(nil),       // 10: types.TokenAcceptOwnership
+	(*TokenUpdateInfo)(nil),            // 11: types.TokenUpdateInfo
+	(*Token)(nil),                      // 12: types.Token
+	(*ReceiptToken)(nil),               // 13: types.ReceiptToken
+	(*ReceiptTokenAmount)(nil),         // 14: types.ReceiptTokenAmount
+	(*ReceiptTokenFreeze)(nil),         // 15: types.ReceiptTokenFreeze
+	(*ReceiptTokenPause)(nil),          // 16: types.ReceiptTokenPause
+	(*ReceiptTokenOwnership)(nil),      // 17: types.ReceiptTokenOwnership
+	(*LocalToken)(nil),                 // 18: types.LocalToken
+	(*LocalLogs)(nil),                  // 19: types.LocalLogs
+	(*ReqTokens)(nil),                  // 20: types.ReqTokens
+	(*ReplyTokens)(nil),                // 21: types.ReplyTokens
+	(*TokenRecv)(nil),                  // 22: types.TokenRecv
+	(*ReplyAddrRecvForTokens)(nil),     // 23: types.ReplyAddrRecvForTokens
+	(*ReqTokenBalance)(nil),            // 24: types.ReqTokenBalance
+	(*ReqAccountTokenAssets)(nil),      // 25: types.ReqAccountTokenAssets
+	(*TokenAsset)(nil),                 // 26: types.TokenAsset
+	(*ReplyAccountTokenAssets)(nil),    // 27: types.ReplyAccountTokenAssets
+	(*ReqAddrTokens)(nil),              // 28: types.ReqAddrTokens
+	(*ReqTokenTx)(nil),                 // 29: types.ReqTokenTx
+	(*ReplyTokenLogs)(nil),             // 30: types.ReplyTokenLogs
+	(*ReplyTokenFreezeInfo)(nil),       // 31: types.ReplyTokenFreezeInfo
+	(*ReplyTokenOwnership)(nil),        // 32: types.ReplyTokenOwnership
+	(*types.AssetsTransfer)(nil),       // 33: types.AssetsTransfer
+	(*types.AssetsWithdraw)(nil),       // 34: types.AssetsWithdraw
+	(*types.AssetsGenesis)(nil),        // 35: types.AssetsGenesis
+	(*types.AssetsTransferToExec)(nil), // 36: types.AssetsTransferToExec
+	(*types.Account)(nil),              // 37: types.Account
+	(*types.Accounts)(nil),             // 38: types.Accounts
 }
 var file_token_proto_depIdxs = []int32{
 	1,  // 0: types.TokenAction.tokenPreCreate:type_name -> types.TokenPreCreate
 	2,  // 1: types.TokenAction.tokenFinishCreate:type_name -> types.TokenFinishCreate
 	3,  // 2: types.TokenAction.tokenRevokeCreate:type_name -> types.TokenRevokeCreate
-	22, // 3: types.TokenAction.transfer:type_name -> types.AssetsTransfer
-	23, // 4: types.TokenAction.withdraw:type_name -> types.AssetsWithdraw
-	24, // 5: types.TokenAction.genesis:type_name -> types.AssetsGenesis
-	25, // 6: types.TokenAction.transferToExec:type_name -> types.AssetsTransferToExec
+	33, // 3: types.TokenAction.transfer:type_name -> types.AssetsTransfer
+	34, // 4: types.TokenAction.withdraw:type_name -> types.AssetsWithdraw
+	35, // 5: types.TokenAction.genesis:type_name -> types.AssetsGenesis
+	36, // 6: types.TokenAction.transferToExec:type_name -> types.AssetsTransferToExec
 	4,  // 7: types.TokenAction.tokenMint:type_name -> types.TokenMint
 	5,  // 8: types.TokenAction.tokenBurn:type_name -> types.TokenBurn
-	6,  // 9: types.ReceiptTokenAmount.prev:type_name -> types.Token
-	6,  // 10: types.ReceiptTokenAmount.current:type_name -> types.Token
-	9,  // 11: types.ReplyTokens.tokens:type_name -> types.LocalToken
-	13, // 12: types.ReplyAddrRecvForTokens.tokenRecvs:type_name -> types.TokenRecv
-	26, // 13: types.TokenAsset.account:type_name -> types.Account
-	17, // 14: types.ReplyAccountTokenAssets.tokenAssets:type_name -> types.TokenAsset
-	10, // 15: types.ReplyTokenLogs.logs:type_name -> types.LocalLogs
-	15, // 16: types.token.GetTokenBalance:input_type -> types.ReqTokenBalance
-	27, // 17: types.token.GetTokenBalance:output_type -> types.Accounts
-	17, // [17:18] is the sub-list for method output_type
-	16, // [16:17] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	6,  // 9: types.TokenAction.tokenFreezeAccount:type_name -> types.TokenFreezeAccount
+	7,  // 10: types.TokenAction.tokenUnfreezeAccount:type_name -> types.TokenUnfreezeAccount
+	8,  // 11: types.TokenAction.tokenPause:type_name -> types.TokenPause
+	9,  // 12: types.TokenAction.tokenTransferOwnership:type_name -> types.TokenTransferOwnership
+	10, // 13: types.TokenAction.tokenAcceptOwnership:type_name -> types.TokenAcceptOwnership
+	11, // 14: types.TokenAction.tokenUpdateInfo:type_name -> types.TokenUpdateInfo
+	12, // 15: types.ReceiptTokenAmount.prev:type_name -> types.Token
+	12, // 16: types.ReceiptTokenAmount.current:type_name -> types.Token
+	18, // 17: types.ReplyTokens.tokens:type_name -> types.LocalToken
+	22, // 18: types.ReplyAddrRecvForTokens.tokenRecvs:type_name -> types.TokenRecv
+	37, // 19: types.TokenAsset.account:type_name -> types.Account
+	26, // 20: types.ReplyAccountTokenAssets.tokenAssets:type_name -> types.TokenAsset
+	19, // 21: types.ReplyTokenLogs.logs:type_name -> types.LocalLogs
+	24, // 22: types.token.GetTokenBalance:input_type -> types.ReqTokenBalance
+	38, // 23: types.token.GetTokenBalance:output_type -> types.Accounts
+	23, // [23:24] is the sub-list for method output_type
+	22, // [22:23] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_token_proto_init() }
@@ -2040,7 +2878,7 @@ func file_token_proto_init() {
 			}
 		}
 		file_token_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Token); i {
+			switch v := v.(*TokenFreezeAccount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2052,7 +2890,7 @@ func file_token_proto_init() {
 			}
 		}
 		file_token_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptToken); i {
+			switch v := v.(*TokenUnfreezeAccount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2064,7 +2902,7 @@ func file_token_proto_init() {
 			}
 		}
 		file_token_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptTokenAmount); i {
+			switch v := v.(*TokenPause); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2076,7 +2914,7 @@ func file_token_proto_init() {
 			}
 		}
 		file_token_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocalToken); i {
+			switch v := v.(*TokenTransferOwnership); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2088,7 +2926,7 @@ func file_token_proto_init() {
 			}
 		}
 		file_token_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocalLogs); i {
+			switch v := v.(*TokenAcceptOwnership); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2100,7 +2938,7 @@ func file_token_proto_init() {
 			}
 		}
 		file_token_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqTokens); i {
+			switch v := v.(*TokenUpdateInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2112,7 +2950,7 @@ func file_token_proto_init() {
 			}
 		}
 		file_token_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyTokens); i {
+			switch v := v.(*Token); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2124,7 +2962,7 @@ func file_token_proto_init() {
 			}
 		}
 		file_token_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenRecv); i {
+			switch v := v.(*ReceiptToken); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2136,7 +2974,7 @@ func file_token_proto_init() {
 			}
 		}
 		file_token_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyAddrRecvForTokens); i {
+			switch v := v.(*ReceiptTokenAmount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2148,7 +2986,7 @@ func file_token_proto_init() {
 			}
 		}
 		file_token_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqTokenBalance); i {
+			switch v := v.(*ReceiptTokenFreeze); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2160,7 +2998,7 @@ func file_token_proto_init() {
 			}
 		}
 		file_token_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqAccountTokenAssets); i {
+			switch v := v.(*ReceiptTokenPause); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2172,7 +3010,7 @@ func file_token_proto_init() {
 			}
 		}
 		file_token_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenAsset); i {
+			switch v := v.(*ReceiptTokenOwnership); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2184,7 +3022,7 @@ func file_token_proto_init() {
 			}
 		}
 		file_token_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyAccountTokenAssets); i {
+			switch v := v.(*LocalToken); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2196,7 +3034,7 @@ func file_token_proto_init() {
 			}
 		}
 		file_token_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqAddrTokens); i {
+			switch v := v.(*LocalLogs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2208,7 +3046,7 @@ func file_token_proto_init() {
 			}
 		}
 		file_token_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqTokenTx); i {
+			switch v := v.(*ReqTokens); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2220,6 +3058,114 @@ func file_token_proto_init() {
 			}
 		}
 		file_token_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyTokens); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_token_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenRecv); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_token_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyAddrRecvForTokens); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_token_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqTokenBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_token_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqAccountTokenAssets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_token_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenAsset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_token_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyAccountTokenAssets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_token_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqAddrTokens); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_token_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqTokenTx); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_token_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReplyTokenLogs); i {
 			case 0:
 				return &v.state
@@ -2231,6 +3177,30 @@ func file_token_proto_init() {
 				return nil
 			}
 		}
+		file_token_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyTokenFreezeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_token_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyTokenOwnership); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_token_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*TokenAction_TokenPreCreate)(nil),
@@ -2242,6 +3212,12 @@ func file_token_proto_init() {
 		(*TokenAction_TransferToExec)(nil),
 		(*TokenAction_TokenMint)(nil),
 		(*TokenAction_TokenBurn)(nil),
+		(*TokenAction_TokenFreezeAccount)(nil),
+		(*TokenAction_TokenUnfreezeAccount)(nil),
+		(*TokenAction_TokenPause)(nil),
+		(*TokenAction_TokenTransferOwnership)(nil),
+		(*TokenAction_TokenAcceptOwnership)(nil),
+		(*TokenAction_TokenUpdateInfo)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2249,7 +3225,7 @@ func file_token_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_token_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
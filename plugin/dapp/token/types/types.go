@@ -30,6 +30,8 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(TokenX, ForkTokenSymbolWithNumberX, 0)
 	cfg.RegisterDappFork(TokenX, ForkTokenCheckX, 0)
 	cfg.RegisterDappFork(TokenX, ForkTokenEvm, 0)
+	cfg.RegisterDappFork(TokenX, ForkTokenFreezeX, 0)
+	cfg.RegisterDappFork(TokenX, ForkTokenOwnershipX, 0)
 }
 
 //InitExecutor ...
@@ -72,6 +74,14 @@ func (t *TokenType) GetTypeMap() map[string]int32 {
 		"TransferToExec":    TokenActionTransferToExec,
 		"TokenMint":         TokenActionMint,
 		"TokenBurn":         TokenActionBurn,
+
+		"TokenFreezeAccount":   TokenActionFreezeAccount,
+		"TokenUnfreezeAccount": TokenActionUnfreezeAccount,
+		"TokenPause":           TokenActionPause,
+
+		"TokenTransferOwnership": TokenActionTransferOwnership,
+		"TokenAcceptOwnership":   TokenActionAcceptOwnership,
+		"TokenUpdateInfo":        TokenActionUpdateInfo,
 	}
 }
 
@@ -92,6 +102,13 @@ func (t *TokenType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogRevokeCreateToken:    {Ty: reflect.TypeOf(ReceiptToken{}), Name: "LogRevokeCreateToken"},
 		TyLogTokenMint:            {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogMintToken"},
 		TyLogTokenBurn:            {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogBurnToken"},
+		TyLogTokenFreezeAccount:   {Ty: reflect.TypeOf(ReceiptTokenFreeze{}), Name: "LogTokenFreezeAccount"},
+		TyLogTokenUnfreezeAccount: {Ty: reflect.TypeOf(ReceiptTokenFreeze{}), Name: "LogTokenUnfreezeAccount"},
+		TyLogTokenPause:           {Ty: reflect.TypeOf(ReceiptTokenPause{}), Name: "LogTokenPause"},
+
+		TyLogTokenTransferOwnership: {Ty: reflect.TypeOf(ReceiptTokenOwnership{}), Name: "LogTokenTransferOwnership"},
+		TyLogTokenAcceptOwnership:   {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogTokenAcceptOwnership"},
+		TyLogTokenUpdateInfo:        {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogTokenUpdateInfo"},
 	}
 }
 
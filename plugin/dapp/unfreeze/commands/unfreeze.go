@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
@@ -36,6 +37,7 @@ func Cmd() *cobra.Command {
 	cmd.AddCommand(terminateCmd())
 	cmd.AddCommand(showCmd())
 	cmd.AddCommand(queryWithdrawCmd())
+	cmd.AddCommand(queryScheduleCmd())
 	cmd.AddCommand(listUnfreezeCmd())
 	return cmd
 }
@@ -48,6 +50,8 @@ func createCmd() *cobra.Command {
 
 	cmd.AddCommand(fixAmountCmd())
 	cmd.AddCommand(leftCmd())
+	cmd.AddCommand(cliffLinearCmd())
+	cmd.AddCommand(tranchesCmd())
 	return cmd
 }
 
@@ -225,6 +229,134 @@ func left(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+func cliffLinearCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cliff_linear",
+		Short: "create cliff then linear means unfreeze construct",
+		Run:   cliffLinear,
+	}
+	cmd = createFlag(cmd)
+	cmd.Flags().Int64P("cliff", "c", 0, "cliff in second, nothing unfreeze before cliff")
+	cmd.MarkFlagRequired("cliff")
+
+	cmd.Flags().Int64P("period", "p", 0, "period in second")
+	cmd.MarkFlagRequired("period")
+
+	cmd.Flags().Int64P("duration", "d", 0, "duration in second until all unfreeze")
+	cmd.MarkFlagRequired("duration")
+	return cmd
+}
+
+func cliffLinear(cmd *cobra.Command, args []string) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "GetChainConfig"))
+		return
+	}
+
+	create, err := getCreateFlags(cmd, cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	cliff, _ := cmd.Flags().GetInt64("cliff")
+	period, _ := cmd.Flags().GetInt64("period")
+	duration, _ := cmd.Flags().GetInt64("duration")
+	if period <= 0 || duration <= 0 || cliff < 0 {
+		fmt.Fprintf(os.Stderr, "period and duration must be positive integer")
+		return
+	}
+	if cliff > duration || period > duration {
+		fmt.Fprintf(os.Stderr, "cliff and period must not bigger than duration")
+		return
+	}
+
+	create.Means = pty.CliffLinearX
+	create.MeansOpt = &pty.UnfreezeCreate_CliffLinear{
+		CliffLinear: &pty.CliffLinear{Cliff: cliff, Period: period, Duration: duration}}
+
+	params := &rpctypes.CreateTxIn{
+		Execer:     types.GetExecName(pty.UnfreezeX, paraName),
+		ActionName: pty.Action_CreateUnfreeze,
+		Payload:    types.MustPBToJSON(create),
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+func tranchesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tranches",
+		Short: "create custom schedule means unfreeze construct",
+		Run:   tranches,
+	}
+	cmd = createFlag(cmd)
+	cmd.Flags().StringP("tranches", "r", "", "unfreeze schedule, UTC timestamp:amount separated by comma, as 1600000000:100,1700000000:200")
+	cmd.MarkFlagRequired("tranches")
+	return cmd
+}
+
+func tranches(cmd *cobra.Command, args []string) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "GetChainConfig"))
+		return
+	}
+
+	create, err := getCreateFlags(cmd, cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	schedule, _ := cmd.Flags().GetString("tranches")
+	opt := &pty.Tranches{}
+	for _, item := range strings.Split(schedule, ",") {
+		kv := strings.Split(item, ":")
+		if len(kv) != 2 {
+			fmt.Fprintln(os.Stderr, "tranche must be timestamp:amount", item)
+			return
+		}
+		ts, err := strconv.ParseInt(kv[0], 10, 64)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, errors.Wrapf(err, "tranche time"))
+			return
+		}
+		amount, err := strconv.ParseFloat(kv[1], 64)
+		if err == nil {
+			err = checkAmount(amount)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, errors.Wrapf(err, "tranche amount"))
+			return
+		}
+		amountInt64, err := types.FormatFloatDisplay2Value(amount, cfg.CoinPrecision)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, errors.Wrapf(err, "FormatFloatDisplay2Value.amount"))
+			return
+		}
+		opt.Tranches = append(opt.Tranches, &pty.Tranche{Time: ts, Amount: amountInt64})
+	}
+
+	create.Means = pty.TranchesX
+	create.MeansOpt = &pty.UnfreezeCreate_Tranches{Tranches: opt}
+
+	params := &rpctypes.CreateTxIn{
+		Execer:     types.GetExecName(pty.UnfreezeX, paraName),
+		ActionName: pty.Action_CreateUnfreeze,
+		Payload:    types.MustPBToJSON(create),
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
 func withdrawCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "withdraw",
@@ -273,6 +405,19 @@ func queryWithdrawCmd() *cobra.Command {
 	return cmd
 }
 
+func queryScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show_schedule",
+		Short: "show projected release schedule of one unfreeze construct",
+		Run:   querySchedule,
+	}
+	cmd.Flags().StringP("id", "", "", "unfreeze construct id")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().Int32P("count", "", 0, "max release count, default 100")
+
+	return cmd
+}
+
 func withdraw(cmd *cobra.Command, args []string) {
 	paraName, _ := cmd.Flags().GetString("paraName")
 
@@ -331,6 +476,33 @@ func queryWithdraw(cmd *cobra.Command, args []string) {
 	jsonOutput(&resp)
 }
 
+func querySchedule(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+
+	id, _ := cmd.Flags().GetString("id")
+	count, _ := cmd.Flags().GetInt32("count")
+	cli, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	param := &rpctypes.Query4Jrpc{
+		Execer:   getRealExecName(paraName, pty.UnfreezeX),
+		FuncName: "GetUnfreezeSchedule",
+		Payload:  types.MustPBToJSON(&pty.ReqUnfreezeSchedule{UnfreezeID: id, Count: count}),
+	}
+	var resp pty.ReplyUnfreezeSchedule
+	err = cli.Call("Chain33.Query", param, &resp)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	jsonOutput(&resp)
+}
+
 func show(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	paraName, _ := cmd.Flags().GetString("paraName")
@@ -5,6 +5,8 @@
 package executor
 
 import (
+	"math/big"
+
 	"github.com/33cn/chain33/types"
 	pty "github.com/33cn/plugin/plugin/dapp/unfreeze/types"
 )
@@ -13,9 +15,18 @@ import (
 type Means interface {
 	setOpt(unfreeze *pty.Unfreeze, from *pty.UnfreezeCreate) (*pty.Unfreeze, error)
 	calcFrozen(unfreeze *pty.Unfreeze, now int64) (int64, error)
+	//after 之后的下一个解冻时间点，没有更多的解冻时返回false
+	nextRelease(unfreeze *pty.Unfreeze, after int64) (int64, bool)
 }
 
 func newMeans(cfg *types.Chain33Config, means string, height int64) (Means, error) {
+	if cfg.IsDappFork(height, pty.UnfreezeX, pty.ForkUnfreezeVestingX) {
+		if means == pty.CliffLinearX {
+			return &cliffLinear{}, nil
+		} else if means == pty.TranchesX {
+			return &tranches{}, nil
+		}
+	}
 	if cfg.IsDappFork(height, pty.UnfreezeX, "ForkTerminatePart") {
 		if means == "FixAmount" {
 			return &fixAmountV2{}, nil
@@ -60,6 +71,10 @@ func (opt *fixAmount) calcFrozen(unfreeze *pty.Unfreeze, now int64) (int64, erro
 	return unfreeze.TotalCount - unfreezeAmount, nil
 }
 
+func (opt *fixAmount) nextRelease(unfreeze *pty.Unfreeze, after int64) (int64, bool) {
+	return periodRelease(unfreeze.StartTime, unfreeze.GetFixAmount().GetPeriod(), after)
+}
+
 type leftProportion struct {
 }
 
@@ -88,6 +103,21 @@ func (opt *leftProportion) calcFrozen(unfreeze *pty.Unfreeze, now int64) (int64,
 	return int64(frozen), nil
 }
 
+func (opt *leftProportion) nextRelease(unfreeze *pty.Unfreeze, after int64) (int64, bool) {
+	return periodRelease(unfreeze.StartTime, unfreeze.GetLeftProportion().GetPeriod(), after)
+}
+
+//按固定间隔解冻，开始时间即第一次解冻
+func periodRelease(start, period, after int64) (int64, bool) {
+	if period <= 0 {
+		return 0, false
+	}
+	if after < start {
+		return start, true
+	}
+	return start + ((after-start)/period+1)*period, true
+}
+
 func withdraw(unfreeze *pty.Unfreeze, frozen int64) (*pty.Unfreeze, int64) {
 	if unfreeze.Remaining == 0 {
 		return unfreeze, 0
@@ -128,6 +158,10 @@ func (opt *fixAmountV2) calcFrozen(unfreeze *pty.Unfreeze, now int64) (int64, er
 	return unfreeze.TotalCount - unfreezeAmount, nil
 }
 
+func (opt *fixAmountV2) nextRelease(unfreeze *pty.Unfreeze, after int64) (int64, bool) {
+	return periodRelease(unfreeze.StartTime, unfreeze.GetFixAmount().GetPeriod(), after)
+}
+
 type leftProportionV2 struct {
 }
 
@@ -158,3 +192,117 @@ func (opt *leftProportionV2) calcFrozen(unfreeze *pty.Unfreeze, now int64) (int6
 	}
 	return int64(frozen), nil
 }
+
+func (opt *leftProportionV2) nextRelease(unfreeze *pty.Unfreeze, after int64) (int64, bool) {
+	return periodRelease(unfreeze.StartTime, unfreeze.GetLeftProportion().GetPeriod(), after)
+}
+
+type cliffLinear struct {
+}
+
+func (opt *cliffLinear) setOpt(unfreeze *pty.Unfreeze, from *pty.UnfreezeCreate) (*pty.Unfreeze, error) {
+	o := from.GetCliffLinear()
+	if o == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if o.Cliff < 0 || o.Period <= 0 || o.Duration <= 0 || o.Cliff > o.Duration || o.Period > o.Duration {
+		return nil, types.ErrInvalidParam
+	}
+	unfreeze.MeansOpt = &pty.Unfreeze_CliffLinear{CliffLinear: o}
+	return unfreeze, nil
+}
+
+func (opt *cliffLinear) calcFrozen(unfreeze *pty.Unfreeze, now int64) (int64, error) {
+	means := unfreeze.GetCliffLinear()
+	if means == nil {
+		return 0, types.ErrInvalidParam
+	}
+	if unfreeze.Terminated {
+		return 0, nil
+	}
+	elapsed := now - unfreeze.StartTime
+	if elapsed < means.Cliff {
+		return unfreeze.TotalCount, nil
+	}
+	if elapsed >= means.Duration {
+		return 0, nil
+	}
+	//锁定期结束时一次解冻锁定期内累积的部分，之后每个间隔解冻 total*period/duration
+	vested := (elapsed / means.Period) * means.Period
+	released := new(big.Int).Mul(big.NewInt(unfreeze.TotalCount), big.NewInt(vested))
+	released.Div(released, big.NewInt(means.Duration))
+	return unfreeze.TotalCount - released.Int64(), nil
+}
+
+func (opt *cliffLinear) nextRelease(unfreeze *pty.Unfreeze, after int64) (int64, bool) {
+	means := unfreeze.GetCliffLinear()
+	if means == nil {
+		return 0, false
+	}
+	end := unfreeze.StartTime + means.Duration
+	if after >= end {
+		return 0, false
+	}
+	cliff := unfreeze.StartTime + means.Cliff
+	if after < cliff {
+		return cliff, true
+	}
+	next, _ := periodRelease(unfreeze.StartTime, means.Period, after)
+	if next > end {
+		return end, true
+	}
+	return next, true
+}
+
+type tranches struct {
+}
+
+func (opt *tranches) setOpt(unfreeze *pty.Unfreeze, from *pty.UnfreezeCreate) (*pty.Unfreeze, error) {
+	o := from.GetTranches()
+	if o == nil || len(o.Tranches) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	var sum, last int64
+	for i, t := range o.Tranches {
+		if t.Amount <= 0 || t.Amount > unfreeze.TotalCount-sum {
+			return nil, pty.ErrUnfreezeSchedule
+		}
+		if i > 0 && t.Time <= last {
+			return nil, pty.ErrUnfreezeSchedule
+		}
+		sum += t.Amount
+		last = t.Time
+	}
+	if sum != unfreeze.TotalCount {
+		return nil, pty.ErrUnfreezeSchedule
+	}
+	unfreeze.MeansOpt = &pty.Unfreeze_Tranches{Tranches: o}
+	return unfreeze, nil
+}
+
+func (opt *tranches) calcFrozen(unfreeze *pty.Unfreeze, now int64) (int64, error) {
+	means := unfreeze.GetTranches()
+	if means == nil {
+		return 0, types.ErrInvalidParam
+	}
+	if unfreeze.Terminated {
+		return 0, nil
+	}
+	frozen := unfreeze.TotalCount
+	for _, t := range means.Tranches {
+		if t.Time > now {
+			break
+		}
+		frozen -= t.Amount
+	}
+	return frozen, nil
+}
+
+func (opt *tranches) nextRelease(unfreeze *pty.Unfreeze, after int64) (int64, bool) {
+	for _, t := range unfreeze.GetTranches().GetTranches() {
+		if t.Time > after {
+			return t.Time, true
+		}
+	}
+	return 0, false
+}
@@ -1,8 +1,10 @@
 package executor
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/33cn/chain33/types"
 	"github.com/stretchr/testify/assert"
 
 	pty "github.com/33cn/plugin/plugin/dapp/unfreeze/types"
@@ -233,3 +235,103 @@ func TestLeftV2(t *testing.T) {
 		})
 	}
 }
+
+func TestCliffLinear(t *testing.T) {
+	m, err := newMeans(chain33TestCfg, pty.CliffLinearX, 15000000)
+	assert.Nil(t, err)
+
+	create := &pty.UnfreezeCreate{
+		TotalCount: 1200,
+		Means:      pty.CliffLinearX,
+		MeansOpt:   &pty.UnfreezeCreate_CliffLinear{CliffLinear: &pty.CliffLinear{Cliff: 30, Period: 10, Duration: 120}},
+	}
+	u, err := m.setOpt(&pty.Unfreeze{TotalCount: 1200, StartTime: 10000, Means: pty.CliffLinearX}, create)
+	assert.Nil(t, err)
+
+	cases := []struct {
+		now    int64
+		expect int64
+	}{
+		{9999, 1200},
+		{10029, 1200},
+		{10030, 900},
+		{10045, 800},
+		{10119, 100},
+		{10120, 0},
+	}
+	for _, c := range cases {
+		f, err := m.calcFrozen(u, c.now)
+		assert.Nil(t, err)
+		assert.Equal(t, c.expect, f, "now %d", c.now)
+	}
+
+	create.MeansOpt = &pty.UnfreezeCreate_CliffLinear{CliffLinear: &pty.CliffLinear{Cliff: 130, Period: 10, Duration: 120}}
+	_, err = m.setOpt(&pty.Unfreeze{TotalCount: 1200}, create)
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	releases, err := getSchedule(chain33TestCfg, u, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, len(releases))
+	assert.Equal(t, &pty.UnfreezeRelease{Time: 10030, Amount: 300, Frozen: 900}, releases[0])
+	assert.Equal(t, &pty.UnfreezeRelease{Time: 10120, Amount: 100, Frozen: 0}, releases[9])
+}
+
+func TestTranches(t *testing.T) {
+	m, err := newMeans(chain33TestCfg, pty.TranchesX, 15000000)
+	assert.Nil(t, err)
+
+	tranches := &pty.Tranches{Tranches: []*pty.Tranche{{Time: 10100, Amount: 300}, {Time: 10200, Amount: 700}}}
+	create := &pty.UnfreezeCreate{
+		TotalCount: 1000,
+		Means:      pty.TranchesX,
+		MeansOpt:   &pty.UnfreezeCreate_Tranches{Tranches: tranches},
+	}
+	u, err := m.setOpt(&pty.Unfreeze{TotalCount: 1000, StartTime: 10000, Means: pty.TranchesX}, create)
+	assert.Nil(t, err)
+
+	f, _ := m.calcFrozen(u, 10099)
+	assert.Equal(t, int64(1000), f)
+	f, _ = m.calcFrozen(u, 10100)
+	assert.Equal(t, int64(700), f)
+	f, _ = m.calcFrozen(u, 10200)
+	assert.Equal(t, int64(0), f)
+
+	releases, err := getSchedule(chain33TestCfg, u, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []*pty.UnfreezeRelease{{Time: 10100, Amount: 300, Frozen: 700}}, releases)
+
+	// 总额不等或者时间没有递增
+	_, err = m.setOpt(&pty.Unfreeze{TotalCount: 1001}, create)
+	assert.Equal(t, pty.ErrUnfreezeSchedule, err)
+	tranches.Tranches[1].Time = 10100
+	_, err = m.setOpt(&pty.Unfreeze{TotalCount: 1000}, create)
+	assert.Equal(t, pty.ErrUnfreezeSchedule, err)
+}
+
+func TestVestingMeansFork(t *testing.T) {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	cfg.SetDappFork(pty.UnfreezeX, pty.ForkUnfreezeVestingX, 100)
+
+	_, err := newMeans(cfg, pty.CliffLinearX, 99)
+	assert.Equal(t, types.ErrNotSupport, err)
+	_, err = newMeans(cfg, pty.TranchesX, 99)
+	assert.Equal(t, types.ErrNotSupport, err)
+	_, err = newMeans(cfg, pty.TranchesX, 100)
+	assert.Nil(t, err)
+}
+
+func TestFixAmountSchedule(t *testing.T) {
+	u := &pty.Unfreeze{
+		TotalCount: 25,
+		Means:      pty.FixAmountX,
+		StartTime:  10000,
+		MeansOpt:   &pty.Unfreeze_FixAmount{FixAmount: &pty.FixAmount{Period: 10, Amount: 10}},
+	}
+	releases, err := getSchedule(chain33TestCfg, u, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []*pty.UnfreezeRelease{
+		{Time: 10000, Amount: 10, Frozen: 15},
+		{Time: 10010, Amount: 10, Frozen: 5},
+		{Time: 10020, Amount: 5, Frozen: 0},
+	}, releases)
+}
@@ -34,6 +34,18 @@ func (u *Unfreeze) Query_ListUnfreezeByBeneficiary(in *pty.ReqUnfreezes) (types.
 	return ListUnfreezeByBeneficiary(u.GetLocalDB(), in)
 }
 
+// Query_GetUnfreezeSchedule 查询合约的解冻时间表
+func (u *Unfreeze) Query_GetUnfreezeSchedule(in *pty.ReqUnfreezeSchedule) (types.Message, error) {
+	cfg := u.GetAPI().GetConfig()
+	return QuerySchedule(cfg, u.GetStateDB(), in)
+}
+
+// Query_PreviewUnfreezeSchedule 创建合约之前预览解冻时间表
+func (u *Unfreeze) Query_PreviewUnfreezeSchedule(in *pty.UnfreezeCreate) (types.Message, error) {
+	cfg := u.GetAPI().GetConfig()
+	return PreviewSchedule(cfg, in)
+}
+
 // QueryWithdraw 查询可提币状态
 func QueryWithdraw(cfg *types.Chain33Config, stateDB dbm.KV, id string) (types.Message, error) {
 	id = unfreezeIDFromHex(id)
@@ -54,7 +66,7 @@ func QueryWithdraw(cfg *types.Chain33Config, stateDB dbm.KV, id string) (types.M
 }
 
 func getWithdrawAvailable(cfg *types.Chain33Config, unfreeze *pty.Unfreeze, calcTime int64) (int64, error) {
-	means, err := newMeans(cfg, unfreeze.Means, 1500000)
+	means, err := newMeans(cfg, unfreeze.Means, types.MaxHeight)
 	if err != nil {
 		return 0, err
 	}
@@ -146,8 +158,101 @@ func fmtLocalUnfreeze(rows []*table.Row) (*pty.ReplyUnfreezes, error) {
 			v.MeansOpt = &pty.ReplyUnfreeze_FixAmount{FixAmount: r.Unfreeze.GetFixAmount()}
 		} else if v.Means == pty.LeftProportionX {
 			v.MeansOpt = &pty.ReplyUnfreeze_LeftProportion{LeftProportion: r.Unfreeze.GetLeftProportion()}
+		} else if v.Means == pty.CliffLinearX {
+			v.MeansOpt = &pty.ReplyUnfreeze_CliffLinear{CliffLinear: r.Unfreeze.GetCliffLinear()}
+		} else if v.Means == pty.TranchesX {
+			v.MeansOpt = &pty.ReplyUnfreeze_Tranches{Tranches: r.Unfreeze.GetTranches()}
 		}
 		results.Unfreeze = append(results.Unfreeze, v)
 	}
 	return &results, nil
 }
+
+// QuerySchedule 查询解冻时间表
+func QuerySchedule(cfg *types.Chain33Config, stateDB dbm.KV, req *pty.ReqUnfreezeSchedule) (types.Message, error) {
+	if req == nil {
+		return nil, types.ErrInvalidParam
+	}
+	id := unfreezeIDFromHex(req.UnfreezeID)
+	unfreeze, err := loadUnfreeze(id, stateDB)
+	if err != nil {
+		uflog.Error("QuerySchedule ", "unfreezeID", id, "err", err)
+		return nil, err
+	}
+	releases, err := getSchedule(cfg, unfreeze, int(req.Count))
+	if err != nil {
+		return nil, err
+	}
+	return &pty.ReplyUnfreezeSchedule{UnfreezeID: id, Releases: releases}, nil
+}
+
+// PreviewSchedule 按照创建参数计算解冻时间表
+func PreviewSchedule(cfg *types.Chain33Config, create *pty.UnfreezeCreate) (types.Message, error) {
+	if create == nil || create.TotalCount <= 0 {
+		return nil, types.ErrInvalidParam
+	}
+	unfreeze := &pty.Unfreeze{
+		StartTime:   create.StartTime,
+		AssetExec:   create.AssetExec,
+		AssetSymbol: create.AssetSymbol,
+		TotalCount:  create.TotalCount,
+		Remaining:   create.TotalCount,
+		Beneficiary: create.Beneficiary,
+		Means:       create.Means,
+	}
+	if unfreeze.StartTime == 0 {
+		unfreeze.StartTime = time.Now().Unix()
+	}
+	means, err := newMeans(cfg, create.Means, types.MaxHeight)
+	if err != nil {
+		return nil, err
+	}
+	unfreeze, err = means.setOpt(unfreeze, create)
+	if err != nil {
+		return nil, err
+	}
+	releases, err := getSchedule(cfg, unfreeze, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &pty.ReplyUnfreezeSchedule{Releases: releases}, nil
+}
+
+const (
+	//时间表最多返回的解冻次数
+	maxScheduleCount = 100
+	//按余量比例解冻时解冻数量可能为0，限制计算的次数
+	maxScheduleSteps = 1000
+)
+
+//从开始时间依次计算每个解冻时间点的解冻数量，已经终止的合约没有后续的解冻
+func getSchedule(cfg *types.Chain33Config, unfreeze *pty.Unfreeze, count int) ([]*pty.UnfreezeRelease, error) {
+	if count <= 0 || count > maxScheduleCount {
+		count = maxScheduleCount
+	}
+	means, err := newMeans(cfg, unfreeze.Means, types.MaxHeight)
+	if err != nil {
+		return nil, err
+	}
+	var releases []*pty.UnfreezeRelease
+	if unfreeze.Terminated {
+		return releases, nil
+	}
+	prevFrozen := unfreeze.TotalCount
+	after := unfreeze.StartTime - 1
+	for i := 0; i < maxScheduleSteps && len(releases) < count && prevFrozen > 0; i++ {
+		t, ok := means.nextRelease(unfreeze, after)
+		if !ok {
+			break
+		}
+		frozen, err := means.calcFrozen(unfreeze, t)
+		if err != nil {
+			return nil, err
+		}
+		if frozen < prevFrozen {
+			releases = append(releases, &pty.UnfreezeRelease{Time: t, Amount: prevFrozen - frozen, Frozen: frozen})
+		}
+		prevFrozen, after = frozen, t
+	}
+	return releases, nil
+}
@@ -36,6 +36,18 @@ func (c *channelClient) GetUnfreezeWithdraw(ctx context.Context, in *types.ReqSt
 	return nil, types.ErrDecode
 }
 
+// GetUnfreezeSchedule 获得冻结合约的解冻时间表
+func (c *channelClient) GetUnfreezeSchedule(ctx context.Context, in *pty.ReqUnfreezeSchedule) (*pty.ReplyUnfreezeSchedule, error) {
+	v, err := c.Query(pty.UnfreezeX, "GetUnfreezeSchedule", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp, ok := v.(*pty.ReplyUnfreezeSchedule); ok {
+		return resp, nil
+	}
+	return nil, types.ErrDecode
+}
+
 // GetUnfreeze 获得冻结合约
 func (c *Jrpc) GetUnfreeze(in *types.ReqString, result *interface{}) error {
 	v, err := c.cli.GetUnfreeze(context.Background(), in)
@@ -58,6 +70,16 @@ func (c *Jrpc) GetUnfreezeWithdraw(in *types.ReqString, result *interface{}) err
 	return nil
 }
 
+// GetUnfreezeSchedule 获得冻结合约的解冻时间表
+func (c *Jrpc) GetUnfreezeSchedule(in *pty.ReqUnfreezeSchedule, result *interface{}) error {
+	v, err := c.cli.GetUnfreezeSchedule(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = v
+	return nil
+}
+
 // CreateRawUnfreezeCreate 创建冻结合约
 func (c *Jrpc) CreateRawUnfreezeCreate(param *pty.UnfreezeCreate, result *interface{}) error {
 	if param == nil {
@@ -41,8 +41,11 @@ var (
 
 	FixAmountX      = "FixAmount"
 	LeftProportionX = "LeftProportion"
-	SupportMeans    = []string{"FixAmount", "LeftProportion"}
+	CliffLinearX    = "CliffLinear"
+	TranchesX       = "Tranches"
+	SupportMeans    = []string{"FixAmount", "LeftProportion", "CliffLinear", "Tranches"}
 
-	ForkTerminatePartX = "ForkTerminatePart"
-	ForkUnfreezeIDX    = "ForkUnfreezeIDX"
+	ForkTerminatePartX   = "ForkTerminatePart"
+	ForkUnfreezeIDX      = "ForkUnfreezeIDX"
+	ForkUnfreezeVestingX = "ForkUnfreezeVesting"
 )
@@ -17,4 +17,6 @@ var (
 	ErrNoPrivilege = errors.New("ErrNoPrivilege")
 	// ErrTerminated 已经被取消过了
 	ErrTerminated = errors.New("ErrTerminated")
+	// ErrUnfreezeSchedule 解冻时间表错误
+	ErrUnfreezeSchedule = errors.New("ErrUnfreezeSchedule")
 )
@@ -32,6 +32,7 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(name, "Enable", 0)
 	cfg.RegisterDappFork(name, ForkTerminatePartX, 0)
 	cfg.RegisterDappFork(name, ForkUnfreezeIDX, 0)
+	cfg.RegisterDappFork(name, ForkUnfreezeVestingX, 0)
 }
 
 //InitExecutor ...
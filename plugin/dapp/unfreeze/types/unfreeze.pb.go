@@ -55,6 +55,8 @@ type Unfreeze struct {
 	// Types that are assignable to MeansOpt:
 	//	*Unfreeze_FixAmount
 	//	*Unfreeze_LeftProportion
+	//	*Unfreeze_CliffLinear
+	//	*Unfreeze_Tranches
 	MeansOpt   isUnfreeze_MeansOpt `protobuf_oneof:"meansOpt"`
 	Terminated bool                `protobuf:"varint,12,opt,name=terminated,proto3" json:"terminated,omitempty"`
 }
@@ -175,6 +177,20 @@ func (x *Unfreeze) GetLeftProportion() *LeftProportion {
 	return nil
 }
 
+func (x *Unfreeze) GetCliffLinear() *CliffLinear {
+	if x, ok := x.GetMeansOpt().(*Unfreeze_CliffLinear); ok {
+		return x.CliffLinear
+	}
+	return nil
+}
+
+func (x *Unfreeze) GetTranches() *Tranches {
+	if x, ok := x.GetMeansOpt().(*Unfreeze_Tranches); ok {
+		return x.Tranches
+	}
+	return nil
+}
+
 func (x *Unfreeze) GetTerminated() bool {
 	if x != nil {
 		return x.Terminated
@@ -194,10 +210,22 @@ type Unfreeze_LeftProportion struct {
 	LeftProportion *LeftProportion `protobuf:"bytes,11,opt,name=leftProportion,proto3,oneof"`
 }
 
+type Unfreeze_CliffLinear struct {
+	CliffLinear *CliffLinear `protobuf:"bytes,13,opt,name=cliffLinear,proto3,oneof"`
+}
+
+type Unfreeze_Tranches struct {
+	Tranches *Tranches `protobuf:"bytes,14,opt,name=tranches,proto3,oneof"`
+}
+
 func (*Unfreeze_FixAmount) isUnfreeze_MeansOpt() {}
 
 func (*Unfreeze_LeftProportion) isUnfreeze_MeansOpt() {}
 
+func (*Unfreeze_CliffLinear) isUnfreeze_MeansOpt() {}
+
+func (*Unfreeze_Tranches) isUnfreeze_MeansOpt() {}
+
 // 按时间固定额度解冻
 type FixAmount struct {
 	state         protoimpl.MessageState
@@ -310,6 +338,177 @@ func (x *LeftProportion) GetTenThousandth() int64 {
 	return 0
 }
 
+// 锁定期之后按固定时间间隔线性解冻，锁定期结束时一次解冻锁定期内累积的部分
+type CliffLinear struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//锁定期，单位秒
+	Cliff int64 `protobuf:"varint,1,opt,name=cliff,proto3" json:"cliff,omitempty"`
+	//解冻间隔
+	Period int64 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	//从开始到全部解冻的时长
+	Duration int64 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *CliffLinear) Reset() {
+	*x = CliffLinear{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_unfreeze_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CliffLinear) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CliffLinear) ProtoMessage() {}
+
+func (x *CliffLinear) ProtoReflect() protoreflect.Message {
+	mi := &file_unfreeze_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CliffLinear.ProtoReflect.Descriptor instead.
+func (*CliffLinear) Descriptor() ([]byte, []int) {
+	return file_unfreeze_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CliffLinear) GetCliff() int64 {
+	if x != nil {
+		return x.Cliff
+	}
+	return 0
+}
+
+func (x *CliffLinear) GetPeriod() int64 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *CliffLinear) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+// 在指定时间解冻指定数量
+type Tranche struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time   int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Tranche) Reset() {
+	*x = Tranche{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_unfreeze_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tranche) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tranche) ProtoMessage() {}
+
+func (x *Tranche) ProtoReflect() protoreflect.Message {
+	mi := &file_unfreeze_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tranche.ProtoReflect.Descriptor instead.
+func (*Tranche) Descriptor() ([]byte, []int) {
+	return file_unfreeze_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Tranche) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Tranche) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// 按自定义的时间表解冻，时间递增，总额等于冻结总额
+type Tranches struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tranches []*Tranche `protobuf:"bytes,1,rep,name=tranches,proto3" json:"tranches,omitempty"`
+}
+
+func (x *Tranches) Reset() {
+	*x = Tranches{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_unfreeze_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tranches) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tranches) ProtoMessage() {}
+
+func (x *Tranches) ProtoReflect() protoreflect.Message {
+	mi := &file_unfreeze_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tranches.ProtoReflect.Descriptor instead.
+func (*Tranches) Descriptor() ([]byte, []int) {
+	return file_unfreeze_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Tranches) GetTranches() []*Tranche {
+	if x != nil {
+		return x.Tranches
+	}
+	return nil
+}
+
 // message for execs.unfreeze
 type UnfreezeAction struct {
 	state         protoimpl.MessageState
@@ -327,7 +526,7 @@ type UnfreezeAction struct {
 func (x *UnfreezeAction) Reset() {
 	*x = UnfreezeAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_unfreeze_proto_msgTypes[3]
+		mi := &file_unfreeze_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -340,7 +539,7 @@ func (x *UnfreezeAction) String() string {
 func (*UnfreezeAction) ProtoMessage() {}
 
 func (x *UnfreezeAction) ProtoReflect() protoreflect.Message {
-	mi := &file_unfreeze_proto_msgTypes[3]
+	mi := &file_unfreeze_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -353,7 +552,7 @@ func (x *UnfreezeAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnfreezeAction.ProtoReflect.Descriptor instead.
 func (*UnfreezeAction) Descriptor() ([]byte, []int) {
-	return file_unfreeze_proto_rawDescGZIP(), []int{3}
+	return file_unfreeze_proto_rawDescGZIP(), []int{6}
 }
 
 func (m *UnfreezeAction) GetValue() isUnfreezeAction_Value {
@@ -428,13 +627,15 @@ type UnfreezeCreate struct {
 	// Types that are assignable to MeansOpt:
 	//	*UnfreezeCreate_FixAmount
 	//	*UnfreezeCreate_LeftProportion
+	//	*UnfreezeCreate_CliffLinear
+	//	*UnfreezeCreate_Tranches
 	MeansOpt isUnfreezeCreate_MeansOpt `protobuf_oneof:"meansOpt"`
 }
 
 func (x *UnfreezeCreate) Reset() {
 	*x = UnfreezeCreate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_unfreeze_proto_msgTypes[4]
+		mi := &file_unfreeze_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -447,7 +648,7 @@ func (x *UnfreezeCreate) String() string {
 func (*UnfreezeCreate) ProtoMessage() {}
 
 func (x *UnfreezeCreate) ProtoReflect() protoreflect.Message {
-	mi := &file_unfreeze_proto_msgTypes[4]
+	mi := &file_unfreeze_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -460,7 +661,7 @@ func (x *UnfreezeCreate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnfreezeCreate.ProtoReflect.Descriptor instead.
 func (*UnfreezeCreate) Descriptor() ([]byte, []int) {
-	return file_unfreeze_proto_rawDescGZIP(), []int{4}
+	return file_unfreeze_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UnfreezeCreate) GetStartTime() int64 {
@@ -526,6 +727,20 @@ func (x *UnfreezeCreate) GetLeftProportion() *LeftProportion {
 	return nil
 }
 
+func (x *UnfreezeCreate) GetCliffLinear() *CliffLinear {
+	if x, ok := x.GetMeansOpt().(*UnfreezeCreate_CliffLinear); ok {
+		return x.CliffLinear
+	}
+	return nil
+}
+
+func (x *UnfreezeCreate) GetTranches() *Tranches {
+	if x, ok := x.GetMeansOpt().(*UnfreezeCreate_Tranches); ok {
+		return x.Tranches
+	}
+	return nil
+}
+
 type isUnfreezeCreate_MeansOpt interface {
 	isUnfreezeCreate_MeansOpt()
 }
@@ -538,10 +753,22 @@ type UnfreezeCreate_LeftProportion struct {
 	LeftProportion *LeftProportion `protobuf:"bytes,8,opt,name=leftProportion,proto3,oneof"`
 }
 
+type UnfreezeCreate_CliffLinear struct {
+	CliffLinear *CliffLinear `protobuf:"bytes,9,opt,name=cliffLinear,proto3,oneof"`
+}
+
+type UnfreezeCreate_Tranches struct {
+	Tranches *Tranches `protobuf:"bytes,10,opt,name=tranches,proto3,oneof"`
+}
+
 func (*UnfreezeCreate_FixAmount) isUnfreezeCreate_MeansOpt() {}
 
 func (*UnfreezeCreate_LeftProportion) isUnfreezeCreate_MeansOpt() {}
 
+func (*UnfreezeCreate_CliffLinear) isUnfreezeCreate_MeansOpt() {}
+
+func (*UnfreezeCreate_Tranches) isUnfreezeCreate_MeansOpt() {}
+
 type UnfreezeWithdraw struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -553,7 +780,7 @@ type UnfreezeWithdraw struct {
 func (x *UnfreezeWithdraw) Reset() {
 	*x = UnfreezeWithdraw{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_unfreeze_proto_msgTypes[5]
+		mi := &file_unfreeze_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -566,7 +793,7 @@ func (x *UnfreezeWithdraw) String() string {
 func (*UnfreezeWithdraw) ProtoMessage() {}
 
 func (x *UnfreezeWithdraw) ProtoReflect() protoreflect.Message {
-	mi := &file_unfreeze_proto_msgTypes[5]
+	mi := &file_unfreeze_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +806,7 @@ func (x *UnfreezeWithdraw) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnfreezeWithdraw.ProtoReflect.Descriptor instead.
 func (*UnfreezeWithdraw) Descriptor() ([]byte, []int) {
-	return file_unfreeze_proto_rawDescGZIP(), []int{5}
+	return file_unfreeze_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UnfreezeWithdraw) GetUnfreezeID() string {
@@ -600,7 +827,7 @@ type UnfreezeTerminate struct {
 func (x *UnfreezeTerminate) Reset() {
 	*x = UnfreezeTerminate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_unfreeze_proto_msgTypes[6]
+		mi := &file_unfreeze_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -613,7 +840,7 @@ func (x *UnfreezeTerminate) String() string {
 func (*UnfreezeTerminate) ProtoMessage() {}
 
 func (x *UnfreezeTerminate) ProtoReflect() protoreflect.Message {
-	mi := &file_unfreeze_proto_msgTypes[6]
+	mi := &file_unfreeze_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -626,7 +853,7 @@ func (x *UnfreezeTerminate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnfreezeTerminate.ProtoReflect.Descriptor instead.
 func (*UnfreezeTerminate) Descriptor() ([]byte, []int) {
-	return file_unfreeze_proto_rawDescGZIP(), []int{6}
+	return file_unfreeze_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UnfreezeTerminate) GetUnfreezeID() string {
@@ -649,7 +876,7 @@ type ReceiptUnfreeze struct {
 func (x *ReceiptUnfreeze) Reset() {
 	*x = ReceiptUnfreeze{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_unfreeze_proto_msgTypes[7]
+		mi := &file_unfreeze_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -662,7 +889,7 @@ func (x *ReceiptUnfreeze) String() string {
 func (*ReceiptUnfreeze) ProtoMessage() {}
 
 func (x *ReceiptUnfreeze) ProtoReflect() protoreflect.Message {
-	mi := &file_unfreeze_proto_msgTypes[7]
+	mi := &file_unfreeze_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -675,7 +902,7 @@ func (x *ReceiptUnfreeze) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptUnfreeze.ProtoReflect.Descriptor instead.
 func (*ReceiptUnfreeze) Descriptor() ([]byte, []int) {
-	return file_unfreeze_proto_rawDescGZIP(), []int{7}
+	return file_unfreeze_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ReceiptUnfreeze) GetPrev() *Unfreeze {
@@ -704,7 +931,7 @@ type LocalUnfreeze struct {
 func (x *LocalUnfreeze) Reset() {
 	*x = LocalUnfreeze{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_unfreeze_proto_msgTypes[8]
+		mi := &file_unfreeze_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -717,7 +944,7 @@ func (x *LocalUnfreeze) String() string {
 func (*LocalUnfreeze) ProtoMessage() {}
 
 func (x *LocalUnfreeze) ProtoReflect() protoreflect.Message {
-	mi := &file_unfreeze_proto_msgTypes[8]
+	mi := &file_unfreeze_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -730,7 +957,7 @@ func (x *LocalUnfreeze) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocalUnfreeze.ProtoReflect.Descriptor instead.
 func (*LocalUnfreeze) Descriptor() ([]byte, []int) {
-	return file_unfreeze_proto_rawDescGZIP(), []int{8}
+	return file_unfreeze_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *LocalUnfreeze) GetUnfreeze() *Unfreeze {
@@ -760,7 +987,7 @@ type ReplyQueryUnfreezeWithdraw struct {
 func (x *ReplyQueryUnfreezeWithdraw) Reset() {
 	*x = ReplyQueryUnfreezeWithdraw{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_unfreeze_proto_msgTypes[9]
+		mi := &file_unfreeze_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -773,7 +1000,7 @@ func (x *ReplyQueryUnfreezeWithdraw) String() string {
 func (*ReplyQueryUnfreezeWithdraw) ProtoMessage() {}
 
 func (x *ReplyQueryUnfreezeWithdraw) ProtoReflect() protoreflect.Message {
-	mi := &file_unfreeze_proto_msgTypes[9]
+	mi := &file_unfreeze_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,7 +1013,7 @@ func (x *ReplyQueryUnfreezeWithdraw) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyQueryUnfreezeWithdraw.ProtoReflect.Descriptor instead.
 func (*ReplyQueryUnfreezeWithdraw) Descriptor() ([]byte, []int) {
-	return file_unfreeze_proto_rawDescGZIP(), []int{9}
+	return file_unfreeze_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ReplyQueryUnfreezeWithdraw) GetUnfreezeID() string {
@@ -818,7 +1045,7 @@ type ReqUnfreezes struct {
 func (x *ReqUnfreezes) Reset() {
 	*x = ReqUnfreezes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_unfreeze_proto_msgTypes[10]
+		mi := &file_unfreeze_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -831,7 +1058,7 @@ func (x *ReqUnfreezes) String() string {
 func (*ReqUnfreezes) ProtoMessage() {}
 
 func (x *ReqUnfreezes) ProtoReflect() protoreflect.Message {
-	mi := &file_unfreeze_proto_msgTypes[10]
+	mi := &file_unfreeze_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -844,7 +1071,7 @@ func (x *ReqUnfreezes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqUnfreezes.ProtoReflect.Descriptor instead.
 func (*ReqUnfreezes) Descriptor() ([]byte, []int) {
-	return file_unfreeze_proto_rawDescGZIP(), []int{10}
+	return file_unfreeze_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ReqUnfreezes) GetDirection() int32 {
@@ -907,6 +1134,8 @@ type ReplyUnfreeze struct {
 	// Types that are assignable to MeansOpt:
 	//	*ReplyUnfreeze_FixAmount
 	//	*ReplyUnfreeze_LeftProportion
+	//	*ReplyUnfreeze_CliffLinear
+	//	*ReplyUnfreeze_Tranches
 	MeansOpt   isReplyUnfreeze_MeansOpt `protobuf_oneof:"meansOpt"`
 	Terminated bool                     `protobuf:"varint,12,opt,name=terminated,proto3" json:"terminated,omitempty"`
 	Key        string                   `protobuf:"bytes,13,opt,name=key,proto3" json:"key,omitempty"`
@@ -915,7 +1144,7 @@ type ReplyUnfreeze struct {
 func (x *ReplyUnfreeze) Reset() {
 	*x = ReplyUnfreeze{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_unfreeze_proto_msgTypes[11]
+		mi := &file_unfreeze_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -928,7 +1157,7 @@ func (x *ReplyUnfreeze) String() string {
 func (*ReplyUnfreeze) ProtoMessage() {}
 
 func (x *ReplyUnfreeze) ProtoReflect() protoreflect.Message {
-	mi := &file_unfreeze_proto_msgTypes[11]
+	mi := &file_unfreeze_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -941,7 +1170,7 @@ func (x *ReplyUnfreeze) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyUnfreeze.ProtoReflect.Descriptor instead.
 func (*ReplyUnfreeze) Descriptor() ([]byte, []int) {
-	return file_unfreeze_proto_rawDescGZIP(), []int{11}
+	return file_unfreeze_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ReplyUnfreeze) GetUnfreezeID() string {
@@ -1028,6 +1257,20 @@ func (x *ReplyUnfreeze) GetLeftProportion() *LeftProportion {
 	return nil
 }
 
+func (x *ReplyUnfreeze) GetCliffLinear() *CliffLinear {
+	if x, ok := x.GetMeansOpt().(*ReplyUnfreeze_CliffLinear); ok {
+		return x.CliffLinear
+	}
+	return nil
+}
+
+func (x *ReplyUnfreeze) GetTranches() *Tranches {
+	if x, ok := x.GetMeansOpt().(*ReplyUnfreeze_Tranches); ok {
+		return x.Tranches
+	}
+	return nil
+}
+
 func (x *ReplyUnfreeze) GetTerminated() bool {
 	if x != nil {
 		return x.Terminated
@@ -1054,10 +1297,22 @@ type ReplyUnfreeze_LeftProportion struct {
 	LeftProportion *LeftProportion `protobuf:"bytes,11,opt,name=leftProportion,proto3,oneof"`
 }
 
+type ReplyUnfreeze_CliffLinear struct {
+	CliffLinear *CliffLinear `protobuf:"bytes,14,opt,name=cliffLinear,proto3,oneof"`
+}
+
+type ReplyUnfreeze_Tranches struct {
+	Tranches *Tranches `protobuf:"bytes,15,opt,name=tranches,proto3,oneof"`
+}
+
 func (*ReplyUnfreeze_FixAmount) isReplyUnfreeze_MeansOpt() {}
 
 func (*ReplyUnfreeze_LeftProportion) isReplyUnfreeze_MeansOpt() {}
 
+func (*ReplyUnfreeze_CliffLinear) isReplyUnfreeze_MeansOpt() {}
+
+func (*ReplyUnfreeze_Tranches) isReplyUnfreeze_MeansOpt() {}
+
 type ReplyUnfreezes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1069,7 +1324,7 @@ type ReplyUnfreezes struct {
 func (x *ReplyUnfreezes) Reset() {
 	*x = ReplyUnfreezes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_unfreeze_proto_msgTypes[12]
+		mi := &file_unfreeze_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1082,7 +1337,7 @@ func (x *ReplyUnfreezes) String() string {
 func (*ReplyUnfreezes) ProtoMessage() {}
 
 func (x *ReplyUnfreezes) ProtoReflect() protoreflect.Message {
-	mi := &file_unfreeze_proto_msgTypes[12]
+	mi := &file_unfreeze_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1095,7 +1350,7 @@ func (x *ReplyUnfreezes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyUnfreezes.ProtoReflect.Descriptor instead.
 func (*ReplyUnfreezes) Descriptor() ([]byte, []int) {
-	return file_unfreeze_proto_rawDescGZIP(), []int{12}
+	return file_unfreeze_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ReplyUnfreezes) GetUnfreeze() []*ReplyUnfreeze {
@@ -1105,12 +1360,187 @@ func (x *ReplyUnfreezes) GetUnfreeze() []*ReplyUnfreeze {
 	return nil
 }
 
+type ReqUnfreezeSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnfreezeID string `protobuf:"bytes,1,opt,name=unfreezeID,proto3" json:"unfreezeID,omitempty"`
+	//最多返回的解冻次数
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ReqUnfreezeSchedule) Reset() {
+	*x = ReqUnfreezeSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_unfreeze_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqUnfreezeSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqUnfreezeSchedule) ProtoMessage() {}
+
+func (x *ReqUnfreezeSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_unfreeze_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqUnfreezeSchedule.ProtoReflect.Descriptor instead.
+func (*ReqUnfreezeSchedule) Descriptor() ([]byte, []int) {
+	return file_unfreeze_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReqUnfreezeSchedule) GetUnfreezeID() string {
+	if x != nil {
+		return x.UnfreezeID
+	}
+	return ""
+}
+
+func (x *ReqUnfreezeSchedule) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 一次解冻：解冻时间，本次解冻数量，解冻之后剩余的冻结数量
+type UnfreezeRelease struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time   int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Frozen int64 `protobuf:"varint,3,opt,name=frozen,proto3" json:"frozen,omitempty"`
+}
+
+func (x *UnfreezeRelease) Reset() {
+	*x = UnfreezeRelease{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_unfreeze_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfreezeRelease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfreezeRelease) ProtoMessage() {}
+
+func (x *UnfreezeRelease) ProtoReflect() protoreflect.Message {
+	mi := &file_unfreeze_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfreezeRelease.ProtoReflect.Descriptor instead.
+func (*UnfreezeRelease) Descriptor() ([]byte, []int) {
+	return file_unfreeze_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UnfreezeRelease) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *UnfreezeRelease) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UnfreezeRelease) GetFrozen() int64 {
+	if x != nil {
+		return x.Frozen
+	}
+	return 0
+}
+
+type ReplyUnfreezeSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnfreezeID string             `protobuf:"bytes,1,opt,name=unfreezeID,proto3" json:"unfreezeID,omitempty"`
+	Releases   []*UnfreezeRelease `protobuf:"bytes,2,rep,name=releases,proto3" json:"releases,omitempty"`
+}
+
+func (x *ReplyUnfreezeSchedule) Reset() {
+	*x = ReplyUnfreezeSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_unfreeze_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplyUnfreezeSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyUnfreezeSchedule) ProtoMessage() {}
+
+func (x *ReplyUnfreezeSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_unfreeze_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyUnfreezeSchedule.ProtoReflect.Descriptor instead.
+func (*ReplyUnfreezeSchedule) Descriptor() ([]byte, []int) {
+	return file_unfreeze_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReplyUnfreezeSchedule) GetUnfreezeID() string {
+	if x != nil {
+		return x.UnfreezeID
+	}
+	return ""
+}
+
+func (x *ReplyUnfreezeSchedule) GetReleases() []*UnfreezeRelease {
+	if x != nil {
+		return x.Releases
+	}
+	return nil
+}
+
 var File_unfreeze_proto protoreflect.FileDescriptor
 
 var file_unfreeze_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbb, 0x03, 0x0a, 0x08, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa2, 0x04, 0x0a, 0x08, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65,
 	0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x49, 0x44,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
 	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18,
@@ -1135,130 +1565,184 @@ var file_unfreeze_proto_rawDesc = []byte{
 	0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
 	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4c, 0x65, 0x66, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f,
 	0x72, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0e, 0x6c, 0x65, 0x66, 0x74, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x69,
-	0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x74, 0x65, 0x72,
-	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x6d, 0x65, 0x61, 0x6e, 0x73,
-	0x4f, 0x70, 0x74, 0x22, 0x3b, 0x0a, 0x09, 0x46, 0x69, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0x4e, 0x0a, 0x0e, 0x4c, 0x65, 0x66, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x65,
-	0x6e, 0x54, 0x68, 0x6f, 0x75, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0d, 0x74, 0x65, 0x6e, 0x54, 0x68, 0x6f, 0x75, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x68,
-	0x22, 0xcb, 0x01, 0x0a, 0x0e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x66, 0x72,
-	0x65, 0x65, 0x7a, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x06, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55,
-	0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x48,
-	0x00, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x38, 0x0a, 0x09, 0x74,
-	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x54,
-	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x09, 0x74, 0x65, 0x72, 0x6d,
-	0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x02, 0x74, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xc5,
-	0x02, 0x0a, 0x0e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x65, 0x63, 0x12, 0x20, 0x0a,
-	0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12,
-	0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x20, 0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x66, 0x69, 0x78, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x46, 0x69, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09,
-	0x66, 0x69, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x6c, 0x65, 0x66,
-	0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4c, 0x65, 0x66, 0x74, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0e, 0x6c, 0x65, 0x66, 0x74,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x6d, 0x65,
-	0x61, 0x6e, 0x73, 0x4f, 0x70, 0x74, 0x22, 0x32, 0x0a, 0x10, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65,
-	0x7a, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e,
-	0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x49, 0x44, 0x22, 0x33, 0x0a, 0x11, 0x55, 0x6e,
-	0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12,
-	0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x49, 0x44, 0x22,
-	0x61, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65,
-	0x7a, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a,
-	0x65, 0x52, 0x04, 0x70, 0x72, 0x65, 0x76, 0x12, 0x29, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x22, 0x56, 0x0a, 0x0d, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x55, 0x6e, 0x66, 0x72, 0x65,
-	0x65, 0x7a, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e,
-	0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x08, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x66, 0x0a, 0x1a, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x66, 0x72,
-	0x65, 0x65, 0x7a, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e,
-	0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x9c, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65,
-	0x7a, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x4b,
-	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x4b, 0x65,
-	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12,
-	0x20, 0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72,
-	0x79, 0x22, 0xd2, 0x03, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x6e, 0x66, 0x72, 0x65,
-	0x65, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a,
-	0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x65, 0x63, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x65, 0x63, 0x12,
-	0x20, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12,
-	0x20, 0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72,
-	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12,
-	0x14, 0x0a, 0x05, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6d, 0x65, 0x61, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x66, 0x69, 0x78, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x46, 0x69, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09, 0x66, 0x69,
-	0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x6c, 0x65, 0x66, 0x74, 0x50,
-	0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4c, 0x65, 0x66, 0x74, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0e, 0x6c, 0x65, 0x66, 0x74, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x72, 0x6d,
-	0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x74, 0x65,
-	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x6d, 0x65,
-	0x61, 0x6e, 0x73, 0x4f, 0x70, 0x74, 0x22, 0x42, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x55,
-	0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x75, 0x6e, 0x66, 0x72,
-	0x65, 0x65, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
-	0x52, 0x08, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x32, 0x8e, 0x01, 0x0a, 0x08, 0x75,
-	0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x4c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x6e,
-	0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x10,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x6e,
-	0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
-	0x65, 0x71, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x22, 0x00, 0x42, 0x0a, 0x5a, 0x08, 0x2e,
-	0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x66, 0x66,
+	0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x66, 0x66, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72,
+	0x48, 0x00, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x12,
+	0x2d, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x65, 0x73, 0x48, 0x00, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x42, 0x0a,
+	0x0a, 0x08, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x4f, 0x70, 0x74, 0x22, 0x3b, 0x0a, 0x09, 0x46, 0x69,
+	0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4e, 0x0a, 0x0e, 0x4c, 0x65, 0x66, 0x74, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x65, 0x6e, 0x54, 0x68, 0x6f, 0x75, 0x73, 0x61, 0x6e, 0x64,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x65, 0x6e, 0x54, 0x68, 0x6f,
+	0x75, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x68, 0x22, 0x57, 0x0a, 0x0b, 0x43, 0x6c, 0x69, 0x66, 0x66,
+	0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x35, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x36, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x65, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x22,
+	0xcb, 0x01, 0x0a, 0x0e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x06, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e,
+	0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x48, 0x00,
+	0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x54, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x09, 0x74, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x74, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xac, 0x03,
+	0x0a, 0x0e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0b,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1e,
+	0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x66, 0x69, 0x78, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x46, 0x69, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09, 0x66,
+	0x69, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x6c, 0x65, 0x66, 0x74,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4c, 0x65, 0x66, 0x74, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0e, 0x6c, 0x65, 0x66, 0x74, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0b, 0x63, 0x6c, 0x69,
+	0x66, 0x66, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x66, 0x66, 0x4c, 0x69, 0x6e, 0x65,
+	0x61, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x4c, 0x69, 0x6e, 0x65, 0x61,
+	0x72, 0x12, 0x2d, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x65, 0x73, 0x48, 0x00, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73,
+	0x42, 0x0a, 0x0a, 0x08, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x4f, 0x70, 0x74, 0x22, 0x32, 0x0a, 0x10,
+	0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x49, 0x44,
+	0x22, 0x33, 0x0a, 0x11, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x54, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x66, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x49, 0x44, 0x22, 0x61, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x76,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55,
+	0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x04, 0x70, 0x72, 0x65, 0x76, 0x12, 0x29, 0x0a,
+	0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52,
+	0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x56, 0x0a, 0x0d, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x75, 0x6e, 0x66,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x08, 0x75, 0x6e,
+	0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x22, 0x66, 0x0a, 0x1a, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x6e,
+	0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x1e,
+	0x0a, 0x0a, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x49, 0x44, 0x12, 0x28,
+	0x0a, 0x0f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9c, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x71,
+	0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x66, 0x72, 0x6f, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x66, 0x72, 0x6f, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63,
+	0x69, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x65,
+	0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x22, 0xb9, 0x04, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x66,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75,
+	0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x45, 0x78, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x45, 0x78, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63,
+	0x69, 0x61, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x65,
+	0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x66,
+	0x69, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x46, 0x69, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x48, 0x00, 0x52, 0x09, 0x66, 0x69, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a,
+	0x0e, 0x6c, 0x65, 0x66, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4c, 0x65,
+	0x66, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0e,
+	0x6c, 0x65, 0x66, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36,
+	0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x66,
+	0x66, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x66, 0x66,
+	0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x12, 0x2d, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x48, 0x00, 0x52, 0x08, 0x74, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x6d, 0x65, 0x61, 0x6e, 0x73,
+	0x4f, 0x70, 0x74, 0x22, 0x42, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x6e, 0x66, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x08, 0x75,
+	0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x22, 0x4b, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x55, 0x6e,
+	0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x49, 0x44, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x55, 0x0a, 0x0f, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x22, 0x6b, 0x0a, 0x15, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55,
+	0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x08,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x32, 0xe1, 0x01, 0x0a, 0x08, 0x75, 0x6e, 0x66,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x4c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x66, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x10, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x21,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x66, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x12, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55,
+	0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x55, 0x6e, 0x66, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x1a, 0x1c, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x00, 0x42, 0x0a, 0x5a, 0x08,
+	0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1273,46 +1757,62 @@ func file_unfreeze_proto_rawDescGZIP() []byte {
 	return file_unfreeze_proto_rawDescData
 }
 
-var file_unfreeze_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_unfreeze_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_unfreeze_proto_goTypes = []interface{}{
 	(*Unfreeze)(nil),                   // 0: types.Unfreeze
 	(*FixAmount)(nil),                  // 1: types.FixAmount
 	(*LeftProportion)(nil),             // 2: types.LeftProportion
-	(*UnfreezeAction)(nil),             // 3: types.UnfreezeAction
-	(*UnfreezeCreate)(nil),             // 4: types.UnfreezeCreate
-	(*UnfreezeWithdraw)(nil),           // 5: types.UnfreezeWithdraw
-	(*UnfreezeTerminate)(nil),          // 6: types.UnfreezeTerminate
-	(*ReceiptUnfreeze)(nil),            // 7: types.ReceiptUnfreeze
-	(*LocalUnfreeze)(nil),              // 8: types.LocalUnfreeze
-	(*ReplyQueryUnfreezeWithdraw)(nil), // 9: types.ReplyQueryUnfreezeWithdraw
-	(*ReqUnfreezes)(nil),               // 10: types.ReqUnfreezes
-	(*ReplyUnfreeze)(nil),              // 11: types.ReplyUnfreeze
-	(*ReplyUnfreezes)(nil),             // 12: types.ReplyUnfreezes
-	(*types.ReqString)(nil),            // 13: types.ReqString
+	(*CliffLinear)(nil),                // 3: types.CliffLinear
+	(*Tranche)(nil),                    // 4: types.Tranche
+	(*Tranches)(nil),                   // 5: types.Tranches
+	(*UnfreezeAction)(nil),             // 6: types.UnfreezeAction
+	(*UnfreezeCreate)(nil),             // 7: types.UnfreezeCreate
+	(*UnfreezeWithdraw)(nil),           // 8: types.UnfreezeWithdraw
+	(*UnfreezeTerminate)(nil),          // 9: types.UnfreezeTerminate
+	(*ReceiptUnfreeze)(nil),            // 10: types.ReceiptUnfreeze
+	(*LocalUnfreeze)(nil),              // 11: types.LocalUnfreeze
+	(*ReplyQueryUnfreezeWithdraw)(nil), // 12: types.ReplyQueryUnfreezeWithdraw
+	(*ReqUnfreezes)(nil),               // 13: types.ReqUnfreezes
+	(*ReplyUnfreeze)(nil),              // 14: types.ReplyUnfreeze
+	(*ReplyUnfreezes)(nil),             // 15: types.ReplyUnfreezes
+	(*ReqUnfreezeSchedule)(nil),        // 16: types.ReqUnfreezeSchedule
+	(*UnfreezeRelease)(nil),            // 17: types.UnfreezeRelease
+	(*ReplyUnfreezeSchedule)(nil),      // 18: types.ReplyUnfreezeSchedule
+	(*types.ReqString)(nil),            // 19: types.ReqString
 }
 var file_unfreeze_proto_depIdxs = []int32{
 	1,  // 0: types.Unfreeze.fixAmount:type_name -> types.FixAmount
 	2,  // 1: types.Unfreeze.leftProportion:type_name -> types.LeftProportion
-	4,  // 2: types.UnfreezeAction.create:type_name -> types.UnfreezeCreate
-	5,  // 3: types.UnfreezeAction.withdraw:type_name -> types.UnfreezeWithdraw
-	6,  // 4: types.UnfreezeAction.terminate:type_name -> types.UnfreezeTerminate
-	1,  // 5: types.UnfreezeCreate.fixAmount:type_name -> types.FixAmount
-	2,  // 6: types.UnfreezeCreate.leftProportion:type_name -> types.LeftProportion
-	0,  // 7: types.ReceiptUnfreeze.prev:type_name -> types.Unfreeze
-	0,  // 8: types.ReceiptUnfreeze.current:type_name -> types.Unfreeze
-	0,  // 9: types.LocalUnfreeze.unfreeze:type_name -> types.Unfreeze
-	1,  // 10: types.ReplyUnfreeze.fixAmount:type_name -> types.FixAmount
-	2,  // 11: types.ReplyUnfreeze.leftProportion:type_name -> types.LeftProportion
-	11, // 12: types.ReplyUnfreezes.unfreeze:type_name -> types.ReplyUnfreeze
-	13, // 13: types.unfreeze.GetUnfreezeWithdraw:input_type -> types.ReqString
-	13, // 14: types.unfreeze.QueryUnfreeze:input_type -> types.ReqString
-	9,  // 15: types.unfreeze.GetUnfreezeWithdraw:output_type -> types.ReplyQueryUnfreezeWithdraw
-	0,  // 16: types.unfreeze.QueryUnfreeze:output_type -> types.Unfreeze
-	15, // [15:17] is the sub-list for method output_type
-	13, // [13:15] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	3,  // 2: types.Unfreeze.cliffLinear:type_name -> types.CliffLinear
+	5,  // 3: types.Unfreeze.tranches:type_name -> types.Tranches
+	4,  // 4: types.Tranches.tranches:type_name -> types.Tranche
+	7,  // 5: types.UnfreezeAction.create:type_name -> types.UnfreezeCreate
+	8,  // 6: types.UnfreezeAction.withdraw:type_name -> types.UnfreezeWithdraw
+	9,  // 7: types.UnfreezeAction.terminate:type_name -> types.UnfreezeTerminate
+	1,  // 8: types.UnfreezeCreate.fixAmount:type_name -> types.FixAmount
+	2,  // 9: types.UnfreezeCreate.leftProportion:type_name -> types.LeftProportion
+	3,  // 10: types.UnfreezeCreate.cliffLinear:type_name -> types.CliffLinear
+	5,  // 11: types.UnfreezeCreate.tranches:type_name -> types.Tranches
+	0,  // 12: types.ReceiptUnfreeze.prev:type_name -> types.Unfreeze
+	0,  // 13: types.ReceiptUnfreeze.current:type_name -> types.Unfreeze
+	0,  // 14: types.LocalUnfreeze.unfreeze:type_name -> types.Unfreeze
+	1,  // 15: types.ReplyUnfreeze.fixAmount:type_name -> types.FixAmount
+	2,  // 16: types.ReplyUnfreeze.leftProportion:type_name -> types.LeftProportion
+	3,  // 17: types.ReplyUnfreeze.cliffLinear:type_name -> types.CliffLinear
+	5,  // 18: types.ReplyUnfreeze.tranches:type_name -> types.Tranches
+	14, // 19: types.ReplyUnfreezes.unfreeze:type_name -> types.ReplyUnfreeze
+	17, // 20: types.ReplyUnfreezeSchedule.releases:type_name -> types.UnfreezeRelease
+	19, // 21: types.unfreeze.GetUnfreezeWithdraw:input_type -> types.ReqString
+	19, // 22: types.unfreeze.QueryUnfreeze:input_type -> types.ReqString
+	16, // 23: types.unfreeze.GetUnfreezeSchedule:input_type -> types.ReqUnfreezeSchedule
+	12, // 24: types.unfreeze.GetUnfreezeWithdraw:output_type -> types.ReplyQueryUnfreezeWithdraw
+	0,  // 25: types.unfreeze.QueryUnfreeze:output_type -> types.Unfreeze
+	18, // 26: types.unfreeze.GetUnfreezeSchedule:output_type -> types.ReplyUnfreezeSchedule
+	24, // [24:27] is the sub-list for method output_type
+	21, // [21:24] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_unfreeze_proto_init() }
@@ -1358,7 +1858,7 @@ func file_unfreeze_proto_init() {
 			}
 		}
 		file_unfreeze_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnfreezeAction); i {
+			switch v := v.(*CliffLinear); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1370,7 +1870,7 @@ func file_unfreeze_proto_init() {
 			}
 		}
 		file_unfreeze_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnfreezeCreate); i {
+			switch v := v.(*Tranche); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1382,7 +1882,7 @@ func file_unfreeze_proto_init() {
 			}
 		}
 		file_unfreeze_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnfreezeWithdraw); i {
+			switch v := v.(*Tranches); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1394,7 +1894,7 @@ func file_unfreeze_proto_init() {
 			}
 		}
 		file_unfreeze_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnfreezeTerminate); i {
+			switch v := v.(*UnfreezeAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1406,7 +1906,7 @@ func file_unfreeze_proto_init() {
 			}
 		}
 		file_unfreeze_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptUnfreeze); i {
+			switch v := v.(*UnfreezeCreate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1418,7 +1918,7 @@ func file_unfreeze_proto_init() {
 			}
 		}
 		file_unfreeze_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocalUnfreeze); i {
+			switch v := v.(*UnfreezeWithdraw); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1430,7 +1930,7 @@ func file_unfreeze_proto_init() {
 			}
 		}
 		file_unfreeze_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyQueryUnfreezeWithdraw); i {
+			switch v := v.(*UnfreezeTerminate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1442,7 +1942,7 @@ func file_unfreeze_proto_init() {
 			}
 		}
 		file_unfreeze_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqUnfreezes); i {
+			switch v := v.(*ReceiptUnfreeze); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1454,7 +1954,7 @@ func file_unfreeze_proto_init() {
 			}
 		}
 		file_unfreeze_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyUnfreeze); i {
+			switch v := v.(*LocalUnfreeze); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1466,6 +1966,42 @@ func file_unfreeze_proto_init() {
 			}
 		}
 		file_unfreeze_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyQueryUnfreezeWithdraw); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_unfreeze_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqUnfreezes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_unfreeze_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyUnfreeze); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_unfreeze_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReplyUnfreezes); i {
 			case 0:
 				return &v.state
@@ -1477,23 +2013,65 @@ func file_unfreeze_proto_init() {
 				return nil
 			}
 		}
+		file_unfreeze_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqUnfreezeSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_unfreeze_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfreezeRelease); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_unfreeze_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyUnfreezeSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_unfreeze_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*Unfreeze_FixAmount)(nil),
 		(*Unfreeze_LeftProportion)(nil),
+		(*Unfreeze_CliffLinear)(nil),
+		(*Unfreeze_Tranches)(nil),
 	}
-	file_unfreeze_proto_msgTypes[3].OneofWrappers = []interface{}{
+	file_unfreeze_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*UnfreezeAction_Create)(nil),
 		(*UnfreezeAction_Withdraw)(nil),
 		(*UnfreezeAction_Terminate)(nil),
 	}
-	file_unfreeze_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_unfreeze_proto_msgTypes[7].OneofWrappers = []interface{}{
 		(*UnfreezeCreate_FixAmount)(nil),
 		(*UnfreezeCreate_LeftProportion)(nil),
+		(*UnfreezeCreate_CliffLinear)(nil),
+		(*UnfreezeCreate_Tranches)(nil),
 	}
-	file_unfreeze_proto_msgTypes[11].OneofWrappers = []interface{}{
+	file_unfreeze_proto_msgTypes[14].OneofWrappers = []interface{}{
 		(*ReplyUnfreeze_FixAmount)(nil),
 		(*ReplyUnfreeze_LeftProportion)(nil),
+		(*ReplyUnfreeze_CliffLinear)(nil),
+		(*ReplyUnfreeze_Tranches)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1501,7 +2079,7 @@ func file_unfreeze_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_unfreeze_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1529,6 +2107,7 @@ const _ = grpc.SupportPackageIsVersion6
 type UnfreezeClient interface {
 	GetUnfreezeWithdraw(ctx context.Context, in *types.ReqString, opts ...grpc.CallOption) (*ReplyQueryUnfreezeWithdraw, error)
 	QueryUnfreeze(ctx context.Context, in *types.ReqString, opts ...grpc.CallOption) (*Unfreeze, error)
+	GetUnfreezeSchedule(ctx context.Context, in *ReqUnfreezeSchedule, opts ...grpc.CallOption) (*ReplyUnfreezeSchedule, error)
 }
 
 type unfreezeClient struct {
@@ -1557,10 +2136,20 @@ func (c *unfreezeClient) QueryUnfreeze(ctx context.Context, in *types.ReqString,
 	return out, nil
 }
 
+func (c *unfreezeClient) GetUnfreezeSchedule(ctx context.Context, in *ReqUnfreezeSchedule, opts ...grpc.CallOption) (*ReplyUnfreezeSchedule, error) {
+	out := new(ReplyUnfreezeSchedule)
+	err := c.cc.Invoke(ctx, "/types.unfreeze/GetUnfreezeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UnfreezeServer is the server API for Unfreeze service.
 type UnfreezeServer interface {
 	GetUnfreezeWithdraw(context.Context, *types.ReqString) (*ReplyQueryUnfreezeWithdraw, error)
 	QueryUnfreeze(context.Context, *types.ReqString) (*Unfreeze, error)
+	GetUnfreezeSchedule(context.Context, *ReqUnfreezeSchedule) (*ReplyUnfreezeSchedule, error)
 }
 
 // UnimplementedUnfreezeServer can be embedded to have forward compatible implementations.
@@ -1573,6 +2162,9 @@ func (*UnimplementedUnfreezeServer) GetUnfreezeWithdraw(context.Context, *types.
 func (*UnimplementedUnfreezeServer) QueryUnfreeze(context.Context, *types.ReqString) (*Unfreeze, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryUnfreeze not implemented")
 }
+func (*UnimplementedUnfreezeServer) GetUnfreezeSchedule(context.Context, *ReqUnfreezeSchedule) (*ReplyUnfreezeSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnfreezeSchedule not implemented")
+}
 
 func RegisterUnfreezeServer(s *grpc.Server, srv UnfreezeServer) {
 	s.RegisterService(&_Unfreeze_serviceDesc, srv)
@@ -1614,6 +2206,24 @@ func _Unfreeze_QueryUnfreeze_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Unfreeze_GetUnfreezeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqUnfreezeSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnfreezeServer).GetUnfreezeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.unfreeze/GetUnfreezeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnfreezeServer).GetUnfreezeSchedule(ctx, req.(*ReqUnfreezeSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Unfreeze_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.unfreeze",
 	HandlerType: (*UnfreezeServer)(nil),
@@ -1626,6 +2236,10 @@ var _Unfreeze_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryUnfreeze",
 			Handler:    _Unfreeze_QueryUnfreeze_Handler,
 		},
+		{
+			MethodName: "GetUnfreezeSchedule",
+			Handler:    _Unfreeze_GetUnfreezeSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "unfreeze.proto",
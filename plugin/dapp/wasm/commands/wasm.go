@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"os"
 
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	"github.com/33cn/chain33/types"
@@ -78,6 +79,7 @@ func cmdCallContract() *cobra.Command {
 	cmd.Flags().StringP("method", "m", "", "method name")
 	cmd.Flags().IntSliceP("parameters", "p", nil, "parameters of the method which should be num")
 	cmd.Flags().StringSliceP("env", "v", nil, "string parameters set to environment")
+	cmd.Flags().StringSliceP("input", "i", nil, "encoded input args in type:value format, type can be int, string or bytes(hex), such as int:1,string:abc,bytes:0x01")
 	_ = cmd.MarkFlagRequired("name")
 	_ = cmd.MarkFlagRequired("method")
 	return cmd
@@ -93,6 +95,7 @@ func cmdQueryContract() *cobra.Command {
 	cmd.AddCommand(
 		cmdQueryStateDB(),
 		cmdQueryLocalDB(),
+		cmdQueryCall(),
 	)
 
 	return cmd
@@ -124,6 +127,23 @@ func cmdQueryLocalDB() *cobra.Command {
 	return cmd
 }
 
+func cmdQueryCall() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call",
+		Short: "call contract method without sending transaction",
+		Run:   queryCall,
+	}
+	cmd.Flags().StringP("name", "n", "", "contract name")
+	cmd.Flags().StringP("method", "m", "", "method name")
+	cmd.Flags().IntSliceP("parameters", "p", nil, "parameters of the method which should be num")
+	cmd.Flags().StringSliceP("env", "v", nil, "string parameters set to environment")
+	cmd.Flags().StringSliceP("input", "i", nil, "encoded input args in type:value format, type can be int, string or bytes(hex), such as int:1,string:abc,bytes:0x01")
+	cmd.Flags().StringP("from", "f", "", "caller address")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("method")
+	return cmd
+}
+
 func createContract(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	name, _ := cmd.Flags().GetString("name")
@@ -180,16 +200,23 @@ func callContract(cmd *cobra.Command, args []string) {
 	method, _ := cmd.Flags().GetString("method")
 	parameters, _ := cmd.Flags().GetIntSlice("parameters")
 	env, _ := cmd.Flags().GetStringSlice("env")
+	inputArgs, _ := cmd.Flags().GetStringSlice("input")
 	var parameters2 []int64
 	for _, param := range parameters {
 		parameters2 = append(parameters2, int64(param))
 	}
+	input, err := wasmtypes.ParseArgs(inputArgs)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
 
 	payload := wasmtypes.WasmCall{
 		Contract:   name,
 		Method:     method,
 		Parameters: parameters2,
 		Env:        env,
+		Input:      input,
 	}
 	params := rpctypes.CreateTxIn{
 		Execer:     wasmtypes.WasmX,
@@ -254,3 +281,63 @@ func queryLocalDB(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &resp)
 	ctx.Run()
 }
+
+// queryCallResult 只读调用的返回结果，output按照"类型:值"格式解码，无法解码时为十六进制
+type queryCallResult struct {
+	Result int64    `json:"result"`
+	Output []string `json:"output,omitempty"`
+	Logs   []string `json:"logs,omitempty"`
+}
+
+func queryCall(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	name, _ := cmd.Flags().GetString("name")
+	method, _ := cmd.Flags().GetString("method")
+	parameters, _ := cmd.Flags().GetIntSlice("parameters")
+	env, _ := cmd.Flags().GetStringSlice("env")
+	inputArgs, _ := cmd.Flags().GetStringSlice("input")
+	from, _ := cmd.Flags().GetString("from")
+	var parameters2 []int64
+	for _, param := range parameters {
+		parameters2 = append(parameters2, int64(param))
+	}
+	input, err := wasmtypes.ParseArgs(inputArgs)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	params := rpctypes.Query4Jrpc{
+		Execer:   wasmtypes.WasmX,
+		FuncName: "CallContract",
+		Payload: types.MustPBToJSON(&wasmtypes.QueryContractCall{
+			Contract:   name,
+			Method:     method,
+			Parameters: parameters2,
+			Env:        env,
+			Input:      input,
+			From:       from,
+		}),
+	}
+
+	var resp wasmtypes.QueryContractCallResult
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &resp)
+	ctx.SetResultCb(parseQueryCallResult)
+	ctx.Run()
+}
+
+func parseQueryCallResult(arg interface{}) (interface{}, error) {
+	resp := arg.(*wasmtypes.QueryContractCallResult)
+	result := &queryCallResult{
+		Result: resp.Result,
+		Logs:   resp.Logs,
+	}
+	if len(resp.Output) > 0 {
+		output, err := wasmtypes.FormatArgs(resp.Output)
+		if err != nil {
+			output = []string{common.ToHex(resp.Output)}
+		}
+		result.Output = output
+	}
+	return result, nil
+}
@@ -27,12 +27,34 @@ int execActive(const char* addr, size_t addr_len, int64_t amount);
 int execTransfer(const char* from_addr, size_t from_len, const char* to_addr, size_t to_len, int64_t amount);
 int execTransferFrozen(const char* from_addr, size_t from_len, const char* to_addr, size_t to_len, int64_t amount);
 
+int64_t getAssetBalance(const char* asset_exec, size_t asset_exec_len, const char* symbol, size_t symbol_len, const char* addr, size_t addr_len, const char* exec_addr, size_t exec_len);
+int64_t getAssetFrozen(const char* asset_exec, size_t asset_exec_len, const char* symbol, size_t symbol_len, const char* addr, size_t addr_len, const char* exec_addr, size_t exec_len);
+int transferAsset(const char* asset_exec, size_t asset_exec_len, const char* symbol, size_t symbol_len, const char* from_addr, size_t from_len, const char* to_addr, size_t to_len, int64_t amount);
+int transferAssetToExec(const char* asset_exec, size_t asset_exec_len, const char* symbol, size_t symbol_len, const char* from_addr, size_t from_len, const char* exec_addr, size_t exec_len, int64_t amount);
+int transferAssetWithdraw(const char* asset_exec, size_t asset_exec_len, const char* symbol, size_t symbol_len, const char* from_addr, size_t from_len, const char* exec_addr, size_t exec_len, int64_t amount);
+int execFrozenAsset(const char* asset_exec, size_t asset_exec_len, const char* symbol, size_t symbol_len, const char* addr, size_t addr_len, int64_t amount);
+int execActiveAsset(const char* asset_exec, size_t asset_exec_len, const char* symbol, size_t symbol_len, const char* addr, size_t addr_len, int64_t amount);
+int execTransferAsset(const char* asset_exec, size_t asset_exec_len, const char* symbol, size_t symbol_len, const char* from_addr, size_t from_len, const char* to_addr, size_t to_len, int64_t amount);
+int execTransferFrozenAsset(const char* asset_exec, size_t asset_exec_len, const char* symbol, size_t symbol_len, const char* from_addr, size_t from_len, const char* to_addr, size_t to_len, int64_t amount);
+
+// 调用其他合约的导出方法，返回被调用方法的返回值，返回负值时被调用合约的所有修改都会回滚
+int64_t callContract(const char* name, size_t name_len, const char* method, size_t method_len, const int64_t* params, size_t params_len);
+
+// 交易发起者地址，跨合约调用中被调用合约得到的同样是交易发起者
 void getFrom(const char* from_addr, size_t from_len);
+// 调用当前合约的合约名，返回合约名长度，交易直接调用时返回0
+size_t getCaller(char* caller, size_t caller_len);
 int64_t getHeight();
 int64_t getRandom();
 void sha256(const char* data, size_t data_len, char* sum, size_t sum_len);
 void printlog(const char* log, size_t len);
 void printint(int64_t n);
+// 读取交易或查询中编码后的字节参数，编码格式：每项为1字节类型(1:int64,2:string,3:bytes)加数据，
+// int64为8字节小端序，string和bytes为4字节小端序长度加原始数据
+int64_t getInputSize();
+int64_t getInput(char* value, size_t v_len);
+// 设置返回数据，编码格式与参数相同
+int setOutput(const char* value, size_t v_len);
 size_t getENVSize(int64_t n);
 size_t getENV(int64_t n, char* value, size_t v_len);
 size_t totalENV();
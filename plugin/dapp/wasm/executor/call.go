@@ -0,0 +1,134 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	types2 "github.com/33cn/plugin/plugin/dapp/wasm/types"
+	"github.com/perlin-network/life/exec"
+)
+
+// callStateDB 缓存被调用合约的状态写入，调用成功后按顺序写回上层状态，失败时直接丢弃
+type callStateDB struct {
+	parent db.KV
+	cache  map[string][]byte
+	kvs    []*types.KeyValue
+}
+
+func newCallStateDB(parent db.KV) *callStateDB {
+	return &callStateDB{parent: parent, cache: make(map[string][]byte)}
+}
+
+// Get 优先读取本次调用的写入
+func (c *callStateDB) Get(key []byte) ([]byte, error) {
+	if value, ok := c.cache[string(key)]; ok {
+		if value == nil {
+			return nil, types.ErrNotFound
+		}
+		return value, nil
+	}
+	return c.parent.Get(key)
+}
+
+// Set 写入缓存
+func (c *callStateDB) Set(key []byte, value []byte) error {
+	c.cache[string(key)] = value
+	c.kvs = append(c.kvs, &types.KeyValue{Key: key, Value: value})
+	return nil
+}
+
+// Begin 不支持事务
+func (c *callStateDB) Begin() {}
+
+// Commit 不支持事务
+func (c *callStateDB) Commit() error { return nil }
+
+// Rollback 不支持事务
+func (c *callStateDB) Rollback() {}
+
+func (c *callStateDB) flush() error {
+	for _, kv := range c.kvs {
+		if err := c.parent.Set(kv.Key, kv.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// callContract 在当前交易中调用其他合约的方法，被调用合约使用调用方剩余的gas
+// 被调用合约执行出错或者返回负值时，其状态写入、转账和日志全部回滚
+func callContract(caller *exec.VirtualMachine, contract, method string, params []int64) (int64, error) {
+	w := wasmCB
+	if len(w.callStack)+1 >= types2.MaxCallDepth {
+		return -1, types2.ErrCallDepth
+	}
+	if contract == w.contractName {
+		return -1, types2.ErrReentrantCall
+	}
+	for _, name := range w.callStack {
+		if name == contract {
+			return -1, types2.ErrReentrantCall
+		}
+	}
+	var gasLimit uint64
+	if caller.Config.GasLimit != 0 {
+		if caller.Gas >= caller.Config.GasLimit {
+			return -1, types2.ErrOutOfGas
+		}
+		gasLimit = caller.Config.GasLimit - caller.Gas
+	}
+	vm, err := w.loadVM(contract, gasLimit)
+	if err != nil {
+		return -1, err
+	}
+	entryID, ok := vm.GetFunctionExport(method)
+	if !ok {
+		return -1, types2.ErrInvalidMethod
+	}
+
+	// 保存调用方上下文
+	parentDB, stateKVC, contractName := w.GetStateDB(), w.stateKVC, w.contractName
+	input, output := w.input, w.output
+	nKVs, nLogs, nCustomLogs, nLocal := len(w.kvs), len(w.receiptLogs), len(w.customLogs), len(w.localCache)
+	stateDB := newCallStateDB(parentDB)
+	w.SetStateDB(stateDB)
+	w.stateKVC = dapp.NewKVCreator(stateDB, calcStatePrefix(contract), nil)
+	w.callStack = append(w.callStack, contractName)
+	w.contractName = contract
+	w.input, w.output = nil, nil
+	defer func() {
+		w.SetStateDB(parentDB)
+		w.stateKVC = stateKVC
+		w.contractName = contractName
+		w.input, w.output = input, output
+		w.callStack = w.callStack[:len(w.callStack)-1]
+	}()
+
+	ret, err := vm.RunWithGasLimit(entryID, int(gasLimit), params...)
+	caller.AddAndCheckGas(vm.Gas)
+	if err == nil && (int32(ret) < 0 || int16(ret) < 0) {
+		err = types2.ErrCallFailed
+	}
+	if err == nil {
+		err = stateDB.flush()
+	}
+	if err != nil {
+		log.Error("callContract", "contract", contract, "method", method, "ret", ret, "error", err)
+		w.kvs = w.kvs[:nKVs]
+		w.receiptLogs = w.receiptLogs[:nLogs]
+		w.customLogs = w.customLogs[:nCustomLogs]
+		w.localCache = w.localCache[:nLocal]
+		if ret >= 0 {
+			ret = -1
+		}
+		return ret, err
+	}
+	w.kvs = append(w.kvs, w.stateKVC.KVList()...)
+	w.receiptLogs = append(w.receiptLogs, &types.ReceiptLog{Ty: types2.TyLogWasmCall, Log: types.Encode(&types2.CallContractLog{
+		Contract: contract,
+		Method:   method,
+		Result:   int32(ret),
+		Output:   w.output,
+	})})
+	return ret, nil
+}
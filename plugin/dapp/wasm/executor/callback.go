@@ -1,6 +1,7 @@
 package executor
 
 import (
+	"github.com/33cn/chain33/account"
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/types"
@@ -53,7 +54,62 @@ func getLocalDB(key []byte) ([]byte, error) {
 
 //account wrapper
 func getBalance(addr, execer string) (balance, frozen int64, err error) {
-	accounts, err := wasmCB.GetCoinsAccount().GetBalance(wasmCB.GetAPI(), &types.ReqBalance{
+	return getAssetBalance("", "", addr, execer)
+}
+
+func transfer(from, to string, amount int64) error {
+	return transferAsset("", "", from, to, amount)
+}
+
+func transferToExec(addr, execaddr string, amount int64) error {
+	return transferAssetToExec("", "", addr, execaddr, amount)
+}
+
+func transferWithdraw(addr, execaddr string, amount int64) error {
+	return transferAssetWithdraw("", "", addr, execaddr, amount)
+}
+
+func execFrozen(addr string, amount int64) error {
+	return execFrozenAsset("", "", addr, amount)
+}
+
+func execActive(addr string, amount int64) error {
+	return execActiveAsset("", "", addr, amount)
+}
+
+func execTransfer(from, to string, amount int64) error {
+	return execTransferAsset("", "", from, to, amount)
+}
+
+func execTransferFrozen(from, to string, amount int64) error {
+	return execTransferFrozenAsset("", "", from, to, amount)
+}
+
+//isCallContractEnabled 跨合约调用和多资产转账需要在分叉高度之后才能使用
+func isCallContractEnabled() bool {
+	cfg := wasmCB.GetAPI().GetConfig()
+	return cfg.IsDappFork(wasmCB.GetHeight(), types2.WasmX, types2.ForkCallContract)
+}
+
+//asset account wrapper
+//getAssetAccount 获取资产账户，assetExec和symbol都为空时使用主链币账户
+func getAssetAccount(assetExec, symbol string) (*account.DB, error) {
+	if assetExec == "" && symbol == "" {
+		return wasmCB.GetCoinsAccount(), nil
+	}
+	cfg := wasmCB.GetAPI().GetConfig()
+	if assetExec == cfg.GetCoinExec() && symbol == cfg.GetCoinSymbol() {
+		return wasmCB.GetCoinsAccount(), nil
+	}
+	return account.NewAccountDB(cfg, assetExec, symbol, wasmCB.GetStateDB())
+}
+
+func getAssetBalance(assetExec, symbol, addr, execer string) (balance, frozen int64, err error) {
+	acc, err := getAssetAccount(assetExec, symbol)
+	if err != nil {
+		return -1, -1, err
+	}
+	accounts, err := acc.GetBalance(wasmCB.GetAPI(), &types.ReqBalance{
 		Addresses: []string{addr},
 		Execer:    execer,
 	})
@@ -63,8 +119,12 @@ func getBalance(addr, execer string) (balance, frozen int64, err error) {
 	return accounts[0].Balance, accounts[0].Frozen, nil
 }
 
-func transfer(from, to string, amount int64) error {
-	receipt, err := wasmCB.GetCoinsAccount().Transfer(from, to, amount)
+func transferAsset(assetExec, symbol, from, to string, amount int64) error {
+	acc, err := getAssetAccount(assetExec, symbol)
+	if err != nil {
+		return err
+	}
+	receipt, err := acc.Transfer(from, to, amount)
 	if err != nil {
 		return err
 	}
@@ -72,8 +132,12 @@ func transfer(from, to string, amount int64) error {
 	return nil
 }
 
-func transferToExec(addr, execaddr string, amount int64) error {
-	receipt, err := wasmCB.GetCoinsAccount().TransferToExec(addr, execaddr, amount)
+func transferAssetToExec(assetExec, symbol, addr, execaddr string, amount int64) error {
+	acc, err := getAssetAccount(assetExec, symbol)
+	if err != nil {
+		return err
+	}
+	receipt, err := acc.TransferToExec(addr, execaddr, amount)
 	if err != nil {
 		return err
 	}
@@ -81,8 +145,12 @@ func transferToExec(addr, execaddr string, amount int64) error {
 	return nil
 }
 
-func transferWithdraw(addr, execaddr string, amount int64) error {
-	receipt, err := wasmCB.GetCoinsAccount().TransferWithdraw(addr, execaddr, amount)
+func transferAssetWithdraw(assetExec, symbol, addr, execaddr string, amount int64) error {
+	acc, err := getAssetAccount(assetExec, symbol)
+	if err != nil {
+		return err
+	}
+	receipt, err := acc.TransferWithdraw(addr, execaddr, amount)
 	if err != nil {
 		return err
 	}
@@ -90,8 +158,12 @@ func transferWithdraw(addr, execaddr string, amount int64) error {
 	return nil
 }
 
-func execFrozen(addr string, amount int64) error {
-	receipt, err := wasmCB.GetCoinsAccount().ExecFrozen(addr, wasmCB.execAddr, amount)
+func execFrozenAsset(assetExec, symbol, addr string, amount int64) error {
+	acc, err := getAssetAccount(assetExec, symbol)
+	if err != nil {
+		return err
+	}
+	receipt, err := acc.ExecFrozen(addr, wasmCB.execAddr, amount)
 	if err != nil {
 		log.Error("execFrozen", "error", err)
 		return err
@@ -101,8 +173,12 @@ func execFrozen(addr string, amount int64) error {
 	return nil
 }
 
-func execActive(addr string, amount int64) error {
-	receipt, err := wasmCB.GetCoinsAccount().ExecActive(addr, wasmCB.execAddr, amount)
+func execActiveAsset(assetExec, symbol, addr string, amount int64) error {
+	acc, err := getAssetAccount(assetExec, symbol)
+	if err != nil {
+		return err
+	}
+	receipt, err := acc.ExecActive(addr, wasmCB.execAddr, amount)
 	if err != nil {
 		return err
 	}
@@ -111,8 +187,12 @@ func execActive(addr string, amount int64) error {
 	return nil
 }
 
-func execTransfer(from, to string, amount int64) error {
-	receipt, err := wasmCB.GetCoinsAccount().ExecTransfer(from, to, wasmCB.execAddr, amount)
+func execTransferAsset(assetExec, symbol, from, to string, amount int64) error {
+	acc, err := getAssetAccount(assetExec, symbol)
+	if err != nil {
+		return err
+	}
+	receipt, err := acc.ExecTransfer(from, to, wasmCB.execAddr, amount)
 	if err != nil {
 		return err
 	}
@@ -121,8 +201,12 @@ func execTransfer(from, to string, amount int64) error {
 	return nil
 }
 
-func execTransferFrozen(from, to string, amount int64) error {
-	receipt, err := wasmCB.GetCoinsAccount().ExecTransferFrozen(from, to, wasmCB.execAddr, amount)
+func execTransferFrozenAsset(assetExec, symbol, from, to string, amount int64) error {
+	acc, err := getAssetAccount(assetExec, symbol)
+	if err != nil {
+		return err
+	}
+	receipt, err := acc.ExecTransferFrozen(from, to, wasmCB.execAddr, amount)
 	if err != nil {
 		return err
 	}
@@ -135,17 +219,28 @@ func execAddress(name string) string {
 	return address.ExecAddress(name)
 }
 
+// getFrom 返回交易发起者地址，跨合约调用中被调用合约得到的同样是交易发起者
 func getFrom() string {
-	return wasmCB.tx.From()
+	return wasmCB.from
+}
+
+// getCaller 返回调用当前合约的合约名，交易或查询直接调用时返回空
+func getCaller() string {
+	if len(wasmCB.callStack) == 0 {
+		return ""
+	}
+	return wasmCB.callStack[len(wasmCB.callStack)-1]
 }
 
 func getHeight() int64 {
 	return wasmCB.GetHeight()
 }
 
+//getRandom 按上一区块高度查询随机数，保证重放区块时结果一致
 func getRandom() int64 {
 	req := &types.ReqRandHash{
-		ExecName: "ticket",
+		ExecName: subCfg.RandExecName,
+		Height:   wasmCB.GetHeight() - 1,
 		BlockNum: 5,
 		Hash:     wasmCB.GetLastHash(),
 	}
@@ -171,6 +266,20 @@ func sha256(data []byte) []byte {
 	return common.Sha256(data)
 }
 
+//isCallInputEnabled 字节参数和返回数据需要在分叉高度之后才能使用
+func isCallInputEnabled() bool {
+	cfg := wasmCB.GetAPI().GetConfig()
+	return cfg.IsDappFork(wasmCB.GetHeight(), types2.WasmX, types2.ForkCallInput)
+}
+
+func getInput() []byte {
+	return wasmCB.input
+}
+
+func setOutput(data []byte) {
+	wasmCB.output = data
+}
+
 func getENVSize(n int) int {
 	return len(wasmCB.ENV[n])
 }
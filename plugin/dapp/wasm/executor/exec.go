@@ -2,6 +2,7 @@ package executor
 
 import (
 	"encoding/hex"
+	"sync"
 
 	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/system/dapp"
@@ -14,6 +15,9 @@ import (
 
 var wasmCB *Wasm
 
+// wasmLock 回调函数通过全局的wasmCB访问执行器，合约执行和只读调用需要互斥
+var wasmLock sync.Mutex
+
 func (w *Wasm) userExecName(name string, local bool) string {
 	execer := "user." + types2.WasmX + "." + name
 	if local {
@@ -132,27 +136,12 @@ func (w *Wasm) Exec_Call(payload *types2.WasmCall, tx *types.Transaction, index
 		return nil, types.ErrExecNameNotMatch
 	}
 
+	wasmLock.Lock()
+	defer wasmLock.Unlock()
 	w.stateKVC = dapp.NewKVCreator(w.GetStateDB(), calcStatePrefix(payload.Contract), nil)
-	var vm *exec.VirtualMachine
-	var ok bool
-	if vm, ok = w.VMCache[payload.Contract]; !ok {
-		code, err := w.stateKVC.GetNoPrefix(contractKey(payload.Contract))
-		if err != nil {
-			return nil, err
-		}
-		vm, err = exec.NewVirtualMachine(code, exec.VMConfig{
-			DefaultMemoryPages:   128,
-			DefaultTableSize:     128,
-			DisableFloatingPoint: true,
-			GasLimit:             uint64(tx.Fee),
-		}, new(Resolver), &compiler.SimpleGasPolicy{GasPerInstruction: 1})
-		if err != nil {
-			return nil, err
-		}
-		w.VMCache[payload.Contract] = vm
-	} else {
-		vm.Config.GasLimit = uint64(tx.Fee)
-		vm.Gas = 0
+	vm, err := w.loadVM(payload.Contract, uint64(tx.Fee))
+	if err != nil {
+		return nil, err
 	}
 
 	// Get the function ID of the entry function to be executed.
@@ -163,12 +152,16 @@ func (w *Wasm) Exec_Call(payload *types2.WasmCall, tx *types.Transaction, index
 
 	w.contractName = payload.Contract
 	w.tx = tx
+	w.from = tx.From()
+	w.input = payload.Input
+	w.output = nil
 	w.execAddr = address.ExecAddress(string(types.GetRealExecName(tx.Execer)))
 	w.ENV = make(map[int]string)
 	w.localCache = nil
 	w.kvs = nil
 	w.receiptLogs = nil
 	w.customLogs = nil
+	w.callStack = nil
 	for i, v := range payload.Env {
 		w.ENV[i] = v
 	}
@@ -190,6 +183,7 @@ func (w *Wasm) Exec_Call(payload *types2.WasmCall, tx *types.Transaction, index
 		Contract: payload.Contract,
 		Method:   payload.Method,
 		Result:   int32(ret),
+		Output:   w.output,
 	})})
 	logs = append(logs, w.receiptLogs...)
 	logs = append(logs, &types.ReceiptLog{Ty: types2.TyLogCustom, Log: types.Encode(&types2.CustomLog{
@@ -214,6 +208,30 @@ func (w *Wasm) Exec_Call(payload *types2.WasmCall, tx *types.Transaction, index
 	return receipt, nil
 }
 
+// loadVM 获取合约的虚拟机，优先使用缓存；执行出错的虚拟机无法再次运行，需要重新创建
+func (w *Wasm) loadVM(contract string, gasLimit uint64) (*exec.VirtualMachine, error) {
+	if vm, ok := w.VMCache[contract]; ok && vm.ExitError == nil {
+		vm.Config.GasLimit = gasLimit
+		vm.Gas = 0
+		return vm, nil
+	}
+	code, err := w.GetStateDB().Get(contractKey(contract))
+	if err != nil {
+		return nil, err
+	}
+	vm, err := exec.NewVirtualMachine(code, exec.VMConfig{
+		DefaultMemoryPages:   128,
+		DefaultTableSize:     128,
+		DisableFloatingPoint: true,
+		GasLimit:             gasLimit,
+	}, new(Resolver), &compiler.SimpleGasPolicy{GasPerInstruction: 1})
+	if err != nil {
+		return nil, err
+	}
+	w.VMCache[contract] = vm
+	return vm, nil
+}
+
 func validateName(name string) bool {
 	if !types2.NameReg.MatchString(name) || len(name) < 4 || len(name) > 20 {
 		return false
@@ -1,6 +1,8 @@
 package executor
 
 import (
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
 	types2 "github.com/33cn/plugin/plugin/dapp/wasm/types"
 )
@@ -42,3 +44,56 @@ func (w *Wasm) Query_QueryLocalDB(query *types2.QueryContractDB) (types.Message,
 	}
 	return &types.ReplyString{Data: string(v)}, nil
 }
+
+// Query_CallContract 只读调用合约方法，执行过程中的状态修改和转账都不会保存
+func (w *Wasm) Query_CallContract(query *types2.QueryContractCall) (types.Message, error) {
+	if query == nil {
+		return nil, types.ErrInvalidParam
+	}
+	wasmLock.Lock()
+	defer wasmLock.Unlock()
+
+	parentDB := w.GetStateDB()
+	stateDB := newCallStateDB(parentDB)
+	w.SetStateDB(stateDB)
+	defer w.SetStateDB(parentDB)
+	w.stateKVC = dapp.NewKVCreator(stateDB, calcStatePrefix(query.Contract), nil)
+	vm, err := w.loadVM(query.Contract, uint64(subCfg.QueryGasLimit))
+	if err != nil {
+		return nil, err
+	}
+	entryID, ok := vm.GetFunctionExport(query.Method)
+	if !ok {
+		return nil, types2.ErrInvalidMethod
+	}
+
+	cfg := w.GetAPI().GetConfig()
+	w.contractName = query.Contract
+	w.tx = nil
+	w.from = query.From
+	w.input = query.Input
+	w.output = nil
+	w.execAddr = address.ExecAddress(cfg.ExecName(types2.WasmX))
+	w.ENV = make(map[int]string)
+	w.localCache = nil
+	w.kvs = nil
+	w.receiptLogs = nil
+	w.customLogs = nil
+	w.callStack = nil
+	for i, v := range query.Env {
+		w.ENV[i] = v
+	}
+	wasmCB = w
+	defer func() {
+		wasmCB = nil
+	}()
+	ret, err := vm.RunWithGasLimit(entryID, int(subCfg.QueryGasLimit), query.Parameters...)
+	if err != nil {
+		return nil, err
+	}
+	return &types2.QueryContractCallResult{
+		Result: ret,
+		Output: w.output,
+		Logs:   w.customLogs,
+	}, nil
+}
@@ -1,6 +1,7 @@
 package executor
 
 import (
+	"encoding/binary"
 	"fmt"
 	"strconv"
 
@@ -176,6 +177,136 @@ func (r *Resolver) ResolveFunc(module, field string) exec.FunctionImport {
 				return 0
 			}
 
+		case "getAssetBalance":
+			return func(vm *exec.VirtualMachine) int64 {
+				if !isCallContractEnabled() {
+					return -1
+				}
+				balance, _, err := getAssetBalance(readString(vm, 0), readString(vm, 2), readString(vm, 4), readString(vm, 6))
+				if err != nil {
+					return -1
+				}
+				return balance
+			}
+
+		case "getAssetFrozen":
+			return func(vm *exec.VirtualMachine) int64 {
+				if !isCallContractEnabled() {
+					return -1
+				}
+				_, frozen, err := getAssetBalance(readString(vm, 0), readString(vm, 2), readString(vm, 4), readString(vm, 6))
+				if err != nil {
+					return -1
+				}
+				return frozen
+			}
+
+		case "transferAsset":
+			return func(vm *exec.VirtualMachine) int64 {
+				if !isCallContractEnabled() {
+					return -1
+				}
+				amount := vm.GetCurrentFrame().Locals[8]
+				err := transferAsset(readString(vm, 0), readString(vm, 2), readString(vm, 4), readString(vm, 6), amount)
+				if err != nil {
+					return -1
+				}
+				return 0
+			}
+
+		case "transferAssetToExec":
+			return func(vm *exec.VirtualMachine) int64 {
+				if !isCallContractEnabled() {
+					return -1
+				}
+				amount := vm.GetCurrentFrame().Locals[8]
+				err := transferAssetToExec(readString(vm, 0), readString(vm, 2), readString(vm, 4), readString(vm, 6), amount)
+				if err != nil {
+					return -1
+				}
+				return 0
+			}
+
+		case "transferAssetWithdraw":
+			return func(vm *exec.VirtualMachine) int64 {
+				if !isCallContractEnabled() {
+					return -1
+				}
+				amount := vm.GetCurrentFrame().Locals[8]
+				err := transferAssetWithdraw(readString(vm, 0), readString(vm, 2), readString(vm, 4), readString(vm, 6), amount)
+				if err != nil {
+					return -1
+				}
+				return 0
+			}
+
+		case "execFrozenAsset":
+			return func(vm *exec.VirtualMachine) int64 {
+				if !isCallContractEnabled() {
+					return -1
+				}
+				amount := vm.GetCurrentFrame().Locals[6]
+				err := execFrozenAsset(readString(vm, 0), readString(vm, 2), readString(vm, 4), amount)
+				if err != nil {
+					return -1
+				}
+				return 0
+			}
+
+		case "execActiveAsset":
+			return func(vm *exec.VirtualMachine) int64 {
+				if !isCallContractEnabled() {
+					return -1
+				}
+				amount := vm.GetCurrentFrame().Locals[6]
+				err := execActiveAsset(readString(vm, 0), readString(vm, 2), readString(vm, 4), amount)
+				if err != nil {
+					return -1
+				}
+				return 0
+			}
+
+		case "execTransferAsset":
+			return func(vm *exec.VirtualMachine) int64 {
+				if !isCallContractEnabled() {
+					return -1
+				}
+				amount := vm.GetCurrentFrame().Locals[8]
+				err := execTransferAsset(readString(vm, 0), readString(vm, 2), readString(vm, 4), readString(vm, 6), amount)
+				if err != nil {
+					return -1
+				}
+				return 0
+			}
+
+		case "execTransferFrozenAsset":
+			return func(vm *exec.VirtualMachine) int64 {
+				if !isCallContractEnabled() {
+					return -1
+				}
+				amount := vm.GetCurrentFrame().Locals[8]
+				err := execTransferFrozenAsset(readString(vm, 0), readString(vm, 2), readString(vm, 4), readString(vm, 6), amount)
+				if err != nil {
+					return -1
+				}
+				return 0
+			}
+
+		case "callContract":
+			return func(vm *exec.VirtualMachine) int64 {
+				if !isCallContractEnabled() {
+					return -1
+				}
+				paramsPtr := int(uint32(vm.GetCurrentFrame().Locals[4]))
+				paramsLen := int(uint32(vm.GetCurrentFrame().Locals[5]))
+				params := make([]int64, paramsLen)
+				for i := range params {
+					params[i] = int64(binary.LittleEndian.Uint64(vm.Memory[paramsPtr+i*8 : paramsPtr+i*8+8]))
+				}
+				ret, _ := callContract(vm, readString(vm, 0), readString(vm, 2), params)
+				return ret
+			}
+
 		case "execAddress":
 			return func(vm *exec.VirtualMachine) int64 {
 				namePtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
@@ -255,6 +386,15 @@ func (r *Resolver) ResolveFunc(module, field string) exec.FunctionImport {
 				return 0
 			}
 
+		case "getCaller":
+			return func(vm *exec.VirtualMachine) int64 {
+				callerPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
+				callerLen := int(uint32(vm.GetCurrentFrame().Locals[1]))
+				caller := getCaller()
+				copy(vm.Memory[callerPtr:callerPtr+callerLen], caller)
+				return int64(len(caller))
+			}
+
 		case "getHeight":
 			return func(vm *exec.VirtualMachine) int64 { return getHeight() }
 
@@ -287,6 +427,37 @@ func (r *Resolver) ResolveFunc(module, field string) exec.FunctionImport {
 				copy(vm.Memory[sumPtr:sumPtr+sumLen], sha256(data))
 				return 0
 			}
+		case "getInputSize":
+			return func(vm *exec.VirtualMachine) int64 {
+				if !isCallInputEnabled() {
+					return -1
+				}
+				return int64(len(getInput()))
+			}
+
+		case "getInput":
+			return func(vm *exec.VirtualMachine) int64 {
+				if !isCallInputEnabled() {
+					return -1
+				}
+				valuePtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
+				valueLen := int(uint32(vm.GetCurrentFrame().Locals[1]))
+				return int64(copy(vm.Memory[valuePtr:valuePtr+valueLen], getInput()))
+			}
+
+		case "setOutput":
+			return func(vm *exec.VirtualMachine) int64 {
+				if !isCallInputEnabled() {
+					return -1
+				}
+				valuePtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
+				valueLen := int(uint32(vm.GetCurrentFrame().Locals[1]))
+				value := make([]byte, valueLen)
+				copy(value, vm.Memory[valuePtr:valuePtr+valueLen])
+				setOutput(value)
+				return 0
+			}
+
 		case "getENVSize":
 			return func(vm *exec.VirtualMachine) int64 {
 				n := vm.GetCurrentFrame().Locals[0]
@@ -317,6 +488,13 @@ func (r *Resolver) ResolveFunc(module, field string) exec.FunctionImport {
 	return nil
 }
 
+// readString 读取第i个参数指针和第i+1个参数长度指定的字符串
+func readString(vm *exec.VirtualMachine, i int) string {
+	ptr := int(uint32(vm.GetCurrentFrame().Locals[i]))
+	length := int(uint32(vm.GetCurrentFrame().Locals[i+1]))
+	return string(vm.Memory[ptr : ptr+length])
+}
+
 // ResolveGlobal defines a set of global variables for use within a WebAssembly module.
 func (r *Resolver) ResolveGlobal(module, field string) int64 {
 	fmt.Printf("Resolve global: %s %s\n", module, field)
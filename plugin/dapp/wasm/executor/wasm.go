@@ -12,10 +12,22 @@ import (
 var driverName = types2.WasmX
 var log = log15.New("module", "execs."+types2.WasmX)
 
+type subConfig struct {
+	// 随机数来源执行器，需支持RandNumHash查询，默认ticket
+	RandExecName string `json:"randExecName"`
+	// 只读调用合约时的gas上限
+	QueryGasLimit int64 `json:"queryGasLimit"`
+}
+
+var subCfg = subConfig{RandExecName: "ticket", QueryGasLimit: 1e8}
+
 func Init(name string, cfg *types.Chain33Config, sub []byte) {
 	if name != driverName {
 		panic("system dapp can not be rename")
 	}
+	if sub != nil {
+		types.MustDecode(sub, &subCfg)
+	}
 
 	drivers.Register(cfg, name, newWasm, cfg.GetDappFork(name, "Enable"))
 	initExecType()
@@ -30,6 +42,9 @@ type Wasm struct {
 	drivers.DriverBase
 
 	tx           *types.Transaction
+	from         string
+	input        []byte
+	output       []byte
 	stateKVC     *dapp.KVCreator
 	localCache   []*types2.LocalDataLog
 	kvs          []*types.KeyValue
@@ -37,6 +52,7 @@ type Wasm struct {
 	customLogs   []string
 	execAddr     string
 	contractName string
+	callStack    []string
 	VMCache      map[string]*exec.VirtualMachine
 	ENV          map[int]string
 }
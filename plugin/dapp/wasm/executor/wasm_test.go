@@ -263,6 +263,171 @@ func TestWasm_Callback(t *testing.T) {
 	t.Log(random)
 }
 
+func TestWasm_CallContract(t *testing.T) {
+	dir, ldb, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, ldb)
+	acc := initAccount(ldb)
+	code, err := ioutil.ReadFile("../contracts/dice/dice.wasm")
+	require.Nil(t, err)
+	for _, name := range []string{"dice", "dicex", "dicey"} {
+		require.Nil(t, kvdb.Set(contractKey(name), code))
+	}
+
+	wasmCB = newWasm().(*Wasm)
+	defer func() {
+		wasmCB = nil
+	}()
+	wasmCB.SetCoinsAccount(acc)
+	wasmCB.SetStateDB(kvdb)
+	api := mocks.QueueProtocolAPI{}
+	api.On("GetConfig").Return(cfg)
+	wasmCB.SetAPI(&api)
+	wasmCB.execAddr = wasmAddr
+	wasmCB.contractName = "dice"
+	wasmCB.stateKVC = dapp.NewKVCreator(kvdb, calcStatePrefix("dice"), nil)
+	wasmCB.from = Addrs[0]
+	require.Nil(t, transferToExec(Addrs[0], wasmAddr, 1e9))
+	wasmCB.receiptLogs = nil
+
+	caller, err := wasmCB.loadVM("dice", 1e7)
+	require.Nil(t, err)
+
+	//call success
+	ret, err := callContract(caller, "dicex", "startgame", []int64{1e8})
+	require.Nil(t, err)
+	require.Equal(t, int64(0), ret)
+	require.True(t, caller.Gas > 0)
+	require.Equal(t, "dice", wasmCB.contractName)
+	require.Equal(t, kvdb, wasmCB.GetStateDB())
+	require.Equal(t, "", getCaller())
+	require.Equal(t, Addrs[0], getFrom())
+	require.Equal(t, int32(types2.TyLogWasmCall), wasmCB.receiptLogs[len(wasmCB.receiptLogs)-1].Ty)
+	status, err := kvdb.Get(append(calcStatePrefix("dicex"), []byte("dice_status")...))
+	require.Nil(t, err)
+	require.NotNil(t, status)
+	nKVs, nLogs := len(wasmCB.kvs), len(wasmCB.receiptLogs)
+	require.True(t, nKVs > 0)
+
+	//callee returns a negative value, all changes should be rolled back
+	ret, err = callContract(caller, "dicey", "deposit", []int64{1e8})
+	require.Equal(t, types2.ErrCallFailed, err)
+	require.Equal(t, int64(-1), ret)
+	require.Equal(t, nKVs, len(wasmCB.kvs))
+	require.Equal(t, nLogs, len(wasmCB.receiptLogs))
+	_, err = kvdb.Get(append(calcStatePrefix("dicey"), []byte("dice_status")...))
+	require.Equal(t, types.ErrNotFound, err)
+
+	//invalid calls
+	_, err = callContract(caller, "dice", "startgame", []int64{1e8})
+	require.Equal(t, types2.ErrReentrantCall, err)
+	_, err = callContract(caller, "dicex", "unknown", nil)
+	require.Equal(t, types2.ErrInvalidMethod, err)
+	_, err = callContract(caller, "nocontract", "startgame", []int64{1e8})
+	require.Equal(t, types.ErrNotFound, err)
+	// 被调用合约通过getCaller获取调用方合约，getFrom仍是交易发起者
+	wasmCB.callStack = []string{"dice"}
+	require.Equal(t, "dice", getCaller())
+	require.Equal(t, Addrs[0], getFrom())
+	wasmCB.callStack = make([]string, types2.MaxCallDepth-1)
+	_, err = callContract(caller, "dicex", "startgame", []int64{1e8})
+	require.Equal(t, types2.ErrCallDepth, err)
+}
+
+func TestWasm_QueryCallContract(t *testing.T) {
+	dir, ldb, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, ldb)
+	acc := initAccount(ldb)
+	code, err := ioutil.ReadFile("../contracts/dice/dice.wasm")
+	require.Nil(t, err)
+	require.Nil(t, kvdb.Set(contractKey("dice"), code))
+
+	wasm := newWasm().(*Wasm)
+	wasm.SetCoinsAccount(acc)
+	wasm.SetStateDB(kvdb)
+	api := mocks.QueueProtocolAPI{}
+	api.On("GetConfig").Return(cfg)
+	wasm.SetAPI(&api)
+	wasmCB = wasm
+	require.Nil(t, transferToExec(Addrs[0], wasmAddr, 1e9))
+
+	msg, err := wasm.Query_CallContract(&types2.QueryContractCall{
+		Contract:   "dice",
+		Method:     "startgame",
+		Parameters: []int64{1e8},
+		From:       Addrs[0],
+		Input:      []byte("input"),
+	})
+	require.Nil(t, err)
+	result := msg.(*types2.QueryContractCallResult)
+	require.Equal(t, int64(0), result.Result)
+	require.Equal(t, Addrs[0], result.Logs[0][:len(Addrs[0])])
+	require.Nil(t, wasmCB)
+	//只读调用不修改状态
+	_, err = kvdb.Get(append(calcStatePrefix("dice"), []byte("dice_status")...))
+	require.Equal(t, types.ErrNotFound, err)
+	require.Equal(t, int64(0), acc.LoadExecAccount(Addrs[0], wasmAddr).Frozen)
+
+	_, err = wasm.Query_CallContract(&types2.QueryContractCall{Contract: "dice", Method: "unknown"})
+	require.Equal(t, types2.ErrInvalidMethod, err)
+
+	//input and output
+	wasmCB = wasm
+	defer func() {
+		wasmCB = nil
+	}()
+	wasm.input = []byte("input")
+	require.Equal(t, []byte("input"), getInput())
+	setOutput([]byte("output"))
+	require.Equal(t, []byte("output"), wasm.output)
+	require.True(t, isCallInputEnabled())
+}
+
+func TestWasm_AssetCallback(t *testing.T) {
+	dir, ldb, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, ldb)
+	wasmCB = newWasm().(*Wasm)
+	defer func() {
+		wasmCB = nil
+	}()
+	wasmCB.SetCoinsAccount(initAccount(ldb))
+	wasmCB.SetStateDB(kvdb)
+	api := mocks.QueueProtocolAPI{}
+	api.On("GetConfig").Return(cfg)
+	wasmCB.SetAPI(&api)
+	wasmCB.execAddr = wasmAddr
+
+	tokenAcc, err := account.NewAccountDB(cfg, "token", "TEST", kvdb)
+	require.Nil(t, err)
+	tokenAcc.SaveAccount(&types.Account{Balance: 1e10, Addr: Addrs[0]})
+
+	acc, err := getAssetAccount("", "")
+	require.Nil(t, err)
+	require.Equal(t, wasmCB.GetCoinsAccount(), acc)
+	acc, err = getAssetAccount(cfg.GetCoinExec(), cfg.GetCoinSymbol())
+	require.Nil(t, err)
+	require.Equal(t, wasmCB.GetCoinsAccount(), acc)
+	_, err = getAssetAccount("token-x", "TEST")
+	require.NotNil(t, err)
+
+	require.Nil(t, transferAssetToExec("token", "TEST", Addrs[0], wasmAddr, 1e9))
+	require.Nil(t, execFrozenAsset("token", "TEST", Addrs[0], 2e8))
+	require.Nil(t, execTransferFrozenAsset("token", "TEST", Addrs[0], Addrs[1], 1e8))
+	require.Nil(t, execActiveAsset("token", "TEST", Addrs[0], 1e8))
+	require.Nil(t, execTransferAsset("token", "TEST", Addrs[0], Addrs[1], 1e8))
+	require.Nil(t, transferAssetWithdraw("token", "TEST", Addrs[1], wasmAddr, 2e8))
+	require.Nil(t, transferAsset("token", "TEST", Addrs[1], Addrs[0], 1e8))
+
+	require.Equal(t, int64(9e9+1e8), tokenAcc.LoadAccount(Addrs[0]).Balance)
+	require.Equal(t, int64(1e8), tokenAcc.LoadAccount(Addrs[1]).Balance)
+	execAcc := tokenAcc.LoadExecAccount(Addrs[0], wasmAddr)
+	require.Equal(t, int64(8e8), execAcc.Balance)
+	require.Equal(t, int64(0), execAcc.Frozen)
+	require.Equal(t, int64(0), tokenAcc.LoadExecAccount(Addrs[1], wasmAddr).Balance)
+	//主链币账户不受影响
+	require.Equal(t, int64(1e10), wasmCB.GetCoinsAccount().LoadAccount(Addrs[0]).Balance)
+	require.NotNil(t, transferAsset("token", "TEST", Addrs[1], Addrs[0], 1e9))
+}
+
 func testCreate(t testing.TB, acc *account.DB, stateDB db.KV) {
 	code, err := ioutil.ReadFile("../contracts/dice/dice.wasm")
 	require.Nil(t, err, "read wasm file error")
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/33cn/chain33/common"
+)
+
+// 合约参数和返回数据的编码格式：每一项由1字节类型和数据组成
+// int64为8字节小端序；string和bytes为4字节小端序长度加原始数据
+const (
+	ArgTypeInt64 = iota + 1
+	ArgTypeString
+	ArgTypeBytes
+)
+
+// EncodeArgs 编码参数，支持int64、string和[]byte
+func EncodeArgs(args ...interface{}) ([]byte, error) {
+	var data []byte
+	buf := make([]byte, 8)
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case int64:
+			binary.LittleEndian.PutUint64(buf, uint64(v))
+			data = append(data, ArgTypeInt64)
+			data = append(data, buf...)
+		case string:
+			binary.LittleEndian.PutUint32(buf, uint32(len(v)))
+			data = append(data, ArgTypeString)
+			data = append(data, buf[:4]...)
+			data = append(data, v...)
+		case []byte:
+			binary.LittleEndian.PutUint32(buf, uint32(len(v)))
+			data = append(data, ArgTypeBytes)
+			data = append(data, buf[:4]...)
+			data = append(data, v...)
+		default:
+			return nil, ErrInvalidArgs
+		}
+	}
+	return data, nil
+}
+
+// DecodeArgs 解码参数，返回的每一项为int64、string或[]byte
+func DecodeArgs(data []byte) ([]interface{}, error) {
+	var args []interface{}
+	for len(data) > 0 {
+		ty := data[0]
+		data = data[1:]
+		switch ty {
+		case ArgTypeInt64:
+			if len(data) < 8 {
+				return nil, ErrInvalidArgs
+			}
+			args = append(args, int64(binary.LittleEndian.Uint64(data)))
+			data = data[8:]
+		case ArgTypeString, ArgTypeBytes:
+			if len(data) < 4 {
+				return nil, ErrInvalidArgs
+			}
+			size := binary.LittleEndian.Uint32(data)
+			data = data[4:]
+			if uint64(len(data)) < uint64(size) {
+				return nil, ErrInvalidArgs
+			}
+			if ty == ArgTypeString {
+				args = append(args, string(data[:size]))
+			} else {
+				args = append(args, append([]byte{}, data[:size]...))
+			}
+			data = data[size:]
+		default:
+			return nil, ErrInvalidArgs
+		}
+	}
+	return args, nil
+}
+
+// ParseArgs 解析命令行格式的参数并编码，每个参数格式为"类型:值"，类型为int、string或bytes，bytes的值为十六进制
+func ParseArgs(items []string) ([]byte, error) {
+	var args []interface{}
+	for _, item := range items {
+		kv := strings.SplitN(item, ":", 2)
+		if len(kv) != 2 {
+			return nil, ErrInvalidArgs
+		}
+		switch kv[0] {
+		case "int":
+			n, err := strconv.ParseInt(kv[1], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, n)
+		case "string":
+			args = append(args, kv[1])
+		case "bytes":
+			b, err := common.FromHex(kv[1])
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, b)
+		default:
+			return nil, ErrInvalidArgs
+		}
+	}
+	return EncodeArgs(args...)
+}
+
+// FormatArgs 将编码后的数据解码为"类型:值"格式的字符串，与ParseArgs对应
+func FormatArgs(data []byte) ([]string, error) {
+	args, err := DecodeArgs(data)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]string, 0, len(args))
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case int64:
+			items = append(items, fmt.Sprintf("int:%d", v))
+		case string:
+			items = append(items, "string:"+v)
+		case []byte:
+			items = append(items, "bytes:"+common.ToHex(v))
+		}
+	}
+	return items, nil
+}
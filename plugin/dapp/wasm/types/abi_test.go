@@ -0,0 +1,44 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeArgs(t *testing.T) {
+	data, err := EncodeArgs(int64(-1), "abc", []byte{1, 2})
+	require.Nil(t, err)
+	require.Equal(t, 1+8+1+4+3+1+4+2, len(data))
+	args, err := DecodeArgs(data)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{int64(-1), "abc", []byte{1, 2}}, args)
+
+	_, err = EncodeArgs(1)
+	require.Equal(t, ErrInvalidArgs, err)
+	_, err = DecodeArgs(data[:len(data)-1])
+	require.Equal(t, ErrInvalidArgs, err)
+	_, err = DecodeArgs([]byte{9})
+	require.Equal(t, ErrInvalidArgs, err)
+}
+
+func TestParseArgs(t *testing.T) {
+	items := []string{"int:-1", "string:a:b", "bytes:0x0102"}
+	data, err := ParseArgs(items)
+	require.Nil(t, err)
+	expect, _ := EncodeArgs(int64(-1), "a:b", []byte{1, 2})
+	require.Equal(t, expect, data)
+	formatted, err := FormatArgs(data)
+	require.Nil(t, err)
+	require.Equal(t, items, formatted)
+
+	_, err = ParseArgs([]string{"abc"})
+	require.Equal(t, ErrInvalidArgs, err)
+	_, err = ParseArgs([]string{"float:1.0"})
+	require.Equal(t, ErrInvalidArgs, err)
+	_, err = ParseArgs([]string{"int:abc"})
+	require.NotNil(t, err)
+	data, err = ParseArgs(nil)
+	require.Nil(t, err)
+	require.Nil(t, data)
+}
@@ -12,4 +12,9 @@ var (
 	ErrInvalidContractName = errors.New("invalid contract name")
 	ErrInvalidParam        = errors.New("invalid parameters")
 	ErrUnknown             = errors.New("unknown error")
+	ErrCallDepth           = errors.New("call depth exceeded")
+	ErrReentrantCall       = errors.New("reentrant contract call")
+	ErrCallFailed          = errors.New("contract call failed")
+	ErrInvalidArgs         = errors.New("invalid encoded args")
+	ErrOutOfGas            = errors.New("out of gas")
 )
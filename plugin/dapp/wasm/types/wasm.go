@@ -14,6 +14,12 @@ const (
 	NameRegExp = "^[a-z0-9]+$"
 	//TODO: max size to define
 	MaxCodeSize = 1 << 20
+	// MaxCallDepth 跨合约调用的最大嵌套深度
+	MaxCallDepth = 8
+	// ForkCallContract 支持跨合约调用和多资产转账
+	ForkCallContract = "ForkCallContract"
+	// ForkCallInput 支持编码后的字节参数和返回数据
+	ForkCallInput = "ForkCallInput"
 )
 
 // action for executor
@@ -42,6 +48,8 @@ func init() {
 
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(WasmX, "Enable", 0)
+	cfg.RegisterDappFork(WasmX, ForkCallContract, 0)
+	cfg.RegisterDappFork(WasmX, ForkCallInput, 0)
 }
 
 func InitExecutor(cfg *types.Chain33Config) {
@@ -242,6 +242,8 @@ type WasmCall struct {
 	Method     string   `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
 	Parameters []int64  `protobuf:"varint,3,rep,packed,name=parameters,proto3" json:"parameters,omitempty"`
 	Env        []string `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty"`
+	// 编码后的参数，格式见types.EncodeArgs
+	Input []byte `protobuf:"bytes,5,opt,name=input,proto3" json:"input,omitempty"`
 }
 
 func (x *WasmCall) Reset() {
@@ -304,6 +306,13 @@ func (x *WasmCall) GetEnv() []string {
 	return nil
 }
 
+func (x *WasmCall) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
 type QueryCheckContract struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -406,6 +415,158 @@ func (x *QueryContractDB) GetKey() string {
 	return ""
 }
 
+// 只读调用合约方法，不产生交易
+type QueryContractCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contract   string   `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Method     string   `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Parameters []int64  `protobuf:"varint,3,rep,packed,name=parameters,proto3" json:"parameters,omitempty"`
+	Env        []string `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty"`
+	Input      []byte   `protobuf:"bytes,5,opt,name=input,proto3" json:"input,omitempty"`
+	// 调用者地址，合约中getFrom返回该地址
+	From string `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+}
+
+func (x *QueryContractCall) Reset() {
+	*x = QueryContractCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wasm_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryContractCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryContractCall) ProtoMessage() {}
+
+func (x *QueryContractCall) ProtoReflect() protoreflect.Message {
+	mi := &file_wasm_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryContractCall.ProtoReflect.Descriptor instead.
+func (*QueryContractCall) Descriptor() ([]byte, []int) {
+	return file_wasm_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryContractCall) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *QueryContractCall) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *QueryContractCall) GetParameters() []int64 {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *QueryContractCall) GetEnv() []string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *QueryContractCall) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *QueryContractCall) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+type QueryContractCallResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result int64    `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Output []byte   `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
+	Logs   []string `protobuf:"bytes,3,rep,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (x *QueryContractCallResult) Reset() {
+	*x = QueryContractCallResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wasm_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryContractCallResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryContractCallResult) ProtoMessage() {}
+
+func (x *QueryContractCallResult) ProtoReflect() protoreflect.Message {
+	mi := &file_wasm_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryContractCallResult.ProtoReflect.Descriptor instead.
+func (*QueryContractCallResult) Descriptor() ([]byte, []int) {
+	return file_wasm_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryContractCallResult) GetResult() int64 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+func (x *QueryContractCallResult) GetOutput() []byte {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *QueryContractCallResult) GetLogs() []string {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
 type CustomLog struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -417,7 +578,7 @@ type CustomLog struct {
 func (x *CustomLog) Reset() {
 	*x = CustomLog{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wasm_proto_msgTypes[6]
+		mi := &file_wasm_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -430,7 +591,7 @@ func (x *CustomLog) String() string {
 func (*CustomLog) ProtoMessage() {}
 
 func (x *CustomLog) ProtoReflect() protoreflect.Message {
-	mi := &file_wasm_proto_msgTypes[6]
+	mi := &file_wasm_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -443,7 +604,7 @@ func (x *CustomLog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomLog.ProtoReflect.Descriptor instead.
 func (*CustomLog) Descriptor() ([]byte, []int) {
-	return file_wasm_proto_rawDescGZIP(), []int{6}
+	return file_wasm_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CustomLog) GetInfo() []string {
@@ -465,7 +626,7 @@ type CreateContractLog struct {
 func (x *CreateContractLog) Reset() {
 	*x = CreateContractLog{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wasm_proto_msgTypes[7]
+		mi := &file_wasm_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -478,7 +639,7 @@ func (x *CreateContractLog) String() string {
 func (*CreateContractLog) ProtoMessage() {}
 
 func (x *CreateContractLog) ProtoReflect() protoreflect.Message {
-	mi := &file_wasm_proto_msgTypes[7]
+	mi := &file_wasm_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -491,7 +652,7 @@ func (x *CreateContractLog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateContractLog.ProtoReflect.Descriptor instead.
 func (*CreateContractLog) Descriptor() ([]byte, []int) {
-	return file_wasm_proto_rawDescGZIP(), []int{7}
+	return file_wasm_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CreateContractLog) GetName() string {
@@ -520,7 +681,7 @@ type UpdateContractLog struct {
 func (x *UpdateContractLog) Reset() {
 	*x = UpdateContractLog{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wasm_proto_msgTypes[8]
+		mi := &file_wasm_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -533,7 +694,7 @@ func (x *UpdateContractLog) String() string {
 func (*UpdateContractLog) ProtoMessage() {}
 
 func (x *UpdateContractLog) ProtoReflect() protoreflect.Message {
-	mi := &file_wasm_proto_msgTypes[8]
+	mi := &file_wasm_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -546,7 +707,7 @@ func (x *UpdateContractLog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateContractLog.ProtoReflect.Descriptor instead.
 func (*UpdateContractLog) Descriptor() ([]byte, []int) {
-	return file_wasm_proto_rawDescGZIP(), []int{8}
+	return file_wasm_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateContractLog) GetName() string {
@@ -571,12 +732,13 @@ type CallContractLog struct {
 	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
 	Method   string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
 	Result   int32  `protobuf:"varint,3,opt,name=result,proto3" json:"result,omitempty"`
+	Output   []byte `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"`
 }
 
 func (x *CallContractLog) Reset() {
 	*x = CallContractLog{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wasm_proto_msgTypes[9]
+		mi := &file_wasm_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -589,7 +751,7 @@ func (x *CallContractLog) String() string {
 func (*CallContractLog) ProtoMessage() {}
 
 func (x *CallContractLog) ProtoReflect() protoreflect.Message {
-	mi := &file_wasm_proto_msgTypes[9]
+	mi := &file_wasm_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,7 +764,7 @@ func (x *CallContractLog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CallContractLog.ProtoReflect.Descriptor instead.
 func (*CallContractLog) Descriptor() ([]byte, []int) {
-	return file_wasm_proto_rawDescGZIP(), []int{9}
+	return file_wasm_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CallContractLog) GetContract() string {
@@ -626,6 +788,13 @@ func (x *CallContractLog) GetResult() int32 {
 	return 0
 }
 
+func (x *CallContractLog) GetOutput() []byte {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
 type LocalDataLog struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -638,7 +807,7 @@ type LocalDataLog struct {
 func (x *LocalDataLog) Reset() {
 	*x = LocalDataLog{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wasm_proto_msgTypes[10]
+		mi := &file_wasm_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -651,7 +820,7 @@ func (x *LocalDataLog) String() string {
 func (*LocalDataLog) ProtoMessage() {}
 
 func (x *LocalDataLog) ProtoReflect() protoreflect.Message {
-	mi := &file_wasm_proto_msgTypes[10]
+	mi := &file_wasm_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -664,7 +833,7 @@ func (x *LocalDataLog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocalDataLog.ProtoReflect.Descriptor instead.
 func (*LocalDataLog) Descriptor() ([]byte, []int) {
-	return file_wasm_proto_rawDescGZIP(), []int{10}
+	return file_wasm_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *LocalDataLog) GetKey() []byte {
@@ -702,41 +871,60 @@ var file_wasm_proto_rawDesc = []byte{
 	0x64, 0x65, 0x22, 0x34, 0x0a, 0x0a, 0x77, 0x61, 0x73, 0x6d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
 	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x70, 0x0a, 0x08, 0x77, 0x61, 0x73, 0x6d,
-	0x43, 0x61, 0x6c, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x22, 0x28, 0x0a, 0x12, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x0f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x42, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x1f, 0x0a, 0x09, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c,
-	0x6f, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x3b, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x22, 0x3b, 0x0a, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x22, 0x5d, 0x0a, 0x0f, 0x63, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x4c, 0x6f, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
-	0x36, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x4c, 0x6f, 0x67, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x08, 0x77, 0x61, 0x73,
+	0x6d, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x76,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x22, 0x28, 0x0a, 0x12, 0x71, 0x75, 0x65, 0x72, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x0f, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x42, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0xa3, 0x01, 0x0a,
+	0x11, 0x71, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x61,
+	0x6c, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x22, 0x5d, 0x0a, 0x17, 0x71, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x6f, 0x67,
+	0x73, 0x22, 0x1f, 0x0a, 0x09, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x6f, 0x67, 0x12, 0x12,
+	0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x22, 0x3b, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22,
+	0x3b, 0x0a, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x4c, 0x6f, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x75, 0x0a, 0x0f,
+	0x63, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x22, 0x36, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61,
+	0x4c, 0x6f, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0a, 0x5a, 0x08, 0x2e,
+	0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -751,19 +939,21 @@ func file_wasm_proto_rawDescGZIP() []byte {
 	return file_wasm_proto_rawDescData
 }
 
-var file_wasm_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_wasm_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_wasm_proto_goTypes = []interface{}{
-	(*WasmAction)(nil),         // 0: types.wasmAction
-	(*WasmCreate)(nil),         // 1: types.wasmCreate
-	(*WasmUpdate)(nil),         // 2: types.wasmUpdate
-	(*WasmCall)(nil),           // 3: types.wasmCall
-	(*QueryCheckContract)(nil), // 4: types.queryCheckContract
-	(*QueryContractDB)(nil),    // 5: types.queryContractDB
-	(*CustomLog)(nil),          // 6: types.customLog
-	(*CreateContractLog)(nil),  // 7: types.createContractLog
-	(*UpdateContractLog)(nil),  // 8: types.updateContractLog
-	(*CallContractLog)(nil),    // 9: types.callContractLog
-	(*LocalDataLog)(nil),       // 10: types.localDataLog
+	(*WasmAction)(nil),              // 0: types.wasmAction
+	(*WasmCreate)(nil),              // 1: types.wasmCreate
+	(*WasmUpdate)(nil),              // 2: types.wasmUpdate
+	(*WasmCall)(nil),                // 3: types.wasmCall
+	(*QueryCheckContract)(nil),      // 4: types.queryCheckContract
+	(*QueryContractDB)(nil),         // 5: types.queryContractDB
+	(*QueryContractCall)(nil),       // 6: types.queryContractCall
+	(*QueryContractCallResult)(nil), // 7: types.queryContractCallResult
+	(*CustomLog)(nil),               // 8: types.customLog
+	(*CreateContractLog)(nil),       // 9: types.createContractLog
+	(*UpdateContractLog)(nil),       // 10: types.updateContractLog
+	(*CallContractLog)(nil),         // 11: types.callContractLog
+	(*LocalDataLog)(nil),            // 12: types.localDataLog
 }
 var file_wasm_proto_depIdxs = []int32{
 	1, // 0: types.wasmAction.create:type_name -> types.wasmCreate
@@ -855,7 +1045,7 @@ func file_wasm_proto_init() {
 			}
 		}
 		file_wasm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CustomLog); i {
+			switch v := v.(*QueryContractCall); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -867,7 +1057,7 @@ func file_wasm_proto_init() {
 			}
 		}
 		file_wasm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateContractLog); i {
+			switch v := v.(*QueryContractCallResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -879,7 +1069,7 @@ func file_wasm_proto_init() {
 			}
 		}
 		file_wasm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateContractLog); i {
+			switch v := v.(*CustomLog); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -891,7 +1081,7 @@ func file_wasm_proto_init() {
 			}
 		}
 		file_wasm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CallContractLog); i {
+			switch v := v.(*CreateContractLog); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -903,6 +1093,30 @@ func file_wasm_proto_init() {
 			}
 		}
 		file_wasm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateContractLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wasm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CallContractLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wasm_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LocalDataLog); i {
 			case 0:
 				return &v.state
@@ -926,7 +1140,7 @@ func file_wasm_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_wasm_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
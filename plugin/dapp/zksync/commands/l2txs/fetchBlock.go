@@ -155,6 +155,11 @@ func fetchL2Block(cmd *cobra.Command, args []string) {
 				txCntMap[zt.TySetFeeAction] = txCntMap[zt.TySetFeeAction] + 1
 			case zt.TySetTokenSymbolAction:
 				txCntMap[zt.TySetTokenSymbolAction] = txCntMap[zt.TySetTokenSymbolAction] + 1
+			case zt.TyBatchAction:
+				//batch按其中各个操作类型统计
+				for _, op := range action.GetBatch().GetOps() {
+					txCntMap[int(op.Ty)] = txCntMap[int(op.Ty)] + 1
+				}
 			}
 		}
 
@@ -21,12 +21,15 @@ func SendChain33L2TxCmd() *cobra.Command {
 		sendWithdrawTxCmd(),
 		BatchSendTransferTxCmd(),
 		SendTransferTxCmd(),
+		SendSwapTxCmd(),
+		SendSwapCancelTxCmd(),
 		sendManyDepositTxCmd(),
 		sendManyWithdrawTxCmd(),
 		treeManyToContractCmd(),
 		contractManyToTreeCmd(),
 		SendManyTransferTxCmd(),
 		SendManyTransferTxFromOneCmd(),
+		SendTransferBatchTxCmd(),
 		transferManyToNewCmd(),
 		transferToNewManyCmd(),
 		proxyManyExitCmd(),
@@ -0,0 +1,178 @@
+package l2txs
+
+import (
+	"bytes"
+	"fmt"
+
+	chain33Common "github.com/33cn/chain33/common"
+	zksyncTypes "github.com/33cn/plugin/plugin/dapp/zksync/types"
+	"github.com/33cn/plugin/plugin/dapp/zksync/wallet"
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards/eddsa"
+	"github.com/spf13/cobra"
+)
+
+func SendSwapTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap",
+		Short: "send swap tx to chain33, match left and right order",
+		Run:   swap,
+	}
+	sendSwapFlags(cmd)
+	return cmd
+}
+
+func sendSwapFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64P("left", "l", 0, "left account id")
+	_ = cmd.MarkFlagRequired("left")
+	cmd.Flags().Uint64P("right", "r", 0, "right account id")
+	_ = cmd.MarkFlagRequired("right")
+	cmd.Flags().Uint64P("sellToken", "s", 0, "token id left sells and right buys")
+	_ = cmd.MarkFlagRequired("sellToken")
+	cmd.Flags().Uint64P("buyToken", "b", 0, "token id left buys and right sells")
+	_ = cmd.MarkFlagRequired("buyToken")
+
+	cmd.Flags().StringP("leftAmount", "", "0", "left order max sell amount")
+	_ = cmd.MarkFlagRequired("leftAmount")
+	cmd.Flags().StringP("leftRatioSell", "", "1", "left order price ratio of sell token")
+	cmd.Flags().StringP("leftRatioBuy", "", "1", "left order price ratio of buy token")
+	cmd.Flags().Uint64P("leftNonce", "", 0, "left order nonce, used to cancel the order")
+	cmd.Flags().Int64P("leftExpiry", "", 0, "left order expiry block time, 0 means never expire")
+	cmd.Flags().StringP("rightAmount", "", "0", "right order max sell amount")
+	_ = cmd.MarkFlagRequired("rightAmount")
+	cmd.Flags().StringP("rightRatioSell", "", "1", "right order price ratio of sell token")
+	cmd.Flags().StringP("rightRatioBuy", "", "1", "right order price ratio of buy token")
+	cmd.Flags().Uint64P("rightNonce", "", 0, "right order nonce, used to cancel the order")
+	cmd.Flags().Int64P("rightExpiry", "", 0, "right order expiry block time, 0 means never expire")
+
+	cmd.Flags().StringP("leftDeal", "m", "0", "deal amount left sells")
+	_ = cmd.MarkFlagRequired("leftDeal")
+	cmd.Flags().StringP("rightDeal", "n", "0", "deal amount right sells")
+	_ = cmd.MarkFlagRequired("rightDeal")
+
+	cmd.Flags().StringP("key", "k", "", "left private key, sign left order and send tx")
+	_ = cmd.MarkFlagRequired("key")
+	cmd.Flags().StringP("rightKey", "", "", "right private key, sign right order")
+	_ = cmd.MarkFlagRequired("rightKey")
+}
+
+func swap(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	leftId, _ := cmd.Flags().GetUint64("left")
+	rightId, _ := cmd.Flags().GetUint64("right")
+	sellToken, _ := cmd.Flags().GetUint64("sellToken")
+	buyToken, _ := cmd.Flags().GetUint64("buyToken")
+	leftAmount, _ := cmd.Flags().GetString("leftAmount")
+	leftRatioSell, _ := cmd.Flags().GetString("leftRatioSell")
+	leftRatioBuy, _ := cmd.Flags().GetString("leftRatioBuy")
+	leftNonce, _ := cmd.Flags().GetUint64("leftNonce")
+	leftExpiry, _ := cmd.Flags().GetInt64("leftExpiry")
+	rightAmount, _ := cmd.Flags().GetString("rightAmount")
+	rightRatioSell, _ := cmd.Flags().GetString("rightRatioSell")
+	rightRatioBuy, _ := cmd.Flags().GetString("rightRatioBuy")
+	rightNonce, _ := cmd.Flags().GetUint64("rightNonce")
+	rightExpiry, _ := cmd.Flags().GetInt64("rightExpiry")
+	leftDeal, _ := cmd.Flags().GetString("leftDeal")
+	rightDeal, _ := cmd.Flags().GetString("rightDeal")
+	privateKey, _ := cmd.Flags().GetString("key")
+	rightKey, _ := cmd.Flags().GetString("rightKey")
+	paraName, _ := cmd.Flags().GetString("paraName")
+
+	rightOrder := &zksyncTypes.ZkSwapOrder{
+		AccountId: rightId,
+		TokenSell: buyToken,
+		TokenBuy:  sellToken,
+		Amount:    rightAmount,
+		RatioSell: rightRatioSell,
+		RatioBuy:  rightRatioBuy,
+		Nonce:     rightNonce,
+		Expiry:    rightExpiry,
+	}
+	rightKeyBytes, err := chain33Common.FromHex(rightKey)
+	if err != nil {
+		fmt.Println("swap failed to decode right key due to err:", err.Error())
+		return
+	}
+	rightPrivateKey, err := eddsa.GenerateKey(bytes.NewReader(rightKeyBytes))
+	if err != nil {
+		fmt.Println("swap failed to generate right key due to err:", err.Error())
+		return
+	}
+	rightOrder.Signature, err = SignTxInEddsa(wallet.GetSwapOrderMsg(rightOrder), rightPrivateKey)
+	if err != nil {
+		fmt.Println("swap failed to sign right order due to err:", err.Error())
+		return
+	}
+
+	payload := &zksyncTypes.ZkSwap{
+		Left: &zksyncTypes.ZkSwapOrder{
+			AccountId: leftId,
+			TokenSell: sellToken,
+			TokenBuy:  buyToken,
+			Amount:    leftAmount,
+			RatioSell: leftRatioSell,
+			RatioBuy:  leftRatioBuy,
+			Nonce:     leftNonce,
+			Expiry:    leftExpiry,
+		},
+		Right:           rightOrder,
+		LeftDealAmount:  leftDeal,
+		RightDealAmount: rightDeal,
+	}
+	action := &zksyncTypes.ZksyncAction{
+		Ty: zksyncTypes.TySwapAction,
+		Value: &zksyncTypes.ZksyncAction_Swap{
+			Swap: payload,
+		},
+	}
+
+	tx, err := createChain33Tx(privateKey, getRealExecName(paraName, zksyncTypes.Zksync), action)
+	if nil != err {
+		fmt.Println("swap failed to createChain33Tx due to err:", err.Error())
+		return
+	}
+	sendTx(rpcLaddr, tx)
+}
+
+func SendSwapCancelTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swapCancel",
+		Short: "send swap cancel tx to chain33, cancel the orders of account with the nonce",
+		Run:   swapCancel,
+	}
+	sendSwapCancelFlags(cmd)
+	return cmd
+}
+
+func sendSwapCancelFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64P("accountId", "a", 0, "account id")
+	_ = cmd.MarkFlagRequired("accountId")
+	cmd.Flags().Uint64P("nonce", "n", 0, "order nonce")
+	_ = cmd.MarkFlagRequired("nonce")
+	cmd.Flags().StringP("key", "k", "", "private key")
+	_ = cmd.MarkFlagRequired("key")
+}
+
+func swapCancel(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	accountId, _ := cmd.Flags().GetUint64("accountId")
+	nonce, _ := cmd.Flags().GetUint64("nonce")
+	privateKey, _ := cmd.Flags().GetString("key")
+	paraName, _ := cmd.Flags().GetString("paraName")
+
+	action := &zksyncTypes.ZksyncAction{
+		Ty: zksyncTypes.TySwapCancelAction,
+		Value: &zksyncTypes.ZksyncAction_SwapCancel{
+			SwapCancel: &zksyncTypes.ZkSwapCancel{
+				AccountId: accountId,
+				Nonce:     nonce,
+			},
+		},
+	}
+
+	tx, err := createChain33Tx(privateKey, getRealExecName(paraName, zksyncTypes.Zksync), action)
+	if nil != err {
+		fmt.Println("swapCancel failed to createChain33Tx due to err:", err.Error())
+		return
+	}
+	sendTx(rpcLaddr, tx)
+}
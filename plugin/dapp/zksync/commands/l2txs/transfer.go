@@ -258,3 +258,81 @@ func tranferManyFromOne(cmd *cobra.Command, args []string) {
 		sendTx(rpcLaddr, tx)
 	}
 }
+
+func SendTransferBatchTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer_batch",
+		Short: "from one account, send transfers to many accounts in one batch tx, all or nothing",
+		Run:   transferBatch,
+	}
+	sendTransferBatchFlags(cmd)
+	return cmd
+}
+
+func sendTransferBatchFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64P("tokenId", "t", 0, "eth token id")
+	_ = cmd.MarkFlagRequired("tokenId")
+	cmd.Flags().Uint64P("from", "f", 0, "from account id")
+	_ = cmd.MarkFlagRequired("from")
+	cmd.Flags().StringP("toIDs", "d", "", "to account ids, use ',' separate")
+	_ = cmd.MarkFlagRequired("toIDs")
+	cmd.Flags().StringP("amounts", "m", "", "transfer amounts, use ',' separate")
+	_ = cmd.MarkFlagRequired("amounts")
+	cmd.Flags().StringP("key", "k", "", "private key")
+	_ = cmd.MarkFlagRequired("key")
+}
+
+func transferBatch(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	tokenId, _ := cmd.Flags().GetUint64("tokenId")
+	fromAccountId, _ := cmd.Flags().GetUint64("from")
+	toIDs, _ := cmd.Flags().GetString("toIDs")
+	amounts, _ := cmd.Flags().GetString("amounts")
+	privateKey, _ := cmd.Flags().GetString("key")
+	paraName, _ := cmd.Flags().GetString("paraName")
+
+	tids := strings.Split(toIDs, ",")
+	vals := strings.Split(amounts, ",")
+	if len(tids) != len(vals) {
+		fmt.Println("err len(toIDs) != len(amounts)", len(tids), "!=", len(vals))
+		return
+	}
+	if len(tids) > zksyncTypes.MaxBatchOpCount {
+		fmt.Println("err batch ops over max", len(tids), ">", zksyncTypes.MaxBatchOpCount)
+		return
+	}
+
+	batch := &zksyncTypes.ZkBatch{}
+	for i := 0; i < len(tids); i++ {
+		tid, err := strconv.ParseUint(tids[i], 10, 64)
+		if err != nil {
+			fmt.Println("err toID", tids[i], err.Error())
+			return
+		}
+		batch.Ops = append(batch.Ops, &zksyncTypes.ZksyncAction{
+			Ty: zksyncTypes.TyTransferAction,
+			Value: &zksyncTypes.ZksyncAction_ZkTransfer{
+				ZkTransfer: &zksyncTypes.ZkTransfer{
+					TokenId:       tokenId,
+					Amount:        vals[i],
+					FromAccountId: fromAccountId,
+					ToAccountId:   tid,
+				},
+			},
+		})
+	}
+
+	action := &zksyncTypes.ZksyncAction{
+		Ty: zksyncTypes.TyBatchAction,
+		Value: &zksyncTypes.ZksyncAction_Batch{
+			Batch: batch,
+		},
+	}
+
+	tx, err := createChain33Tx(privateKey, getRealExecName(paraName, zksyncTypes.Zksync), action)
+	if nil != err {
+		fmt.Println("transferBatch failed to createChain33Tx due to err:", err.Error())
+		return
+	}
+	sendTx(rpcLaddr, tx)
+}
@@ -166,6 +166,33 @@ func SignTransaction(key chain33Crypto.PrivKey, tx *types.Transaction) (err erro
 			return
 		}
 		nft.Signature = signInfo
+	case zksyncTypes.TySwapAction:
+		//right挂单需要预先签名，这里对left挂单和撮合信息签名
+		swap := action.GetSwap()
+		signInfo, err = SignTxInEddsa(wallet.GetSwapOrderMsg(swap.Left), privateKey)
+		if err != nil {
+			return
+		}
+		swap.Left.Signature = signInfo
+		msg = wallet.GetSwapMsg(swap)
+		signInfo, err = SignTxInEddsa(msg, privateKey)
+		if err != nil {
+			return
+		}
+		swap.Signature = signInfo
+	case zksyncTypes.TySwapCancelAction:
+		swapCancel := action.GetSwapCancel()
+		msg = wallet.GetSwapCancelMsg(swapCancel)
+		signInfo, err = SignTxInEddsa(msg, privateKey)
+		if err != nil {
+			return
+		}
+		swapCancel.Signature = signInfo
+	case zksyncTypes.TyBatchAction:
+		err = wallet.SignBatchOps(action.GetBatch(), privateKey)
+		if err != nil {
+			return
+		}
 	}
 
 	tx.Payload = types.Encode(action)
@@ -35,6 +35,7 @@ func queryAccountCmd() *cobra.Command {
 	cmd.AddCommand(getContractAccountCmd())
 	cmd.AddCommand(getTokenBalanceCmd())
 	cmd.AddCommand(getMaxAccountCmd())
+	cmd.AddCommand(getL2HistoryCmd())
 
 	return cmd
 }
@@ -246,6 +247,56 @@ func getTokenBalance(cmd *cobra.Command, args []string) {
 	ctx.Run()
 }
 
+func getL2HistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "get zksync account history by accountId, filter by tokenId and operation type",
+		Run:   getL2History,
+	}
+	getL2HistoryFlag(cmd)
+	return cmd
+}
+
+func getL2HistoryFlag(cmd *cobra.Command) {
+	cmd.Flags().Uint64P("accountId", "a", 0, "zksync account id")
+	cmd.MarkFlagRequired("accountId")
+	cmd.Flags().Uint64P("token", "t", 0, "zksync token id, not filter if not set")
+	cmd.Flags().Int32P("opType", "o", 0, "operation type, 0: all")
+	cmd.Flags().StringP("primaryKey", "p", "", "start primary key of this page")
+	cmd.Flags().Int32P("count", "c", 20, "count of this page")
+	cmd.Flags().Int32P("direction", "d", 0, "0:desc, 1:asc")
+}
+
+func getL2History(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	accountId, _ := cmd.Flags().GetUint64("accountId")
+	token, _ := cmd.Flags().GetUint64("token")
+	opType, _ := cmd.Flags().GetInt32("opType")
+	primaryKey, _ := cmd.Flags().GetString("primaryKey")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+
+	var params rpctypes.Query4Jrpc
+
+	params.Execer = zt.Zksync
+	req := &zt.ZkReqL2History{
+		AccountId:   accountId,
+		TokenId:     token,
+		FilterToken: cmd.Flags().Changed("token"),
+		OpType:      opType,
+		PrimaryKey:  primaryKey,
+		Count:       count,
+		Direction:   direction,
+	}
+
+	params.FuncName = "GetL2History"
+	params.Payload = types.MustPBToJSON(req)
+
+	var resp zt.ZkL2HistoryList
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &resp)
+	ctx.Run()
+}
+
 func queryL2QueueCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "l2",
@@ -415,7 +466,7 @@ func getPriority(cmd *cobra.Command, args []string) {
 	params.FuncName = "GetPriorityOpInfo"
 	params.Payload = types.MustPBToJSON(req)
 
-	var resp zt.ZkDepositWitnessInfo
+	var resp zt.ZkOperation
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &resp)
 	ctx.Run()
 }
@@ -71,12 +71,50 @@ func (z *zksync) Exec_SetPubKey(payload *zt.ZkSetPubKey, tx *types.Transaction,
 }
 
 func (z *zksync) Exec_FullExit(payload *zt.ZkFullExit, tx *types.Transaction, index int) (*types.Receipt, error) {
-	return nil, errors.Wrapf(types.ErrNotAllow, "fullExit not allow currently")
+	cfg := z.GetAPI().GetConfig()
+	if !cfg.IsDappFork(z.GetHeight(), zt.Zksync, zt.ForkZksyncFullExit) {
+		return nil, errors.Wrapf(types.ErrNotAllow, "fullExit not allow currently")
+	}
+	action := NewAction(z, tx, index)
+	//系统设置exodus mode后，则不处理此类交易，未被proof确认的fullExit在设置ExodusFinalMode时回滚
+	if err := isExodusMode(z.GetStateDB()); err != nil {
+		return nil, err
+	}
+	return action.FullExit(payload)
 }
 
 func (z *zksync) Exec_Swap(payload *zt.ZkSwap, tx *types.Transaction, index int) (*types.Receipt, error) {
-	//todo swap stub
-	return nil, nil
+	cfg := z.GetAPI().GetConfig()
+	if !cfg.IsDappFork(z.GetHeight(), zt.Zksync, zt.ForkZksyncSwap) {
+		//todo swap stub
+		return nil, nil
+	}
+	action := NewAction(z, tx, index)
+	//系统设置exodus mode后，则不处理此类交易
+	if err := isExodusMode(z.GetStateDB()); err != nil {
+		return nil, err
+	}
+	return action.Swap(payload)
+}
+
+//Exec_SwapCancel 撤销挂单
+func (z *zksync) Exec_SwapCancel(payload *zt.ZkSwapCancel, tx *types.Transaction, index int) (*types.Receipt, error) {
+	cfg := z.GetAPI().GetConfig()
+	if !cfg.IsDappFork(z.GetHeight(), zt.Zksync, zt.ForkZksyncSwap) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := NewAction(z, tx, index)
+	return action.SwapCancel(payload)
+}
+
+//Exec_Batch 批量执行L2操作
+func (z *zksync) Exec_Batch(payload *zt.ZkBatch, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(z, tx, index)
+	//系统设置exodus mode后，则不处理此类交易
+	if err := isExodusMode(z.GetStateDB()); err != nil {
+		return nil, err
+	}
+	return action.Batch(payload)
 }
 
 func (z *zksync) Exec_SetVerifyKey(payload *zt.ZkVerifyKey, tx *types.Transaction, index int) (*types.Receipt, error) {
@@ -41,6 +41,14 @@ func (z *zksync) ExecDelLocal_FullExit(payload *zt.ZkFullExit, tx *types.Transac
 	return z.execAutoDelLocal(tx, receiptData)
 }
 
+func (z *zksync) ExecDelLocal_Swap(payload *zt.ZkSwap, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return z.execAutoDelLocal(tx, receiptData)
+}
+
+func (z *zksync) ExecDelLocal_Batch(payload *zt.ZkBatch, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return z.execAutoDelLocal(tx, receiptData)
+}
+
 func (z *zksync) ExecDelLocal_MintNFT(payload *zt.ZkMintNFT, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return z.execAutoDelLocal(tx, receiptData)
 }
@@ -63,6 +71,10 @@ func (z *zksync) ExecDelLocal_Withdraw(payload *types.AssetsWithdraw, tx *types.
 	return z.execAutoDelLocal(tx, receiptData)
 }
 
+func (z *zksync) ExecDelLocal_SetExodusMode(payload *zt.ZkExodusMode, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return z.execAutoDelLocal(tx, receiptData)
+}
+
 func (z *zksync) ExecDelLocal_CommitProof(payload *zt.ZkCommitProof, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return z.execAutoDelLocal(tx, receiptData)
 }
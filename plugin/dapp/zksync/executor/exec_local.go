@@ -10,8 +10,8 @@ func (z *zksync) ExecLocal_Deposit(payload *zt.ZkDeposit, tx *types.Transaction,
 	return z.execAutoLocalZksync(tx, receiptData, index)
 }
 
-//ExecLocal_Withdraw asset withdraw local db process
-func (z *zksync) ExecLocal_Withdraw(payload *zt.ZkWithdraw, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+//ExecLocal_ZkWithdraw asset withdraw local db process
+func (z *zksync) ExecLocal_ZkWithdraw(payload *zt.ZkWithdraw, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return z.execAutoLocalZksync(tx, receiptData, index)
 }
 
@@ -25,7 +25,7 @@ func (z *zksync) ExecLocal_TreeToContract(payload *zt.ZkTreeToContract, tx *type
 	return z.execAutoLocalZksync(tx, receiptData, index)
 }
 
-func (z *zksync) ExecLocal_Transfer(payload *zt.ZkTransfer, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+func (z *zksync) ExecLocal_ZkTransfer(payload *zt.ZkTransfer, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return z.execAutoLocalZksync(tx, receiptData, index)
 }
 
@@ -33,7 +33,7 @@ func (z *zksync) ExecLocal_TransferToNew(payload *zt.ZkTransferToNew, tx *types.
 	return z.execAutoLocalZksync(tx, receiptData, index)
 }
 
-func (z *zksync) ExecLocal_ProxyExit(payload *zt.ZkForceExit, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+func (z *zksync) ExecLocal_ProxyExit(payload *zt.ZkProxyExit, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return z.execAutoLocalZksync(tx, receiptData, index)
 }
 
@@ -45,6 +45,14 @@ func (z *zksync) ExecLocal_FullExit(payload *zt.ZkFullExit, tx *types.Transactio
 	return z.execAutoLocalZksync(tx, receiptData, index)
 }
 
+func (z *zksync) ExecLocal_Swap(payload *zt.ZkSwap, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return z.execAutoLocalZksync(tx, receiptData, index)
+}
+
+func (z *zksync) ExecLocal_Batch(payload *zt.ZkBatch, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return z.execAutoLocalZksync(tx, receiptData, index)
+}
+
 func (z *zksync) ExecLocal_MintNFT(payload *zt.ZkMintNFT, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return z.execAutoLocalZksync(tx, receiptData, index)
 }
@@ -57,6 +65,11 @@ func (z *zksync) ExecLocal_TransferNFT(payload *zt.ZkTransferNFT, tx *types.Tran
 	return z.execAutoLocalZksync(tx, receiptData, index)
 }
 
+//ExecLocal_SetExodusMode 记录exodus回滚产生的账户余额变化
+func (z *zksync) ExecLocal_SetExodusMode(payload *zt.ZkExodusMode, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return z.execAutoLocalZksync(tx, receiptData, index)
+}
+
 func (z *zksync) ExecLocal_CommitProof(payload *zt.ZkCommitProof, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return z.execCommitProofLocal(payload, tx, receiptData, index)
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
 	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards/eddsa"
 	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -457,6 +458,356 @@ func TestWithdraw(t *testing.T) {
 	assert.Equal(t, acc4token1Balance.TokenId, uint64(0))
 }
 
+func TestL2History(t *testing.T) {
+	initSetup()
+	defer util.CloseTestDB(dbDir, dbHanleGlobal)
+
+	var driver secp256k1.Driver
+
+	//12qyocayNF7Lv6C9qW4avxs2E7U41fKSfv
+	managerPrivateKeySli, err := chain33Common.FromHex("4257D8692EF7FE13C68B65D6A52F03933DB2FA5CE8FAF210B5B8B80C721CED01")
+	assert.Nil(t, err)
+	mpriKey, err := driver.PrivKeyFromBytes(managerPrivateKeySli)
+	assert.Nil(t, err)
+
+	tokenId := uint64(0)
+	_, _, err = deposit(zksyncHandle, mpriKey, tokenId, 0, "1000000000000", "abcd68033A72978C1084E2d44D1Fa06DdC4A2d57", "2b8a83399ffc86cc88f0493f17c9698878dcf7caf0bf04a3a5321542a7a416d1")
+	assert.Nil(t, err)
+	accountID := uint64(firstUserAccoutID)
+	for _, ty := range []int32{zksyncTypes.TyTransferAction, zksyncTypes.TyTransferToNewAction, zksyncTypes.TyWithdrawAction} {
+		_, _, err = setTxFee(zksyncHandle, mpriKey, tokenId, zksyncTypes.FeeMap[int64(ty)], ty)
+		assert.Nil(t, err)
+	}
+
+	acc1privkeySli, err := chain33Common.FromHex("0x19c069234f9d3e61135fefbeb7791b149cdf6af536f26bebb310d4cd22c3fee4")
+	assert.Nil(t, err)
+	acc1privkey, err := driver.PrivKeyFromBytes(acc1privkeySli)
+	assert.Nil(t, err)
+	err = setPubKey(zksyncHandle, acc1privkey, accountID)
+	assert.Nil(t, err)
+
+	_, _, err = transfer2New(zksyncHandle, acc1privkey, tokenId, accountID, "200", "12a0e25e62c1dbd32e505446062b26aecb65f028", "2afff20cc3c20f9def369626463fb027ebeba0bd976025f68316bb8eab55d48c")
+	assert.Nil(t, err)
+	_, _, err = transfer(zksyncHandle, acc1privkey, accountID, accountID+1, tokenId, "300")
+	assert.Nil(t, err)
+	_, _, err = withdraw(zksyncHandle, acc1privkey, accountID, tokenId, "100")
+	assert.Nil(t, err)
+
+	//按账户获取全部历史，降序
+	msg, err := zksyncHandle.Query_GetL2History(&zksyncTypes.ZkReqL2History{AccountId: accountID})
+	assert.Nil(t, err)
+	list := msg.(*zksyncTypes.ZkL2HistoryList)
+	assert.Equal(t, 4, len(list.Items))
+	assert.Equal(t, int32(zksyncTypes.TyWithdrawAction), list.Items[0].OpType)
+	assert.Equal(t, int32(zksyncTypes.TyDepositAction), list.Items[3].OpType)
+	assert.Equal(t, "1000000000000", list.Items[3].BalanceAfter)
+	assert.Equal(t, "", list.PrimaryKey)
+
+	//按操作类型过滤，对手账户
+	msg, err = zksyncHandle.Query_GetL2History(&zksyncTypes.ZkReqL2History{AccountId: accountID + 1, FilterToken: true, TokenId: tokenId, OpType: zksyncTypes.TyTransferAction})
+	assert.Nil(t, err)
+	list = msg.(*zksyncTypes.ZkL2HistoryList)
+	assert.Equal(t, 1, len(list.Items))
+	assert.Equal(t, accountID, list.Items[0].PeerAccountId)
+	assert.Equal(t, "200", list.Items[0].BalanceBefore)
+	assert.Equal(t, "500", list.Items[0].BalanceAfter)
+
+	//分页
+	msg, err = zksyncHandle.Query_GetL2History(&zksyncTypes.ZkReqL2History{AccountId: accountID, Count: 3, Direction: zksyncTypes.ListASC})
+	assert.Nil(t, err)
+	list = msg.(*zksyncTypes.ZkL2HistoryList)
+	assert.Equal(t, 3, len(list.Items))
+	assert.NotEqual(t, "", list.PrimaryKey)
+	msg, err = zksyncHandle.Query_GetL2History(&zksyncTypes.ZkReqL2History{AccountId: accountID, Count: 3, Direction: zksyncTypes.ListASC, PrimaryKey: list.PrimaryKey})
+	assert.Nil(t, err)
+	list = msg.(*zksyncTypes.ZkL2HistoryList)
+	assert.Equal(t, 1, len(list.Items))
+	assert.Equal(t, int32(zksyncTypes.TyWithdrawAction), list.Items[0].OpType)
+
+	_, err = zksyncHandle.Query_GetL2History(&zksyncTypes.ZkReqL2History{AccountId: accountID, OpType: zksyncTypes.TySwapAction})
+	assert.Equal(t, types.ErrNotFound, err)
+}
+
+func TestL2HistoryUpgrade(t *testing.T) {
+	initSetup()
+	defer util.CloseTestDB(dbDir, dbHanleGlobal)
+
+	var driver secp256k1.Driver
+
+	//12qyocayNF7Lv6C9qW4avxs2E7U41fKSfv
+	managerPrivateKeySli, err := chain33Common.FromHex("4257D8692EF7FE13C68B65D6A52F03933DB2FA5CE8FAF210B5B8B80C721CED01")
+	assert.Nil(t, err)
+	mpriKey, err := driver.PrivKeyFromBytes(managerPrivateKeySli)
+	assert.Nil(t, err)
+
+	//升级前的deposit只执行Exec，没有建立历史索引
+	action := &zksyncTypes.ZksyncAction{
+		Ty: zksyncTypes.TyDepositAction,
+		Value: &zksyncTypes.ZksyncAction_Deposit{Deposit: &zksyncTypes.ZkDeposit{
+			TokenId:     0,
+			Amount:      "1000000000000",
+			EthAddress:  "abcd68033A72978C1084E2d44D1Fa06DdC4A2d57",
+			Chain33Addr: "2b8a83399ffc86cc88f0493f17c9698878dcf7caf0bf04a3a5321542a7a416d1",
+		}},
+	}
+	oldTx := createChain33Tx(mpriKey, action, zksyncTypes.Zksync, int64(1e8))
+	oldIndex := index
+	receipt, err := zksyncHandle.Exec_Deposit(action.GetDeposit(), oldTx, oldIndex)
+	assert.Nil(t, err)
+	for _, kv := range receipt.KV {
+		_ = zksyncHandle.GetStateDB().Set(kv.GetKey(), kv.GetValue())
+	}
+	index++
+	accountID := uint64(firstUserAccoutID)
+	_, err = zksyncHandle.Query_GetL2History(&zksyncTypes.ZkReqL2History{AccountId: accountID})
+	assert.Equal(t, types.ErrNotFound, err)
+
+	//升级后的deposit已经在ExecLocal中建立索引
+	_, _, err = deposit(zksyncHandle, mpriKey, 0, 1, "2000", "12a0e25e62c1dbd32e505446062b26aecb65f028", "2afff20cc3c20f9def369626463fb027ebeba0bd976025f68316bb8eab55d48c")
+	assert.Nil(t, err)
+
+	block := &types.Block{Height: zksyncHandle.GetHeight()}
+	receipts := make([]*types.ReceiptData, oldIndex+2)
+	for i := 0; i < oldIndex+2; i++ {
+		block.Txs = append(block.Txs, &types.Transaction{Execer: []byte("coins")})
+		receipts[i] = &types.ReceiptData{Ty: types.ExecOk}
+	}
+	block.Txs[oldIndex] = oldTx
+	receipts[oldIndex] = &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	//已经建立索引的交易位置跳过，否则会重复记录oldTx的回执
+	block.Txs[oldIndex+1] = oldTx
+	receipts[oldIndex+1] = receipts[oldIndex]
+	api := zksyncHandle.GetAPI().(*apimock.QueueProtocolAPI)
+	api.On("GetBlocks", mock.Anything).Return(&types.BlockDetails{Items: []*types.BlockDetail{{Block: block, Receipts: receipts}}}, nil)
+
+	//与执行器升级流程一致，在localdb事务中升级，补建的索引按批次直接写入localdb，返回的只有版本号
+	localDB := db.NewLocalDB(dbHanleGlobal, false)
+	zksyncHandle.SetLocalDB(localDB)
+	localDB.Begin()
+	kvset, err := zksyncHandle.Upgrade()
+	assert.Nil(t, err)
+	assert.Nil(t, localDB.Commit())
+	assert.Equal(t, 1, len(kvset.KV))
+	assert.Equal(t, []byte(zksyncLocaldbVersion), kvset.KV[0].Key)
+	next, err := getUpgradeHeight(zksyncHandle.GetLocalDB())
+	assert.Nil(t, err)
+	assert.Equal(t, zksyncHandle.GetHeight()+1, next)
+	msg, err := zksyncHandle.Query_GetL2History(&zksyncTypes.ZkReqL2History{AccountId: accountID})
+	assert.Nil(t, err)
+	list := msg.(*zksyncTypes.ZkL2HistoryList)
+	assert.Equal(t, 1, len(list.Items))
+	assert.Equal(t, "1000000000000", list.Items[0].BalanceAfter)
+	assert.Equal(t, int32(oldIndex), list.Items[0].TxIndex)
+	msg, err = zksyncHandle.Query_GetL2History(&zksyncTypes.ZkReqL2History{AccountId: accountID + 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(msg.(*zksyncTypes.ZkL2HistoryList).Items))
+
+	//已经升级的版本不再重复补建
+	kvset, err = zksyncHandle.Upgrade()
+	assert.Nil(t, err)
+	assert.Nil(t, kvset)
+}
+
+func TestBatch(t *testing.T) {
+	initSetup()
+	defer util.CloseTestDB(dbDir, dbHanleGlobal)
+
+	var driver secp256k1.Driver
+
+	//12qyocayNF7Lv6C9qW4avxs2E7U41fKSfv
+	managerPrivateKeySli, err := chain33Common.FromHex("4257D8692EF7FE13C68B65D6A52F03933DB2FA5CE8FAF210B5B8B80C721CED01")
+	assert.Nil(t, err)
+	mpriKey, err := driver.PrivKeyFromBytes(managerPrivateKeySli)
+	assert.Nil(t, err)
+
+	tokenId := uint64(0)
+	_, _, err = deposit(zksyncHandle, mpriKey, tokenId, 0, "1000000000000", "abcd68033A72978C1084E2d44D1Fa06DdC4A2d57", "2b8a83399ffc86cc88f0493f17c9698878dcf7caf0bf04a3a5321542a7a416d1")
+	assert.Nil(t, err)
+	_, _, err = deposit(zksyncHandle, mpriKey, tokenId, 1, "1000", "12a0e25e62c1dbd32e505446062b26aecb65f028", "2afff20cc3c20f9def369626463fb027ebeba0bd976025f68316bb8eab55d48c")
+	assert.Nil(t, err)
+	fromId := uint64(firstUserAccoutID)
+	toId := fromId + 1
+	for _, ty := range []int32{zksyncTypes.TyTransferAction, zksyncTypes.TyWithdrawAction, zksyncTypes.TyBatchAction} {
+		_, _, err = setTxFee(zksyncHandle, mpriKey, tokenId, zksyncTypes.FeeMap[int64(ty)], ty)
+		assert.Nil(t, err)
+	}
+
+	acc1privkeySli, err := chain33Common.FromHex("0x19c069234f9d3e61135fefbeb7791b149cdf6af536f26bebb310d4cd22c3fee4")
+	assert.Nil(t, err)
+	acc1privkey, err := driver.PrivKeyFromBytes(acc1privkeySli)
+	assert.Nil(t, err)
+	err = setPubKey(zksyncHandle, acc1privkey, fromId)
+	assert.Nil(t, err)
+
+	transferOp := func(amount string) *zksyncTypes.ZksyncAction {
+		return &zksyncTypes.ZksyncAction{
+			Ty: zksyncTypes.TyTransferAction,
+			Value: &zksyncTypes.ZksyncAction_ZkTransfer{
+				ZkTransfer: &zksyncTypes.ZkTransfer{TokenId: tokenId, Amount: amount, FromAccountId: fromId, ToAccountId: toId},
+			},
+		}
+	}
+	withdrawOp := func(amount string) *zksyncTypes.ZksyncAction {
+		return &zksyncTypes.ZksyncAction{
+			Ty: zksyncTypes.TyWithdrawAction,
+			Value: &zksyncTypes.ZksyncAction_ZkWithdraw{
+				ZkWithdraw: &zksyncTypes.ZkWithdraw{TokenId: tokenId, Amount: amount, AccountId: fromId},
+			},
+		}
+	}
+
+	lastQueueId, err := GetL2LastQueueId(zksyncHandle.GetStateDB())
+	assert.Nil(t, err)
+	receipt, _, err := batch(zksyncHandle, acc1privkey, []*zksyncTypes.ZksyncAction{transferOp("100"), transferOp("200"), withdrawOp("50")})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecOk), receipt.Ty)
+
+	fromBalance, err := GetTokenByAccountIdAndTokenIdInDB(zksyncHandle.GetStateDB(), fromId, tokenId)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("%d", int64(1000000000000)-300-50-100000), fromBalance.Balance)
+	toBalance, err := GetTokenByAccountIdAndTokenIdInDB(zksyncHandle.GetStateDB(), toId, tokenId)
+	assert.Nil(t, err)
+	assert.Equal(t, "1300", toBalance.Balance)
+	feeBalance, err := GetTokenByAccountIdAndTokenIdInDB(zksyncHandle.GetStateDB(), zksyncTypes.SystemFeeAccountId, tokenId)
+	assert.Nil(t, err)
+	assert.Equal(t, "100000", feeBalance.Balance)
+	//每个操作各自生成queue op，整个batch只在第一个操作后生成一个手续费op
+	newQueueId, err := GetL2LastQueueId(zksyncHandle.GetStateDB())
+	assert.Nil(t, err)
+	assert.Equal(t, lastQueueId+4, newQueueId)
+	op, err := GetL2QueueIdOp(zksyncHandle.GetStateDB(), lastQueueId+2)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(zksyncTypes.TyFeeAction), op.Ty)
+	op, err = GetL2QueueIdOp(zksyncHandle.GetStateDB(), lastQueueId+4)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(zksyncTypes.TyWithdrawAction), op.Ty)
+
+	//batch中的操作在history中按各自类型记录
+	msg, err := zksyncHandle.Query_GetL2History(&zksyncTypes.ZkReqL2History{AccountId: fromId, OpType: zksyncTypes.TyTransferAction})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(msg.(*zksyncTypes.ZkL2HistoryList).Items))
+
+	//任一操作失败则整体失败，前面的操作也不生效
+	_, _, err = batch(zksyncHandle, acc1privkey, []*zksyncTypes.ZksyncAction{transferOp("100"), withdrawOp("1000000000000")})
+	assert.NotNil(t, err)
+	toBalance, err = GetTokenByAccountIdAndTokenIdInDB(zksyncHandle.GetStateDB(), toId, tokenId)
+	assert.Nil(t, err)
+	assert.Equal(t, "1300", toBalance.Balance)
+	failQueueId, err := GetL2LastQueueId(zksyncHandle.GetStateDB())
+	assert.Nil(t, err)
+	assert.Equal(t, newQueueId, failQueueId)
+
+	//不支持的操作类型和嵌套batch
+	err = checkBatchParam(&zksyncTypes.ZkBatch{Ops: []*zksyncTypes.ZksyncAction{{
+		Ty:    zksyncTypes.TyFullExitAction,
+		Value: &zksyncTypes.ZksyncAction_FullExit{FullExit: &zksyncTypes.ZkFullExit{AccountId: fromId}},
+	}}})
+	assert.NotNil(t, err)
+	err = checkBatchParam(&zksyncTypes.ZkBatch{Ops: []*zksyncTypes.ZksyncAction{{
+		Ty:    zksyncTypes.TyBatchAction,
+		Value: &zksyncTypes.ZksyncAction_Batch{Batch: &zksyncTypes.ZkBatch{Ops: []*zksyncTypes.ZksyncAction{transferOp("1")}}},
+	}}})
+	assert.NotNil(t, err)
+	//ty和payload不一致
+	err = checkBatchParam(&zksyncTypes.ZkBatch{Ops: []*zksyncTypes.ZksyncAction{{
+		Ty:    zksyncTypes.TyWithdrawAction,
+		Value: transferOp("1").Value,
+	}}})
+	assert.NotNil(t, err)
+
+	//其他账户签名的操作不能通过校验
+	acc2privkeySli, err := chain33Common.FromHex("0x7a80a1f75d7360c6123c32a78ecf978c1ac55636f87892df38d8b85a9aeff115")
+	assert.Nil(t, err)
+	acc2privkey, err := driver.PrivKeyFromBytes(acc2privkeySli)
+	assert.Nil(t, err)
+	_, _, err = batch(zksyncHandle, acc2privkey, []*zksyncTypes.ZksyncAction{transferOp("100")})
+	assert.NotNil(t, err)
+}
+
+func TestFullExit(t *testing.T) {
+	initSetup()
+	defer util.CloseTestDB(dbDir, dbHanleGlobal)
+
+	var driver secp256k1.Driver
+
+	//12qyocayNF7Lv6C9qW4avxs2E7U41fKSfv
+	managerPrivateKeySli, err := chain33Common.FromHex("4257D8692EF7FE13C68B65D6A52F03933DB2FA5CE8FAF210B5B8B80C721CED01")
+	assert.Nil(t, err)
+	mpriKey, err := driver.PrivKeyFromBytes(managerPrivateKeySli)
+	assert.Nil(t, err)
+
+	tokenId := uint64(0)
+	receipt, _, err := deposit(zksyncHandle, mpriKey, tokenId, 0, "1000000000000", "abcd68033A72978C1084E2d44D1Fa06DdC4A2d57", "2b8a83399ffc86cc88f0493f17c9698878dcf7caf0bf04a3a5321542a7a416d1")
+	assert.Nil(t, err)
+	assert.Equal(t, receipt.Ty, int32(types.ExecOk))
+	accountID := uint64(firstUserAccoutID)
+
+	receipt, _, err = setTxFee(zksyncHandle, mpriKey, tokenId, zksyncTypes.FeeMap[zksyncTypes.TyFullExitAction], zksyncTypes.TyFullExitAction)
+	assert.Nil(t, err)
+	assert.Equal(t, receipt.Ty, int32(types.ExecOk))
+
+	//priority id不连续
+	_, _, err = fullExit(zksyncHandle, mpriKey, accountID, tokenId, 2)
+	assert.NotNil(t, err)
+
+	//非管理员不能提交
+	acc1privkeySli, err := chain33Common.FromHex("0x19c069234f9d3e61135fefbeb7791b149cdf6af536f26bebb310d4cd22c3fee4")
+	assert.Nil(t, err)
+	acc1privkey, err := driver.PrivKeyFromBytes(acc1privkeySli)
+	assert.Nil(t, err)
+	_, _, err = fullExit(zksyncHandle, acc1privkey, accountID, tokenId, 1)
+	assert.NotNil(t, err)
+
+	receipt, _, err = fullExit(zksyncHandle, mpriKey, accountID, tokenId, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, receipt.Ty, int32(types.ExecOk))
+	acc4token1Balance, err := GetTokenByAccountIdAndTokenIdInDB(zksyncHandle.GetStateDB(), accountID, tokenId)
+	assert.Nil(t, err)
+	assert.Equal(t, "0", acc4token1Balance.Balance)
+	feeBalance, err := GetTokenByAccountIdAndTokenIdInDB(zksyncHandle.GetStateDB(), zksyncTypes.SystemFeeAccountId, tokenId)
+	assert.Nil(t, err)
+	assert.Equal(t, zksyncTypes.FeeMap[zksyncTypes.TyFullExitAction], feeBalance.Balance)
+
+	//priority queue中的deposit和fullExit都可以查询
+	msg, err := zksyncHandle.Query_GetPriorityOpInfo(&types.Int64{Data: 0})
+	assert.Nil(t, err)
+	assert.Equal(t, zksyncTypes.TyDepositAction, int(msg.(*zksyncTypes.ZkOperation).Ty))
+	msg, err = zksyncHandle.Query_GetPriorityOpInfo(&types.Int64{Data: 1})
+	assert.Nil(t, err)
+	op := msg.(*zksyncTypes.ZkOperation)
+	assert.Equal(t, zksyncTypes.TyFullExitAction, int(op.Ty))
+	assert.Equal(t, "999999000000", op.GetOp().GetFullExit().GetAmount())
+
+	//operator转发的fullExit没有二层签名
+	action := &zksyncTypes.ZksyncAction{
+		Ty:    zksyncTypes.TyFullExitAction,
+		Value: &zksyncTypes.ZksyncAction_FullExit{FullExit: &zksyncTypes.ZkFullExit{AccountId: accountID, TokenId: tokenId, EthPriorityQueueId: 2}},
+	}
+	tx := &types.Transaction{Execer: []byte(zksyncTypes.Zksync), Payload: types.Encode(action)}
+	assert.Nil(t, zksyncHandle.CheckTx(tx, index))
+
+	//ForkZksyncFullExit之前fullExit需要二层签名，并且不允许执行
+	chain33TestCfg.SetDappFork(zksyncTypes.Zksync, zksyncTypes.ForkZksyncFullExit, 1)
+	assert.Equal(t, types.ErrInvalidParam, zksyncHandle.CheckTx(tx, index))
+	_, _, err = fullExit(zksyncHandle, mpriKey, accountID, tokenId, 2)
+	assert.Equal(t, types.ErrNotAllow, errors.Cause(err))
+	chain33TestCfg.SetDappFork(zksyncTypes.Zksync, zksyncTypes.ForkZksyncFullExit, 0)
+
+	//余额为0的账户和不存在的账户也占用priority id
+	receipt, _, err = fullExit(zksyncHandle, mpriKey, accountID, tokenId, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, receipt.Ty, int32(types.ExecOk))
+	receipt, _, err = fullExit(zksyncHandle, mpriKey, accountID+100, tokenId, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, receipt.Ty, int32(types.ExecOk))
+	op, err = GetPriorityOpData(zksyncHandle.GetStateDB(), 3)
+	assert.Nil(t, err)
+	assert.Equal(t, "0", op.GetOp().GetFullExit().GetAmount())
+	lastPriority, err := getLastEthPriorityQueueID(zksyncHandle.GetStateDB())
+	assert.Nil(t, err)
+	assert.Equal(t, "3", lastPriority.GetID())
+}
+
 func TestTransfer(t *testing.T) {
 	initSetup()
 	defer util.CloseTestDB(dbDir, dbHanleGlobal)
@@ -1349,6 +1700,123 @@ func TestNFTMisc(t *testing.T) {
 	fmt.Println("TokenBalance for account ID", toAccountID, "tokenId", nftTokenId, toBalance.Balance)
 }
 
+func TestSwap(t *testing.T) {
+	initSetup()
+	defer util.CloseTestDB(dbDir, dbHanleGlobal)
+
+	var driver secp256k1.Driver
+
+	//12qyocayNF7Lv6C9qW4avxs2E7U41fKSfv
+	managerPrivateKeySli, err := chain33Common.FromHex("4257D8692EF7FE13C68B65D6A52F03933DB2FA5CE8FAF210B5B8B80C721CED01")
+	assert.Nil(t, err)
+	mpriKey, err := driver.PrivKeyFromBytes(managerPrivateKeySli)
+	assert.Nil(t, err)
+
+	//left账户持有token0，right账户持有token1
+	leftTokenId, rightTokenId := uint64(0), uint64(1)
+	receipt, _, err := deposit(zksyncHandle, mpriKey, leftTokenId, 0, "1000000000000", "abcd68033A72978C1084E2d44D1Fa06DdC4A2d57", "2b8a83399ffc86cc88f0493f17c9698878dcf7caf0bf04a3a5321542a7a416d1")
+	assert.Nil(t, err)
+	assert.Equal(t, receipt.Ty, int32(types.ExecOk))
+	receipt, _, err = deposit(zksyncHandle, mpriKey, rightTokenId, 1, "1000000000000", "12a0e25e62c1dbd32e505446062b26aecb65f028", "2afff20cc3c20f9def369626463fb027ebeba0bd976025f68316bb8eab55d48c")
+	assert.Nil(t, err)
+	assert.Equal(t, receipt.Ty, int32(types.ExecOk))
+	leftAccountId := uint64(firstUserAccoutID)
+	rightAccountId := leftAccountId + 1
+
+	receipt, _, err = setTxFee(zksyncHandle, mpriKey, rightTokenId, "10", zksyncTypes.TySwapAction)
+	assert.Nil(t, err)
+	assert.Equal(t, receipt.Ty, int32(types.ExecOk))
+
+	leftPrivKeySli, err := chain33Common.FromHex("0x19c069234f9d3e61135fefbeb7791b149cdf6af536f26bebb310d4cd22c3fee4")
+	assert.Nil(t, err)
+	leftPrivKey, err := driver.PrivKeyFromBytes(leftPrivKeySli)
+	assert.Nil(t, err)
+	err = setPubKey(zksyncHandle, leftPrivKey, leftAccountId)
+	assert.Nil(t, err)
+	rightPrivKeySli, err := chain33Common.FromHex("0x7a80a1f75d7360c6123c32a78ecf978c1ac55636f87892df38d8b85a9aeff115")
+	assert.Nil(t, err)
+	rightPrivKey, err := driver.PrivKeyFromBytes(rightPrivKeySli)
+	assert.Nil(t, err)
+	err = setPubKey(zksyncHandle, rightPrivKey, rightAccountId)
+	assert.Nil(t, err)
+
+	//left最多卖出1000个token0，每个token0至少换2个token1；right最多卖出5000个token1，每2个token1至少换1个token0
+	left := &zksyncTypes.ZkSwapOrder{AccountId: leftAccountId, TokenSell: leftTokenId, TokenBuy: rightTokenId, Amount: "1000", RatioSell: "1", RatioBuy: "2"}
+	right := &zksyncTypes.ZkSwapOrder{AccountId: rightAccountId, TokenSell: rightTokenId, TokenBuy: leftTokenId, Amount: "5000", RatioSell: "2", RatioBuy: "1"}
+
+	//ForkZksyncSwap之前swap不执行也不校验签名，撤单不支持
+	chain33TestCfg.SetDappFork(zksyncTypes.Zksync, zksyncTypes.ForkZksyncSwap, 1)
+	action := &zksyncTypes.ZksyncAction{
+		Ty:    zksyncTypes.TySwapAction,
+		Value: &zksyncTypes.ZksyncAction_Swap{Swap: &zksyncTypes.ZkSwap{Left: left, Right: right, LeftDealAmount: "400", RightDealAmount: "800"}},
+	}
+	tx := createChain33Tx(leftPrivKey, action, zksyncTypes.Zksync, int64(1e8))
+	assert.Nil(t, zksyncHandle.CheckTx(tx, index))
+	receipt, err = zksyncHandle.Exec_Swap(action.GetSwap(), tx, index)
+	assert.Nil(t, err)
+	assert.Nil(t, receipt)
+	_, err = swapCancel(zksyncHandle, rightPrivKey, rightAccountId, 0)
+	assert.Equal(t, types.ErrActionNotSupport, err)
+	chain33TestCfg.SetDappFork(zksyncTypes.Zksync, zksyncTypes.ForkZksyncSwap, 0)
+
+	//分两次成交
+	_, _, err = swap(zksyncHandle, leftPrivKey, rightPrivKey, left, right, "400", "800")
+	assert.Nil(t, err)
+	_, _, err = swap(zksyncHandle, leftPrivKey, rightPrivKey, left, right, "600", "1200")
+	assert.Nil(t, err)
+
+	balance, err := GetTokenByAccountIdAndTokenIdInDB(zksyncHandle.GetStateDB(), leftAccountId, leftTokenId)
+	assert.Nil(t, err)
+	assert.Equal(t, "999999999000", balance.Balance)
+	balance, err = GetTokenByAccountIdAndTokenIdInDB(zksyncHandle.GetStateDB(), leftAccountId, rightTokenId)
+	assert.Nil(t, err)
+	assert.Equal(t, "1980", balance.Balance)
+	balance, err = GetTokenByAccountIdAndTokenIdInDB(zksyncHandle.GetStateDB(), rightAccountId, rightTokenId)
+	assert.Nil(t, err)
+	assert.Equal(t, "999999998000", balance.Balance)
+	balance, err = GetTokenByAccountIdAndTokenIdInDB(zksyncHandle.GetStateDB(), rightAccountId, leftTokenId)
+	assert.Nil(t, err)
+	assert.Equal(t, "1000", balance.Balance)
+	balance, err = GetTokenByAccountIdAndTokenIdInDB(zksyncHandle.GetStateDB(), zksyncTypes.SystemFeeAccountId, rightTokenId)
+	assert.Nil(t, err)
+	assert.Equal(t, "20", balance.Balance)
+
+	//left挂单已经全部成交
+	_, _, err = swap(zksyncHandle, leftPrivKey, rightPrivKey, left, right, "100", "200")
+	assert.NotNil(t, err)
+	//价格不满足left挂单
+	left.Amount = "2000"
+	_, _, err = swap(zksyncHandle, leftPrivKey, rightPrivKey, left, right, "100", "199")
+	assert.NotNil(t, err)
+	//right挂单不能由其他账户签名
+	_, _, err = swap(zksyncHandle, leftPrivKey, leftPrivKey, left, right, "100", "200")
+	assert.NotNil(t, err)
+
+	//nonce和expiry在签名信息中，过期的挂单不能成交
+	left = &zksyncTypes.ZkSwapOrder{AccountId: leftAccountId, TokenSell: leftTokenId, TokenBuy: rightTokenId, Amount: "1000", RatioSell: "1", RatioBuy: "2", Nonce: 1}
+	right = &zksyncTypes.ZkSwapOrder{AccountId: rightAccountId, TokenSell: rightTokenId, TokenBuy: leftTokenId, Amount: "5000", RatioSell: "2", RatioBuy: "1", Nonce: 1, Expiry: 1539918073}
+	_, _, err = swap(zksyncHandle, leftPrivKey, rightPrivKey, left, right, "100", "200")
+	assert.NotNil(t, err)
+	right.Expiry = 1539918074
+	_, _, err = swap(zksyncHandle, leftPrivKey, rightPrivKey, left, right, "100", "200")
+	assert.Nil(t, err)
+
+	//right撤销nonce=1的挂单后，剩余部分不能再成交
+	_, err = swapCancel(zksyncHandle, rightPrivKey, rightAccountId, 1)
+	assert.Nil(t, err)
+	_, _, err = swap(zksyncHandle, leftPrivKey, rightPrivKey, left, right, "100", "200")
+	assert.NotNil(t, err)
+	_, err = swapCancel(zksyncHandle, rightPrivKey, rightAccountId, 1)
+	assert.NotNil(t, err)
+	//只能由账户自己撤单
+	_, err = swapCancel(zksyncHandle, leftPrivKey, rightAccountId, 2)
+	assert.NotNil(t, err)
+	//其他nonce的挂单不受影响
+	right.Nonce = 2
+	_, _, err = swap(zksyncHandle, leftPrivKey, rightPrivKey, left, right, "100", "200")
+	assert.Nil(t, err)
+}
+
 func deposit(zksyncHandle *zksync, privateKey chain33Crypto.PrivKey, tokenId, queueId uint64, amount, ethAddress, chain33Addr string) (*types.Receipt, *types.LocalDBSet, error) {
 	deposit := &zksyncTypes.ZkDeposit{
 		TokenId:      tokenId,
@@ -1466,7 +1934,7 @@ func withdraw(zksyncHandle *zksync, privateKey chain33Crypto.PrivKey, accountID,
 	fmt.Println("exec withdraw cost time = ", time.Since(t1))
 
 	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
-	localDBSet, err := zksyncHandle.ExecLocal_Withdraw(nil, tx, receiptData, index)
+	localDBSet, err := zksyncHandle.ExecLocal_ZkWithdraw(nil, tx, receiptData, index)
 	if nil != err {
 		return nil, nil, err
 	}
@@ -1548,7 +2016,7 @@ func transfer(zksyncHandle *zksync, privateKey chain33Crypto.PrivKey, fromAccoun
 	fmt.Println("exec transfer cost time = ", time.Since(t1))
 
 	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
-	localDBSet, err := zksyncHandle.ExecLocal_Transfer(nil, tx, receiptData, index)
+	localDBSet, err := zksyncHandle.ExecLocal_ZkTransfer(nil, tx, receiptData, index)
 	if nil != err {
 		return nil, nil, err
 	}
@@ -1955,6 +2423,129 @@ func transferNFT(zksyncHandle *zksync, privateKey chain33Crypto.PrivKey, fromAcc
 	return receipt, localDBSet, nil
 }
 
+func swap(zksyncHandle *zksync, leftKey, rightKey chain33Crypto.PrivKey, left, right *zksyncTypes.ZkSwapOrder, leftDeal, rightDeal string) (*types.Receipt, *types.LocalDBSet, error) {
+	rightPrivateKey, err := eddsa.GenerateKey(bytes.NewReader(rightKey.Bytes()))
+	if err != nil {
+		return nil, nil, err
+	}
+	right.Signature, err = SignTxInEddsa(wallet.GetSwapOrderMsg(right), rightPrivateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	swap := &zksyncTypes.ZkSwap{
+		Left:            left,
+		Right:           right,
+		LeftDealAmount:  leftDeal,
+		RightDealAmount: rightDeal,
+	}
+
+	action := &zksyncTypes.ZksyncAction{
+		Ty: zksyncTypes.TySwapAction,
+		Value: &zksyncTypes.ZksyncAction_Swap{
+			Swap: swap,
+		},
+	}
+
+	tx := createChain33Tx(leftKey, action, zksyncTypes.Zksync, int64(1e8))
+	if err := types.Decode(tx.Payload, action); nil != err {
+		return nil, nil, err
+	}
+	if err := zksyncHandle.CheckTx(tx, index); nil != err {
+		return nil, nil, err
+	}
+
+	receipt, err := zksyncHandle.Exec_Swap(action.GetSwap(), tx, index)
+	if nil != err {
+		return nil, nil, err
+	}
+
+	for _, kv := range receipt.KV {
+		_ = zksyncHandle.GetStateDB().Set(kv.GetKey(), kv.GetValue())
+	}
+
+	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	localDBSet, err := zksyncHandle.ExecLocal_Swap(nil, tx, receiptData, index)
+	if nil != err {
+		return nil, nil, err
+	}
+	for _, kv := range localDBSet.KV {
+		_ = zksyncHandle.GetLocalDB().Set(kv.GetKey(), kv.GetValue())
+	}
+	index++
+
+	return receipt, localDBSet, nil
+}
+
+func swapCancel(zksyncHandle *zksync, privateKey chain33Crypto.PrivKey, accountId, nonce uint64) (*types.Receipt, error) {
+	action := &zksyncTypes.ZksyncAction{
+		Ty: zksyncTypes.TySwapCancelAction,
+		Value: &zksyncTypes.ZksyncAction_SwapCancel{
+			SwapCancel: &zksyncTypes.ZkSwapCancel{AccountId: accountId, Nonce: nonce},
+		},
+	}
+
+	tx := createChain33Tx(privateKey, action, zksyncTypes.Zksync, int64(1e8))
+	if err := types.Decode(tx.Payload, action); nil != err {
+		return nil, err
+	}
+	if err := zksyncHandle.CheckTx(tx, index); nil != err {
+		return nil, err
+	}
+
+	receipt, err := zksyncHandle.Exec_SwapCancel(action.GetSwapCancel(), tx, index)
+	if nil != err {
+		return nil, err
+	}
+	for _, kv := range receipt.KV {
+		_ = zksyncHandle.GetStateDB().Set(kv.GetKey(), kv.GetValue())
+	}
+	index++
+
+	return receipt, nil
+}
+
+func batch(zksyncHandle *zksync, privateKey chain33Crypto.PrivKey, ops []*zksyncTypes.ZksyncAction) (*types.Receipt, *types.LocalDBSet, error) {
+	action := &zksyncTypes.ZksyncAction{
+		Ty: zksyncTypes.TyBatchAction,
+		Value: &zksyncTypes.ZksyncAction_Batch{
+			Batch: &zksyncTypes.ZkBatch{Ops: ops},
+		},
+	}
+
+	tx := createChain33Tx(privateKey, action, zksyncTypes.Zksync, int64(1e8))
+	if err := types.Decode(tx.Payload, action); nil != err {
+		return nil, nil, err
+	}
+	if err := zksyncHandle.CheckTx(tx, index); nil != err {
+		return nil, nil, err
+	}
+
+	//模拟框架，交易执行中对statedb的写入在执行失败时丢弃
+	statedb := zksyncHandle.GetStateDB()
+	zksyncHandle.SetStateDB(db.NewLocalDB(dbHanleGlobal, false))
+	receipt, err := zksyncHandle.Exec_Batch(action.GetBatch(), tx, index)
+	zksyncHandle.SetStateDB(statedb)
+	if nil != err {
+		return nil, nil, err
+	}
+
+	for _, kv := range receipt.KV {
+		_ = zksyncHandle.GetStateDB().Set(kv.GetKey(), kv.GetValue())
+	}
+
+	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	localDBSet, err := zksyncHandle.ExecLocal_Batch(nil, tx, receiptData, index)
+	if nil != err {
+		return nil, nil, err
+	}
+	for _, kv := range localDBSet.KV {
+		_ = zksyncHandle.GetLocalDB().Set(kv.GetKey(), kv.GetValue())
+	}
+	index++
+
+	return receipt, localDBSet, nil
+}
+
 func createChain33Tx(privateKey chain33Crypto.PrivKey, action proto.Message, execer string, fee int64) *types.Transaction {
 	tx := &types.Transaction{Execer: []byte(execer), Payload: types.Encode(action), Fee: fee}
 
@@ -2090,6 +2681,32 @@ func SignTransaction(key chain33Crypto.PrivKey, tx *types.Transaction) (err erro
 			return
 		}
 		nft.Signature = signInfo
+	case zksyncTypes.TySwapAction:
+		swap := action.GetSwap()
+		signInfo, err = SignTxInEddsa(wallet.GetSwapOrderMsg(swap.Left), privateKey)
+		if err != nil {
+			return
+		}
+		swap.Left.Signature = signInfo
+		msg = wallet.GetSwapMsg(swap)
+		signInfo, err = SignTxInEddsa(msg, privateKey)
+		if err != nil {
+			return
+		}
+		swap.Signature = signInfo
+	case zksyncTypes.TySwapCancelAction:
+		swapCancel := action.GetSwapCancel()
+		msg = wallet.GetSwapCancelMsg(swapCancel)
+		signInfo, err = SignTxInEddsa(msg, privateKey)
+		if err != nil {
+			return
+		}
+		swapCancel.Signature = signInfo
+	case zksyncTypes.TyBatchAction:
+		err = wallet.SignBatchOps(action.GetBatch(), privateKey)
+		if err != nil {
+			return
+		}
 	}
 
 	tx.Payload = types.Encode(action)
@@ -136,3 +136,13 @@ func getProofId2QueueIdKey(proofID uint64) []byte {
 func getL1PriorityId2QueueIdKey(priorityId int64) []byte {
 	return []byte(fmt.Sprintf("%s%022d", KeyPrefixStateDB+"-priority2QueId", priorityId))
 }
+
+//挂单已经成交的卖出数量，以挂单签名信息的hash作为标识
+func getSwapOrderFilledKey(orderHash []byte) []byte {
+	return []byte(fmt.Sprintf("%s%x", KeyPrefixStateDB+"-swapOrder-", orderHash))
+}
+
+//账户已撤销的挂单nonce
+func getSwapOrderCancelKey(accountId, nonce uint64) []byte {
+	return []byte(fmt.Sprintf("%s%022d-%022d", KeyPrefixStateDB+"-swapOrderCancel-", accountId, nonce))
+}
@@ -0,0 +1,152 @@
+package executor
+
+import (
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	zt "github.com/33cn/plugin/plugin/dapp/zksync/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	zksyncLocaldbVersion = KeyPrefixLocalDB + "-version"
+	//zksyncUpgradeHeight 补建索引下次开始的区块高度
+	zksyncUpgradeHeight = zksyncLocaldbVersion + "-height"
+	//l2HistoryVersion 建立L2账户历史索引的localdb版本，默认版本号是1
+	l2HistoryVersion = 2
+	//upgradeBlockCount 补建索引时每次读取的区块数
+	upgradeBlockCount = 100
+)
+
+// Upgrade L2账户历史索引只在升级后的ExecLocal中建立，这里从区块回执为升级前的区块补建索引，
+// 从zksync启用高度开始按批次写入localdb并记录下次开始的高度，中断后重启可以继续
+func (z *zksync) Upgrade() (*types.LocalDBSet, error) {
+	localDB := z.GetLocalDB()
+	version, err := getVersion(localDB)
+	if err != nil {
+		return nil, errors.Wrap(err, "Upgrade getVersion")
+	}
+	if version >= l2HistoryVersion {
+		return nil, nil
+	}
+	start := z.GetAPI().GetConfig().GetDappFork(zt.Zksync, "Enable")
+	next, err := getUpgradeHeight(localDB)
+	if err != nil {
+		return nil, errors.Wrap(err, "Upgrade getUpgradeHeight")
+	}
+	if next > start {
+		start = next
+	}
+	zklog.Info("Upgrade l2 history start", "start", start, "height", z.GetHeight())
+
+	for ; start <= z.GetHeight(); start += upgradeBlockCount {
+		end := start + upgradeBlockCount - 1
+		if end > z.GetHeight() {
+			end = z.GetHeight()
+		}
+		details, err := z.GetAPI().GetBlocks(&types.ReqBlocks{Start: start, End: end, IsDetail: true})
+		if err != nil {
+			return nil, errors.Wrapf(err, "Upgrade GetBlocks start=%d,end=%d", start, end)
+		}
+		for _, detail := range details.GetItems() {
+			kvs, err := z.upgradeBlockL2History(detail)
+			if err != nil {
+				return nil, errors.Wrapf(err, "Upgrade height=%d", detail.GetBlock().GetHeight())
+			}
+			for _, kv := range kvs {
+				err = localDB.Set(kv.GetKey(), kv.GetValue())
+				if err != nil {
+					return nil, errors.Wrapf(err, "Upgrade height=%d", detail.GetBlock().GetHeight())
+				}
+			}
+		}
+		//每个批次提交一次，避免整条链的索引都缓存在内存中
+		err = setUpgradeHeight(localDB, end+1)
+		if err != nil {
+			return nil, errors.Wrap(err, "Upgrade setUpgradeHeight")
+		}
+		err = localDB.Commit()
+		if err != nil {
+			return nil, errors.Wrapf(err, "Upgrade commit end=%d", end)
+		}
+		localDB.Begin()
+	}
+
+	kvs, err := setVersion(localDB, l2HistoryVersion)
+	if err != nil {
+		return nil, errors.Wrap(err, "Upgrade setVersion")
+	}
+	zklog.Info("Upgrade l2 history done", "height", z.GetHeight())
+	return &types.LocalDBSet{KV: kvs}, nil
+}
+
+//upgradeBlockL2History 补建一个区块中zksync交易的历史记录，已经建立索引的交易跳过
+func (z *zksync) upgradeBlockL2History(detail *types.BlockDetail) ([]*types.KeyValue, error) {
+	var kvs []*types.KeyValue
+	block := detail.GetBlock()
+	historyTable := NewL2HistoryTable(z.GetLocalDB())
+	for i, tx := range block.GetTxs() {
+		if string(types.GetRealExecName(tx.Execer)) != zt.Zksync || i >= len(detail.GetReceipts()) {
+			continue
+		}
+		receipt := detail.GetReceipts()[i]
+		if receipt.GetTy() != types.ExecOk {
+			continue
+		}
+		_, err := historyTable.GetData(getL2HistoryPrimaryKey(block.GetHeight(), int32(i), 0))
+		if err == nil {
+			continue
+		}
+		if err != types.ErrNotFound {
+			return nil, err
+		}
+		txKVs, err := z.saveL2History(tx, receipt, block.GetHeight(), i)
+		if err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, txKVs...)
+	}
+	return kvs, nil
+}
+
+func getVersion(kvdb dbm.KV) (int, error) {
+	value, err := kvdb.Get([]byte(zksyncLocaldbVersion))
+	if err != nil && err != types.ErrNotFound {
+		return 1, err
+	}
+	if err == types.ErrNotFound {
+		return 1, nil
+	}
+	var v types.Int32
+	err = types.Decode(value, &v)
+	if err != nil {
+		return 1, err
+	}
+	return int(v.Data), nil
+}
+
+func setVersion(kvdb dbm.KV, version int) ([]*types.KeyValue, error) {
+	v := types.Int32{Data: int32(version)}
+	x := types.Encode(&v)
+	err := kvdb.Set([]byte(zksyncLocaldbVersion), x)
+	return []*types.KeyValue{{Key: []byte(zksyncLocaldbVersion), Value: x}}, err
+}
+
+func getUpgradeHeight(kvdb dbm.KV) (int64, error) {
+	value, err := kvdb.Get([]byte(zksyncUpgradeHeight))
+	if err == types.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	var h types.Int64
+	err = types.Decode(value, &h)
+	if err != nil {
+		return 0, err
+	}
+	return h.Data, nil
+}
+
+func setUpgradeHeight(kvdb dbm.KV, height int64) error {
+	return kvdb.Set([]byte(zksyncUpgradeHeight), types.Encode(&types.Int64{Data: height}))
+}
@@ -253,12 +253,12 @@ func (z *zksync) Query_GetLastPriorityQueueId(in *types.ReqNil) (types.Message,
 	return getLastEthPriorityQueueID(z.GetStateDB())
 }
 
-// Query_GetPriorityOpInfo 根据priorityId获取operation信息
+// Query_GetPriorityOpInfo 根据priorityId获取operation信息，deposit或fullExit
 func (z *zksync) Query_GetPriorityOpInfo(in *types.Int64) (types.Message, error) {
 	if in == nil {
 		return nil, types.ErrInvalidParam
 	}
-	return GetPriorityDepositData(z.GetStateDB(), in.Data)
+	return GetPriorityOpData(z.GetStateDB(), in.Data)
 }
 
 // Query_GetBatchPriorityOpInfo 根据priorityId获取operation信息
@@ -268,12 +268,12 @@ func (z *zksync) Query_GetBatchPriorityOpInfo(in *types.ReqBlocks) (types.Messag
 	}
 	var batch zt.ZkBatchOperation
 	for i := in.Start; i <= in.End; i++ {
-		deposit, err := GetPriorityDepositData(z.GetStateDB(), i)
+		op, err := GetPriorityOpData(z.GetStateDB(), i)
 		if err != nil {
 			zklog.Error("Query_GetBatchPriorityOpInfo", "priorityid", i, "err", err)
 			return nil, err
 		}
-		batch.Ops = append(batch.Ops, &zt.ZkOperation{Ty: zt.TyDepositAction, Op: &zt.OperationSpecialInfo{Value: &zt.OperationSpecialInfo_Deposit{Deposit: deposit}}})
+		batch.Ops = append(batch.Ops, op)
 	}
 	return &batch, nil
 
@@ -378,6 +378,52 @@ func (z *zksync) Query_GetProofList(in *zt.ZkFetchProofList) (types.Message, err
 	return rows.Data.(*zt.ZkCommitProof), nil
 }
 
+// Query_GetL2History 分页获取L2账户的历史记录，可按token和操作类型过滤
+// 升级前区块的历史记录由Upgrade从区块回执补建，补建完成前只能查到升级后的记录
+func (z *zksync) Query_GetL2History(in *zt.ZkReqL2History) (types.Message, error) {
+	if in == nil {
+		return nil, types.ErrInvalidParam
+	}
+	count := in.GetCount()
+	if count <= 0 || count > maxL2HistoryCount {
+		count = maxL2HistoryCount
+	}
+	var indexName, prefix string
+	switch {
+	case in.GetFilterToken() && in.GetOpType() > 0:
+		indexName = "account_token_ty"
+		prefix = fmt.Sprintf("%016d.%016d.%03d", in.GetAccountId(), in.GetTokenId(), in.GetOpType())
+	case in.GetFilterToken():
+		indexName = "account_token"
+		prefix = fmt.Sprintf("%016d.%016d", in.GetAccountId(), in.GetTokenId())
+	case in.GetOpType() > 0:
+		indexName = "account_ty"
+		prefix = fmt.Sprintf("%016d.%03d", in.GetAccountId(), in.GetOpType())
+	default:
+		indexName = "accountID"
+		prefix = fmt.Sprintf("%016d", in.GetAccountId())
+	}
+	var primaryKey []byte
+	if len(in.GetPrimaryKey()) > 0 {
+		primaryKey = []byte(in.GetPrimaryKey())
+	}
+
+	table := NewL2HistoryTable(z.GetLocalDB())
+	rows, err := table.ListIndex(indexName, []byte(prefix), primaryKey, count, in.GetDirection())
+	if err != nil {
+		zklog.Error("Query_GetL2History.ListIndex", "index", indexName, "prefix", prefix, "err", err.Error())
+		return nil, err
+	}
+	var list zt.ZkL2HistoryList
+	for _, row := range rows {
+		list.Items = append(list.Items, row.Data.(*zt.ZkL2History))
+	}
+	if int32(len(rows)) == count {
+		list.PrimaryKey = string(rows[len(rows)-1].Primary)
+	}
+	return &list, nil
+}
+
 func (z *zksync) Query_GetCurrentExodusMode(in *types.ReqNil) (types.Message, error) {
 	var mode types.Int64
 	data, err := getExodusMode(z.GetStateDB())
@@ -16,6 +16,9 @@ const (
 	KeyPrefixStateDB = "mavl-zksync-"
 	//KeyPrefixLocalDB local db的key必须前缀
 	KeyPrefixLocalDB = "LODB-zksync"
+
+	//maxL2HistoryCount 单次查询L2历史记录的最大条数
+	maxL2HistoryCount = 100
 )
 
 var opt_account_tree = &table.Option{
@@ -185,3 +188,64 @@ func (r *CommitProofRow) Get(key string) ([]byte, error) {
 	}
 	return nil, types.ErrNotFound
 }
+
+var opt_l2_history = &table.Option{
+	Prefix:  KeyPrefixLocalDB,
+	Name:    "history",
+	Primary: "history_id",
+	Index:   []string{"accountID", "account_token", "account_ty", "account_token_ty"},
+}
+
+// NewL2HistoryTable ...
+func NewL2HistoryTable(kvdb db.KV) *table.Table {
+	rowmeta := NewL2HistoryRow()
+	table, err := table.NewTable(rowmeta, kvdb, opt_l2_history)
+	if err != nil {
+		panic(err)
+	}
+	return table
+}
+
+//getL2HistoryPrimaryKey 历史记录按区块高度、交易序号和交易内序号排序
+func getL2HistoryPrimaryKey(height int64, txIndex, seq int32) []byte {
+	return []byte(fmt.Sprintf("%016d.%06d.%04d", height, txIndex, seq))
+}
+
+// L2HistoryRow table meta 结构
+type L2HistoryRow struct {
+	*zt.ZkL2History
+}
+
+func NewL2HistoryRow() *L2HistoryRow {
+	return &L2HistoryRow{ZkL2History: &zt.ZkL2History{}}
+}
+
+//CreateRow 新建数据行
+func (r *L2HistoryRow) CreateRow() *table.Row {
+	return &table.Row{Data: &zt.ZkL2History{}}
+}
+
+//SetPayload 设置数据
+func (r *L2HistoryRow) SetPayload(data types.Message) error {
+	if txdata, ok := data.(*zt.ZkL2History); ok {
+		r.ZkL2History = txdata
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+//Get 按照indexName 查询 indexValue
+func (r *L2HistoryRow) Get(key string) ([]byte, error) {
+	if key == "history_id" {
+		return getL2HistoryPrimaryKey(r.GetBlockHeight(), r.GetTxIndex(), r.GetSeq()), nil
+	} else if key == "accountID" {
+		return []byte(fmt.Sprintf("%016d", r.GetAccountId())), nil
+	} else if key == "account_token" {
+		return []byte(fmt.Sprintf("%016d.%016d", r.GetAccountId(), r.GetTokenId())), nil
+	} else if key == "account_ty" {
+		return []byte(fmt.Sprintf("%016d.%03d", r.GetAccountId(), r.GetOpType())), nil
+	} else if key == "account_token_ty" {
+		return []byte(fmt.Sprintf("%016d.%016d.%03d", r.GetAccountId(), r.GetTokenId(), r.GetOpType())), nil
+	}
+	return nil, types.ErrNotFound
+}
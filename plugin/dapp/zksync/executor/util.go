@@ -103,6 +103,22 @@ func GetPriorityDepositData(db dbm.KV, priorityId int64) (*zt.ZkDepositWitnessIn
 	return op.GetOp().GetDeposit(), nil
 }
 
+//GetPriorityOpData 获取priority id对应的L2 operation，deposit或fullExit
+func GetPriorityOpData(db dbm.KV, priorityId int64) (*zt.ZkOperation, error) {
+	queueId, err := GetPriority2QueueId(db, priorityId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GetPriority2QueueId=%d", priorityId)
+	}
+	op, err := GetL2QueueIdOp(db, queueId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GetL2QueueIdOp id=%d", queueId)
+	}
+	if op.Ty != zt.TyDepositAction && op.Ty != zt.TyFullExitAction {
+		return nil, errors.Wrapf(types.ErrInvalidParam, "priorityId=%d,to queueId=%d, queue op.ty=%d not priority op", priorityId, queueId, op.Ty)
+	}
+	return op, nil
+}
+
 func checkOpSame(queueOp, pubDataOp *zt.ZkOperation) error {
 	if queueOp == nil || pubDataOp == nil {
 		return errors.Wrapf(types.ErrInvalidParam, "nil op: queueOp=%x,pubDataOp=%x ", queueOp, pubDataOp)
@@ -134,6 +150,10 @@ func checkOpSame(queueOp, pubDataOp *zt.ZkOperation) error {
 		return checkSameWithdrawNFT(queueOp, pubDataOp)
 	case zt.TyTransferNFTAction:
 		return checkSameTransferNFT(queueOp, pubDataOp)
+	case zt.TySwapAction:
+		return checkSameSwap(queueOp, pubDataOp)
+	case zt.TyFullExitAction:
+		return checkSameFullExit(queueOp, pubDataOp)
 	default:
 		return errors.Wrapf(types.ErrNotFound, "action=%d", queueOp.Ty)
 	}
@@ -181,6 +201,24 @@ func checkSameWithdraw(queueOp, pubDataOp *zt.ZkOperation) error {
 	return nil
 }
 
+func checkSameFullExit(queueOp, pubDataOp *zt.ZkOperation) error {
+	q := queueOp.Op.GetFullExit()
+	p := pubDataOp.Op.GetFullExit()
+	if q.AccountID != p.AccountID {
+		return errors.Wrapf(types.ErrInvalidParam, "fullExit acctId queue=%d, pub=%d", q.AccountID, p.AccountID)
+	}
+	if q.TokenID != p.TokenID {
+		return errors.Wrapf(types.ErrInvalidParam, "fullExit tokenId queue=%d, pub=%d", q.TokenID, p.TokenID)
+	}
+	if q.Amount != p.Amount {
+		return errors.Wrapf(types.ErrInvalidParam, "fullExit amount queue=%s, pub=%s", q.Amount, p.Amount)
+	}
+	if q.Fee.Fee != p.Fee.Fee {
+		return errors.Wrapf(types.ErrInvalidParam, "fullExit fee queue=%s, pub=%s", q.Fee.Fee, p.Fee.Fee)
+	}
+	return nil
+}
+
 func checkSameTransfer(queueOp, pubDataOp *zt.ZkOperation) error {
 	q := queueOp.Op.GetTransfer()
 	p := pubDataOp.Op.GetTransfer()
@@ -439,6 +477,33 @@ func checkSameTransferNFT(queueOp, pubDataOp *zt.ZkOperation) error {
 	return nil
 }
 
+func checkSameSwap(queueOp, pubDataOp *zt.ZkOperation) error {
+	q := queueOp.Op.GetSwap()
+	p := pubDataOp.Op.GetSwap()
+	if q.Left.AccountID != p.Left.AccountID {
+		return errors.Wrapf(types.ErrInvalidParam, "swap left acctId queue=%d, pub=%d", q.Left.AccountID, p.Left.AccountID)
+	}
+	if q.Right.AccountID != p.Right.AccountID {
+		return errors.Wrapf(types.ErrInvalidParam, "swap right acctId queue=%d, pub=%d", q.Right.AccountID, p.Right.AccountID)
+	}
+	if q.LeftTokenID != p.LeftTokenID {
+		return errors.Wrapf(types.ErrInvalidParam, "swap left tokenId queue=%d, pub=%d", q.LeftTokenID, p.LeftTokenID)
+	}
+	if q.RightTokenID != p.RightTokenID {
+		return errors.Wrapf(types.ErrInvalidParam, "swap right tokenId queue=%d, pub=%d", q.RightTokenID, p.RightTokenID)
+	}
+	if q.LeftDealAmount != p.LeftDealAmount {
+		return errors.Wrapf(types.ErrInvalidParam, "swap left amount queue=%s, pub=%s", q.LeftDealAmount, p.LeftDealAmount)
+	}
+	if q.RightDealAmount != p.RightDealAmount {
+		return errors.Wrapf(types.ErrInvalidParam, "swap right amount queue=%s, pub=%s", q.RightDealAmount, p.RightDealAmount)
+	}
+	if q.Fee.Fee != p.Fee.Fee {
+		return errors.Wrapf(types.ErrInvalidParam, "swap fee queue=%s, pub=%s", q.Fee.Fee, p.Fee.Fee)
+	}
+	return nil
+}
+
 func checkPackValue(amount string, manMaxBitWidth int64) error {
 	amountInt, ok := new(big.Int).SetString(amount, 10)
 	if !ok {
@@ -449,127 +449,59 @@ func getAccountMapByOp(op *zt.ZkOperation, accountMap map[uint64]*zt.HistoryLeaf
 		}
 		accountMap[operation.AccountID] = fromLeaf
 
-	//case zt.TyFullExitAction:
-	//	operation := op.Op.GetFullExit()
-	//	fromLeaf, ok := accountMap[operation.AccountID]
-	//	if !ok {
-	//		return nil, errors.New(fmt.Sprintf("account=%d not exist", operation.AccountID))
-	//	}
-	//	var tokenBalance *zt.TokenBalance
-	//	//找到token
-	//	for _, token := range fromLeaf.Tokens {
-	//		if token.TokenId == operation.TokenID {
-	//			tokenBalance = token
-	//		}
-	//	}
-	//	if tokenBalance == nil {
-	//		return nil, errors.New(fmt.Sprintf("token=%d not exist", operation.TokenID))
-	//	} else {
-	//		tokenBalance.Balance = "0"
-	//	}
-	//	accountMap[operation.AccountID] = fromLeaf
-
-	//case zt.TySwapAction:
-	//	operation := op.Op.GetSwap()
-	//	//token: left asset, right asset 顺序
-	//	//电路顺序为：sell-leftAsset, buy+leftAsset, sell-rightAsset-fee, buy+rightAsset-2ndFee
-	//	//这里考虑leaf获取方便，顺序调整为 sell-leftAsset buy+rightAsset-2ndfee, sell-rightAsset-fee,buy+leftAsset
-	//	leftLeaf, ok := accountMap[operation.Left.AccountID]
-	//	if !ok {
-	//		return nil, errors.New("account not exist")
-	//	}
-	//	var tokenBalance *zt.TokenBalance
-	//	//找到sell token
-	//	for _, token := range leftLeaf.Tokens {
-	//		if token.TokenId == operation.LeftTokenID {
-	//			tokenBalance = token
-	//		}
-	//	}
-	//	if tokenBalance == nil {
-	//		return nil, errors.New("taker token not exist")
-	//	}
-	//	balance, _ := new(big.Int).SetString(tokenBalance.GetBalance(), 10)
-	//	change, _ := new(big.Int).SetString(operation.LeftDealAmount, 10)
-	//	if change.Cmp(balance) > 0 {
-	//		return nil, errors.New("balance not enough")
-	//	}
-	//	tokenBalance.Balance = new(big.Int).Sub(balance, change).String()
-	//
-	//	//buy token
-	//	tokenBalance = nil
-	//	for _, token := range leftLeaf.Tokens {
-	//		if token.TokenId == operation.RightTokenID {
-	//			tokenBalance = token
-	//		}
-	//	}
-	//	//taker 2nd fee
-	//	change, _ = new(big.Int).SetString(operation.RightDealAmount, 10)
-	//	fee, _ := new(big.Int).SetString(operation.Fee.Fee, 10)
-	//	if fee.Cmp(change) > 0 {
-	//		return nil, errors.New("change not enough to fee to taker")
-	//	}
-	//	//sub fee
-	//	change = new(big.Int).Sub(change, fee)
-	//	if tokenBalance == nil {
-	//		newToken := &zt.TokenBalance{
-	//			TokenId: operation.RightTokenID,
-	//			Balance: change.String(),
-	//		}
-	//		leftLeaf.Tokens = append(leftLeaf.Tokens, newToken)
-	//	} else {
-	//		balance, _ := new(big.Int).SetString(tokenBalance.GetBalance(), 10)
-	//		tokenBalance.Balance = new(big.Int).Add(balance, change).String()
-	//	}
-	//	accountMap[operation.Left.AccountID] = leftLeaf
-	//
-	//	//toAccount leaf
-	//	rightLeaf, ok := accountMap[operation.Right.AccountID]
-	//	if !ok {
-	//		return nil, errors.New(fmt.Sprintf("right account=%d not exist", operation.Right.AccountID))
-	//	}
-	//
-	//	//找到right asset
-	//	tokenBalance = nil
-	//	for _, token := range rightLeaf.Tokens {
-	//		if token.TokenId == operation.RightTokenID {
-	//			tokenBalance = token
-	//		}
-	//	}
-	//	if tokenBalance == nil {
-	//		return nil, errors.New(fmt.Sprintf("right sell token=%d not exist", operation.RightTokenID))
-	//	}
-	//	balance, _ = new(big.Int).SetString(tokenBalance.GetBalance(), 10)
-	//	change, _ = new(big.Int).SetString(operation.RightDealAmount, 10)
-	//	if change.Cmp(balance) > 0 {
-	//		return nil, errors.New("maker token balance not enough")
-	//	}
-	//	newBalance := new(big.Int).Sub(balance, change)
-	//	//1st fee
-	//	fee, _ = new(big.Int).SetString(operation.Fee.Fee, 10)
-	//	if fee.Cmp(newBalance) > 0 {
-	//		return nil, errors.New("change not enough to fee to taker")
-	//	}
-	//	tokenBalance.Balance = new(big.Int).Sub(newBalance, fee).String()
-	//
-	//	//buy token
-	//	tokenBalance = nil
-	//	for _, token := range rightLeaf.Tokens {
-	//		if token.TokenId == operation.RightTokenID {
-	//			tokenBalance = token
-	//		}
-	//	}
-	//	if tokenBalance == nil {
-	//		newToken := &zt.TokenBalance{
-	//			TokenId: operation.RightTokenID,
-	//			Balance: operation.RightDealAmount,
-	//		}
-	//		rightLeaf.Tokens = append(rightLeaf.Tokens, newToken)
-	//	} else {
-	//		balance, _ := new(big.Int).SetString(tokenBalance.GetBalance(), 10)
-	//		change, _ := new(big.Int).SetString(operation.RightDealAmount, 10)
-	//		tokenBalance.Balance = new(big.Int).Add(balance, change).String()
-	//	}
-	//	accountMap[operation.Right.AccountID] = rightLeaf
+	case zt.TyFullExitAction:
+		operation := op.Op.GetFullExit()
+		change, _ := new(big.Int).SetString(operation.Amount, 10)
+		fee, _ := new(big.Int).SetString(operation.Fee.Fee, 10)
+		change = new(big.Int).Add(change, fee)
+		//账户或token不存在的fullExit只占用priority id，不改变余额
+		if change.Sign() == 0 {
+			break
+		}
+		fromLeaf, ok := accountMap[operation.AccountID]
+		if !ok {
+			return 0, errors.New(fmt.Sprintf("fullExit account=%d not exist", operation.AccountID))
+		}
+		err := updateHistoryLeafToken(fromLeaf, operation.TokenID, change.String(), zt.Sub)
+		if err != nil {
+			return 0, errors.Wrapf(err, "fullExit account=%d", operation.AccountID)
+		}
+		accountMap[operation.AccountID] = fromLeaf
+
+	case zt.TySwapAction:
+		operation := op.Op.GetSwap()
+		//left卖出leftAsset, 买入rightAsset并支付手续费; right卖出rightAsset, 买入leftAsset
+		leftLeaf, ok := accountMap[operation.Left.AccountID]
+		if !ok {
+			return 0, errors.New(fmt.Sprintf("left account=%d not exist", operation.Left.AccountID))
+		}
+		rightLeaf, ok := accountMap[operation.Right.AccountID]
+		if !ok {
+			return 0, errors.New(fmt.Sprintf("right account=%d not exist", operation.Right.AccountID))
+		}
+		rightDeal, _ := new(big.Int).SetString(operation.RightDealAmount, 10)
+		fee, _ := new(big.Int).SetString(operation.Fee.Fee, 10)
+		if fee.Cmp(rightDeal) > 0 {
+			return 0, errors.New("swap deal amount not enough to fee")
+		}
+		err := updateHistoryLeafToken(leftLeaf, operation.LeftTokenID, operation.LeftDealAmount, zt.Sub)
+		if err != nil {
+			return 0, errors.Wrapf(err, "swap left account=%d", operation.Left.AccountID)
+		}
+		err = updateHistoryLeafToken(rightLeaf, operation.RightTokenID, operation.RightDealAmount, zt.Sub)
+		if err != nil {
+			return 0, errors.Wrapf(err, "swap right account=%d", operation.Right.AccountID)
+		}
+		err = updateHistoryLeafToken(leftLeaf, operation.RightTokenID, new(big.Int).Sub(rightDeal, fee).String(), zt.Add)
+		if err != nil {
+			return 0, errors.Wrapf(err, "swap left account=%d", operation.Left.AccountID)
+		}
+		err = updateHistoryLeafToken(rightLeaf, operation.LeftTokenID, operation.LeftDealAmount, zt.Add)
+		if err != nil {
+			return 0, errors.Wrapf(err, "swap right account=%d", operation.Right.AccountID)
+		}
+		accountMap[operation.Left.AccountID] = leftLeaf
+		accountMap[operation.Right.AccountID] = rightLeaf
 
 	case zt.TyContractToTreeAction:
 		operation := op.Op.GetContractToTree()
@@ -939,6 +871,34 @@ func getAccountMapByOp(op *zt.ZkOperation, accountMap map[uint64]*zt.HistoryLeaf
 	return maxAccountID, nil
 }
 
+//updateHistoryLeafToken 更新历史账户的token余额，增加时token不存在则新建
+func updateHistoryLeafToken(leaf *zt.HistoryLeaf, tokenId uint64, amount string, option int32) error {
+	var tokenBalance *zt.TokenBalance
+	for _, token := range leaf.Tokens {
+		if token.TokenId == tokenId {
+			tokenBalance = token
+		}
+	}
+	change, _ := new(big.Int).SetString(amount, 10)
+	if tokenBalance == nil {
+		if option == zt.Sub {
+			return errors.New(fmt.Sprintf("token=%d not exist", tokenId))
+		}
+		leaf.Tokens = append(leaf.Tokens, &zt.TokenBalance{TokenId: tokenId, Balance: change.String()})
+		return nil
+	}
+	balance, _ := new(big.Int).SetString(tokenBalance.GetBalance(), 10)
+	if option == zt.Sub {
+		if change.Cmp(balance) > 0 {
+			return errors.New(fmt.Sprintf("token=%d balance=%s less than %s", tokenId, balance, change))
+		}
+		tokenBalance.Balance = new(big.Int).Sub(balance, change).String()
+		return nil
+	}
+	tokenBalance.Balance = new(big.Int).Add(balance, change).String()
+	return nil
+}
+
 func getHistoryAccounts(accountMap map[uint64]*zt.HistoryLeaf, maxAccountId uint64) (*zt.HistoryAccountProofInfo, error) {
 	h := mimc.NewMiMC(zt.ZkMimcHashSeed)
 	historyAccounts := &zt.HistoryAccountProofInfo{}
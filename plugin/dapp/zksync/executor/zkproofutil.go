@@ -604,6 +604,26 @@ func parseRollbackOps(ops []*zt.ZkOperation) ([]uint64, []uint64, map[uint64]*zt
 			depositAccountMap[zt.SystemFeeAccountId] = updateLeaf(depositAccountMap, zt.SystemFeeAccountId, operation.TokenID, operation.Fee.Fee)
 			zklog.Info("parseRollbackOps", "idx", i, "ty", "proxyExit", "proxyId", operation.ProxyID, "targetId", operation.TargetID,
 				"tokenId", operation.TokenID, "amount", operation.Amount, "fee", operation.Fee.Fee, "height", operation.BlockInfo.Height)
+		case zt.TyFullExitAction:
+			operation := op.Op.GetFullExit()
+			amount, _ := new(big.Int).SetString(operation.Amount, 10)
+			fee, _ := new(big.Int).SetString(operation.Fee.Fee, 10)
+			//账户或token不存在的fullExit没有余额变化
+			if amount.Sign() == 0 && fee.Sign() == 0 {
+				continue
+			}
+			if _, ok := withdrawAccountMap[operation.AccountID]; !ok {
+				withdrawAcctIds = append(withdrawAcctIds, operation.AccountID)
+			}
+			withdrawAccountMap[operation.AccountID] = updateLeaf(withdrawAccountMap, operation.AccountID, operation.TokenID, new(big.Int).Add(amount, fee).String())
+
+			//扣除fee账户的tx fee，放到depositAccountMap中
+			if _, ok := depositAccountMap[zt.SystemFeeAccountId]; !ok {
+				depositAcctIds = append(depositAcctIds, zt.SystemFeeAccountId)
+			}
+			depositAccountMap[zt.SystemFeeAccountId] = updateLeaf(depositAccountMap, zt.SystemFeeAccountId, operation.TokenID, operation.Fee.Fee)
+			zklog.Info("parseRollbackOps", "idx", i, "ty", "fullExit", "acctId", operation.AccountID, "tokenId", operation.TokenID,
+				"amount", operation.Amount, "fee", operation.Fee.Fee, "height", operation.BlockInfo.Height)
 		}
 
 	}
@@ -66,6 +66,12 @@ func (z *zksync) CheckTx(tx *types.Transaction, index int) error {
 	if err := types.Decode(tx.Payload, action); err != nil {
 		return err
 	}
+	return z.checkActionSignature(action)
+}
+
+//checkActionSignature 校验L2操作的签名，batch中的每个操作单独校验
+func (z *zksync) checkActionSignature(action *zt.ZksyncAction) error {
+	cfg := z.GetAPI().GetConfig()
 	var signature *zt.ZkSignature
 	var msg *zt.ZkMsg
 	switch action.GetTy() {
@@ -94,6 +100,11 @@ func (z *zksync) CheckTx(tx *types.Transaction, index int) error {
 		signature = action.GetSetPubKey().GetSignature()
 		msg = wallet.GetSetPubKeyMsg(action.GetSetPubKey())
 	case zt.TyFullExitAction:
+		//ForkZksyncFullExit之后fullExit由L1用户在以太坊发起，operator从priority queue转发提交，L1用户没有二层签名，
+		//只在执行时校验提交者是管理员或verifier
+		if cfg.IsDappFork(z.GetHeight(), zt.Zksync, zt.ForkZksyncFullExit) {
+			return nil
+		}
 		signature = action.GetFullExit().GetSignature()
 		msg = wallet.GetFullExitMsg(action.GetFullExit())
 	case zt.TyMintNFTAction:
@@ -105,6 +116,42 @@ func (z *zksync) CheckTx(tx *types.Transaction, index int) error {
 	case zt.TyTransferNFTAction:
 		signature = action.GetTransferNFT().GetSignature()
 		msg = wallet.GetTransferNFTMsg(action.GetTransferNFT())
+	case zt.TySwapAction:
+		//ForkZksyncSwap之前swap不执行，也不校验
+		if !cfg.IsDappFork(z.GetHeight(), zt.Zksync, zt.ForkZksyncSwap) {
+			return nil
+		}
+		swap := action.GetSwap()
+		if err := checkSwapParam(swap); err != nil {
+			return err
+		}
+		//双方挂单的签名
+		for _, order := range []*zt.ZkSwapOrder{swap.Left, swap.Right} {
+			if err := verifySignature(order.GetSignature(), wallet.GetSwapOrderMsg(order)); err != nil {
+				zlog.Error("checkTx.swap order signature", "accountId", order.AccountId, "err", err)
+				return err
+			}
+		}
+		signature = swap.GetSignature()
+		msg = wallet.GetSwapMsg(swap)
+	case zt.TySwapCancelAction:
+		if !cfg.IsDappFork(z.GetHeight(), zt.Zksync, zt.ForkZksyncSwap) {
+			return nil
+		}
+		signature = action.GetSwapCancel().GetSignature()
+		msg = wallet.GetSwapCancelMsg(action.GetSwapCancel())
+	case zt.TyBatchAction:
+		batch := action.GetBatch()
+		if err := checkBatchParam(batch); err != nil {
+			return err
+		}
+		for i, op := range batch.GetOps() {
+			if err := z.checkActionSignature(op); err != nil {
+				zlog.Error("checkTx.batch op signature", "index", i, "ty", op.GetTy(), "err", err)
+				return err
+			}
+		}
+		return nil
 	default:
 		return nil
 	}
@@ -112,6 +159,14 @@ func (z *zksync) CheckTx(tx *types.Transaction, index int) error {
 		zlog.Error("checkTx.signature is nil", "ty", action.GetTy())
 		return types.ErrInvalidParam
 	}
+	return verifySignature(signature, msg)
+}
+
+//verifySignature 校验二层交易的eddsa签名
+func verifySignature(signature *zt.ZkSignature, msg *zt.ZkMsg) error {
+	if signature == nil || signature.PubKey == nil {
+		return types.ErrInvalidParam
+	}
 	pubKey := eddsa.PublicKey{}
 	pubKey.A.X.SetString(signature.PubKey.X)
 	pubKey.A.Y.SetString(signature.PubKey.Y)
@@ -1,6 +1,7 @@
 package executor
 
 import (
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/types"
 	zt "github.com/33cn/plugin/plugin/dapp/zksync/types"
 )
@@ -47,9 +48,90 @@ func (z *zksync) execLocalZksync(tx *types.Transaction, receiptData *types.Recei
 		return nil, err
 	}
 	dbSet.KV = append(dbSet.KV, kvs...)
+
+	kvs, err = z.saveL2History(tx, receiptData, z.GetHeight(), index)
+	if err != nil {
+		return nil, err
+	}
+	dbSet.KV = append(dbSet.KV, kvs...)
 	return dbSet, nil
 }
 
+//saveL2History 按照交易回执中的账户余额变化log记录L2账户历史
+func (z *zksync) saveL2History(tx *types.Transaction, receiptData *types.ReceiptData, height int64, index int) ([]*types.KeyValue, error) {
+	var action zt.ZksyncAction
+	err := types.Decode(tx.GetPayload(), &action)
+	if err != nil {
+		return nil, err
+	}
+	historyTable := NewL2HistoryTable(z.GetLocalDB())
+	txHash := common.ToHex(tx.Hash())
+	seq := int32(0)
+	opType := action.Ty
+	add := func(logTy int32, receipt *zt.AccountTokenBalanceReceipt, peerAccountId uint64) error {
+		if receipt == nil {
+			return nil
+		}
+		history := &zt.ZkL2History{
+			AccountId:     receipt.AccountId,
+			TokenId:       receipt.TokenId,
+			OpType:        opType,
+			LogTy:         logTy,
+			BalanceBefore: receipt.BalanceBefore,
+			BalanceAfter:  receipt.BalanceAfter,
+			PeerAccountId: peerAccountId,
+			BlockHeight:   height,
+			TxIndex:       int32(index),
+			Seq:           seq,
+			TxHash:        txHash,
+		}
+		seq++
+		return historyTable.Add(history)
+	}
+
+	for _, log := range receiptData.Logs {
+		//batch中按log类型记录各个操作的类型，手续费log跟随其所属的操作
+		if action.Ty == zt.TyBatchAction {
+			switch log.Ty {
+			case zt.TyWithdrawLog:
+				opType = zt.TyWithdrawAction
+			case zt.TyTransferLog:
+				opType = zt.TyTransferAction
+			case zt.TyTransferToNewLog:
+				opType = zt.TyTransferToNewAction
+			}
+		}
+		switch log.Ty {
+		case zt.TyDepositLog, zt.TyWithdrawLog, zt.TyProxyExitLog, zt.TyFullExitLog, zt.TySwapLog, zt.TyFeeLog,
+			zt.TyMintNFTLog, zt.TyWithdrawNFTLog, zt.TyDepositRollbackLog, zt.TyWithdrawRollbackLog:
+			var receipt zt.AccountTokenBalanceReceipt
+			err := types.Decode(log.GetLog(), &receipt)
+			if err != nil {
+				return nil, err
+			}
+			err = add(log.Ty, &receipt, 0)
+			if err != nil {
+				return nil, err
+			}
+		case zt.TyTransferLog, zt.TyTransferToNewLog, zt.TyContractToTreeLog, zt.TyTreeToContractLog, zt.TyTransferNFTLog:
+			var receipt zt.TransferReceipt4L2
+			err := types.Decode(log.GetLog(), &receipt)
+			if err != nil {
+				return nil, err
+			}
+			err = add(log.Ty, receipt.GetFrom(), receipt.GetTo().GetAccountId())
+			if err != nil {
+				return nil, err
+			}
+			err = add(log.Ty, receipt.GetTo(), receipt.GetFrom().GetAccountId())
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return historyTable.Save()
+}
+
 func (z *zksync) execAutoDelLocal(tx *types.Transaction, receiptData *types.ReceiptData) (*types.LocalDBSet, error) {
 	kvs, err := z.DelRollbackKV(tx, tx.Execer)
 	if err != nil {
@@ -15,6 +15,7 @@ import (
 	"github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
 	zt "github.com/33cn/plugin/plugin/dapp/zksync/types"
+	"github.com/33cn/plugin/plugin/dapp/zksync/wallet"
 	"github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
 	"github.com/pkg/errors"
 )
@@ -34,6 +35,13 @@ type Action struct {
 	localDB   dbm.KVDB
 	index     int
 	api       client.QueueProtocolAPI
+	batch     *batchFee
+}
+
+//batchFee batch中只由第一个操作收取一次batch手续费并生成一个fee op，其余操作不收手续费
+type batchFee struct {
+	fee     string
+	charged bool
 }
 
 //NewAction ...
@@ -223,7 +231,7 @@ func (a *Action) ZkWithdraw(payload *zt.ZkWithdraw) (*types.Receipt, error) {
 		return nil, errors.Wrapf(err, "checkParam")
 	}
 
-	amountPlusFee, fee, err := GetAmountWithFee(a.statedb, zt.TyWithdrawAction, payload.Amount, payload.TokenId)
+	amountPlusFee, fee, err := a.getAmountWithFee(zt.TyWithdrawAction, payload.Amount, payload.TokenId)
 	if err != nil {
 		return nil, err
 	}
@@ -297,7 +305,7 @@ func (a *Action) ZkWithdraw(payload *zt.ZkWithdraw) (*types.Receipt, error) {
 		return nil, err
 	}
 
-	feeReceipt, feeQueue, err := a.MakeFeeLog(fee, payload.TokenId)
+	feeReceipt, feeQueue, err := a.makeFeeOp(fee, payload.TokenId)
 	if err != nil {
 		return nil, errors.Wrapf(err, "MakeFeeLog")
 	}
@@ -306,7 +314,9 @@ func (a *Action) ZkWithdraw(payload *zt.ZkWithdraw) (*types.Receipt, error) {
 	//add  withdraw & fee queue
 	var ops []*zt.ZkOperation
 	ops = append(ops, &zt.ZkOperation{Ty: zt.TyWithdrawAction, Op: &zt.OperationSpecialInfo{Value: &zt.OperationSpecialInfo_Withdraw{Withdraw: special}}})
-	ops = append(ops, feeQueue)
+	if feeQueue != nil {
+		ops = append(ops, feeQueue)
+	}
 	r, _, err := setL2QueueData(a.statedb, ops)
 	if err != nil {
 		return nil, err
@@ -605,7 +615,7 @@ func (a *Action) l2TransferProc(payload *zt.ZkTransfer, actionTy int32, decimal
 		return nil, errors.Wrapf(types.ErrNotAllow, "tokenId=%d should less than system NFT base ID=%d", payload.TokenId, zt.SystemNFTTokenId)
 	}
 
-	amountPlusFee, fee, err := GetAmountWithFee(a.statedb, actionTy, payload.Amount, payload.TokenId)
+	amountPlusFee, fee, err := a.getAmountWithFee(actionTy, payload.Amount, payload.TokenId)
 	if err != nil {
 		return nil, err
 	}
@@ -702,7 +712,7 @@ func (a *Action) l2TransferProc(payload *zt.ZkTransfer, actionTy int32, decimal
 	}
 
 	//2.操作交易费账户
-	feeReceipt, feeQueue, err := a.MakeFeeLog(fee, payload.TokenId)
+	feeReceipt, feeQueue, err := a.makeFeeOp(fee, payload.TokenId)
 	if err != nil {
 		return nil, errors.Wrapf(err, "MakeFeeLog")
 	}
@@ -711,7 +721,9 @@ func (a *Action) l2TransferProc(payload *zt.ZkTransfer, actionTy int32, decimal
 	//add  transfer & fee queue
 	var ops []*zt.ZkOperation
 	ops = append(ops, operation)
-	ops = append(ops, feeQueue)
+	if feeQueue != nil {
+		ops = append(ops, feeQueue)
+	}
 	r, _, err := setL2QueueData(a.statedb, ops)
 	if err != nil {
 		return nil, err
@@ -806,7 +818,7 @@ func (a *Action) TransferToNew(payload *zt.ZkTransferToNew) (*types.Receipt, err
 	if err != nil {
 		return nil, errors.Wrapf(err, "checkParam")
 	}
-	amountPlusFee, fee, err := GetAmountWithFee(a.statedb, zt.TyTransferToNewAction, payload.Amount, payload.TokenId)
+	amountPlusFee, fee, err := a.getAmountWithFee(zt.TyTransferToNewAction, payload.Amount, payload.TokenId)
 	if err != nil {
 		return nil, err
 	}
@@ -851,7 +863,7 @@ func (a *Action) TransferToNew(payload *zt.ZkTransferToNew) (*types.Receipt, err
 		return nil, err
 	}
 
-	feeReceipt, feeQueue, err := a.MakeFeeLog(fee, payload.TokenId)
+	feeReceipt, feeQueue, err := a.makeFeeOp(fee, payload.TokenId)
 	if err != nil {
 		return nil, errors.Wrapf(err, "MakeFeeLog")
 	}
@@ -870,7 +882,9 @@ func (a *Action) TransferToNew(payload *zt.ZkTransferToNew) (*types.Receipt, err
 	}
 	var ops []*zt.ZkOperation
 	ops = append(ops, &zt.ZkOperation{Ty: zt.TyTransferToNewAction, Op: &zt.OperationSpecialInfo{Value: &zt.OperationSpecialInfo_TransferToNew{TransferToNew: special}}})
-	ops = append(ops, feeQueue)
+	if feeQueue != nil {
+		ops = append(ops, feeQueue)
+	}
 	r, _, err := setL2QueueData(a.statedb, ops)
 	if err != nil {
 		return nil, err
@@ -1088,78 +1102,116 @@ func (a *Action) SetProxyPubKey(payload *zt.ZkSetPubKey, leaf *zt.Leaf) ([]*type
 	return kvs, localKvs, nil
 }
 
-//func (a *Action) FullExit(payload *zt.ZkFullExit) (*types.Receipt, error) {
-//	var logs []*types.ReceiptLog
-//	var kvs []*types.KeyValue
-//
-//	fee := zt.FeeMap[zt.TyFullExitAction]
-//
-//	//只有管理员能操作
-//	cfg := a.api.GetConfig()
-//	if !isSuperManager(cfg, a.fromaddr) && !isVerifier(a.statedb, a.fromaddr) {
-//		return nil, errors.Wrapf(types.ErrNotAllow, "from addr is not manager")
-//	}
-//
-//	//fullexit last priority id 不能为空
-//	lastPriority, err := getLastEthPriorityQueueID(a.statedb, 0)
-//	if err != nil {
-//		return nil, errors.Wrapf(err, "get eth last priority queue id")
-//	}
-//	lastId, ok := big.NewInt(0).SetString(lastPriority.GetID(), 10)
-//	if !ok {
-//		return nil, errors.Wrapf(types.ErrInvalidParam, fmt.Sprintf("getID =%s", lastPriority.GetID()))
-//	}
-//
-//	if lastId.Int64()+1 != payload.GetEthPriorityQueueId() {
-//		return nil, errors.Wrapf(types.ErrNotAllow, "eth last priority queue id=%s,new=%d", lastPriority.ID, payload.GetEthPriorityQueueId())
-//	}
-//
-//	leaf, err := GetLeafByAccountId(a.statedb, payload.AccountId)
-//	if err != nil {
-//		return nil, errors.Wrapf(err, "calProof")
-//	}
-//
-//	if leaf == nil {
-//		return nil, errors.New("account not exist")
-//	}
-//
-//	token, err := GetTokenByAccountIdAndTokenId(a.statedb, payload.AccountId, payload.TokenId)
-//	if err != nil {
-//		return nil, errors.Wrapf(err, "db.GetTokenByAccountIdAndTokenId")
-//	}
-//
-//	//token不存在时，不需要取
-//	if token == nil {
-//		return nil, errors.New("token not find")
-//	}
-//
-//	//加上手续费
-//	amountInt, _ := new(big.Int).SetString(token.Balance, 10)
-//	feeInt, _ := new(big.Int).SetString(fee, 10)
-//	//存量不够手续费时，不能取
-//	if amountInt.Cmp(feeInt) <= 0 {
-//		return nil, errors.New("no enough fee")
-//	}
-//
-//	fromKVs, l2LogFrom, _, err := applyL2AccountUpdate(leaf.GetAccountId(), payload.GetTokenId(), token.Balance, zt.Sub, a.statedb, leaf, true)
-//	if nil != err {
-//		return nil, errors.Wrapf(err, "applyL2AccountUpdate")
-//	}
-//	kvs = append(kvs, fromKVs...)
-//	l2LogFrom.Ty = zt.TyFullExitLog
-//	logs = append(logs, l2LogFrom)
-//
-//	receipts := &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
-//	//add priority part
-//	r := makeSetEthPriorityIdReceipt(0, lastId.Int64(), payload.EthPriorityQueueId)
-//
-//	feeReceipt, err := a.MakeFeeLog(fee, payload.TokenId, payload.Signature)
-//	if err != nil {
-//		return nil, errors.Wrapf(err, "MakeFeeLog")
-//	}
-//	receipts = mergeReceipt(receipts, feeReceipt)
-//	return mergeReceipt(receipts, r), nil
-//}
+//FullExit L1发起的强制退出，由管理员或verifier按L1 priority id顺序提交，退出账户某个token的全部余额
+//L1 priority queue需要连续处理，所以账户或token不存在时不返回错误，仍然记录priority id和amount为0的op
+//余额不足手续费时不收取手续费，保证用户总能退出
+func (a *Action) FullExit(payload *zt.ZkFullExit) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kvs []*types.KeyValue
+
+	if !checkIsNormalToken(payload.TokenId) {
+		return nil, errors.Wrapf(types.ErrNotAllow, "tokenId=%d should less than system NFT base ID=%d", payload.TokenId, zt.SystemNFTTokenId)
+	}
+	//只有管理员能操作
+	cfg := a.api.GetConfig()
+	if !isSuperManager(cfg, a.fromaddr) && !isVerifier(a.statedb, a.fromaddr) {
+		return nil, errors.Wrapf(types.ErrNotAllow, "from addr is not manager")
+	}
+
+	lastPriority, err := getLastEthPriorityQueueID(a.statedb)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get eth last priority queue id")
+	}
+	lastPriorityId, ok := big.NewInt(0).SetString(lastPriority.GetID(), 10)
+	if !ok {
+		return nil, errors.Wrapf(types.ErrInvalidParam, fmt.Sprintf("lastPriorityID=%s", lastPriority.GetID()))
+	}
+	if lastPriorityId.Int64()+1 != payload.EthPriorityQueueId {
+		return nil, errors.Wrapf(types.ErrNotAllow, "eth last priority queue id=%d,new=%d", lastPriorityId, payload.EthPriorityQueueId)
+	}
+
+	special := &zt.ZkFullExitWitnessInfo{
+		AccountID:  payload.AccountId,
+		TokenID:    payload.TokenId,
+		Amount:     "0",
+		EthAddress: "0",
+		Fee:        &zt.ZkFee{Fee: "0", TokenID: payload.TokenId},
+		BlockInfo:  &zt.OpBlockInfo{Height: a.height, TxIndex: int32(a.index)},
+	}
+	receipts := &types.Receipt{Ty: types.ExecOk}
+	var feeQueue *zt.ZkOperation
+
+	leaf, err := GetLeafByAccountId(a.statedb, payload.AccountId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "db.GetLeafByAccountId")
+	}
+	var token *zt.TokenBalance
+	if leaf != nil {
+		special.EthAddress = leaf.EthAddress
+		token, err = GetTokenByAccountIdAndTokenId(a.statedb, payload.AccountId, payload.TokenId)
+		if err != nil {
+			return nil, errors.Wrapf(err, "db.GetTokenByAccountIdAndTokenId")
+		}
+	}
+	balance := big.NewInt(0)
+	if token != nil {
+		balance, _ = new(big.Int).SetString(token.Balance, 10)
+	}
+	if balance.Sign() > 0 {
+		feeInfo, err := GetFeeData(a.statedb, zt.TyFullExitAction, payload.TokenId)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getFeeData")
+		}
+		fee, _ := new(big.Int).SetString(feeInfo.Fee, 10)
+		if balance.Cmp(fee) <= 0 {
+			fee = big.NewInt(0)
+		}
+		special.Amount = new(big.Int).Sub(balance, fee).String()
+		special.Fee.Fee = fee.String()
+
+		fromKVs, l2Log, _, err := applyL2AccountUpdate(leaf.GetAccountId(), payload.TokenId, token.Balance, zt.Sub, a.statedb, leaf, true)
+		if nil != err {
+			return nil, errors.Wrapf(err, "applyL2AccountUpdate")
+		}
+		kvs = append(kvs, fromKVs...)
+		l2Log.Ty = zt.TyFullExitLog
+		logs = append(logs, l2Log)
+		receipts = &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
+		err = saveKvs(a.statedb, kvs)
+		if err != nil {
+			return nil, err
+		}
+
+		if fee.Sign() > 0 {
+			feeReceipt, feeOp, err := a.MakeFeeLog(special.Fee.Fee, payload.TokenId)
+			if err != nil {
+				return nil, errors.Wrapf(err, "MakeFeeLog")
+			}
+			receipts = mergeReceipt(receipts, feeReceipt)
+			feeQueue = feeOp
+		}
+	} else {
+		zklog.Info("zksync fullExit with nil balance", "accountId", payload.AccountId, "tokenId", payload.TokenId, "priorityId", payload.EthPriorityQueueId)
+	}
+
+	//add priority part
+	r := makeSetL1PriorityIdReceipt(lastPriorityId.Int64(), payload.EthPriorityQueueId)
+	mergeReceipt(receipts, r)
+
+	ops := []*zt.ZkOperation{{Ty: zt.TyFullExitAction, Op: &zt.OperationSpecialInfo{Value: &zt.OperationSpecialInfo_FullExit{FullExit: special}}}}
+	if feeQueue != nil {
+		ops = append(ops, feeQueue)
+	}
+	r, lastQueueId, err := setL2QueueData(a.statedb, ops)
+	if err != nil {
+		return nil, err
+	}
+	mergeReceipt(receipts, r)
+	//priority id 对应fullExit op, 不是后面的fee op
+	r = makeSetPriority2QueIdReceipt(payload.EthPriorityQueueId, lastQueueId-int64(len(ops))+1)
+	mergeReceipt(receipts, r)
+	return receipts, nil
+}
 
 //验证身份
 func authVerification(signPubKey *zt.ZkPubKey, leafPubKey *zt.ZkPubKey) error {
@@ -1494,6 +1546,34 @@ func GetAmountWithFee(db dbm.KV, actionTy int32, amount string, tokenId uint64)
 	return totalFromAmount.String(), fromFeeInt.String(), nil
 }
 
+//getAmountWithFee batch中只有第一个操作收取batch手续费，其余操作手续费为0
+func (a *Action) getAmountWithFee(actionTy int32, amount string, tokenId uint64) (amountPlusFee, fee string, err error) {
+	if a.batch == nil {
+		return GetAmountWithFee(a.statedb, actionTy, amount, tokenId)
+	}
+	fee = "0"
+	if !a.batch.charged {
+		fee = a.batch.fee
+	}
+	amountInt, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return "", "", errors.Wrapf(types.ErrInvalidParam, "decode amount=%s", amount)
+	}
+	feeInt, _ := new(big.Int).SetString(fee, 10)
+	return new(big.Int).Add(amountInt, feeInt).String(), fee, nil
+}
+
+//makeFeeOp batch中只为第一个操作生成fee op，其余操作返回空的receipt和nil op
+func (a *Action) makeFeeOp(fee string, tokenId uint64) (*types.Receipt, *zt.ZkOperation, error) {
+	if a.batch != nil {
+		if a.batch.charged {
+			return &types.Receipt{Ty: types.ExecOk}, nil, nil
+		}
+		a.batch.charged = true
+	}
+	return a.MakeFeeLog(fee, tokenId)
+}
+
 func (a *Action) MintNFT(payload *zt.ZkMintNFT) (*types.Receipt, error) {
 	var logs []*types.ReceiptLog
 	var kvs []*types.KeyValue
@@ -1998,6 +2078,408 @@ func (a *Action) transferNFT(payload *zt.ZkTransferNFT) (*types.Receipt, error)
 	return receipts, nil
 }
 
+//检查swap数量，需要能放入签名信息的数量位宽
+func checkSwapAmount(amount string) error {
+	err := checkParam(amount)
+	if err != nil {
+		return err
+	}
+	v, _ := new(big.Int).SetString(amount, 10)
+	if v.BitLen() > zt.AmountBitWidth {
+		return errors.Wrapf(types.ErrInvalidParam, "amount=%s too big", amount)
+	}
+	return nil
+}
+
+//checkSwapParam 检查双方挂单是否匹配以及成交数量是否满足双方的价格要求
+func checkSwapParam(payload *zt.ZkSwap) error {
+	left, right := payload.GetLeft(), payload.GetRight()
+	if left == nil || right == nil {
+		return errors.Wrapf(types.ErrInvalidParam, "swap order nil")
+	}
+	if left.AccountId == right.AccountId {
+		return errors.Wrapf(types.ErrInvalidParam, "swap with same account=%d", left.AccountId)
+	}
+	if left.TokenSell == left.TokenBuy || left.TokenSell != right.TokenBuy || left.TokenBuy != right.TokenSell {
+		return errors.Wrapf(types.ErrInvalidParam, "swap token not match, left sell=%d,buy=%d, right sell=%d,buy=%d",
+			left.TokenSell, left.TokenBuy, right.TokenSell, right.TokenBuy)
+	}
+	if !checkIsNormalToken(left.TokenSell) || !checkIsNormalToken(left.TokenBuy) {
+		return errors.Wrapf(types.ErrNotAllow, "swap tokenId should less than system NFT base ID=%d", zt.SystemNFTTokenId)
+	}
+	for _, order := range []*zt.ZkSwapOrder{left, right} {
+		for _, amount := range []string{order.Amount, order.RatioSell, order.RatioBuy} {
+			if err := checkSwapAmount(amount); err != nil {
+				return errors.Wrapf(err, "swap order account=%d", order.AccountId)
+			}
+		}
+		if err := checkSwapNonce(order.Nonce); err != nil {
+			return errors.Wrapf(err, "swap order account=%d", order.AccountId)
+		}
+		if order.Expiry < 0 {
+			return errors.Wrapf(types.ErrInvalidParam, "swap order account=%d expiry=%d", order.AccountId, order.Expiry)
+		}
+	}
+	for _, amount := range []string{payload.LeftDealAmount, payload.RightDealAmount} {
+		if err := checkSwapAmount(amount); err != nil {
+			return errors.Wrapf(err, "swap deal amount")
+		}
+		if err := checkPackValue(amount, zt.PacAmountManBitWidth); err != nil {
+			return errors.Wrapf(err, "checkPackVal")
+		}
+	}
+
+	leftDeal, _ := new(big.Int).SetString(payload.LeftDealAmount, 10)
+	rightDeal, _ := new(big.Int).SetString(payload.RightDealAmount, 10)
+	//left 卖出leftDeal得到rightDeal, 需要满足 rightDeal/leftDeal >= left.ratioBuy/left.ratioSell
+	if !checkSwapPrice(leftDeal, rightDeal, left) {
+		return errors.Wrapf(types.ErrNotAllow, "swap price not match left order")
+	}
+	//right 卖出rightDeal得到leftDeal
+	if !checkSwapPrice(rightDeal, leftDeal, right) {
+		return errors.Wrapf(types.ErrNotAllow, "swap price not match right order")
+	}
+	return nil
+}
+
+//检查挂单nonce，需要能放入签名信息的nonce位宽
+func checkSwapNonce(nonce uint64) error {
+	if new(big.Int).SetUint64(nonce).BitLen() > zt.NonceBitWidth {
+		return errors.Wrapf(types.ErrInvalidParam, "nonce=%d too big", nonce)
+	}
+	return nil
+}
+
+//卖出sell得到buy，检查是否满足挂单价格 buy*ratioSell >= sell*ratioBuy
+func checkSwapPrice(sell, buy *big.Int, order *zt.ZkSwapOrder) bool {
+	ratioSell, _ := new(big.Int).SetString(order.RatioSell, 10)
+	ratioBuy, _ := new(big.Int).SetString(order.RatioBuy, 10)
+	return new(big.Int).Mul(buy, ratioSell).Cmp(new(big.Int).Mul(sell, ratioBuy)) >= 0
+}
+
+//getSwapOrderFilled 获取挂单已成交的卖出数量
+func getSwapOrderFilled(db dbm.KV, orderHash []byte) (*big.Int, error) {
+	v, err := db.Get(getSwapOrderFilledKey(orderHash))
+	if err != nil {
+		if isNotFound(err) {
+			return big.NewInt(0), nil
+		}
+		return nil, errors.Wrapf(err, "get db")
+	}
+	filled, ok := new(big.Int).SetString(string(v), 10)
+	if !ok {
+		return nil, errors.Wrapf(types.ErrInvalidParam, "swap order filled=%s", string(v))
+	}
+	return filled, nil
+}
+
+//isSwapOrderCancelled 账户是否已撤销该nonce的挂单
+func isSwapOrderCancelled(db dbm.KV, accountId, nonce uint64) (bool, error) {
+	_, err := db.Get(getSwapOrderCancelKey(accountId, nonce))
+	if err != nil {
+		if isNotFound(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "get db")
+	}
+	return true, nil
+}
+
+//checkSwapOrderValid 挂单未过期且未被撤销
+func checkSwapOrderValid(db dbm.KV, order *zt.ZkSwapOrder, blocktime int64) error {
+	if order.Expiry > 0 && blocktime > order.Expiry {
+		return errors.Wrapf(types.ErrNotAllow, "swap order account=%d nonce=%d expired at %d", order.AccountId, order.Nonce, order.Expiry)
+	}
+	cancelled, err := isSwapOrderCancelled(db, order.AccountId, order.Nonce)
+	if err != nil {
+		return err
+	}
+	if cancelled {
+		return errors.Wrapf(types.ErrNotAllow, "swap order account=%d nonce=%d cancelled", order.AccountId, order.Nonce)
+	}
+	return nil
+}
+
+//updateSwapOrderFilled 累加挂单的成交数量，不能超过挂单数量
+func updateSwapOrderFilled(db dbm.KV, order *zt.ZkSwapOrder, deal string) (*types.KeyValue, error) {
+	orderHash := wallet.GetMsgHash(wallet.GetSwapOrderMsg(order))
+	filled, err := getSwapOrderFilled(db, orderHash)
+	if err != nil {
+		return nil, err
+	}
+	dealInt, _ := new(big.Int).SetString(deal, 10)
+	amount, _ := new(big.Int).SetString(order.Amount, 10)
+	filled = new(big.Int).Add(filled, dealInt)
+	if filled.Cmp(amount) > 0 {
+		return nil, errors.Wrapf(types.ErrNotAllow, "swap order account=%d filled=%s over amount=%s", order.AccountId, filled, order.Amount)
+	}
+	return &types.KeyValue{Key: getSwapOrderFilledKey(orderHash), Value: []byte(filled.String())}, nil
+}
+
+//Swap 撮合两个挂单，left卖出LeftDealAmount的left.TokenSell，right卖出RightDealAmount的right.TokenSell
+//手续费由left从买入的token中支付
+func (a *Action) Swap(payload *zt.ZkSwap) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kvs []*types.KeyValue
+
+	err := checkSwapParam(payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "checkSwapParam")
+	}
+	left, right := payload.Left, payload.Right
+	leftTokenId, rightTokenId := left.TokenSell, right.TokenSell
+	for _, order := range []*zt.ZkSwapOrder{left, right} {
+		if err := checkSwapOrderValid(a.statedb, order, a.blocktime); err != nil {
+			return nil, err
+		}
+	}
+
+	leftLeaf, err := GetLeafByAccountId(a.statedb, left.AccountId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "db.GetLeafByAccountId")
+	}
+	if leftLeaf == nil {
+		return nil, errors.New("account not exist")
+	}
+	rightLeaf, err := GetLeafByAccountId(a.statedb, right.AccountId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "db.GetLeafByAccountId")
+	}
+	if rightLeaf == nil {
+		return nil, errors.New("account not exist")
+	}
+	err = authVerification(payload.GetSignature().GetPubKey(), leftLeaf.PubKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "authVerification")
+	}
+	err = authVerification(left.GetSignature().GetPubKey(), leftLeaf.PubKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "authVerification left order")
+	}
+	err = authVerification(right.GetSignature().GetPubKey(), rightLeaf.PubKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "authVerification right order")
+	}
+
+	//手续费从left买入的数量中扣除
+	feeInfo, err := GetFeeData(a.statedb, zt.TySwapAction, rightTokenId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getFeeData")
+	}
+	fee := feeInfo.Fee
+	feeInt, _ := new(big.Int).SetString(fee, 10)
+	rightDeal, _ := new(big.Int).SetString(payload.RightDealAmount, 10)
+	if feeInt.Cmp(rightDeal) >= 0 {
+		return nil, errors.Wrapf(types.ErrNotAllow, "swap fee=%s not less than deal amount=%s", fee, payload.RightDealAmount)
+	}
+	leftReceive := new(big.Int).Sub(rightDeal, feeInt).String()
+
+	//挂单成交数量
+	leftFilledKV, err := updateSwapOrderFilled(a.statedb, left, payload.LeftDealAmount)
+	if err != nil {
+		return nil, err
+	}
+	rightFilledKV, err := updateSwapOrderFilled(a.statedb, right, payload.RightDealAmount)
+	if err != nil {
+		return nil, err
+	}
+	kvs = append(kvs, leftFilledKV, rightFilledKV)
+
+	leftToken, err := GetTokenByAccountIdAndTokenId(a.statedb, left.AccountId, leftTokenId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "db.GetTokenByAccountIdAndTokenId")
+	}
+	err = checkAmount(leftToken, payload.LeftDealAmount)
+	if err != nil {
+		return nil, errors.Wrapf(err, "left checkAmount")
+	}
+	rightToken, err := GetTokenByAccountIdAndTokenId(a.statedb, right.AccountId, rightTokenId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "db.GetTokenByAccountIdAndTokenId")
+	}
+	err = checkAmount(rightToken, payload.RightDealAmount)
+	if err != nil {
+		return nil, errors.Wrapf(err, "right checkAmount")
+	}
+
+	//双方账户各有一卖一买，每次更新后写入db，保证同一账户的后续更新读到最新状态
+	updates := []struct {
+		leaf    *zt.Leaf
+		tokenId uint64
+		amount  string
+		option  int32
+	}{
+		{leftLeaf, leftTokenId, payload.LeftDealAmount, zt.Sub},
+		{rightLeaf, rightTokenId, payload.RightDealAmount, zt.Sub},
+		{leftLeaf, rightTokenId, leftReceive, zt.Add},
+		{rightLeaf, leftTokenId, payload.LeftDealAmount, zt.Add},
+	}
+	for _, u := range updates {
+		updateKVs, l2Log, _, err := applyL2AccountUpdate(u.leaf.GetAccountId(), u.tokenId, u.amount, u.option, a.statedb, u.leaf, true)
+		if nil != err {
+			return nil, errors.Wrapf(err, "applyL2AccountUpdate")
+		}
+		l2Log.Ty = zt.TySwapLog
+		logs = append(logs, l2Log)
+		kvs = append(kvs, updateKVs...)
+		err = saveKvs(a.statedb, updateKVs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = saveKvs(a.statedb, []*types.KeyValue{leftFilledKV, rightFilledKV})
+	if err != nil {
+		return nil, err
+	}
+	receipts := &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
+
+	feeReceipt, feeQueue, err := a.MakeFeeLog(fee, rightTokenId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "MakeFeeLog")
+	}
+	receipts = mergeReceipt(receipts, feeReceipt)
+
+	special := &zt.ZkSwapWitnessInfo{
+		LeftTokenID:     leftTokenId,
+		RightTokenID:    rightTokenId,
+		LeftDealAmount:  payload.LeftDealAmount,
+		RightDealAmount: payload.RightDealAmount,
+		Left: &zt.ZkSwapOrderInfo{
+			AccountID: left.AccountId,
+			Amount:    left.Amount,
+			RatioSell: left.RatioSell,
+			RatioBuy:  left.RatioBuy,
+			Signature: left.Signature,
+		},
+		Right: &zt.ZkSwapOrderInfo{
+			AccountID: right.AccountId,
+			Amount:    right.Amount,
+			RatioSell: right.RatioSell,
+			RatioBuy:  right.RatioBuy,
+			Signature: right.Signature,
+		},
+		Fee:       feeInfo,
+		BlockInfo: &zt.OpBlockInfo{Height: a.height, TxIndex: int32(a.index)},
+	}
+	var ops []*zt.ZkOperation
+	ops = append(ops, &zt.ZkOperation{Ty: zt.TySwapAction, Op: &zt.OperationSpecialInfo{Value: &zt.OperationSpecialInfo_Swap{Swap: special}}})
+	ops = append(ops, feeQueue)
+	r, _, err := setL2QueueData(a.statedb, ops)
+	if err != nil {
+		return nil, err
+	}
+	receipts = mergeReceipt(receipts, r)
+
+	return receipts, nil
+}
+
+//SwapCancel 撤销账户某个nonce的挂单，已部分成交的挂单剩余部分也不能再成交
+func (a *Action) SwapCancel(payload *zt.ZkSwapCancel) (*types.Receipt, error) {
+	if err := checkSwapNonce(payload.GetNonce()); err != nil {
+		return nil, err
+	}
+	leaf, err := GetLeafByAccountId(a.statedb, payload.GetAccountId())
+	if err != nil {
+		return nil, errors.Wrapf(err, "db.GetLeafByAccountId")
+	}
+	if leaf == nil {
+		return nil, errors.New("account not exist")
+	}
+	err = authVerification(payload.GetSignature().GetPubKey(), leaf.PubKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "authVerification")
+	}
+	cancelled, err := isSwapOrderCancelled(a.statedb, payload.AccountId, payload.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	if cancelled {
+		return nil, errors.Wrapf(types.ErrNotAllow, "swap order account=%d nonce=%d already cancelled", payload.AccountId, payload.Nonce)
+	}
+
+	kv := &types.KeyValue{Key: getSwapOrderCancelKey(payload.AccountId, payload.Nonce), Value: types.Encode(payload)}
+	log := &types.ReceiptLog{Ty: zt.TySwapCancelLog, Log: types.Encode(payload)}
+	return &types.Receipt{Ty: types.ExecOk, KV: []*types.KeyValue{kv}, Logs: []*types.ReceiptLog{log}}, nil
+}
+
+//getBatchOpTokenId batch中操作的tokenId
+func getBatchOpTokenId(op *zt.ZksyncAction) uint64 {
+	switch op.GetTy() {
+	case zt.TyWithdrawAction:
+		return op.GetZkWithdraw().GetTokenId()
+	case zt.TyTransferAction:
+		return op.GetZkTransfer().GetTokenId()
+	case zt.TyTransferToNewAction:
+		return op.GetTransferToNew().GetTokenId()
+	}
+	return 0
+}
+
+//checkBatchParam batch只允许transfer, withdraw, transferToNew操作，且不能嵌套
+func checkBatchParam(payload *zt.ZkBatch) error {
+	if payload == nil || len(payload.GetOps()) == 0 {
+		return errors.Wrapf(types.ErrInvalidParam, "batch ops nil")
+	}
+	if len(payload.GetOps()) > zt.MaxBatchOpCount {
+		return errors.Wrapf(types.ErrInvalidParam, "batch ops=%d over max=%d", len(payload.GetOps()), zt.MaxBatchOpCount)
+	}
+	for i, op := range payload.GetOps() {
+		var ok bool
+		switch op.GetTy() {
+		case zt.TyWithdrawAction:
+			ok = op.GetZkWithdraw() != nil
+		case zt.TyTransferAction:
+			ok = op.GetZkTransfer() != nil
+		case zt.TyTransferToNewAction:
+			ok = op.GetTransferToNew() != nil
+		default:
+			return errors.Wrapf(types.ErrNotAllow, "batch op index=%d ty=%d not support", i, op.GetTy())
+		}
+		if !ok {
+			return errors.Wrapf(types.ErrInvalidParam, "batch op index=%d ty=%d payload nil", i, op.GetTy())
+		}
+	}
+	return nil
+}
+
+//Batch 按顺序执行各个L2操作，每个操作生成各自的ZkOperation，整个batch只收取一次手续费并生成一个手续费op，任一操作失败则整个交易失败回滚
+func (a *Action) Batch(payload *zt.ZkBatch) (*types.Receipt, error) {
+	err := checkBatchParam(payload)
+	if err != nil {
+		return nil, err
+	}
+	//整个batch按第一个操作的token收取一次手续费
+	feeInfo, err := GetFeeData(a.statedb, zt.TyBatchAction, getBatchOpTokenId(payload.GetOps()[0]))
+	if err != nil {
+		return nil, errors.Wrapf(err, "getFeeData")
+	}
+	a.batch = &batchFee{fee: feeInfo.Fee}
+	defer func() { a.batch = nil }()
+
+	receipts := &types.Receipt{Ty: types.ExecOk}
+	for i, op := range payload.GetOps() {
+		var r *types.Receipt
+		switch op.GetTy() {
+		case zt.TyWithdrawAction:
+			r, err = a.ZkWithdraw(op.GetZkWithdraw())
+		case zt.TyTransferAction:
+			r, err = a.ZkTransfer(op.GetZkTransfer(), zt.TyTransferAction)
+		case zt.TyTransferToNewAction:
+			r, err = a.TransferToNew(op.GetTransferToNew())
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "batch op index=%d ty=%d", i, op.GetTy())
+		}
+		//后续操作需要基于前面操作的余额和queue id执行，这里需要把kv设进db
+		err = saveKvs(a.statedb, r.KV)
+		if err != nil {
+			return nil, err
+		}
+		receipts = mergeReceipt(receipts, r)
+	}
+	return receipts, nil
+}
+
 func makeSetExodusModeReceipt(prev, current int64) *types.Receipt {
 	key := getExodusModeKey()
 	log := &zt.ReceiptExodusMode{
@@ -45,6 +45,8 @@ const (
 	TySetFeeAction         = 105 //设置手续费
 	TySetTokenSymbolAction = 106 //设置token的symbol 以方便在合约使用
 	TySetExodusModeAction  = 107 //设置token的symbol 以方便在合约使用
+	TyBatchAction          = 108 //批量执行L2操作
+	TySwapCancelAction     = 109 //撤销挂单
 
 	TyAssetTransferAction       = 120 //从tree转到zksync合约的资产账户之间转账
 	TyAssetTransferToExecAction = 121 //从tree转到zksync合约的资产转到执行器
@@ -74,6 +76,8 @@ const (
 	NameSetFeeAction         = "SetFee"
 	NameSetTokenSymbolAction = "SetTokenSymbol"
 	NameSetExodusMode        = "SetExodusMode"
+	NameBatchAction          = "Batch"
+	NameSwapCancelAction     = "SwapCancel"
 
 	NameAssetTransfer      = "Transfer"
 	NameAssetTransfer2Exec = "TransferToExec"
@@ -116,6 +120,7 @@ const (
 	TyDepositRollbackLog       = 216 //deposit 回滚的log
 	TyWithdrawRollbackLog      = 217 //withdraw 回滚的log
 	TyPriority2QueIdLog        = 218 //priority id to queueId
+	TySwapCancelLog            = 219 //撤销挂单
 )
 
 const (
@@ -128,6 +133,9 @@ const (
 
 	MaxDecimalAllow = 18
 	MinDecimalAllow = 4
+
+	//MaxBatchOpCount 一个batch交易最多包含的L2操作数
+	MaxBatchOpCount = 100
 )
 
 //Zksync 执行器名称定义
@@ -150,6 +158,8 @@ const (
 	AddrBitWidth      = 160 //20byte
 	HashBitWidth      = 256 //32byte
 	PubKeyBitWidth    = 256 //32byte
+	NonceBitWidth     = 32  //4byte, swap挂单nonce
+	TimeBitWidth      = 64  //8byte, swap挂单过期时间
 
 	PacAmountManBitWidth = 35 //amount mantissa part, 比如12340000,只取1234部分，0000用exponent表示
 	PacFeeManBitWidth    = 11 //fee mantissa part
@@ -253,6 +263,8 @@ var (
 		NameTransferNFTAction:    TyTransferNFTAction,
 		NameSetTokenSymbolAction: TySetTokenSymbolAction,
 		NameSetExodusMode:        TySetExodusModeAction,
+		NameBatchAction:          TyBatchAction,
+		NameSwapCancelAction:     TySwapCancelAction,
 		NameAssetTransfer:        TyAssetTransferAction,
 		NameAssetTransfer2Exec:   TyAssetTransferToExecAction,
 		NameAssetWithdraw:        TyAssetWithdrawAction,
@@ -291,6 +303,7 @@ var (
 		TyDepositRollbackLog:       {Ty: reflect.TypeOf(AccountTokenBalanceReceipt{}), Name: "TyDepositRollbackLog"},
 		TyWithdrawRollbackLog:      {Ty: reflect.TypeOf(AccountTokenBalanceReceipt{}), Name: "TyWithdrawRollbackLog"},
 		TyPriority2QueIdLog:        {Ty: reflect.TypeOf(Priority2QueueId{}), Name: "TyPriority2QueueIdLog"},
+		TySwapCancelLog:            {Ty: reflect.TypeOf(ZkSwapCancel{}), Name: "TySwapCancelLog"},
 	}
 
 	FeeMap = map[int64]string{
@@ -300,6 +313,7 @@ var (
 		TyProxyExitAction:      "1000000",
 		TyFullExitAction:       "1000000",
 		TySwapAction:           "100000",
+		TyBatchAction:          "100000",
 		TyContractToTreeAction: "10000",
 		TyTreeToContractAction: "10000",
 		TyMintNFTAction:        "100",
@@ -308,6 +322,13 @@ var (
 	}
 )
 
+const (
+	//ForkZksyncSwap L2账户之间的挂单撮合交换和撤销挂单
+	ForkZksyncSwap = "ForkZksyncSwap"
+	//ForkZksyncFullExit operator从priority queue转发L1用户发起的fullExit
+	ForkZksyncFullExit = "ForkZksyncFullExit"
+)
+
 // init defines a register function
 func init() {
 	types.AllowUserExec = append(types.AllowUserExec, []byte(Zksync))
@@ -319,6 +340,8 @@ func init() {
 // InitFork defines register fork
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(Zksync, "Enable", 0)
+	cfg.RegisterDappFork(Zksync, ForkZksyncSwap, 0)
+	cfg.RegisterDappFork(Zksync, ForkZksyncFullExit, 0)
 }
 
 // InitExecutor defines register executor
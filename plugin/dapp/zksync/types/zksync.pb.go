@@ -48,6 +48,8 @@ type ZksyncAction struct {
 	//	*ZksyncAction_SetFee
 	//	*ZksyncAction_SetTokenSymbol
 	//	*ZksyncAction_SetExodusMode
+	//	*ZksyncAction_Batch
+	//	*ZksyncAction_SwapCancel
 	//	*ZksyncAction_Transfer
 	//	*ZksyncAction_Withdraw
 	//	*ZksyncAction_TransferToExec
@@ -227,6 +229,20 @@ func (x *ZksyncAction) GetSetExodusMode() *ZkExodusMode {
 	return nil
 }
 
+func (x *ZksyncAction) GetBatch() *ZkBatch {
+	if x, ok := x.GetValue().(*ZksyncAction_Batch); ok {
+		return x.Batch
+	}
+	return nil
+}
+
+func (x *ZksyncAction) GetSwapCancel() *ZkSwapCancel {
+	if x, ok := x.GetValue().(*ZksyncAction_SwapCancel); ok {
+		return x.SwapCancel
+	}
+	return nil
+}
+
 func (x *ZksyncAction) GetTransfer() *types.AssetsTransfer {
 	if x, ok := x.GetValue().(*ZksyncAction_Transfer); ok {
 		return x.Transfer
@@ -335,6 +351,14 @@ type ZksyncAction_SetExodusMode struct {
 	SetExodusMode *ZkExodusMode `protobuf:"bytes,36,opt,name=setExodusMode,proto3,oneof"`
 }
 
+type ZksyncAction_Batch struct {
+	Batch *ZkBatch `protobuf:"bytes,37,opt,name=batch,proto3,oneof"`
+}
+
+type ZksyncAction_SwapCancel struct {
+	SwapCancel *ZkSwapCancel `protobuf:"bytes,38,opt,name=swapCancel,proto3,oneof"`
+}
+
 type ZksyncAction_Transfer struct {
 	Transfer *types.AssetsTransfer `protobuf:"bytes,40,opt,name=transfer,proto3,oneof"`
 }
@@ -385,6 +409,10 @@ func (*ZksyncAction_SetTokenSymbol) isZksyncAction_Value() {}
 
 func (*ZksyncAction_SetExodusMode) isZksyncAction_Value() {}
 
+func (*ZksyncAction_Batch) isZksyncAction_Value() {}
+
+func (*ZksyncAction_SwapCancel) isZksyncAction_Value() {}
+
 func (*ZksyncAction_Transfer) isZksyncAction_Value() {}
 
 func (*ZksyncAction_Withdraw) isZksyncAction_Value() {}
@@ -1642,7 +1670,7 @@ type ZkFullExit struct {
 	TokenId            uint64       `protobuf:"varint,1,opt,name=tokenId,proto3" json:"tokenId,omitempty"`
 	AccountId          uint64       `protobuf:"varint,2,opt,name=accountId,proto3" json:"accountId,omitempty"`
 	EthPriorityQueueId int64        `protobuf:"varint,3,opt,name=ethPriorityQueueId,proto3" json:"ethPriorityQueueId,omitempty"`
-	Signature          *ZkSignature `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	Signature          *ZkSignature `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"` //由L1发起，operator转发提交，不校验二层签名
 }
 
 func (x *ZkFullExit) Reset() {
@@ -1705,18 +1733,183 @@ func (x *ZkFullExit) GetSignature() *ZkSignature {
 	return nil
 }
 
+//ZkSwapOrder 挂单，用tokenSell换取tokenBuy，成交价格不低于ratioBuy/ratioSell，可以分多次成交
+type ZkSwapOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId uint64       `protobuf:"varint,1,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	TokenSell uint64       `protobuf:"varint,2,opt,name=tokenSell,proto3" json:"tokenSell,omitempty"`
+	TokenBuy  uint64       `protobuf:"varint,3,opt,name=tokenBuy,proto3" json:"tokenBuy,omitempty"`
+	Amount    string       `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"` //tokenSell最大卖出数量
+	RatioSell string       `protobuf:"bytes,5,opt,name=ratioSell,proto3" json:"ratioSell,omitempty"`
+	RatioBuy  string       `protobuf:"bytes,6,opt,name=ratioBuy,proto3" json:"ratioBuy,omitempty"`
+	Signature *ZkSignature `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	Nonce     uint64       `protobuf:"varint,8,opt,name=nonce,proto3" json:"nonce,omitempty"`   //账户自选的挂单序号，用于撤单
+	Expiry    int64        `protobuf:"varint,9,opt,name=expiry,proto3" json:"expiry,omitempty"` //过期时间(区块时间，秒)，0表示不过期
+}
+
+func (x *ZkSwapOrder) Reset() {
+	*x = ZkSwapOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zksync_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZkSwapOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZkSwapOrder) ProtoMessage() {}
+
+func (x *ZkSwapOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_zksync_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZkSwapOrder.ProtoReflect.Descriptor instead.
+func (*ZkSwapOrder) Descriptor() ([]byte, []int) {
+	return file_zksync_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ZkSwapOrder) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ZkSwapOrder) GetTokenSell() uint64 {
+	if x != nil {
+		return x.TokenSell
+	}
+	return 0
+}
+
+func (x *ZkSwapOrder) GetTokenBuy() uint64 {
+	if x != nil {
+		return x.TokenBuy
+	}
+	return 0
+}
+
+func (x *ZkSwapOrder) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ZkSwapOrder) GetRatioSell() string {
+	if x != nil {
+		return x.RatioSell
+	}
+	return ""
+}
+
+func (x *ZkSwapOrder) GetRatioBuy() string {
+	if x != nil {
+		return x.RatioBuy
+	}
+	return ""
+}
+
+func (x *ZkSwapOrder) GetSignature() *ZkSignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *ZkSwapOrder) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *ZkSwapOrder) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+//ZkBatch 一笔chain33交易中按顺序执行多个各自签名的L2操作，任一操作失败则整体失败
+type ZkBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ops []*ZksyncAction `protobuf:"bytes,1,rep,name=ops,proto3" json:"ops,omitempty"` //只支持transfer, withdraw, transferToNew
+}
+
+func (x *ZkBatch) Reset() {
+	*x = ZkBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zksync_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZkBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZkBatch) ProtoMessage() {}
+
+func (x *ZkBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_zksync_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZkBatch.ProtoReflect.Descriptor instead.
+func (*ZkBatch) Descriptor() ([]byte, []int) {
+	return file_zksync_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ZkBatch) GetOps() []*ZksyncAction {
+	if x != nil {
+		return x.Ops
+	}
+	return nil
+}
+
+//ZkSwap 撮合left和right两个挂单，由left账户签名提交，手续费由left从买入的token中支付
 type ZkSwap struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signature *ZkSignature `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Signature       *ZkSignature `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Left            *ZkSwapOrder `protobuf:"bytes,2,opt,name=left,proto3" json:"left,omitempty"`
+	Right           *ZkSwapOrder `protobuf:"bytes,3,opt,name=right,proto3" json:"right,omitempty"`
+	LeftDealAmount  string       `protobuf:"bytes,4,opt,name=leftDealAmount,proto3" json:"leftDealAmount,omitempty"`   //left卖出，right买入的数量
+	RightDealAmount string       `protobuf:"bytes,5,opt,name=rightDealAmount,proto3" json:"rightDealAmount,omitempty"` //right卖出，left买入的数量
 }
 
 func (x *ZkSwap) Reset() {
 	*x = ZkSwap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[18]
+		mi := &file_zksync_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1729,7 +1922,7 @@ func (x *ZkSwap) String() string {
 func (*ZkSwap) ProtoMessage() {}
 
 func (x *ZkSwap) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[18]
+	mi := &file_zksync_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1742,7 +1935,7 @@ func (x *ZkSwap) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkSwap.ProtoReflect.Descriptor instead.
 func (*ZkSwap) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{18}
+	return file_zksync_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ZkSwap) GetSignature() *ZkSignature {
@@ -1752,6 +1945,98 @@ func (x *ZkSwap) GetSignature() *ZkSignature {
 	return nil
 }
 
+func (x *ZkSwap) GetLeft() *ZkSwapOrder {
+	if x != nil {
+		return x.Left
+	}
+	return nil
+}
+
+func (x *ZkSwap) GetRight() *ZkSwapOrder {
+	if x != nil {
+		return x.Right
+	}
+	return nil
+}
+
+func (x *ZkSwap) GetLeftDealAmount() string {
+	if x != nil {
+		return x.LeftDealAmount
+	}
+	return ""
+}
+
+func (x *ZkSwap) GetRightDealAmount() string {
+	if x != nil {
+		return x.RightDealAmount
+	}
+	return ""
+}
+
+//ZkSwapCancel 撤销账户某个nonce的挂单，撤销后该nonce的挂单不能再成交
+type ZkSwapCancel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId uint64       `protobuf:"varint,1,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	Nonce     uint64       `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Signature *ZkSignature `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *ZkSwapCancel) Reset() {
+	*x = ZkSwapCancel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zksync_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZkSwapCancel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZkSwapCancel) ProtoMessage() {}
+
+func (x *ZkSwapCancel) ProtoReflect() protoreflect.Message {
+	mi := &file_zksync_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZkSwapCancel.ProtoReflect.Descriptor instead.
+func (*ZkSwapCancel) Descriptor() ([]byte, []int) {
+	return file_zksync_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ZkSwapCancel) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ZkSwapCancel) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *ZkSwapCancel) GetSignature() *ZkSignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
 type ZkMintNFT struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1768,7 +2053,7 @@ type ZkMintNFT struct {
 func (x *ZkMintNFT) Reset() {
 	*x = ZkMintNFT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[19]
+		mi := &file_zksync_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1781,7 +2066,7 @@ func (x *ZkMintNFT) String() string {
 func (*ZkMintNFT) ProtoMessage() {}
 
 func (x *ZkMintNFT) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[19]
+	mi := &file_zksync_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1794,7 +2079,7 @@ func (x *ZkMintNFT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkMintNFT.ProtoReflect.Descriptor instead.
 func (*ZkMintNFT) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{19}
+	return file_zksync_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ZkMintNFT) GetFromAccountId() uint64 {
@@ -1853,7 +2138,7 @@ type ZkWithdrawNFT struct {
 func (x *ZkWithdrawNFT) Reset() {
 	*x = ZkWithdrawNFT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[20]
+		mi := &file_zksync_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1866,7 +2151,7 @@ func (x *ZkWithdrawNFT) String() string {
 func (*ZkWithdrawNFT) ProtoMessage() {}
 
 func (x *ZkWithdrawNFT) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[20]
+	mi := &file_zksync_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1879,7 +2164,7 @@ func (x *ZkWithdrawNFT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkWithdrawNFT.ProtoReflect.Descriptor instead.
 func (*ZkWithdrawNFT) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{20}
+	return file_zksync_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ZkWithdrawNFT) GetFromAccountId() uint64 {
@@ -1925,7 +2210,7 @@ type ZkTransferNFT struct {
 func (x *ZkTransferNFT) Reset() {
 	*x = ZkTransferNFT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[21]
+		mi := &file_zksync_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1938,7 +2223,7 @@ func (x *ZkTransferNFT) String() string {
 func (*ZkTransferNFT) ProtoMessage() {}
 
 func (x *ZkTransferNFT) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[21]
+	mi := &file_zksync_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1951,7 +2236,7 @@ func (x *ZkTransferNFT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkTransferNFT.ProtoReflect.Descriptor instead.
 func (*ZkTransferNFT) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{21}
+	return file_zksync_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ZkTransferNFT) GetFromAccountId() uint64 {
@@ -2007,7 +2292,7 @@ type ZkNFTTokenStatus struct {
 func (x *ZkNFTTokenStatus) Reset() {
 	*x = ZkNFTTokenStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[22]
+		mi := &file_zksync_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2020,7 +2305,7 @@ func (x *ZkNFTTokenStatus) String() string {
 func (*ZkNFTTokenStatus) ProtoMessage() {}
 
 func (x *ZkNFTTokenStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[22]
+	mi := &file_zksync_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2033,7 +2318,7 @@ func (x *ZkNFTTokenStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkNFTTokenStatus.ProtoReflect.Descriptor instead.
 func (*ZkNFTTokenStatus) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{22}
+	return file_zksync_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ZkNFTTokenStatus) GetId() uint64 {
@@ -2104,7 +2389,7 @@ type ZkVerifyKey struct {
 func (x *ZkVerifyKey) Reset() {
 	*x = ZkVerifyKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[23]
+		mi := &file_zksync_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2117,7 +2402,7 @@ func (x *ZkVerifyKey) String() string {
 func (*ZkVerifyKey) ProtoMessage() {}
 
 func (x *ZkVerifyKey) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[23]
+	mi := &file_zksync_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2130,7 +2415,7 @@ func (x *ZkVerifyKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkVerifyKey.ProtoReflect.Descriptor instead.
 func (*ZkVerifyKey) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{23}
+	return file_zksync_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ZkVerifyKey) GetKey() string {
@@ -2152,7 +2437,7 @@ type ReceiptSetVerifyKey struct {
 func (x *ReceiptSetVerifyKey) Reset() {
 	*x = ReceiptSetVerifyKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[24]
+		mi := &file_zksync_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2165,7 +2450,7 @@ func (x *ReceiptSetVerifyKey) String() string {
 func (*ReceiptSetVerifyKey) ProtoMessage() {}
 
 func (x *ReceiptSetVerifyKey) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[24]
+	mi := &file_zksync_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2178,7 +2463,7 @@ func (x *ReceiptSetVerifyKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptSetVerifyKey.ProtoReflect.Descriptor instead.
 func (*ReceiptSetVerifyKey) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{24}
+	return file_zksync_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ReceiptSetVerifyKey) GetPrev() *ZkVerifyKey {
@@ -2207,7 +2492,7 @@ type ZkFeeAddrs struct {
 func (x *ZkFeeAddrs) Reset() {
 	*x = ZkFeeAddrs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[25]
+		mi := &file_zksync_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2220,7 +2505,7 @@ func (x *ZkFeeAddrs) String() string {
 func (*ZkFeeAddrs) ProtoMessage() {}
 
 func (x *ZkFeeAddrs) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[25]
+	mi := &file_zksync_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2233,7 +2518,7 @@ func (x *ZkFeeAddrs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkFeeAddrs.ProtoReflect.Descriptor instead.
 func (*ZkFeeAddrs) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{25}
+	return file_zksync_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ZkFeeAddrs) GetEthFeeAddr() string {
@@ -2279,7 +2564,7 @@ type ZkCommitProof struct {
 func (x *ZkCommitProof) Reset() {
 	*x = ZkCommitProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[26]
+		mi := &file_zksync_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2292,7 +2577,7 @@ func (x *ZkCommitProof) String() string {
 func (*ZkCommitProof) ProtoMessage() {}
 
 func (x *ZkCommitProof) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[26]
+	mi := &file_zksync_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2305,7 +2590,7 @@ func (x *ZkCommitProof) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkCommitProof.ProtoReflect.Descriptor instead.
 func (*ZkCommitProof) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{26}
+	return file_zksync_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ZkCommitProof) GetBlockStart() uint64 {
@@ -2433,7 +2718,7 @@ type CommitProofState struct {
 func (x *CommitProofState) Reset() {
 	*x = CommitProofState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[27]
+		mi := &file_zksync_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2446,7 +2731,7 @@ func (x *CommitProofState) String() string {
 func (*CommitProofState) ProtoMessage() {}
 
 func (x *CommitProofState) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[27]
+	mi := &file_zksync_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2459,7 +2744,7 @@ func (x *CommitProofState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitProofState.ProtoReflect.Descriptor instead.
 func (*CommitProofState) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{27}
+	return file_zksync_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *CommitProofState) GetBlockStart() uint64 {
@@ -2565,7 +2850,7 @@ type ReceiptCommitProof struct {
 func (x *ReceiptCommitProof) Reset() {
 	*x = ReceiptCommitProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[28]
+		mi := &file_zksync_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2578,7 +2863,7 @@ func (x *ReceiptCommitProof) String() string {
 func (*ReceiptCommitProof) ProtoMessage() {}
 
 func (x *ReceiptCommitProof) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[28]
+	mi := &file_zksync_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2591,7 +2876,7 @@ func (x *ReceiptCommitProof) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptCommitProof.ProtoReflect.Descriptor instead.
 func (*ReceiptCommitProof) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{28}
+	return file_zksync_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ReceiptCommitProof) GetPrev() *CommitProofState {
@@ -2619,7 +2904,7 @@ type ReceiptCommitProofRecord struct {
 func (x *ReceiptCommitProofRecord) Reset() {
 	*x = ReceiptCommitProofRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[29]
+		mi := &file_zksync_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2632,7 +2917,7 @@ func (x *ReceiptCommitProofRecord) String() string {
 func (*ReceiptCommitProofRecord) ProtoMessage() {}
 
 func (x *ReceiptCommitProofRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[29]
+	mi := &file_zksync_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2645,7 +2930,7 @@ func (x *ReceiptCommitProofRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptCommitProofRecord.ProtoReflect.Descriptor instead.
 func (*ReceiptCommitProofRecord) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{29}
+	return file_zksync_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ReceiptCommitProofRecord) GetProof() *CommitProofState {
@@ -2667,7 +2952,7 @@ type QueryProofInfo struct {
 func (x *QueryProofInfo) Reset() {
 	*x = QueryProofInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[30]
+		mi := &file_zksync_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2680,7 +2965,7 @@ func (x *QueryProofInfo) String() string {
 func (*QueryProofInfo) ProtoMessage() {}
 
 func (x *QueryProofInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[30]
+	mi := &file_zksync_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2693,7 +2978,7 @@ func (x *QueryProofInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryProofInfo.ProtoReflect.Descriptor instead.
 func (*QueryProofInfo) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{30}
+	return file_zksync_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *QueryProofInfo) GetProof() *ZkCommitProof {
@@ -2722,7 +3007,7 @@ type ZkVerifier struct {
 func (x *ZkVerifier) Reset() {
 	*x = ZkVerifier{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[31]
+		mi := &file_zksync_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2735,7 +3020,7 @@ func (x *ZkVerifier) String() string {
 func (*ZkVerifier) ProtoMessage() {}
 
 func (x *ZkVerifier) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[31]
+	mi := &file_zksync_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2748,7 +3033,7 @@ func (x *ZkVerifier) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkVerifier.ProtoReflect.Descriptor instead.
 func (*ZkVerifier) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{31}
+	return file_zksync_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ZkVerifier) GetVerifiers() []string {
@@ -2770,7 +3055,7 @@ type ReceiptSetVerifier struct {
 func (x *ReceiptSetVerifier) Reset() {
 	*x = ReceiptSetVerifier{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[32]
+		mi := &file_zksync_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2783,7 +3068,7 @@ func (x *ReceiptSetVerifier) String() string {
 func (*ReceiptSetVerifier) ProtoMessage() {}
 
 func (x *ReceiptSetVerifier) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[32]
+	mi := &file_zksync_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2796,7 +3081,7 @@ func (x *ReceiptSetVerifier) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptSetVerifier.ProtoReflect.Descriptor instead.
 func (*ReceiptSetVerifier) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{32}
+	return file_zksync_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ReceiptSetVerifier) GetPrev() *ZkVerifier {
@@ -2826,7 +3111,7 @@ type ZkSetFee struct {
 func (x *ZkSetFee) Reset() {
 	*x = ZkSetFee{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[33]
+		mi := &file_zksync_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2839,7 +3124,7 @@ func (x *ZkSetFee) String() string {
 func (*ZkSetFee) ProtoMessage() {}
 
 func (x *ZkSetFee) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[33]
+	mi := &file_zksync_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2852,7 +3137,7 @@ func (x *ZkSetFee) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkSetFee.ProtoReflect.Descriptor instead.
 func (*ZkSetFee) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{33}
+	return file_zksync_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ZkSetFee) GetTokenId() uint64 {
@@ -2890,7 +3175,7 @@ type ReceiptSetFee struct {
 func (x *ReceiptSetFee) Reset() {
 	*x = ReceiptSetFee{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[34]
+		mi := &file_zksync_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2903,7 +3188,7 @@ func (x *ReceiptSetFee) String() string {
 func (*ReceiptSetFee) ProtoMessage() {}
 
 func (x *ReceiptSetFee) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[34]
+	mi := &file_zksync_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2916,7 +3201,7 @@ func (x *ReceiptSetFee) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptSetFee.ProtoReflect.Descriptor instead.
 func (*ReceiptSetFee) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{34}
+	return file_zksync_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ReceiptSetFee) GetTokenId() uint64 {
@@ -2959,7 +3244,7 @@ type RelayerOperators struct {
 func (x *RelayerOperators) Reset() {
 	*x = RelayerOperators{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[35]
+		mi := &file_zksync_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2972,7 +3257,7 @@ func (x *RelayerOperators) String() string {
 func (*RelayerOperators) ProtoMessage() {}
 
 func (x *RelayerOperators) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[35]
+	mi := &file_zksync_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2985,7 +3270,7 @@ func (x *RelayerOperators) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RelayerOperators.ProtoReflect.Descriptor instead.
 func (*RelayerOperators) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{35}
+	return file_zksync_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *RelayerOperators) GetOperators() []string {
@@ -3017,7 +3302,7 @@ type ZkQueryReq struct {
 func (x *ZkQueryReq) Reset() {
 	*x = ZkQueryReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[36]
+		mi := &file_zksync_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3030,7 +3315,7 @@ func (x *ZkQueryReq) String() string {
 func (*ZkQueryReq) ProtoMessage() {}
 
 func (x *ZkQueryReq) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[36]
+	mi := &file_zksync_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3043,7 +3328,7 @@ func (x *ZkQueryReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkQueryReq.ProtoReflect.Descriptor instead.
 func (*ZkQueryReq) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{36}
+	return file_zksync_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ZkQueryReq) GetAccountId() uint64 {
@@ -3143,7 +3428,7 @@ type ZkQueryResp struct {
 func (x *ZkQueryResp) Reset() {
 	*x = ZkQueryResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[37]
+		mi := &file_zksync_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3156,7 +3441,7 @@ func (x *ZkQueryResp) String() string {
 func (*ZkQueryResp) ProtoMessage() {}
 
 func (x *ZkQueryResp) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[37]
+	mi := &file_zksync_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3169,7 +3454,7 @@ func (x *ZkQueryResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkQueryResp.ProtoReflect.Descriptor instead.
 func (*ZkQueryResp) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{37}
+	return file_zksync_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ZkQueryResp) GetOperationInfos() []*OperationInfo {
@@ -3193,6 +3478,284 @@ func (x *ZkQueryResp) GetTokenBalances() []*TokenBalance {
 	return nil
 }
 
+//L2账户余额变化的历史记录，localdb中按账户、token、操作类型索引
+type ZkL2History struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId     uint64 `protobuf:"varint,1,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	TokenId       uint64 `protobuf:"varint,2,opt,name=tokenId,proto3" json:"tokenId,omitempty"`
+	OpType        int32  `protobuf:"varint,3,opt,name=opType,proto3" json:"opType,omitempty"` //交易action类型
+	LogTy         int32  `protobuf:"varint,4,opt,name=logTy,proto3" json:"logTy,omitempty"`
+	BalanceBefore string `protobuf:"bytes,5,opt,name=balanceBefore,proto3" json:"balanceBefore,omitempty"`
+	BalanceAfter  string `protobuf:"bytes,6,opt,name=balanceAfter,proto3" json:"balanceAfter,omitempty"`
+	PeerAccountId uint64 `protobuf:"varint,7,opt,name=peerAccountId,proto3" json:"peerAccountId,omitempty"` //转账类交易的对手账户
+	BlockHeight   int64  `protobuf:"varint,8,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	TxIndex       int32  `protobuf:"varint,9,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
+	Seq           int32  `protobuf:"varint,10,opt,name=seq,proto3" json:"seq,omitempty"` //同一交易内的序号
+	TxHash        string `protobuf:"bytes,11,opt,name=txHash,proto3" json:"txHash,omitempty"`
+}
+
+func (x *ZkL2History) Reset() {
+	*x = ZkL2History{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zksync_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZkL2History) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZkL2History) ProtoMessage() {}
+
+func (x *ZkL2History) ProtoReflect() protoreflect.Message {
+	mi := &file_zksync_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZkL2History.ProtoReflect.Descriptor instead.
+func (*ZkL2History) Descriptor() ([]byte, []int) {
+	return file_zksync_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ZkL2History) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ZkL2History) GetTokenId() uint64 {
+	if x != nil {
+		return x.TokenId
+	}
+	return 0
+}
+
+func (x *ZkL2History) GetOpType() int32 {
+	if x != nil {
+		return x.OpType
+	}
+	return 0
+}
+
+func (x *ZkL2History) GetLogTy() int32 {
+	if x != nil {
+		return x.LogTy
+	}
+	return 0
+}
+
+func (x *ZkL2History) GetBalanceBefore() string {
+	if x != nil {
+		return x.BalanceBefore
+	}
+	return ""
+}
+
+func (x *ZkL2History) GetBalanceAfter() string {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return ""
+}
+
+func (x *ZkL2History) GetPeerAccountId() uint64 {
+	if x != nil {
+		return x.PeerAccountId
+	}
+	return 0
+}
+
+func (x *ZkL2History) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *ZkL2History) GetTxIndex() int32 {
+	if x != nil {
+		return x.TxIndex
+	}
+	return 0
+}
+
+func (x *ZkL2History) GetSeq() int32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ZkL2History) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+type ZkReqL2History struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId   uint64 `protobuf:"varint,1,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	TokenId     uint64 `protobuf:"varint,2,opt,name=tokenId,proto3" json:"tokenId,omitempty"`
+	FilterToken bool   `protobuf:"varint,3,opt,name=filterToken,proto3" json:"filterToken,omitempty"` //tokenId=0也是有效token，需要单独标志是否按token过滤
+	OpType      int32  `protobuf:"varint,4,opt,name=opType,proto3" json:"opType,omitempty"`           //0表示不按操作类型过滤
+	PrimaryKey  string `protobuf:"bytes,5,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count       int32  `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	Direction   int32  `protobuf:"varint,7,opt,name=direction,proto3" json:"direction,omitempty"`
+}
+
+func (x *ZkReqL2History) Reset() {
+	*x = ZkReqL2History{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zksync_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZkReqL2History) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZkReqL2History) ProtoMessage() {}
+
+func (x *ZkReqL2History) ProtoReflect() protoreflect.Message {
+	mi := &file_zksync_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZkReqL2History.ProtoReflect.Descriptor instead.
+func (*ZkReqL2History) Descriptor() ([]byte, []int) {
+	return file_zksync_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ZkReqL2History) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ZkReqL2History) GetTokenId() uint64 {
+	if x != nil {
+		return x.TokenId
+	}
+	return 0
+}
+
+func (x *ZkReqL2History) GetFilterToken() bool {
+	if x != nil {
+		return x.FilterToken
+	}
+	return false
+}
+
+func (x *ZkReqL2History) GetOpType() int32 {
+	if x != nil {
+		return x.OpType
+	}
+	return 0
+}
+
+func (x *ZkReqL2History) GetPrimaryKey() string {
+	if x != nil {
+		return x.PrimaryKey
+	}
+	return ""
+}
+
+func (x *ZkReqL2History) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ZkReqL2History) GetDirection() int32 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+type ZkL2HistoryList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items      []*ZkL2History `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	PrimaryKey string         `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"` //下一页查询的起始primaryKey
+}
+
+func (x *ZkL2HistoryList) Reset() {
+	*x = ZkL2HistoryList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zksync_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZkL2HistoryList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZkL2HistoryList) ProtoMessage() {}
+
+func (x *ZkL2HistoryList) ProtoReflect() protoreflect.Message {
+	mi := &file_zksync_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZkL2HistoryList.ProtoReflect.Descriptor instead.
+func (*ZkL2HistoryList) Descriptor() ([]byte, []int) {
+	return file_zksync_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ZkL2HistoryList) GetItems() []*ZkL2History {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ZkL2HistoryList) GetPrimaryKey() string {
+	if x != nil {
+		return x.PrimaryKey
+	}
+	return ""
+}
+
 type ZkReceiptLog struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3205,7 +3768,7 @@ type ZkReceiptLog struct {
 func (x *ZkReceiptLog) Reset() {
 	*x = ZkReceiptLog{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[38]
+		mi := &file_zksync_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3218,7 +3781,7 @@ func (x *ZkReceiptLog) String() string {
 func (*ZkReceiptLog) ProtoMessage() {}
 
 func (x *ZkReceiptLog) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[38]
+	mi := &file_zksync_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3231,7 +3794,7 @@ func (x *ZkReceiptLog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkReceiptLog.ProtoReflect.Descriptor instead.
 func (*ZkReceiptLog) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{38}
+	return file_zksync_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ZkReceiptLog) GetOperationInfo() *OperationInfo {
@@ -3263,7 +3826,7 @@ type ZkQueryProofReq struct {
 func (x *ZkQueryProofReq) Reset() {
 	*x = ZkQueryProofReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[39]
+		mi := &file_zksync_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3276,7 +3839,7 @@ func (x *ZkQueryProofReq) String() string {
 func (*ZkQueryProofReq) ProtoMessage() {}
 
 func (x *ZkQueryProofReq) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[39]
+	mi := &file_zksync_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3289,7 +3852,7 @@ func (x *ZkQueryProofReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkQueryProofReq.ProtoReflect.Descriptor instead.
 func (*ZkQueryProofReq) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{39}
+	return file_zksync_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *ZkQueryProofReq) GetNeedDetail() bool {
@@ -3338,7 +3901,7 @@ type ZkQueryProofResp struct {
 func (x *ZkQueryProofResp) Reset() {
 	*x = ZkQueryProofResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[40]
+		mi := &file_zksync_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3351,7 +3914,7 @@ func (x *ZkQueryProofResp) String() string {
 func (*ZkQueryProofResp) ProtoMessage() {}
 
 func (x *ZkQueryProofResp) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[40]
+	mi := &file_zksync_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3364,7 +3927,7 @@ func (x *ZkQueryProofResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkQueryProofResp.ProtoReflect.Descriptor instead.
 func (*ZkQueryProofResp) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{40}
+	return file_zksync_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *ZkQueryProofResp) GetOperationInfos() []*OperationInfo {
@@ -3390,7 +3953,7 @@ type ZkFetchProofList struct {
 func (x *ZkFetchProofList) Reset() {
 	*x = ZkFetchProofList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[41]
+		mi := &file_zksync_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3403,7 +3966,7 @@ func (x *ZkFetchProofList) String() string {
 func (*ZkFetchProofList) ProtoMessage() {}
 
 func (x *ZkFetchProofList) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[41]
+	mi := &file_zksync_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3416,7 +3979,7 @@ func (x *ZkFetchProofList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkFetchProofList.ProtoReflect.Descriptor instead.
 func (*ZkFetchProofList) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{41}
+	return file_zksync_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ZkFetchProofList) GetProofId() uint64 {
@@ -3467,7 +4030,7 @@ type ZkContentHash struct {
 func (x *ZkContentHash) Reset() {
 	*x = ZkContentHash{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[42]
+		mi := &file_zksync_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3480,7 +4043,7 @@ func (x *ZkContentHash) String() string {
 func (*ZkContentHash) ProtoMessage() {}
 
 func (x *ZkContentHash) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[42]
+	mi := &file_zksync_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3493,7 +4056,7 @@ func (x *ZkContentHash) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkContentHash.ProtoReflect.Descriptor instead.
 func (*ZkContentHash) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{42}
+	return file_zksync_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ZkContentHash) GetPart1() string {
@@ -3527,7 +4090,7 @@ type ZkOpNFTData struct {
 func (x *ZkOpNFTData) Reset() {
 	*x = ZkOpNFTData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[43]
+		mi := &file_zksync_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3540,7 +4103,7 @@ func (x *ZkOpNFTData) String() string {
 func (*ZkOpNFTData) ProtoMessage() {}
 
 func (x *ZkOpNFTData) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[43]
+	mi := &file_zksync_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3553,7 +4116,7 @@ func (x *ZkOpNFTData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkOpNFTData.ProtoReflect.Descriptor instead.
 func (*ZkOpNFTData) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{43}
+	return file_zksync_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *ZkOpNFTData) GetTokenId() uint64 {
@@ -3619,7 +4182,7 @@ type ZkOpSwapData struct {
 func (x *ZkOpSwapData) Reset() {
 	*x = ZkOpSwapData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[44]
+		mi := &file_zksync_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3632,7 +4195,7 @@ func (x *ZkOpSwapData) String() string {
 func (*ZkOpSwapData) ProtoMessage() {}
 
 func (x *ZkOpSwapData) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[44]
+	mi := &file_zksync_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3645,7 +4208,7 @@ func (x *ZkOpSwapData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkOpSwapData.ProtoReflect.Descriptor instead.
 func (*ZkOpSwapData) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{44}
+	return file_zksync_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ZkOpSwapData) GetTokenId() uint64 {
@@ -3681,7 +4244,7 @@ type ZkOpFeeData struct {
 func (x *ZkOpFeeData) Reset() {
 	*x = ZkOpFeeData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[45]
+		mi := &file_zksync_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3694,7 +4257,7 @@ func (x *ZkOpFeeData) String() string {
 func (*ZkOpFeeData) ProtoMessage() {}
 
 func (x *ZkOpFeeData) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[45]
+	mi := &file_zksync_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3707,7 +4270,7 @@ func (x *ZkOpFeeData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkOpFeeData.ProtoReflect.Descriptor instead.
 func (*ZkOpFeeData) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{45}
+	return file_zksync_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ZkOpFeeData) GetTokenId() uint64 {
@@ -3736,7 +4299,7 @@ type ZkSetPubKeyData struct {
 func (x *ZkSetPubKeyData) Reset() {
 	*x = ZkSetPubKeyData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[46]
+		mi := &file_zksync_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3749,7 +4312,7 @@ func (x *ZkSetPubKeyData) String() string {
 func (*ZkSetPubKeyData) ProtoMessage() {}
 
 func (x *ZkSetPubKeyData) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[46]
+	mi := &file_zksync_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3762,7 +4325,7 @@ func (x *ZkSetPubKeyData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkSetPubKeyData.ProtoReflect.Descriptor instead.
 func (*ZkSetPubKeyData) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{46}
+	return file_zksync_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ZkSetPubKeyData) GetTy() uint64 {
@@ -3790,7 +4353,7 @@ type L1PriorityID struct {
 func (x *L1PriorityID) Reset() {
 	*x = L1PriorityID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[47]
+		mi := &file_zksync_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3803,7 +4366,7 @@ func (x *L1PriorityID) String() string {
 func (*L1PriorityID) ProtoMessage() {}
 
 func (x *L1PriorityID) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[47]
+	mi := &file_zksync_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3816,7 +4379,7 @@ func (x *L1PriorityID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use L1PriorityID.ProtoReflect.Descriptor instead.
 func (*L1PriorityID) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{47}
+	return file_zksync_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *L1PriorityID) GetID() string {
@@ -3838,7 +4401,7 @@ type ReceiptL1PriorityID struct {
 func (x *ReceiptL1PriorityID) Reset() {
 	*x = ReceiptL1PriorityID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[48]
+		mi := &file_zksync_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3851,7 +4414,7 @@ func (x *ReceiptL1PriorityID) String() string {
 func (*ReceiptL1PriorityID) ProtoMessage() {}
 
 func (x *ReceiptL1PriorityID) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[48]
+	mi := &file_zksync_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3864,7 +4427,7 @@ func (x *ReceiptL1PriorityID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptL1PriorityID.ProtoReflect.Descriptor instead.
 func (*ReceiptL1PriorityID) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{48}
+	return file_zksync_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *ReceiptL1PriorityID) GetPrev() int64 {
@@ -3894,7 +4457,7 @@ type MerkleTreeProof struct {
 func (x *MerkleTreeProof) Reset() {
 	*x = MerkleTreeProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[49]
+		mi := &file_zksync_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3907,7 +4470,7 @@ func (x *MerkleTreeProof) String() string {
 func (*MerkleTreeProof) ProtoMessage() {}
 
 func (x *MerkleTreeProof) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[49]
+	mi := &file_zksync_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3920,7 +4483,7 @@ func (x *MerkleTreeProof) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MerkleTreeProof.ProtoReflect.Descriptor instead.
 func (*MerkleTreeProof) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{49}
+	return file_zksync_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *MerkleTreeProof) GetRootHash() string {
@@ -3959,7 +4522,7 @@ type ZkReceiptLeaf struct {
 func (x *ZkReceiptLeaf) Reset() {
 	*x = ZkReceiptLeaf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[50]
+		mi := &file_zksync_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3972,7 +4535,7 @@ func (x *ZkReceiptLeaf) String() string {
 func (*ZkReceiptLeaf) ProtoMessage() {}
 
 func (x *ZkReceiptLeaf) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[50]
+	mi := &file_zksync_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3985,7 +4548,7 @@ func (x *ZkReceiptLeaf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkReceiptLeaf.ProtoReflect.Descriptor instead.
 func (*ZkReceiptLeaf) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{50}
+	return file_zksync_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *ZkReceiptLeaf) GetLeaf() *Leaf {
@@ -4031,7 +4594,7 @@ type ZkAcctRollbackInfo struct {
 func (x *ZkAcctRollbackInfo) Reset() {
 	*x = ZkAcctRollbackInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[51]
+		mi := &file_zksync_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4044,7 +4607,7 @@ func (x *ZkAcctRollbackInfo) String() string {
 func (*ZkAcctRollbackInfo) ProtoMessage() {}
 
 func (x *ZkAcctRollbackInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[51]
+	mi := &file_zksync_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4057,7 +4620,7 @@ func (x *ZkAcctRollbackInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkAcctRollbackInfo.ProtoReflect.Descriptor instead.
 func (*ZkAcctRollbackInfo) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{51}
+	return file_zksync_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *ZkAcctRollbackInfo) GetAccountId() uint64 {
@@ -4110,7 +4673,7 @@ type ZkExodusRollbackModeParm struct {
 func (x *ZkExodusRollbackModeParm) Reset() {
 	*x = ZkExodusRollbackModeParm{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[52]
+		mi := &file_zksync_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4123,7 +4686,7 @@ func (x *ZkExodusRollbackModeParm) String() string {
 func (*ZkExodusRollbackModeParm) ProtoMessage() {}
 
 func (x *ZkExodusRollbackModeParm) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[52]
+	mi := &file_zksync_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4136,7 +4699,7 @@ func (x *ZkExodusRollbackModeParm) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkExodusRollbackModeParm.ProtoReflect.Descriptor instead.
 func (*ZkExodusRollbackModeParm) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{52}
+	return file_zksync_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *ZkExodusRollbackModeParm) GetLastSuccessProofId() uint64 {
@@ -4167,7 +4730,7 @@ type ZkExodusMode struct {
 func (x *ZkExodusMode) Reset() {
 	*x = ZkExodusMode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[53]
+		mi := &file_zksync_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4180,7 +4743,7 @@ func (x *ZkExodusMode) String() string {
 func (*ZkExodusMode) ProtoMessage() {}
 
 func (x *ZkExodusMode) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[53]
+	mi := &file_zksync_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4193,7 +4756,7 @@ func (x *ZkExodusMode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkExodusMode.ProtoReflect.Descriptor instead.
 func (*ZkExodusMode) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{53}
+	return file_zksync_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *ZkExodusMode) GetMode() uint32 {
@@ -4239,7 +4802,7 @@ type ReceiptExodusMode struct {
 func (x *ReceiptExodusMode) Reset() {
 	*x = ReceiptExodusMode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[54]
+		mi := &file_zksync_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4252,7 +4815,7 @@ func (x *ReceiptExodusMode) String() string {
 func (*ReceiptExodusMode) ProtoMessage() {}
 
 func (x *ReceiptExodusMode) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[54]
+	mi := &file_zksync_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4265,7 +4828,7 @@ func (x *ReceiptExodusMode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptExodusMode.ProtoReflect.Descriptor instead.
 func (*ReceiptExodusMode) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{54}
+	return file_zksync_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *ReceiptExodusMode) GetPrev() int64 {
@@ -4294,7 +4857,7 @@ type ReceiptSetTokenSymbol struct {
 func (x *ReceiptSetTokenSymbol) Reset() {
 	*x = ReceiptSetTokenSymbol{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[55]
+		mi := &file_zksync_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4307,7 +4870,7 @@ func (x *ReceiptSetTokenSymbol) String() string {
 func (*ReceiptSetTokenSymbol) ProtoMessage() {}
 
 func (x *ReceiptSetTokenSymbol) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[55]
+	mi := &file_zksync_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4320,7 +4883,7 @@ func (x *ReceiptSetTokenSymbol) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptSetTokenSymbol.ProtoReflect.Descriptor instead.
 func (*ReceiptSetTokenSymbol) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{55}
+	return file_zksync_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *ReceiptSetTokenSymbol) GetPre() *ZkTokenSymbol {
@@ -4349,7 +4912,7 @@ type LastOnChainProof struct {
 func (x *LastOnChainProof) Reset() {
 	*x = LastOnChainProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[56]
+		mi := &file_zksync_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4362,7 +4925,7 @@ func (x *LastOnChainProof) String() string {
 func (*LastOnChainProof) ProtoMessage() {}
 
 func (x *LastOnChainProof) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[56]
+	mi := &file_zksync_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4375,7 +4938,7 @@ func (x *LastOnChainProof) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LastOnChainProof.ProtoReflect.Descriptor instead.
 func (*LastOnChainProof) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{56}
+	return file_zksync_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *LastOnChainProof) GetProofId() uint64 {
@@ -4405,7 +4968,7 @@ type HistoryAccountProofInfo struct {
 func (x *HistoryAccountProofInfo) Reset() {
 	*x = HistoryAccountProofInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[57]
+		mi := &file_zksync_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4418,7 +4981,7 @@ func (x *HistoryAccountProofInfo) String() string {
 func (*HistoryAccountProofInfo) ProtoMessage() {}
 
 func (x *HistoryAccountProofInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[57]
+	mi := &file_zksync_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4431,7 +4994,7 @@ func (x *HistoryAccountProofInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoryAccountProofInfo.ProtoReflect.Descriptor instead.
 func (*HistoryAccountProofInfo) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{57}
+	return file_zksync_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *HistoryAccountProofInfo) GetRootHash() string {
@@ -4468,7 +5031,7 @@ type ZkReqExistenceProof struct {
 func (x *ZkReqExistenceProof) Reset() {
 	*x = ZkReqExistenceProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[58]
+		mi := &file_zksync_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4481,7 +5044,7 @@ func (x *ZkReqExistenceProof) String() string {
 func (*ZkReqExistenceProof) ProtoMessage() {}
 
 func (x *ZkReqExistenceProof) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[58]
+	mi := &file_zksync_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4494,7 +5057,7 @@ func (x *ZkReqExistenceProof) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkReqExistenceProof.ProtoReflect.Descriptor instead.
 func (*ZkReqExistenceProof) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{58}
+	return file_zksync_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *ZkReqExistenceProof) GetAccountId() uint64 {
@@ -4534,7 +5097,7 @@ type ZkQueryTxOperationReq struct {
 func (x *ZkQueryTxOperationReq) Reset() {
 	*x = ZkQueryTxOperationReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[59]
+		mi := &file_zksync_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4547,7 +5110,7 @@ func (x *ZkQueryTxOperationReq) String() string {
 func (*ZkQueryTxOperationReq) ProtoMessage() {}
 
 func (x *ZkQueryTxOperationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[59]
+	mi := &file_zksync_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4560,7 +5123,7 @@ func (x *ZkQueryTxOperationReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkQueryTxOperationReq.ProtoReflect.Descriptor instead.
 func (*ZkQueryTxOperationReq) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{59}
+	return file_zksync_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *ZkQueryTxOperationReq) GetStartBlockHeight() uint64 {
@@ -4619,7 +5182,7 @@ type ZkExodusBatchProofReq struct {
 func (x *ZkExodusBatchProofReq) Reset() {
 	*x = ZkExodusBatchProofReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[60]
+		mi := &file_zksync_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4632,7 +5195,7 @@ func (x *ZkExodusBatchProofReq) String() string {
 func (*ZkExodusBatchProofReq) ProtoMessage() {}
 
 func (x *ZkExodusBatchProofReq) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[60]
+	mi := &file_zksync_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4645,7 +5208,7 @@ func (x *ZkExodusBatchProofReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZkExodusBatchProofReq.ProtoReflect.Descriptor instead.
 func (*ZkExodusBatchProofReq) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{60}
+	return file_zksync_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *ZkExodusBatchProofReq) GetStartAccountId() uint64 {
@@ -4689,7 +5252,7 @@ type ReceiptL2LastQueueID struct {
 func (x *ReceiptL2LastQueueID) Reset() {
 	*x = ReceiptL2LastQueueID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[61]
+		mi := &file_zksync_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4702,7 +5265,7 @@ func (x *ReceiptL2LastQueueID) String() string {
 func (*ReceiptL2LastQueueID) ProtoMessage() {}
 
 func (x *ReceiptL2LastQueueID) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[61]
+	mi := &file_zksync_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4715,7 +5278,7 @@ func (x *ReceiptL2LastQueueID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptL2LastQueueID.ProtoReflect.Descriptor instead.
 func (*ReceiptL2LastQueueID) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{61}
+	return file_zksync_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *ReceiptL2LastQueueID) GetPrev() int64 {
@@ -4744,7 +5307,7 @@ type ReceiptL2FirstQueueID struct {
 func (x *ReceiptL2FirstQueueID) Reset() {
 	*x = ReceiptL2FirstQueueID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[62]
+		mi := &file_zksync_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4757,7 +5320,7 @@ func (x *ReceiptL2FirstQueueID) String() string {
 func (*ReceiptL2FirstQueueID) ProtoMessage() {}
 
 func (x *ReceiptL2FirstQueueID) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[62]
+	mi := &file_zksync_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4770,7 +5333,7 @@ func (x *ReceiptL2FirstQueueID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptL2FirstQueueID.ProtoReflect.Descriptor instead.
 func (*ReceiptL2FirstQueueID) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{62}
+	return file_zksync_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *ReceiptL2FirstQueueID) GetPrev() int64 {
@@ -4799,7 +5362,7 @@ type ReceiptL2QueueIDData struct {
 func (x *ReceiptL2QueueIDData) Reset() {
 	*x = ReceiptL2QueueIDData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[63]
+		mi := &file_zksync_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4812,7 +5375,7 @@ func (x *ReceiptL2QueueIDData) String() string {
 func (*ReceiptL2QueueIDData) ProtoMessage() {}
 
 func (x *ReceiptL2QueueIDData) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[63]
+	mi := &file_zksync_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4825,7 +5388,7 @@ func (x *ReceiptL2QueueIDData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptL2QueueIDData.ProtoReflect.Descriptor instead.
 func (*ReceiptL2QueueIDData) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{63}
+	return file_zksync_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *ReceiptL2QueueIDData) GetId() int64 {
@@ -4855,7 +5418,7 @@ type ProofId2QueueIdData struct {
 func (x *ProofId2QueueIdData) Reset() {
 	*x = ProofId2QueueIdData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[64]
+		mi := &file_zksync_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4868,7 +5431,7 @@ func (x *ProofId2QueueIdData) String() string {
 func (*ProofId2QueueIdData) ProtoMessage() {}
 
 func (x *ProofId2QueueIdData) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[64]
+	mi := &file_zksync_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4881,7 +5444,7 @@ func (x *ProofId2QueueIdData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProofId2QueueIdData.ProtoReflect.Descriptor instead.
 func (*ProofId2QueueIdData) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{64}
+	return file_zksync_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *ProofId2QueueIdData) GetProofId() uint64 {
@@ -4916,7 +5479,7 @@ type ReceiptProofId2QueueIDData struct {
 func (x *ReceiptProofId2QueueIDData) Reset() {
 	*x = ReceiptProofId2QueueIDData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[65]
+		mi := &file_zksync_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4929,7 +5492,7 @@ func (x *ReceiptProofId2QueueIDData) String() string {
 func (*ReceiptProofId2QueueIDData) ProtoMessage() {}
 
 func (x *ReceiptProofId2QueueIDData) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[65]
+	mi := &file_zksync_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4942,7 +5505,7 @@ func (x *ReceiptProofId2QueueIDData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptProofId2QueueIDData.ProtoReflect.Descriptor instead.
 func (*ReceiptProofId2QueueIDData) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{65}
+	return file_zksync_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *ReceiptProofId2QueueIDData) GetData() *ProofId2QueueIdData {
@@ -4964,7 +5527,7 @@ type Priority2QueueId struct {
 func (x *Priority2QueueId) Reset() {
 	*x = Priority2QueueId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zksync_proto_msgTypes[66]
+		mi := &file_zksync_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4977,7 +5540,7 @@ func (x *Priority2QueueId) String() string {
 func (*Priority2QueueId) ProtoMessage() {}
 
 func (x *Priority2QueueId) ProtoReflect() protoreflect.Message {
-	mi := &file_zksync_proto_msgTypes[66]
+	mi := &file_zksync_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4990,7 +5553,7 @@ func (x *Priority2QueueId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Priority2QueueId.ProtoReflect.Descriptor instead.
 func (*Priority2QueueId) Descriptor() ([]byte, []int) {
-	return file_zksync_proto_rawDescGZIP(), []int{66}
+	return file_zksync_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *Priority2QueueId) GetPriorityId() int64 {
@@ -5014,7 +5577,7 @@ var file_zksync_proto_rawDesc = []byte{
 	0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x0d, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe9, 0x09, 0x0a, 0x0c, 0x5a, 0x6b, 0x73, 0x79, 0x6e, 0x63,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc8, 0x0a, 0x0a, 0x0c, 0x5a, 0x6b, 0x73, 0x79, 0x6e, 0x63,
 	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
 	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
 	0x5a, 0x6b, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x70,
@@ -5080,60 +5643,47 @@ var file_zksync_proto_rawDesc = []byte{
 	0x74, 0x45, 0x78, 0x6f, 0x64, 0x75, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x24, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x45, 0x78, 0x6f, 0x64,
 	0x75, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x65, 0x74, 0x45, 0x78, 0x6f,
-	0x64, 0x75, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x48, 0x00, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x08,
-	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x48, 0x00, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x12, 0x45, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x45,
-	0x78, 0x65, 0x63, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x54, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x54, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x51, 0x0a, 0x0d, 0x5a, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65,
-	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x64, 0x65, 0x63,
-	0x69, 0x6d, 0x61, 0x6c, 0x22, 0x60, 0x0a, 0x0c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x22, 0x99, 0x01, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x54, 0x72, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0a,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x28, 0x0a, 0x0f,
-	0x6d, 0x61, 0x78, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x54, 0x72, 0x65,
-	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x53, 0x75, 0x62, 0x54, 0x72, 0x65, 0x65, 0x52, 0x08, 0x73, 0x75, 0x62, 0x54, 0x72, 0x65,
-	0x65, 0x73, 0x22, 0xa5, 0x02, 0x0a, 0x04, 0x4c, 0x65, 0x61, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x74, 0x68,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
-	0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x75, 0x62,
-	0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x5a, 0x6b, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b,
-	0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x41, 0x64, 0x64,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33,
-	0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x61, 0x73,
-	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x61,
-	0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x73, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x3e,
-	0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73,
-	0x52, 0x0c, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x48, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x48, 0x61, 0x73, 0x68, 0x22, 0xbb, 0x02, 0x0a, 0x0b, 0x48,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x65, 0x61, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63,
+	0x64, 0x75, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a,
+	0x6b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x35, 0x0a, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x18, 0x26, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x53, 0x77,
+	0x61, 0x70, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x77, 0x61, 0x70,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x33, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x48,
+	0x00, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x08, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x48, 0x00, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x12, 0x45, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x45, 0x78,
+	0x65, 0x63, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54,
+	0x6f, 0x45, 0x78, 0x65, 0x63, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x54, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x51, 0x0a, 0x0d, 0x5a, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x22, 0x60, 0x0a, 0x0c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x48, 0x61, 0x73, 0x68, 0x22, 0x99, 0x01, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x54, 0x72, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x28, 0x0a, 0x0f, 0x6d,
+	0x61, 0x78, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x54, 0x72, 0x65, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x53, 0x75, 0x62, 0x54, 0x72, 0x65, 0x65, 0x52, 0x08, 0x73, 0x75, 0x62, 0x54, 0x72, 0x65, 0x65,
+	0x73, 0x22, 0xa5, 0x02, 0x0a, 0x04, 0x4c, 0x65, 0x61, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63,
 	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61,
 	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x74, 0x68, 0x41,
 	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x74,
@@ -5143,559 +5693,656 @@ var file_zksync_proto_rawDesc = []byte{
 	0x79, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x41, 0x64, 0x64, 0x72,
 	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x41,
 	0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x61, 0x73, 0x68,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x2b, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x78,
-	0x79, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72,
-	0x6f, 0x78, 0x79, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x78,
-	0x79, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x5e, 0x0a, 0x08, 0x52, 0x6f, 0x6f, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68,
-	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3d, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x54,
-	0x72, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72,
-	0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72,
-	0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22, 0xd5, 0x01, 0x0a, 0x09, 0x5a, 0x6b, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x74, 0x68, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x74, 0x68,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x33, 0x33, 0x41, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x33, 0x33, 0x41, 0x64, 0x64, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x31, 0x50,
-	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x6c, 0x31, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x30, 0x0a,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x3e, 0x0a,
+	0x0c, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x52,
+	0x0c, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x65, 0x61, 0x66, 0x48, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x6c, 0x65, 0x61, 0x66, 0x48, 0x61, 0x73, 0x68, 0x22, 0xbb, 0x02, 0x0a, 0x0b, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x65, 0x61, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x74, 0x68, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x74, 0x68,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x5a, 0x6b, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x41, 0x64, 0x64, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x2b, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x5e, 0x0a, 0x08, 0x52, 0x6f, 0x6f, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3d, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x54, 0x72,
+	0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f,
+	0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x6f,
+	0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22, 0xd5, 0x01, 0x0a, 0x09, 0x5a, 0x6b, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x74, 0x68, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x74, 0x68, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33,
+	0x33, 0x41, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x33, 0x33, 0x41, 0x64, 0x64, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x31, 0x50, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x6c, 0x31, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x8e,
+	0x01, 0x0a, 0x0a, 0x5a, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61,
 	0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
-	0x8e, 0x01, 0x0a, 0x0a, 0x5a, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x18,
-	0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x30,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x22, 0xfe, 0x01, 0x0a, 0x10, 0x5a, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54,
-	0x6f, 0x54, 0x72, 0x65, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
-	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x45, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x45, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x12,
-	0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x32, 0x41, 0x64, 0x64, 0x72, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x32, 0x41,
-	0x64, 0x64, 0x72, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a,
-	0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x78, 0x65,
-	0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x78, 0x65,
-	0x63, 0x22, 0xc8, 0x01, 0x0a, 0x10, 0x5a, 0x6b, 0x54, 0x72, 0x65, 0x65, 0x54, 0x6f, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x41, 0x63, 0x63, 0x74,
-	0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x6f, 0x41, 0x63, 0x63, 0x74,
-	0x49, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b,
+	0xfe, 0x01, 0x0a, 0x10, 0x5a, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x6f,
+	0x54, 0x72, 0x65, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x74, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x45, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x45, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x12, 0x22,
+	0x0a, 0x0c, 0x74, 0x6f, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x32, 0x41, 0x64, 0x64, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x32, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b,
 	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x22, 0xb8, 0x01, 0x0a,
-	0x0a, 0x5a, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a,
-	0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x5a, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xe9, 0x01, 0x0a, 0x0f, 0x5a, 0x6b, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x4e, 0x65, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a,
-	0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x45, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x45, 0x74, 0x68,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x4c, 0x61, 0x79,
-	0x65, 0x72, 0x32, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x74, 0x6f, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x32, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x22, 0x77, 0x0a, 0x0b, 0x5a, 0x6b, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x45, 0x78,
-	0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xaf, 0x01, 0x0a,
-	0x0b, 0x5a, 0x6b, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x45, 0x78, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x49,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x49, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1e,
-	0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x46, 0x65, 0x65, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0xa2,
-	0x01, 0x0a, 0x0b, 0x5a, 0x6b, 0x53, 0x65, 0x74, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1c,
-	0x0a, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x06,
-	0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x70,
-	0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x54,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x54,
-	0x79, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x78, 0x65, 0x63,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x78, 0x65, 0x63,
+	0x22, 0xc8, 0x01, 0x0a, 0x10, 0x5a, 0x6b, 0x54, 0x72, 0x65, 0x65, 0x54, 0x6f, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x41, 0x63, 0x63, 0x74, 0x49,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x6f, 0x41, 0x63, 0x63, 0x74, 0x49,
+	0x64, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x53,
 	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x0a, 0x5a, 0x6b, 0x46, 0x75, 0x6c, 0x6c, 0x45, 0x78,
-	0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x74,
-	0x68, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x65, 0x74, 0x68, 0x50, 0x72, 0x69, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x3a, 0x0a, 0x06,
-	0x5a, 0x6b, 0x53, 0x77, 0x61, 0x70, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x5a, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xe1, 0x01, 0x0a, 0x09, 0x5a, 0x6b, 0x4d,
-	0x69, 0x6e, 0x74, 0x4e, 0x46, 0x54, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x66,
-	0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b,
-	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x20,
-	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68,
-	0x12, 0x20, 0x0a, 0x0b, 0x65, 0x72, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x72, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x9f, 0x01, 0x0a,
-	0x0d, 0x5a, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4e, 0x46, 0x54, 0x12, 0x24,
-	0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x46, 0x54, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x4e, 0x46, 0x54, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xc1,
-	0x01, 0x0a, 0x0d, 0x5a, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4e, 0x46, 0x54,
-	0x12, 0x24, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x63,
-	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x46, 0x54, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x4e, 0x46,
-	0x54, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x22, 0xb8, 0x01, 0x0a, 0x0a,
+	0x5a, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d,
+	0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x5a, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xe9, 0x01, 0x0a, 0x0f, 0x5a, 0x6b, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x4e, 0x65, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d,
+	0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x45, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x45, 0x74, 0x68, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x4c, 0x61, 0x79, 0x65,
+	0x72, 0x32, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x74, 0x6f, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x32, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
 	0x12, 0x30, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x53, 0x69,
 	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x22, 0x9a, 0x02, 0x0a, 0x10, 0x5a, 0x6b, 0x4e, 0x46, 0x54, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x45, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x12, 0x28, 0x0a,
-	0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x49, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x53,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x72, 0x63, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x72,
-	0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x6e,
-	0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d,
-	0x69, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x62,
-	0x75, 0x72, 0x6e, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0c, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x1f, 0x0a, 0x0b, 0x5a, 0x6b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x22, 0x6b, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x53, 0x65, 0x74, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x76, 0x18,
+	0x72, 0x65, 0x22, 0x77, 0x0a, 0x0b, 0x5a, 0x6b, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x45, 0x78, 0x69,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x0b,
+	0x5a, 0x6b, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x45, 0x78, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1e, 0x0a,
+	0x03, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x46, 0x65, 0x65, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0xa2, 0x01,
+	0x0a, 0x0b, 0x5a, 0x6b, 0x53, 0x65, 0x74, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x06, 0x70,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x70, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x54, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x54, 0x79,
+	0x12, 0x30, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x0a, 0x5a, 0x6b, 0x46, 0x75, 0x6c, 0x6c, 0x45, 0x78, 0x69,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x74, 0x68,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x65, 0x74, 0x68, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x97, 0x02, 0x0a, 0x0b,
+	0x5a, 0x6b, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x53, 0x65, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x53, 0x65, 0x6c, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x42, 0x75, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x42, 0x75, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x53, 0x65, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x53, 0x65, 0x6c, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x42, 0x75, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x42, 0x75, 0x79, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x5a, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x30, 0x0a, 0x07, 0x5a, 0x6b, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x25, 0x0a, 0x03, 0x6f, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x73, 0x79, 0x6e, 0x63, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x03, 0x6f, 0x70, 0x73, 0x22, 0xde, 0x01, 0x0a, 0x06, 0x5a, 0x6b, 0x53, 0x77,
+	0x61, 0x70, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x70, 0x72, 0x65, 0x76, 0x12,
-	0x2c, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x79, 0x4b, 0x65, 0x79, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x4a, 0x0a,
-	0x0a, 0x5a, 0x6b, 0x46, 0x65, 0x65, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
-	0x74, 0x68, 0x46, 0x65, 0x65, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x65, 0x74, 0x68, 0x46, 0x65, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
-	0x32, 0x46, 0x65, 0x65, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6c, 0x32, 0x46, 0x65, 0x65, 0x41, 0x64, 0x64, 0x72, 0x22, 0xd3, 0x03, 0x0a, 0x0d, 0x5a, 0x6b,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1e, 0x0a, 0x0a, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x45, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x45, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x20, 0x0a,
-	0x0b, 0x6f, 0x6c, 0x64, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12,
-	0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f,
-	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62,
-	0x44, 0x61, 0x74, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62,
-	0x44, 0x61, 0x74, 0x61, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x50, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f,
-	0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x6e, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0e, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49,
-	0x64, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
-	0xac, 0x03, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x45, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x45, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f,
-	0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x54, 0x72, 0x65,
-	0x65, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64,
-	0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x54,
-	0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e,
-	0x65, 0x77, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6f,
-	0x6f, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e,
-	0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f,
-	0x6f, 0x66, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61, 0x73, 0x18, 0x0e,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61, 0x73, 0x22, 0x74,
-	0x0a, 0x12, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50,
-	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x2b, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x76, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x04, 0x70, 0x72, 0x65,
-	0x76, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x07, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x22, 0x49, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x12, 0x2d, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22,
-	0x70, 0x0a, 0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x2a, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x32, 0x0a,
-	0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x32, 0x51, 0x75,
-	0x65, 0x75, 0x65, 0x49, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x73, 0x22, 0x2a, 0x0a, 0x0a, 0x5a, 0x6b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
-	0x1c, 0x0a, 0x09, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x68, 0x0a,
-	0x12, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x53, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x52, 0x04, 0x70, 0x72, 0x65, 0x76, 0x12, 0x2b, 0x0a, 0x07, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x58, 0x0a, 0x08, 0x5a, 0x6b, 0x53, 0x65, 0x74,
-	0x46, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x79, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x53, 0x65, 0x74,
-	0x46, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65,
-	0x76, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
-	0x72, 0x65, 0x76, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x30, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x73, 0x22, 0xf6, 0x02, 0x0a, 0x0a, 0x5a, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x20,
-	0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x74,
-	0x68, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x65, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x33, 0x33, 0x41, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x0b,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x2c,
-	0x0a, 0x11, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x33, 0x33, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x70, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x22, 0xab, 0x01, 0x0a, 0x0b, 0x5a,
-	0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3c, 0x0a, 0x0e, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x23, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x76,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x12, 0x39, 0x0a,
-	0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x77, 0x0a, 0x0c, 0x5a, 0x6b, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x70, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x3a, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4b, 0x76, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b,
-	0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4b, 0x76,
-	0x73, 0x22, 0xbf, 0x01, 0x0a, 0x0f, 0x5a, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f,
-	0x6f, 0x66, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x65, 0x64, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6e, 0x65, 0x65, 0x64, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x6e, 0x64, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x49,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x53, 0x77, 0x61,
+	0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x12, 0x28, 0x0a, 0x05,
+	0x72, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x65, 0x66, 0x74, 0x44, 0x65,
+	0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x6c, 0x65, 0x66, 0x74, 0x44, 0x65, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28,
+	0x0a, 0x0f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x44, 0x65, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x44, 0x65,
+	0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x74, 0x0a, 0x0c, 0x5a, 0x6b, 0x53, 0x77,
+	0x61, 0x70, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xe1,
+	0x01, 0x0a, 0x09, 0x5a, 0x6b, 0x4d, 0x69, 0x6e, 0x74, 0x4e, 0x46, 0x54, 0x12, 0x24, 0x0a, 0x0d,
+	0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x72, 0x63, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x72, 0x63,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x30, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x5a, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x4e, 0x46, 0x54, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x66, 0x72, 0x6f,
+	0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x46,
+	0x54, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x4e, 0x46, 0x54, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x0d, 0x5a, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x4e, 0x46, 0x54, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x66,
+	0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x4e, 0x46, 0x54, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x4e, 0x46, 0x54, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x5a, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x9a, 0x02, 0x0a, 0x10, 0x5a, 0x6b, 0x4e,
+	0x46, 0x54, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x74, 0x68, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x65, 0x72, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x65, 0x72, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1f, 0x0a, 0x0b, 0x5a, 0x6b, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x6b, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x53, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x0a,
+	0x04, 0x70, 0x72, 0x65, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4b, 0x65, 0x79, 0x52,
+	0x04, 0x70, 0x72, 0x65, 0x76, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a,
+	0x6b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4b, 0x65, 0x79, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x0a, 0x5a, 0x6b, 0x46, 0x65, 0x65, 0x41, 0x64, 0x64, 0x72,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x74, 0x68, 0x46, 0x65, 0x65, 0x41, 0x64, 0x64, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x74, 0x68, 0x46, 0x65, 0x65, 0x41, 0x64, 0x64,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x32, 0x46, 0x65, 0x65, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x32, 0x46, 0x65, 0x65, 0x41, 0x64, 0x64, 0x72, 0x22,
+	0xd3, 0x03, 0x0a, 0x0d, 0x5a, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x45, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x45, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x49,
 	0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x70, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x22, 0x50, 0x0a, 0x10, 0x5a, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3c, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0xc4, 0x01, 0x0a, 0x10, 0x5a, 0x6b, 0x46, 0x65, 0x74, 0x63,
-	0x68, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
-	0x6f, 0x6f, 0x66, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x72, 0x6f,
-	0x6f, 0x66, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50,
-	0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x6e,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f,
-	0x72, 0x65, 0x71, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x4c, 0x61, 0x74,
-	0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
-	0x72, 0x65, 0x71, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1c,
-	0x0a, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x3b, 0x0a, 0x0d,
-	0x5a, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x61, 0x72, 0x74, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61,
-	0x72, 0x74, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x32, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x32, 0x22, 0xf9, 0x01, 0x0a, 0x0b, 0x5a, 0x6b,
-	0x4f, 0x70, 0x4e, 0x46, 0x54, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b,
+	0x64, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f,
+	0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x54, 0x72, 0x65,
+	0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x54, 0x72, 0x65, 0x65,
+	0x52, 0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x54,
+	0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6f,
+	0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x75, 0x62, 0x44, 0x61, 0x74, 0x61, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x75, 0x62,
+	0x44, 0x61, 0x74, 0x61, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x12,
+	0x26, 0x0a, 0x0e, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49,
+	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xac, 0x03, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x45,
+	0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x45,
+	0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0b,
+	0x6f, 0x6c, 0x64, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x49, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x6e, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x22, 0x74, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x2b, 0x0a, 0x04, 0x70, 0x72,
+	0x65, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x04, 0x70, 0x72, 0x65, 0x76, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x49, 0x0a, 0x18, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x70, 0x0a, 0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a,
+	0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x12, 0x32, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x49, 0x64, 0x32, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x0a, 0x5a, 0x6b, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x73, 0x22, 0x68, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x53, 0x65,
+	0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x72, 0x65,
+	0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x5a, 0x6b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x04, 0x70, 0x72, 0x65, 0x76,
+	0x12, 0x2b, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x58, 0x0a,
+	0x08, 0x5a, 0x6b, 0x53, 0x65, 0x74, 0x46, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b,
 	0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49,
-	0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x65,
-	0x72, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0b, 0x65, 0x72, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1e, 0x0a,
-	0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a,
-	0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x52, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x52, 0x0a, 0x0c, 0x5a, 0x6b, 0x4f, 0x70, 0x53, 0x77, 0x61,
-	0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0x39, 0x0a, 0x0b, 0x5a, 0x6b, 0x4f,
-	0x70, 0x46, 0x65, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x66, 0x65, 0x65, 0x22, 0x4a, 0x0a, 0x0f, 0x5a, 0x6b, 0x53, 0x65, 0x74, 0x50, 0x75, 0x62,
-	0x4b, 0x65, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65,
-	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x5a, 0x6b, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79,
-	0x22, 0x1e, 0x0a, 0x0c, 0x4c, 0x31, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x44,
-	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44,
-	0x22, 0x43, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4c, 0x31, 0x50, 0x72, 0x69,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x76, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x72, 0x65, 0x76, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x63, 0x0a, 0x0f, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x54,
-	0x72, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x74,
-	0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x53, 0x65, 0x74,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x53, 0x65, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x0d, 0x5a,
-	0x6b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x12, 0x1f, 0x0a, 0x04,
-	0x6c, 0x65, 0x61, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x12, 0x29, 0x0a,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x34, 0x0a, 0x09, 0x74, 0x72, 0x65, 0x65,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x54, 0x72, 0x65, 0x65, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x52, 0x09, 0x74, 0x72, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x36,
-	0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c,
-	0x65, 0x54, 0x72, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x0a, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x9c, 0x01, 0x0a, 0x12, 0x5a, 0x6b, 0x41, 0x63, 0x63,
-	0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a,
+	0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x53, 0x65, 0x74, 0x46, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x24, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x30, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0xf6, 0x02, 0x0a, 0x0a, 0x5a, 0x6b, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x41, 0x64, 0x64, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x6f, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61,
+	0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x22, 0xab, 0x01, 0x0a, 0x0b, 0x5a, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x3c, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x23,
+	0x0a, 0x06, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x06, 0x6c, 0x65, 0x61,
+	0x76, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xc9,
+	0x02, 0x0a, 0x0b, 0x5a, 0x6b, 0x4c, 0x32, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x54, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x6f, 0x67, 0x54, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x24,
+	0x0a, 0x0d, 0x70, 0x65, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x65, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0xd6, 0x01, 0x0a, 0x0e, 0x5a,
+	0x6b, 0x52, 0x65, 0x71, 0x4c, 0x32, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a,
 	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
 	0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74,
 	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x22, 0x0a, 0x0c, 0x6e, 0x65, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x65, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x6c, 0x62,
-	0x61, 0x63, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x67, 0x61, 0x70, 0x22, 0x74, 0x0a, 0x18, 0x5a, 0x6b, 0x45, 0x78, 0x6f, 0x64, 0x75,
-	0x73, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x72,
-	0x6d, 0x12, 0x2e, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6c,
-	0x61, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49,
-	0x64, 0x12, 0x28, 0x0a, 0x0f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x47, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6b, 0x6e, 0x6f, 0x77,
-	0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x47, 0x61, 0x70, 0x22, 0x6a, 0x0a, 0x0c, 0x5a,
-	0x6b, 0x45, 0x78, 0x6f, 0x64, 0x75, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12,
-	0x3d, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x45, 0x78, 0x6f, 0x64,
-	0x75, 0x73, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x50, 0x61,
-	0x72, 0x6d, 0x48, 0x00, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x42, 0x07,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x41, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x65, 0x69,
-	0x70, 0x74, 0x45, 0x78, 0x6f, 0x64, 0x75, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x72, 0x65, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x72, 0x65, 0x76,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x67, 0x0a, 0x15, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x70, 0x74, 0x53, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x79, 0x6d,
-	0x62, 0x6f, 0x6c, 0x12, 0x26, 0x0a, 0x03, 0x70, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x03, 0x70, 0x72, 0x65, 0x12, 0x26, 0x0a, 0x03, 0x63,
-	0x75, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x0f, 0x5a, 0x6b, 0x4c, 0x32, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b,
+	0x4c, 0x32, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79,
+	0x22, 0x77, 0x0a, 0x0c, 0x5a, 0x6b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4c, 0x6f, 0x67,
+	0x12, 0x3a, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4b, 0x76, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4b, 0x76, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x0f, 0x5a, 0x6b,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6e, 0x65, 0x65, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x6e, 0x65, 0x65, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x2a, 0x0a,
+	0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x6e, 0x64,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x65, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x50, 0x0a, 0x10, 0x5a,
+	0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x3c, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0xc4, 0x01,
+	0x0a, 0x10, 0x5a, 0x6b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e,
+	0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x4f, 0x6e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72,
+	0x65, 0x71, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x26,
+	0x0a, 0x0e, 0x72, 0x65, 0x71, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x4c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0x3b, 0x0a, 0x0d, 0x5a, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x31, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x61, 0x72, 0x74, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74,
+	0x32, 0x22, 0xf9, 0x01, 0x0a, 0x0b, 0x5a, 0x6b, 0x4f, 0x70, 0x4e, 0x46, 0x54, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x72, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x72, 0x63, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x48, 0x61, 0x73, 0x68, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x52, 0x0a,
+	0x0c, 0x5a, 0x6b, 0x4f, 0x70, 0x53, 0x77, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x65,
+	0x65, 0x22, 0x39, 0x0a, 0x0b, 0x5a, 0x6b, 0x4f, 0x70, 0x46, 0x65, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0x4a, 0x0a, 0x0f,
+	0x5a, 0x6b, 0x53, 0x65, 0x74, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x74, 0x79, 0x12,
+	0x27, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0x1e, 0x0a, 0x0c, 0x4c, 0x31, 0x50, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x43, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x4c, 0x31, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70,
+	0x72, 0x65, 0x76, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x63, 0x0a,
+	0x0f, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x54, 0x72, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x53, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x53, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x6c, 0x70,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x6c, 0x70, 0x65,
+	0x72, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x0d, 0x5a, 0x6b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x4c, 0x65, 0x61, 0x66, 0x12, 0x1f, 0x0a, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4c, 0x65, 0x61, 0x66, 0x52,
+	0x04, 0x6c, 0x65, 0x61, 0x66, 0x12, 0x29, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x34, 0x0a, 0x09, 0x74, 0x72, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x72, 0x6b,
+	0x6c, 0x65, 0x54, 0x72, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x09, 0x74, 0x72, 0x65,
+	0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x36, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x54, 0x72, 0x65, 0x65, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x52, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x9c,
+	0x01, 0x0a, 0x12, 0x5a, 0x6b, 0x41, 0x63, 0x63, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x65, 0x65, 0x64, 0x52,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e,
+	0x65, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x67,
+	0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x67, 0x61, 0x70, 0x22, 0x74, 0x0a,
+	0x18, 0x5a, 0x6b, 0x45, 0x78, 0x6f, 0x64, 0x75, 0x73, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x72, 0x6d, 0x12, 0x2e, 0x0a, 0x12, 0x6c, 0x61, 0x73,
+	0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x47, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x47, 0x61, 0x70, 0x22, 0x6a, 0x0a, 0x0c, 0x5a, 0x6b, 0x45, 0x78, 0x6f, 0x64, 0x75, 0x73, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x5a, 0x6b, 0x45, 0x78, 0x6f, 0x64, 0x75, 0x73, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x72, 0x6d, 0x48, 0x00, 0x52, 0x08, 0x72, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x41, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x45, 0x78, 0x6f, 0x64, 0x75, 0x73,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x76, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x70, 0x72, 0x65, 0x76, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x22, 0x67, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x53, 0x65, 0x74,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x26, 0x0a, 0x03, 0x70,
+	0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
 	0x2e, 0x5a, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x03,
-	0x63, 0x75, 0x72, 0x22, 0x54, 0x0a, 0x10, 0x4c, 0x61, 0x73, 0x74, 0x4f, 0x6e, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49,
-	0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x6e, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x17, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x2a, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x12, 0x1e, 0x0a,
-	0x0a, 0x6c, 0x65, 0x61, 0x66, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0c, 0x52, 0x0a, 0x6c, 0x65, 0x61, 0x66, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x69, 0x0a,
-	0x13, 0x5a, 0x6b, 0x52, 0x65, 0x71, 0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x50,
-	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22, 0xd1, 0x01, 0x0a, 0x15, 0x5a, 0x6b, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e,
-	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18,
-	0x0a, 0x07, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x07, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20,
-	0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x22, 0x99, 0x01, 0x0a,
-	0x15, 0x5a, 0x6b, 0x45, 0x78, 0x6f, 0x64, 0x75, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22,
-	0x0a, 0x0c, 0x65, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22, 0x44, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x4c, 0x32, 0x4c, 0x61, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x44,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
-	0x70, 0x72, 0x65, 0x76, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x45,
-	0x0a, 0x15, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4c, 0x32, 0x46, 0x69, 0x72, 0x73, 0x74,
-	0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x76, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x72, 0x65, 0x76, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
-	0x4c, 0x32, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x44, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a,
-	0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x5a, 0x6b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x6f,
-	0x70, 0x22, 0x75, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x32, 0x51, 0x75, 0x65,
-	0x75, 0x65, 0x49, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6f,
-	0x66, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x69, 0x72, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65,
-	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x69, 0x72, 0x73, 0x74, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x51, 0x75,
-	0x65, 0x75, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73,
-	0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x1a, 0x52, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x32, 0x51, 0x75, 0x65, 0x75, 0x65,
-	0x49, 0x44, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x70, 0x72, 0x65, 0x12, 0x26, 0x0a, 0x03, 0x63, 0x75, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x03, 0x63, 0x75, 0x72, 0x22, 0x54, 0x0a, 0x10, 0x4c,
+	0x61, 0x73, 0x74, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x6e, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49,
+	0x64, 0x22, 0x81, 0x01, 0x0a, 0x17, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2a, 0x0a, 0x06, 0x6c, 0x65, 0x61,
+	0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x06, 0x6c,
+	0x65, 0x61, 0x76, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x66, 0x48, 0x61, 0x73,
+	0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x6c, 0x65, 0x61, 0x66, 0x48,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x69, 0x0a, 0x13, 0x5a, 0x6b, 0x52, 0x65, 0x71, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x22, 0xd1, 0x01, 0x0a, 0x15, 0x5a, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x22, 0x99, 0x01, 0x0a, 0x15, 0x5a, 0x6b, 0x45, 0x78, 0x6f, 0x64, 0x75,
+	0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x12, 0x26,
+	0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6e, 0x64, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x6e,
+	0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x22, 0x44, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4c, 0x32, 0x4c, 0x61, 0x73,
+	0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x76,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x72, 0x65, 0x76, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x45, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x4c, 0x32, 0x46, 0x69, 0x72, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70,
+	0x72, 0x65, 0x76, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x4a, 0x0a,
+	0x14, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4c, 0x32, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49,
+	0x44, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x5a, 0x6b, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x6f, 0x70, 0x22, 0x75, 0x0a, 0x13, 0x50, 0x72, 0x6f,
 	0x6f, 0x66, 0x49, 0x64, 0x32, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4c, 0x0a, 0x10, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x32, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72,
-	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75,
-	0x65, 0x75, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x49, 0x64, 0x32, 0x08, 0x0a, 0x06, 0x7a, 0x6b, 0x73, 0x79, 0x6e, 0x63, 0x42, 0x0a,
-	0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x66, 0x69, 0x72, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64,
+	0x22, 0x4c, 0x0a, 0x1a, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x49, 0x64, 0x32, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x44, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2e,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x64, 0x32, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x49, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4c,
+	0x0a, 0x10, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x32, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x64, 0x32, 0x08, 0x0a, 0x06,
+	0x7a, 0x6b, 0x73, 0x79, 0x6e, 0x63, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5710,7 +6357,7 @@ func file_zksync_proto_rawDescGZIP() []byte {
 	return file_zksync_proto_rawDescData
 }
 
-var file_zksync_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
+var file_zksync_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
 var file_zksync_proto_goTypes = []interface{}{
 	(*ZksyncAction)(nil),               // 0: types.ZksyncAction
 	(*ZkTokenSymbol)(nil),              // 1: types.ZkTokenSymbol
@@ -5730,65 +6377,71 @@ var file_zksync_proto_goTypes = []interface{}{
 	(*ZkProxyExit)(nil),                // 15: types.ZkProxyExit
 	(*ZkSetPubKey)(nil),                // 16: types.ZkSetPubKey
 	(*ZkFullExit)(nil),                 // 17: types.ZkFullExit
-	(*ZkSwap)(nil),                     // 18: types.ZkSwap
-	(*ZkMintNFT)(nil),                  // 19: types.ZkMintNFT
-	(*ZkWithdrawNFT)(nil),              // 20: types.ZkWithdrawNFT
-	(*ZkTransferNFT)(nil),              // 21: types.ZkTransferNFT
-	(*ZkNFTTokenStatus)(nil),           // 22: types.ZkNFTTokenStatus
-	(*ZkVerifyKey)(nil),                // 23: types.ZkVerifyKey
-	(*ReceiptSetVerifyKey)(nil),        // 24: types.ReceiptSetVerifyKey
-	(*ZkFeeAddrs)(nil),                 // 25: types.ZkFeeAddrs
-	(*ZkCommitProof)(nil),              // 26: types.ZkCommitProof
-	(*CommitProofState)(nil),           // 27: types.CommitProofState
-	(*ReceiptCommitProof)(nil),         // 28: types.ReceiptCommitProof
-	(*ReceiptCommitProofRecord)(nil),   // 29: types.ReceiptCommitProofRecord
-	(*QueryProofInfo)(nil),             // 30: types.QueryProofInfo
-	(*ZkVerifier)(nil),                 // 31: types.ZkVerifier
-	(*ReceiptSetVerifier)(nil),         // 32: types.ReceiptSetVerifier
-	(*ZkSetFee)(nil),                   // 33: types.ZkSetFee
-	(*ReceiptSetFee)(nil),              // 34: types.ReceiptSetFee
-	(*RelayerOperators)(nil),           // 35: types.RelayerOperators
-	(*ZkQueryReq)(nil),                 // 36: types.ZkQueryReq
-	(*ZkQueryResp)(nil),                // 37: types.ZkQueryResp
-	(*ZkReceiptLog)(nil),               // 38: types.ZkReceiptLog
-	(*ZkQueryProofReq)(nil),            // 39: types.ZkQueryProofReq
-	(*ZkQueryProofResp)(nil),           // 40: types.ZkQueryProofResp
-	(*ZkFetchProofList)(nil),           // 41: types.ZkFetchProofList
-	(*ZkContentHash)(nil),              // 42: types.ZkContentHash
-	(*ZkOpNFTData)(nil),                // 43: types.ZkOpNFTData
-	(*ZkOpSwapData)(nil),               // 44: types.ZkOpSwapData
-	(*ZkOpFeeData)(nil),                // 45: types.ZkOpFeeData
-	(*ZkSetPubKeyData)(nil),            // 46: types.ZkSetPubKeyData
-	(*L1PriorityID)(nil),               // 47: types.L1PriorityID
-	(*ReceiptL1PriorityID)(nil),        // 48: types.ReceiptL1PriorityID
-	(*MerkleTreeProof)(nil),            // 49: types.MerkleTreeProof
-	(*ZkReceiptLeaf)(nil),              // 50: types.ZkReceiptLeaf
-	(*ZkAcctRollbackInfo)(nil),         // 51: types.ZkAcctRollbackInfo
-	(*ZkExodusRollbackModeParm)(nil),   // 52: types.ZkExodusRollbackModeParm
-	(*ZkExodusMode)(nil),               // 53: types.ZkExodusMode
-	(*ReceiptExodusMode)(nil),          // 54: types.ReceiptExodusMode
-	(*ReceiptSetTokenSymbol)(nil),      // 55: types.ReceiptSetTokenSymbol
-	(*LastOnChainProof)(nil),           // 56: types.LastOnChainProof
-	(*HistoryAccountProofInfo)(nil),    // 57: types.HistoryAccountProofInfo
-	(*ZkReqExistenceProof)(nil),        // 58: types.ZkReqExistenceProof
-	(*ZkQueryTxOperationReq)(nil),      // 59: types.ZkQueryTxOperationReq
-	(*ZkExodusBatchProofReq)(nil),      // 60: types.ZkExodusBatchProofReq
-	(*ReceiptL2LastQueueID)(nil),       // 61: types.ReceiptL2LastQueueID
-	(*ReceiptL2FirstQueueID)(nil),      // 62: types.ReceiptL2FirstQueueID
-	(*ReceiptL2QueueIDData)(nil),       // 63: types.ReceiptL2QueueIDData
-	(*ProofId2QueueIdData)(nil),        // 64: types.ProofId2QueueIdData
-	(*ReceiptProofId2QueueIDData)(nil), // 65: types.ReceiptProofId2QueueIDData
-	(*Priority2QueueId)(nil),           // 66: types.Priority2QueueId
-	(*types.AssetsTransfer)(nil),       // 67: types.AssetsTransfer
-	(*types.AssetsWithdraw)(nil),       // 68: types.AssetsWithdraw
-	(*types.AssetsTransferToExec)(nil), // 69: types.AssetsTransferToExec
-	(*ZkPubKey)(nil),                   // 70: types.ZkPubKey
-	(*AccountProxyPubKeys)(nil),        // 71: types.AccountProxyPubKeys
-	(*ZkSignature)(nil),                // 72: types.ZkSignature
-	(*ZkFee)(nil),                      // 73: types.ZkFee
-	(*OperationInfo)(nil),              // 74: types.OperationInfo
-	(*types.KeyValue)(nil),             // 75: types.KeyValue
-	(*ZkOperation)(nil),                // 76: types.ZkOperation
+	(*ZkSwapOrder)(nil),                // 18: types.ZkSwapOrder
+	(*ZkBatch)(nil),                    // 19: types.ZkBatch
+	(*ZkSwap)(nil),                     // 20: types.ZkSwap
+	(*ZkSwapCancel)(nil),               // 21: types.ZkSwapCancel
+	(*ZkMintNFT)(nil),                  // 22: types.ZkMintNFT
+	(*ZkWithdrawNFT)(nil),              // 23: types.ZkWithdrawNFT
+	(*ZkTransferNFT)(nil),              // 24: types.ZkTransferNFT
+	(*ZkNFTTokenStatus)(nil),           // 25: types.ZkNFTTokenStatus
+	(*ZkVerifyKey)(nil),                // 26: types.ZkVerifyKey
+	(*ReceiptSetVerifyKey)(nil),        // 27: types.ReceiptSetVerifyKey
+	(*ZkFeeAddrs)(nil),                 // 28: types.ZkFeeAddrs
+	(*ZkCommitProof)(nil),              // 29: types.ZkCommitProof
+	(*CommitProofState)(nil),           // 30: types.CommitProofState
+	(*ReceiptCommitProof)(nil),         // 31: types.ReceiptCommitProof
+	(*ReceiptCommitProofRecord)(nil),   // 32: types.ReceiptCommitProofRecord
+	(*QueryProofInfo)(nil),             // 33: types.QueryProofInfo
+	(*ZkVerifier)(nil),                 // 34: types.ZkVerifier
+	(*ReceiptSetVerifier)(nil),         // 35: types.ReceiptSetVerifier
+	(*ZkSetFee)(nil),                   // 36: types.ZkSetFee
+	(*ReceiptSetFee)(nil),              // 37: types.ReceiptSetFee
+	(*RelayerOperators)(nil),           // 38: types.RelayerOperators
+	(*ZkQueryReq)(nil),                 // 39: types.ZkQueryReq
+	(*ZkQueryResp)(nil),                // 40: types.ZkQueryResp
+	(*ZkL2History)(nil),                // 41: types.ZkL2History
+	(*ZkReqL2History)(nil),             // 42: types.ZkReqL2History
+	(*ZkL2HistoryList)(nil),            // 43: types.ZkL2HistoryList
+	(*ZkReceiptLog)(nil),               // 44: types.ZkReceiptLog
+	(*ZkQueryProofReq)(nil),            // 45: types.ZkQueryProofReq
+	(*ZkQueryProofResp)(nil),           // 46: types.ZkQueryProofResp
+	(*ZkFetchProofList)(nil),           // 47: types.ZkFetchProofList
+	(*ZkContentHash)(nil),              // 48: types.ZkContentHash
+	(*ZkOpNFTData)(nil),                // 49: types.ZkOpNFTData
+	(*ZkOpSwapData)(nil),               // 50: types.ZkOpSwapData
+	(*ZkOpFeeData)(nil),                // 51: types.ZkOpFeeData
+	(*ZkSetPubKeyData)(nil),            // 52: types.ZkSetPubKeyData
+	(*L1PriorityID)(nil),               // 53: types.L1PriorityID
+	(*ReceiptL1PriorityID)(nil),        // 54: types.ReceiptL1PriorityID
+	(*MerkleTreeProof)(nil),            // 55: types.MerkleTreeProof
+	(*ZkReceiptLeaf)(nil),              // 56: types.ZkReceiptLeaf
+	(*ZkAcctRollbackInfo)(nil),         // 57: types.ZkAcctRollbackInfo
+	(*ZkExodusRollbackModeParm)(nil),   // 58: types.ZkExodusRollbackModeParm
+	(*ZkExodusMode)(nil),               // 59: types.ZkExodusMode
+	(*ReceiptExodusMode)(nil),          // 60: types.ReceiptExodusMode
+	(*ReceiptSetTokenSymbol)(nil),      // 61: types.ReceiptSetTokenSymbol
+	(*LastOnChainProof)(nil),           // 62: types.LastOnChainProof
+	(*HistoryAccountProofInfo)(nil),    // 63: types.HistoryAccountProofInfo
+	(*ZkReqExistenceProof)(nil),        // 64: types.ZkReqExistenceProof
+	(*ZkQueryTxOperationReq)(nil),      // 65: types.ZkQueryTxOperationReq
+	(*ZkExodusBatchProofReq)(nil),      // 66: types.ZkExodusBatchProofReq
+	(*ReceiptL2LastQueueID)(nil),       // 67: types.ReceiptL2LastQueueID
+	(*ReceiptL2FirstQueueID)(nil),      // 68: types.ReceiptL2FirstQueueID
+	(*ReceiptL2QueueIDData)(nil),       // 69: types.ReceiptL2QueueIDData
+	(*ProofId2QueueIdData)(nil),        // 70: types.ProofId2QueueIdData
+	(*ReceiptProofId2QueueIDData)(nil), // 71: types.ReceiptProofId2QueueIDData
+	(*Priority2QueueId)(nil),           // 72: types.Priority2QueueId
+	(*types.AssetsTransfer)(nil),       // 73: types.AssetsTransfer
+	(*types.AssetsWithdraw)(nil),       // 74: types.AssetsWithdraw
+	(*types.AssetsTransferToExec)(nil), // 75: types.AssetsTransferToExec
+	(*ZkPubKey)(nil),                   // 76: types.ZkPubKey
+	(*AccountProxyPubKeys)(nil),        // 77: types.AccountProxyPubKeys
+	(*ZkSignature)(nil),                // 78: types.ZkSignature
+	(*ZkFee)(nil),                      // 79: types.ZkFee
+	(*OperationInfo)(nil),              // 80: types.OperationInfo
+	(*types.KeyValue)(nil),             // 81: types.KeyValue
+	(*ZkOperation)(nil),                // 82: types.ZkOperation
 }
 var file_zksync_proto_depIdxs = []int32{
 	8,  // 0: types.ZksyncAction.deposit:type_name -> types.ZkDeposit
@@ -5798,75 +6451,83 @@ var file_zksync_proto_depIdxs = []int32{
 	15, // 4: types.ZksyncAction.proxyExit:type_name -> types.ZkProxyExit
 	16, // 5: types.ZksyncAction.setPubKey:type_name -> types.ZkSetPubKey
 	17, // 6: types.ZksyncAction.fullExit:type_name -> types.ZkFullExit
-	18, // 7: types.ZksyncAction.swap:type_name -> types.ZkSwap
+	20, // 7: types.ZksyncAction.swap:type_name -> types.ZkSwap
 	10, // 8: types.ZksyncAction.contractToTree:type_name -> types.ZkContractToTree
 	11, // 9: types.ZksyncAction.treeToContract:type_name -> types.ZkTreeToContract
-	19, // 10: types.ZksyncAction.mintNFT:type_name -> types.ZkMintNFT
-	20, // 11: types.ZksyncAction.withdrawNFT:type_name -> types.ZkWithdrawNFT
-	21, // 12: types.ZksyncAction.transferNFT:type_name -> types.ZkTransferNFT
-	23, // 13: types.ZksyncAction.setVerifyKey:type_name -> types.ZkVerifyKey
-	26, // 14: types.ZksyncAction.commitProof:type_name -> types.ZkCommitProof
-	31, // 15: types.ZksyncAction.setVerifier:type_name -> types.ZkVerifier
-	33, // 16: types.ZksyncAction.setFee:type_name -> types.ZkSetFee
+	22, // 10: types.ZksyncAction.mintNFT:type_name -> types.ZkMintNFT
+	23, // 11: types.ZksyncAction.withdrawNFT:type_name -> types.ZkWithdrawNFT
+	24, // 12: types.ZksyncAction.transferNFT:type_name -> types.ZkTransferNFT
+	26, // 13: types.ZksyncAction.setVerifyKey:type_name -> types.ZkVerifyKey
+	29, // 14: types.ZksyncAction.commitProof:type_name -> types.ZkCommitProof
+	34, // 15: types.ZksyncAction.setVerifier:type_name -> types.ZkVerifier
+	36, // 16: types.ZksyncAction.setFee:type_name -> types.ZkSetFee
 	1,  // 17: types.ZksyncAction.setTokenSymbol:type_name -> types.ZkTokenSymbol
-	53, // 18: types.ZksyncAction.setExodusMode:type_name -> types.ZkExodusMode
-	67, // 19: types.ZksyncAction.transfer:type_name -> types.AssetsTransfer
-	68, // 20: types.ZksyncAction.withdraw:type_name -> types.AssetsWithdraw
-	69, // 21: types.ZksyncAction.transferToExec:type_name -> types.AssetsTransferToExec
-	7,  // 22: types.AccountTree.subTrees:type_name -> types.SubTree
-	70, // 23: types.Leaf.pubKey:type_name -> types.ZkPubKey
-	71, // 24: types.Leaf.proxyPubKeys:type_name -> types.AccountProxyPubKeys
-	70, // 25: types.HistoryLeaf.pubKey:type_name -> types.ZkPubKey
-	2,  // 26: types.HistoryLeaf.tokens:type_name -> types.TokenBalance
-	71, // 27: types.HistoryLeaf.proxyPubKeys:type_name -> types.AccountProxyPubKeys
-	72, // 28: types.ZkDeposit.signature:type_name -> types.ZkSignature
-	72, // 29: types.ZkWithdraw.signature:type_name -> types.ZkSignature
-	72, // 30: types.ZkContractToTree.signature:type_name -> types.ZkSignature
-	72, // 31: types.ZkTreeToContract.signature:type_name -> types.ZkSignature
-	72, // 32: types.ZkTransfer.signature:type_name -> types.ZkSignature
-	72, // 33: types.ZkTransferToNew.signature:type_name -> types.ZkSignature
-	72, // 34: types.ZkForceExit.signature:type_name -> types.ZkSignature
-	72, // 35: types.ZkProxyExit.signature:type_name -> types.ZkSignature
-	73, // 36: types.ZkProxyExit.fee:type_name -> types.ZkFee
-	70, // 37: types.ZkSetPubKey.pubKey:type_name -> types.ZkPubKey
-	72, // 38: types.ZkSetPubKey.signature:type_name -> types.ZkSignature
-	72, // 39: types.ZkFullExit.signature:type_name -> types.ZkSignature
-	72, // 40: types.ZkSwap.signature:type_name -> types.ZkSignature
-	72, // 41: types.ZkMintNFT.signature:type_name -> types.ZkSignature
-	72, // 42: types.ZkWithdrawNFT.signature:type_name -> types.ZkSignature
-	72, // 43: types.ZkTransferNFT.signature:type_name -> types.ZkSignature
-	23, // 44: types.ReceiptSetVerifyKey.prev:type_name -> types.ZkVerifyKey
-	23, // 45: types.ReceiptSetVerifyKey.current:type_name -> types.ZkVerifyKey
-	27, // 46: types.ReceiptCommitProof.prev:type_name -> types.CommitProofState
-	27, // 47: types.ReceiptCommitProof.current:type_name -> types.CommitProofState
-	27, // 48: types.ReceiptCommitProofRecord.proof:type_name -> types.CommitProofState
-	26, // 49: types.QueryProofInfo.proof:type_name -> types.ZkCommitProof
-	64, // 50: types.QueryProofInfo.queues:type_name -> types.ProofId2QueueIdData
-	31, // 51: types.ReceiptSetVerifier.prev:type_name -> types.ZkVerifier
-	31, // 52: types.ReceiptSetVerifier.current:type_name -> types.ZkVerifier
-	74, // 53: types.ZkQueryResp.operationInfos:type_name -> types.OperationInfo
-	4,  // 54: types.ZkQueryResp.leaves:type_name -> types.Leaf
-	2,  // 55: types.ZkQueryResp.tokenBalances:type_name -> types.TokenBalance
-	74, // 56: types.ZkReceiptLog.operationInfo:type_name -> types.OperationInfo
-	75, // 57: types.ZkReceiptLog.localKvs:type_name -> types.KeyValue
-	74, // 58: types.ZkQueryProofResp.operationInfos:type_name -> types.OperationInfo
-	42, // 59: types.ZkOpNFTData.content:type_name -> types.ZkContentHash
-	70, // 60: types.ZkSetPubKeyData.pubKey:type_name -> types.ZkPubKey
-	4,  // 61: types.ZkReceiptLeaf.leaf:type_name -> types.Leaf
-	2,  // 62: types.ZkReceiptLeaf.token:type_name -> types.TokenBalance
-	49, // 63: types.ZkReceiptLeaf.treeProof:type_name -> types.MerkleTreeProof
-	49, // 64: types.ZkReceiptLeaf.tokenProof:type_name -> types.MerkleTreeProof
-	52, // 65: types.ZkExodusMode.rollback:type_name -> types.ZkExodusRollbackModeParm
-	1,  // 66: types.ReceiptSetTokenSymbol.pre:type_name -> types.ZkTokenSymbol
-	1,  // 67: types.ReceiptSetTokenSymbol.cur:type_name -> types.ZkTokenSymbol
-	5,  // 68: types.HistoryAccountProofInfo.leaves:type_name -> types.HistoryLeaf
-	76, // 69: types.ReceiptL2QueueIDData.op:type_name -> types.ZkOperation
-	64, // 70: types.ReceiptProofId2QueueIDData.data:type_name -> types.ProofId2QueueIdData
-	71, // [71:71] is the sub-list for method output_type
-	71, // [71:71] is the sub-list for method input_type
-	71, // [71:71] is the sub-list for extension type_name
-	71, // [71:71] is the sub-list for extension extendee
-	0,  // [0:71] is the sub-list for field type_name
+	59, // 18: types.ZksyncAction.setExodusMode:type_name -> types.ZkExodusMode
+	19, // 19: types.ZksyncAction.batch:type_name -> types.ZkBatch
+	21, // 20: types.ZksyncAction.swapCancel:type_name -> types.ZkSwapCancel
+	73, // 21: types.ZksyncAction.transfer:type_name -> types.AssetsTransfer
+	74, // 22: types.ZksyncAction.withdraw:type_name -> types.AssetsWithdraw
+	75, // 23: types.ZksyncAction.transferToExec:type_name -> types.AssetsTransferToExec
+	7,  // 24: types.AccountTree.subTrees:type_name -> types.SubTree
+	76, // 25: types.Leaf.pubKey:type_name -> types.ZkPubKey
+	77, // 26: types.Leaf.proxyPubKeys:type_name -> types.AccountProxyPubKeys
+	76, // 27: types.HistoryLeaf.pubKey:type_name -> types.ZkPubKey
+	2,  // 28: types.HistoryLeaf.tokens:type_name -> types.TokenBalance
+	77, // 29: types.HistoryLeaf.proxyPubKeys:type_name -> types.AccountProxyPubKeys
+	78, // 30: types.ZkDeposit.signature:type_name -> types.ZkSignature
+	78, // 31: types.ZkWithdraw.signature:type_name -> types.ZkSignature
+	78, // 32: types.ZkContractToTree.signature:type_name -> types.ZkSignature
+	78, // 33: types.ZkTreeToContract.signature:type_name -> types.ZkSignature
+	78, // 34: types.ZkTransfer.signature:type_name -> types.ZkSignature
+	78, // 35: types.ZkTransferToNew.signature:type_name -> types.ZkSignature
+	78, // 36: types.ZkForceExit.signature:type_name -> types.ZkSignature
+	78, // 37: types.ZkProxyExit.signature:type_name -> types.ZkSignature
+	79, // 38: types.ZkProxyExit.fee:type_name -> types.ZkFee
+	76, // 39: types.ZkSetPubKey.pubKey:type_name -> types.ZkPubKey
+	78, // 40: types.ZkSetPubKey.signature:type_name -> types.ZkSignature
+	78, // 41: types.ZkFullExit.signature:type_name -> types.ZkSignature
+	78, // 42: types.ZkSwapOrder.signature:type_name -> types.ZkSignature
+	0,  // 43: types.ZkBatch.ops:type_name -> types.ZksyncAction
+	78, // 44: types.ZkSwap.signature:type_name -> types.ZkSignature
+	18, // 45: types.ZkSwap.left:type_name -> types.ZkSwapOrder
+	18, // 46: types.ZkSwap.right:type_name -> types.ZkSwapOrder
+	78, // 47: types.ZkSwapCancel.signature:type_name -> types.ZkSignature
+	78, // 48: types.ZkMintNFT.signature:type_name -> types.ZkSignature
+	78, // 49: types.ZkWithdrawNFT.signature:type_name -> types.ZkSignature
+	78, // 50: types.ZkTransferNFT.signature:type_name -> types.ZkSignature
+	26, // 51: types.ReceiptSetVerifyKey.prev:type_name -> types.ZkVerifyKey
+	26, // 52: types.ReceiptSetVerifyKey.current:type_name -> types.ZkVerifyKey
+	30, // 53: types.ReceiptCommitProof.prev:type_name -> types.CommitProofState
+	30, // 54: types.ReceiptCommitProof.current:type_name -> types.CommitProofState
+	30, // 55: types.ReceiptCommitProofRecord.proof:type_name -> types.CommitProofState
+	29, // 56: types.QueryProofInfo.proof:type_name -> types.ZkCommitProof
+	70, // 57: types.QueryProofInfo.queues:type_name -> types.ProofId2QueueIdData
+	34, // 58: types.ReceiptSetVerifier.prev:type_name -> types.ZkVerifier
+	34, // 59: types.ReceiptSetVerifier.current:type_name -> types.ZkVerifier
+	80, // 60: types.ZkQueryResp.operationInfos:type_name -> types.OperationInfo
+	4,  // 61: types.ZkQueryResp.leaves:type_name -> types.Leaf
+	2,  // 62: types.ZkQueryResp.tokenBalances:type_name -> types.TokenBalance
+	41, // 63: types.ZkL2HistoryList.items:type_name -> types.ZkL2History
+	80, // 64: types.ZkReceiptLog.operationInfo:type_name -> types.OperationInfo
+	81, // 65: types.ZkReceiptLog.localKvs:type_name -> types.KeyValue
+	80, // 66: types.ZkQueryProofResp.operationInfos:type_name -> types.OperationInfo
+	48, // 67: types.ZkOpNFTData.content:type_name -> types.ZkContentHash
+	76, // 68: types.ZkSetPubKeyData.pubKey:type_name -> types.ZkPubKey
+	4,  // 69: types.ZkReceiptLeaf.leaf:type_name -> types.Leaf
+	2,  // 70: types.ZkReceiptLeaf.token:type_name -> types.TokenBalance
+	55, // 71: types.ZkReceiptLeaf.treeProof:type_name -> types.MerkleTreeProof
+	55, // 72: types.ZkReceiptLeaf.tokenProof:type_name -> types.MerkleTreeProof
+	58, // 73: types.ZkExodusMode.rollback:type_name -> types.ZkExodusRollbackModeParm
+	1,  // 74: types.ReceiptSetTokenSymbol.pre:type_name -> types.ZkTokenSymbol
+	1,  // 75: types.ReceiptSetTokenSymbol.cur:type_name -> types.ZkTokenSymbol
+	5,  // 76: types.HistoryAccountProofInfo.leaves:type_name -> types.HistoryLeaf
+	82, // 77: types.ReceiptL2QueueIDData.op:type_name -> types.ZkOperation
+	70, // 78: types.ReceiptProofId2QueueIDData.data:type_name -> types.ProofId2QueueIdData
+	79, // [79:79] is the sub-list for method output_type
+	79, // [79:79] is the sub-list for method input_type
+	79, // [79:79] is the sub-list for extension type_name
+	79, // [79:79] is the sub-list for extension extendee
+	0,  // [0:79] is the sub-list for field type_name
 }
 
 func init() { file_zksync_proto_init() }
@@ -6093,7 +6754,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkSwap); i {
+			switch v := v.(*ZkSwapOrder); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6105,7 +6766,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkMintNFT); i {
+			switch v := v.(*ZkBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6117,7 +6778,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkWithdrawNFT); i {
+			switch v := v.(*ZkSwap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6129,7 +6790,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkTransferNFT); i {
+			switch v := v.(*ZkSwapCancel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6141,7 +6802,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkNFTTokenStatus); i {
+			switch v := v.(*ZkMintNFT); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6153,7 +6814,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkVerifyKey); i {
+			switch v := v.(*ZkWithdrawNFT); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6165,7 +6826,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptSetVerifyKey); i {
+			switch v := v.(*ZkTransferNFT); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6177,7 +6838,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkFeeAddrs); i {
+			switch v := v.(*ZkNFTTokenStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6189,7 +6850,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkCommitProof); i {
+			switch v := v.(*ZkVerifyKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6201,7 +6862,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitProofState); i {
+			switch v := v.(*ReceiptSetVerifyKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6213,7 +6874,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptCommitProof); i {
+			switch v := v.(*ZkFeeAddrs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6225,7 +6886,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptCommitProofRecord); i {
+			switch v := v.(*ZkCommitProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6237,7 +6898,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryProofInfo); i {
+			switch v := v.(*CommitProofState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6249,7 +6910,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkVerifier); i {
+			switch v := v.(*ReceiptCommitProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6261,7 +6922,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptSetVerifier); i {
+			switch v := v.(*ReceiptCommitProofRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6273,7 +6934,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkSetFee); i {
+			switch v := v.(*QueryProofInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6285,7 +6946,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptSetFee); i {
+			switch v := v.(*ZkVerifier); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6297,7 +6958,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RelayerOperators); i {
+			switch v := v.(*ReceiptSetVerifier); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6309,7 +6970,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkQueryReq); i {
+			switch v := v.(*ZkSetFee); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6321,7 +6982,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkQueryResp); i {
+			switch v := v.(*ReceiptSetFee); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6333,7 +6994,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkReceiptLog); i {
+			switch v := v.(*RelayerOperators); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6345,7 +7006,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkQueryProofReq); i {
+			switch v := v.(*ZkQueryReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6357,7 +7018,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkQueryProofResp); i {
+			switch v := v.(*ZkQueryResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6369,7 +7030,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkFetchProofList); i {
+			switch v := v.(*ZkL2History); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6381,7 +7042,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkContentHash); i {
+			switch v := v.(*ZkReqL2History); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6393,7 +7054,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkOpNFTData); i {
+			switch v := v.(*ZkL2HistoryList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6405,7 +7066,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkOpSwapData); i {
+			switch v := v.(*ZkReceiptLog); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6417,7 +7078,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkOpFeeData); i {
+			switch v := v.(*ZkQueryProofReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6429,7 +7090,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkSetPubKeyData); i {
+			switch v := v.(*ZkQueryProofResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6441,7 +7102,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*L1PriorityID); i {
+			switch v := v.(*ZkFetchProofList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6453,7 +7114,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptL1PriorityID); i {
+			switch v := v.(*ZkContentHash); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6465,7 +7126,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MerkleTreeProof); i {
+			switch v := v.(*ZkOpNFTData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6477,7 +7138,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkReceiptLeaf); i {
+			switch v := v.(*ZkOpSwapData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6489,7 +7150,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkAcctRollbackInfo); i {
+			switch v := v.(*ZkOpFeeData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6501,7 +7162,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkExodusRollbackModeParm); i {
+			switch v := v.(*ZkSetPubKeyData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6513,7 +7174,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkExodusMode); i {
+			switch v := v.(*L1PriorityID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6525,7 +7186,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptExodusMode); i {
+			switch v := v.(*ReceiptL1PriorityID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6537,7 +7198,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptSetTokenSymbol); i {
+			switch v := v.(*MerkleTreeProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6549,7 +7210,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LastOnChainProof); i {
+			switch v := v.(*ZkReceiptLeaf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6561,7 +7222,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HistoryAccountProofInfo); i {
+			switch v := v.(*ZkAcctRollbackInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6573,7 +7234,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkReqExistenceProof); i {
+			switch v := v.(*ZkExodusRollbackModeParm); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6585,7 +7246,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkQueryTxOperationReq); i {
+			switch v := v.(*ZkExodusMode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6597,7 +7258,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkExodusBatchProofReq); i {
+			switch v := v.(*ReceiptExodusMode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6609,7 +7270,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptL2LastQueueID); i {
+			switch v := v.(*ReceiptSetTokenSymbol); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6621,7 +7282,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptL2FirstQueueID); i {
+			switch v := v.(*LastOnChainProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6633,7 +7294,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptL2QueueIDData); i {
+			switch v := v.(*HistoryAccountProofInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6645,7 +7306,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProofId2QueueIdData); i {
+			switch v := v.(*ZkReqExistenceProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6657,7 +7318,7 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptProofId2QueueIDData); i {
+			switch v := v.(*ZkQueryTxOperationReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6669,6 +7330,78 @@ func file_zksync_proto_init() {
 			}
 		}
 		file_zksync_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ZkExodusBatchProofReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zksync_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptL2LastQueueID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zksync_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptL2FirstQueueID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zksync_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptL2QueueIDData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zksync_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProofId2QueueIdData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zksync_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptProofId2QueueIDData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zksync_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Priority2QueueId); i {
 			case 0:
 				return &v.state
@@ -6701,11 +7434,13 @@ func file_zksync_proto_init() {
 		(*ZksyncAction_SetFee)(nil),
 		(*ZksyncAction_SetTokenSymbol)(nil),
 		(*ZksyncAction_SetExodusMode)(nil),
+		(*ZksyncAction_Batch)(nil),
+		(*ZksyncAction_SwapCancel)(nil),
 		(*ZksyncAction_Transfer)(nil),
 		(*ZksyncAction_Withdraw)(nil),
 		(*ZksyncAction_TransferToExec)(nil),
 	}
-	file_zksync_proto_msgTypes[53].OneofWrappers = []interface{}{
+	file_zksync_proto_msgTypes[59].OneofWrappers = []interface{}{
 		(*ZkExodusMode_Rollback)(nil),
 	}
 	type x struct{}
@@ -6714,7 +7449,7 @@ func file_zksync_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_zksync_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   67,
+			NumMessages:   73,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
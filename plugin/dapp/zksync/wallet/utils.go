@@ -402,6 +402,75 @@ func GetWithdrawNFTMsg(payload *zt.ZkWithdrawNFT) *zt.ZkMsg {
 
 }
 
+//GetSwapOrderMsg 挂单的签名信息
+func GetSwapOrderMsg(order *zt.ZkSwapOrder) *zt.ZkMsg {
+	var pubData []uint
+
+	binaryData := make([]uint, zt.MsgWidth)
+
+	pubData = append(pubData, getBigEndBitsWithFixLen(new(big.Int).SetUint64(zt.TySwapAction), zt.TxTypeBitWidth)...)
+	pubData = append(pubData, getBigEndBitsWithFixLen(new(big.Int).SetUint64(order.AccountId), zt.AccountBitWidth)...)
+	pubData = append(pubData, getBigEndBitsWithFixLen(new(big.Int).SetUint64(order.TokenSell), zt.TokenBitWidth)...)
+	pubData = append(pubData, getBigEndBitsWithFixLen(new(big.Int).SetUint64(order.TokenBuy), zt.TokenBitWidth)...)
+	amount, _ := new(big.Int).SetString(order.Amount, 10)
+	pubData = append(pubData, getBigEndBitsWithFixLen(amount, zt.AmountBitWidth)...)
+	ratioSell, _ := new(big.Int).SetString(order.RatioSell, 10)
+	pubData = append(pubData, getBigEndBitsWithFixLen(ratioSell, zt.AmountBitWidth)...)
+	ratioBuy, _ := new(big.Int).SetString(order.RatioBuy, 10)
+	pubData = append(pubData, getBigEndBitsWithFixLen(ratioBuy, zt.AmountBitWidth)...)
+	pubData = append(pubData, getBigEndBitsWithFixLen(new(big.Int).SetUint64(order.Nonce), zt.NonceBitWidth)...)
+	pubData = append(pubData, getBigEndBitsWithFixLen(new(big.Int).SetUint64(uint64(order.Expiry)), zt.TimeBitWidth)...)
+	copy(binaryData, pubData)
+
+	return &zt.ZkMsg{
+		First:  setBeBitsToVal(binaryData[:zt.MsgFirstWidth]),
+		Second: setBeBitsToVal(binaryData[zt.MsgFirstWidth : zt.MsgFirstWidth+zt.MsgSecondWidth]),
+		Third:  setBeBitsToVal(binaryData[zt.MsgFirstWidth+zt.MsgSecondWidth:]),
+	}
+}
+
+//GetSwapMsg 撮合交易的签名信息，包括双方账户、token和成交数量
+func GetSwapMsg(payload *zt.ZkSwap) *zt.ZkMsg {
+	var pubData []uint
+
+	binaryData := make([]uint, zt.MsgWidth)
+
+	pubData = append(pubData, getBigEndBitsWithFixLen(new(big.Int).SetUint64(zt.TySwapAction), zt.TxTypeBitWidth)...)
+	pubData = append(pubData, getBigEndBitsWithFixLen(new(big.Int).SetUint64(payload.GetLeft().GetAccountId()), zt.AccountBitWidth)...)
+	pubData = append(pubData, getBigEndBitsWithFixLen(new(big.Int).SetUint64(payload.GetRight().GetAccountId()), zt.AccountBitWidth)...)
+	pubData = append(pubData, getBigEndBitsWithFixLen(new(big.Int).SetUint64(payload.GetLeft().GetTokenSell()), zt.TokenBitWidth)...)
+	pubData = append(pubData, getBigEndBitsWithFixLen(new(big.Int).SetUint64(payload.GetRight().GetTokenSell()), zt.TokenBitWidth)...)
+	leftAmount, _ := new(big.Int).SetString(payload.LeftDealAmount, 10)
+	pubData = append(pubData, getBigEndBitsWithFixLen(leftAmount, zt.AmountBitWidth)...)
+	rightAmount, _ := new(big.Int).SetString(payload.RightDealAmount, 10)
+	pubData = append(pubData, getBigEndBitsWithFixLen(rightAmount, zt.AmountBitWidth)...)
+	copy(binaryData, pubData)
+
+	return &zt.ZkMsg{
+		First:  setBeBitsToVal(binaryData[:zt.MsgFirstWidth]),
+		Second: setBeBitsToVal(binaryData[zt.MsgFirstWidth : zt.MsgFirstWidth+zt.MsgSecondWidth]),
+		Third:  setBeBitsToVal(binaryData[zt.MsgFirstWidth+zt.MsgSecondWidth:]),
+	}
+}
+
+//GetSwapCancelMsg 撤销挂单的签名信息
+func GetSwapCancelMsg(payload *zt.ZkSwapCancel) *zt.ZkMsg {
+	var pubData []uint
+
+	binaryData := make([]uint, zt.MsgWidth)
+
+	pubData = append(pubData, getBigEndBitsWithFixLen(new(big.Int).SetUint64(zt.TySwapCancelAction), zt.TxTypeBitWidth)...)
+	pubData = append(pubData, getBigEndBitsWithFixLen(new(big.Int).SetUint64(payload.AccountId), zt.AccountBitWidth)...)
+	pubData = append(pubData, getBigEndBitsWithFixLen(new(big.Int).SetUint64(payload.Nonce), zt.NonceBitWidth)...)
+	copy(binaryData, pubData)
+
+	return &zt.ZkMsg{
+		First:  setBeBitsToVal(binaryData[:zt.MsgFirstWidth]),
+		Second: setBeBitsToVal(binaryData[zt.MsgFirstWidth : zt.MsgFirstWidth+zt.MsgSecondWidth]),
+		Third:  setBeBitsToVal(binaryData[zt.MsgFirstWidth+zt.MsgSecondWidth:]),
+	}
+}
+
 func GetMsgHash(msg *zt.ZkMsg) []byte {
 	hash := mimc.NewMiMC(zt.ZkMimcHashSeed)
 	hash.Write(StringToByte(msg.GetFirst()))
@@ -249,6 +249,41 @@ func (policy *zksyncPolicy) SignTransaction(key crypto.PrivKey, req *types.ReqSi
 			return
 		}
 		nft.Signature = signInfo
+	case zt.TySwapAction:
+		//钱包只对left挂单和撮合信息签名，right挂单需要由对方预先签名
+		swap := action.GetSwap()
+		if swap.GetLeft() == nil || swap.GetRight().GetSignature() == nil {
+			return false, "", errors.Wrapf(types.ErrInvalidParam, "swap need right order signed")
+		}
+		signInfo, err = SignTx(GetSwapOrderMsg(swap.Left), privateKey)
+		if err != nil {
+			bizlog.Error("SignTransaction", "eddsa.signTx error", err)
+			return
+		}
+		swap.Left.Signature = signInfo
+		msg = GetSwapMsg(swap)
+		signInfo, err = SignTx(msg, privateKey)
+		if err != nil {
+			bizlog.Error("SignTransaction", "eddsa.signTx error", err)
+			return
+		}
+		swap.Signature = signInfo
+	case zt.TySwapCancelAction:
+		swapCancel := action.GetSwapCancel()
+		msg := GetSwapCancelMsg(swapCancel)
+		signInfo, err = SignTx(msg, privateKey)
+		if err != nil {
+			bizlog.Error("SignTransaction", "eddsa.signTx error", err)
+			return
+		}
+		swapCancel.Signature = signInfo
+	case zt.TyBatchAction:
+		//已签名的操作保留原签名，未签名的操作由钱包签名
+		err = SignBatchOps(action.GetBatch(), privateKey)
+		if err != nil {
+			bizlog.Error("SignTransaction", "SignBatchOps error", err)
+			return
+		}
 	}
 
 	tx.Payload = types.Encode(action)
@@ -258,6 +293,39 @@ func (policy *zksyncPolicy) SignTransaction(key crypto.PrivKey, req *types.ReqSi
 	return
 }
 
+//SignBatchOps 对batch中未签名的transfer, withdraw, transferToNew操作签名
+func SignBatchOps(batch *zt.ZkBatch, privateKey eddsa.PrivateKey) error {
+	for i, op := range batch.GetOps() {
+		var err error
+		switch op.GetTy() {
+		case zt.TyWithdrawAction:
+			withdraw := op.GetZkWithdraw()
+			if withdraw == nil || withdraw.Signature != nil {
+				continue
+			}
+			withdraw.Signature, err = SignTx(GetWithdrawMsg(withdraw), privateKey)
+		case zt.TyTransferAction:
+			transfer := op.GetZkTransfer()
+			if transfer == nil || transfer.Signature != nil {
+				continue
+			}
+			transfer.Signature, err = SignTx(GetTransferMsg(transfer), privateKey)
+		case zt.TyTransferToNewAction:
+			transferToNew := op.GetTransferToNew()
+			if transferToNew == nil || transferToNew.Signature != nil {
+				continue
+			}
+			transferToNew.Signature, err = SignTx(GetTransferToNewMsg(transferToNew), privateKey)
+		default:
+			return errors.Wrapf(types.ErrNotAllow, "batch op index=%d ty=%d not support", i, op.GetTy())
+		}
+		if err != nil {
+			return errors.Wrapf(err, "batch op index=%d", i)
+		}
+	}
+	return nil
+}
+
 func SignTx(msg *zt.ZkMsg, privateKey eddsa.PrivateKey) (*zt.ZkSignature, error) {
 	signInfo, err := privateKey.Sign(GetMsgHash(msg), mimc.NewMiMC(zt.ZkMimcHashSeed))
 	if err != nil {